@@ -29,6 +29,9 @@ import (
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="READY",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="REASON",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="SUBSCRIBER",type="boolean",JSONPath=`.status.subscriber.ready`
 
 // OcDemo is the Schema for the ocdemoes API.
 type OcDemo struct {
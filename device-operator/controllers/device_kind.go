@@ -0,0 +1,103 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	device "github.com/nttcom/kuesta/pkg/device"
+	"github.com/nttcom/kuesta/pkg/model"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeviceKind is the registration of the device kind reconciled by the DeviceReconciler.
+type DeviceKind struct {
+	// Kind is the name of the custom resource kind, such as OcDemo.
+	Kind string
+
+	// NewObject returns the empty object of the kind.
+	NewObject func() device.Object
+
+	// NewList returns the empty list of the kind.
+	NewList func() client.ObjectList
+
+	// Model is the name of the device model in model.DefaultRegistry with which the device config is converted into
+	// the gNMI SetRequest and the subscriber decodes the device config. The schema-less model is used if empty.
+	Model string
+}
+
+// ModelName returns the name of the device model of the kind.
+func (k DeviceKind) ModelName() string {
+	if k.Model == "" {
+		return model.SchemalessModelName
+	}
+	return k.Model
+}
+
+var (
+	deviceKindsMu sync.RWMutex
+	deviceKinds   = map[string]DeviceKind{}
+)
+
+// RegisterDeviceKind registers the device kind so that the operator reconciles it. It is usually called in init of
+// the controller file of the kind, and panics if the kind is already registered.
+func RegisterDeviceKind(k DeviceKind) {
+	deviceKindsMu.Lock()
+	defer deviceKindsMu.Unlock()
+	if _, ok := deviceKinds[k.Kind]; ok {
+		panic(fmt.Sprintf("device kind %s is already registered", k.Kind))
+	}
+	deviceKinds[k.Kind] = k
+}
+
+// DeviceKinds returns the registered device kinds sorted by kind.
+func DeviceKinds() []DeviceKind {
+	deviceKindsMu.RLock()
+	defer deviceKindsMu.RUnlock()
+	kinds := make([]DeviceKind, 0, len(deviceKinds))
+	for _, k := range deviceKinds {
+		kinds = append(kinds, k)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i].Kind < kinds[j].Kind })
+	return kinds
+}
+
+// LookupDeviceKinds returns the registered device kinds of the given names, or all of them if names is empty.
+func LookupDeviceKinds(names ...string) ([]DeviceKind, error) {
+	if len(names) == 0 {
+		return DeviceKinds(), nil
+	}
+	deviceKindsMu.RLock()
+	defer deviceKindsMu.RUnlock()
+	kinds := make([]DeviceKind, 0, len(names))
+	for _, name := range names {
+		k, ok := deviceKinds[name]
+		if !ok {
+			return nil, fmt.Errorf("device kind %s is not registered", name)
+		}
+		kinds = append(kinds, k)
+	}
+	return kinds, nil
+}
@@ -25,76 +25,162 @@ package controllers
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
 	"os"
+	"path/filepath"
 	"time"
 
-	"cuelang.org/go/cue"
-	"cuelang.org/go/cue/cuecontext"
-	fluxcd "github.com/fluxcd/source-controller/api/v1beta2"
-	deviceoperator "github.com/nttcom/kuesta/device-operator/api/v1alpha1"
-	"github.com/nttcom/kuesta/device-operator/internal"
-	"github.com/nttcom/kuesta/device-operator/internal/model"
 	"github.com/nttcom/kuesta/pkg/artifact"
-	kcue "github.com/nttcom/kuesta/pkg/cue"
 	device "github.com/nttcom/kuesta/pkg/device"
 	"github.com/nttcom/kuesta/pkg/kuesta"
+	"github.com/nttcom/kuesta/pkg/model"
 	"github.com/nttcom/kuesta/pkg/stacktrace"
 	provisioner "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	"github.com/nttcom/kuesta/provisioner/pkg/rolloutsource"
 	gclient "github.com/openconfig/gnmi/client"
 	gnmiclient "github.com/openconfig/gnmi/client/gnmi"
-	gnmiproto "github.com/openconfig/gnmi/proto/gnmi"
-	"github.com/openconfig/ygot/ygot"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/prototext"
 	core "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
-var (
-	subscriberImage        string
-	subscriberImageVersion string
-	aggregatorUrl          string
-)
+// DefaultArtifactCacheDirName is the name of the directory of the artifact cache created under the temp dir by default.
+const DefaultArtifactCacheDirName = "kuesta-artifacts"
+
+// subscriberConfig is the settings of the subscriber Pods loaded from the env vars.
+var subscriberConfig SubscriberConfig
 
 func SetupEnv() {
-	subscriberImage = internal.MustGetEnv("KUESTA_SUBSCRIBER_IMAGE")
-	subscriberImageVersion = internal.MustGetEnv("KUESTA_SUBSCRIBER_IMAGE_VERSION")
-	aggregatorUrl = internal.MustGetEnv("KUESTA_AGGREGATOR_URL")
+	subscriberConfig = LoadSubscriberConfig()
+}
+
+// DeviceReconciler reconciles the devices of a device kind.
+type DeviceReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// ArtifactCache is the cache of the config repository artifacts shared by the device reconcilers. The cache
+	// under the temp dir with the default size is created if nil.
+	ArtifactCache *artifact.Cache
+
+	// Kind is the device kind to reconcile.
+	Kind DeviceKind
+
+	model model.Model
+}
+
+//+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories;ocirepositories;buckets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// SetupWithManager sets up the controller of the device kind with the Manager, and indexes the devices by the
+// DeviceRollout to which they belong.
+func (r *DeviceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	m, err := model.Get(r.Kind.ModelName())
+	if err != nil {
+		return fmt.Errorf("get model of %s: %w", r.Kind.Kind, err)
+	}
+	r.model = m
+	if r.ArtifactCache == nil {
+		c, err := artifact.NewCache(filepath.Join(os.TempDir(), DefaultArtifactCacheDirName), artifact.DefaultCacheSize)
+		if err != nil {
+			return err
+		}
+		r.ArtifactCache = c
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), r.Kind.NewObject(), device.RefField, func(rawObj client.Object) []string {
+		d := rawObj.(device.Object)
+		if d.GetDevice().Spec.RolloutRef == "" {
+			return nil
+		}
+		return []string{d.GetDevice().Spec.RolloutRef}
+	}); err != nil {
+		return fmt.Errorf("index %s by %s: %w", r.Kind.Kind, device.RefField, err)
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), r.Kind.NewObject(), device.SecretRefField, func(rawObj client.Object) []string {
+		spec := &rawObj.(device.Object).GetDevice().Spec
+		var names []string
+		for _, name := range []string{spec.ConnectionInfo.SecretName, spec.TLS.SecretName} {
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+		return names
+	}); err != nil {
+		return fmt.Errorf("index %s by %s: %w", r.Kind.Kind, device.SecretRefField, err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.Kind.NewObject()).
+		Owns(&core.Pod{}).
+		Watches(
+			&source.Kind{Type: &provisioner.DeviceRollout{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectForDeviceRollout),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&source.Kind{Type: &core.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForSecret),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+func (r *DeviceReconciler) getDevice(ctx context.Context, nsName types.NamespacedName) (device.Object, error) {
+	d := r.Kind.NewObject()
+	if err := r.Get(ctx, nsName, d); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return d, nil
 }
 
-func (r *DeviceReconciler) DoReconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *DeviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	l := log.FromContext(ctx)
 	l.Info("start reconciliation")
 
-	if err := r.createSubscriberPodIfNotExist(ctx, req.NamespacedName); err != nil {
-		r.Error(ctx, err, "create subscriberPod")
+	if err := r.reconcileSubscriber(ctx, req.NamespacedName); err != nil {
+		r.Error(ctx, err, "reconcile subscriber")
 		return ctrl.Result{}, err
 	}
 
-	device, err := r.getDevice(ctx, req.NamespacedName)
+	d, err := r.getDevice(ctx, req.NamespacedName)
 	if err != nil {
 		r.Error(ctx, err, "get Device resource")
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	dev := d.GetDevice()
 
 	// force set checksum and lastApplied config when baseRevision updated
-	if device.Spec.BaseRevision != device.Status.BaseRevision {
+	if dev.Spec.BaseRevision != dev.Status.BaseRevision {
 		if err := r.forceReplaceLastApplied(ctx, req); err != nil {
 			r.Error(ctx, err, "force update status to the one given by baseRevision")
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{Requeue: true}, nil
 	}
-	if device.Status.LastApplied == nil {
-		if device.Spec.DiffOnly {
+	if dev.Status.LastApplied == nil {
+		if dev.Spec.DiffOnly {
 			l.Info("reconcile stopped: lastApplied config is not set. you must initialize lastApplied config to update device config automatically")
 			return ctrl.Result{}, nil
 		}
@@ -102,45 +188,58 @@ func (r *DeviceReconciler) DoReconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	var dr provisioner.DeviceRollout
-	if err := r.Get(ctx, types.NamespacedName{Namespace: device.Namespace, Name: device.Spec.RolloutRef}, &dr); err != nil {
+	if err := r.Get(ctx, types.NamespacedName{Namespace: d.GetNamespace(), Name: dev.Spec.RolloutRef}, &dr); err != nil {
 		r.Error(ctx, err, "get DeviceRollout")
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
-	if dr.Status.GetDeviceStatus(device.Name) != provisioner.DeviceStatusRunning {
-		l.Info("reconcile skipped: device status is not running")
+	// NOTE devices pending for the later batches are provisioned when their batch is started
+	if status := dr.Status.GetDeviceStatus(d.GetName()); status != provisioner.DeviceStatusRunning {
+		l.Info("reconcile skipped: device status is not running", "status", status)
 		return ctrl.Result{}, nil
 	}
 
-	next := dr.Status.ResolveNextDeviceConfig(device.Name)
+	next := dr.Status.ResolveNextDeviceConfig(d.GetName())
 	if next == nil || next.Checksum == "" {
 		l.Info("device data is not stored at git repository")
 		return ctrl.Result{}, nil
 	}
-	if next.Checksum == device.Status.Checksum {
-		l.Info(fmt.Sprintf("already provisioned: revision=%s", next.GitRevision))
-		if err := r.updateRolloutStatus(ctx, dr, device.Name, provisioner.DeviceStatusCompleted); err != nil {
+	if next.Checksum == dev.Status.Checksum {
+		msg := fmt.Sprintf("already provisioned: revision=%s", next.GitRevision)
+		l.Info(msg)
+		if err := r.finishProvision(ctx, d.DeepCopyObject().(client.Object), d, dr, provisioner.DeviceStatusCompleted, msg); err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
 	}
 	l.Info(fmt.Sprintf("next: revision=%s", next.GitRevision))
 
-	var gr fluxcd.GitRepository
-	if err := r.Get(ctx, types.NamespacedName{Namespace: dr.Namespace, Name: dr.Name}, &gr); err != nil {
-		r.Error(ctx, err, "get GitRepository")
+	if !meta.IsStatusConditionTrue(dev.Status.Conditions, device.ConditionTypeProgressing) {
+		msg := fmt.Sprintf("provisioning revision %s", next.GitRevision)
+		old := d.DeepCopyObject().(client.Object)
+		dev.Status.MarkProvisioning(d.GetGeneration(), msg)
+		if err := r.Status().Patch(ctx, d, client.MergeFrom(old)); err != nil {
+			r.Error(ctx, err, "patch Device")
+			return ctrl.Result{}, err
+		}
+		r.event(d, core.EventTypeNormal, device.ReasonProvisioning, msg)
+	}
+
+	src, err := rolloutsource.New(ctx, r, &dr)
+	if err != nil {
+		r.Error(ctx, err, "get source")
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	dp, checksum, err := fetchArtifact(ctx, gr, device.Name, "")
+	dp, checksum, release, err := r.fetchArtifact(ctx, src, &dr, d.GetName(), next.GitRevision)
 	if err != nil {
 		r.Error(ctx, err, "failed to fetch device config. re-check after 10 seconds")
 		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
 	}
-	defer os.RemoveAll(dp.RootDir)
+	defer release()
 	if checksum != next.Checksum {
 		err = fmt.Errorf("checksum is different: want=%s, got=%s", next.Checksum, checksum)
 		r.Error(ctx, err, "check checksum")
-		if err := r.updateRolloutStatus(ctx, dr, device.Name, provisioner.DeviceStatusChecksumError); err != nil {
+		if err := r.failProvision(ctx, d, dr, provisioner.DeviceStatusChecksumError, device.ReasonChecksumMismatch, err.Error()); err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
@@ -151,31 +250,31 @@ func (r *DeviceReconciler) DoReconcile(ctx context.Context, req ctrl.Request) (c
 		r.Error(ctx, err, "read device config")
 		return ctrl.Result{}, err
 	}
-	sr, err := makeSetRequest(newBuf, device.Status.LastApplied)
+	sr, err := r.model.SetRequest(newBuf, dev.Status.LastApplied)
 	if err != nil {
 		r.Error(ctx, err, "make gnmi SetRequest")
 		return ctrl.Result{}, err
 	}
-	l.V(1).Info("gnmi SetRequest payload", "updated", sr.GetUpdate(), "deleted", sr.GetDelete())
+	l.V(1).Info("gnmi SetRequest payload", "updated", sr.GetUpdate(), "replaced", sr.GetReplace(), "deleted", sr.GetDelete())
 
 	var secret core.Secret
 	var tlsData, credData map[string][]byte
 
-	if device.Spec.TLS.SecretName != "" {
-		if err := r.Get(ctx, types.NamespacedName{Namespace: device.Namespace, Name: device.Spec.TLS.SecretName}, &secret); err != nil {
-			r.Error(ctx, err, "get secret for TLS", "secretName", device.Spec.TLS.SecretName)
+	if dev.Spec.TLS.SecretName != "" {
+		if err := r.Get(ctx, types.NamespacedName{Namespace: d.GetNamespace(), Name: dev.Spec.TLS.SecretName}, &secret); err != nil {
+			r.Error(ctx, err, "get secret for TLS", "secretName", dev.Spec.TLS.SecretName)
 			return ctrl.Result{}, nil
 		}
 		tlsData = secret.Data
 	}
-	if device.Spec.ConnectionInfo.SecretName != "" {
-		if err := r.Get(ctx, types.NamespacedName{Namespace: device.Namespace, Name: device.Spec.ConnectionInfo.SecretName}, &secret); err != nil {
-			r.Error(ctx, err, "get secret for credential", "secretName", device.Spec.ConnectionInfo.SecretName)
+	if dev.Spec.ConnectionInfo.SecretName != "" {
+		if err := r.Get(ctx, types.NamespacedName{Namespace: d.GetNamespace(), Name: dev.Spec.ConnectionInfo.SecretName}, &secret); err != nil {
+			r.Error(ctx, err, "get secret for credential", "secretName", dev.Spec.ConnectionInfo.SecretName)
 			return ctrl.Result{}, nil
 		}
 		credData = secret.Data
 	}
-	dest, err := device.Spec.GnmiDestination(tlsData, credData)
+	dest, err := dev.Spec.GnmiDestination(tlsData, credData)
 	if err != nil {
 		r.Error(ctx, err, "make gnmi SetRequest")
 		return ctrl.Result{}, err
@@ -189,7 +288,8 @@ func (r *DeviceReconciler) DoReconcile(ctx context.Context, req ctrl.Request) (c
 	}
 	if err != nil {
 		r.Error(ctx, err, "failed to create gNMI client and connect for 3 times. mark as ConnectionError")
-		if err := r.updateRolloutStatus(ctx, dr, device.Name, provisioner.DeviceStatusConnectionError); err != nil {
+		msg := fmt.Sprintf("failed to connect: %v", err)
+		if err := r.failProvision(ctx, d, dr, provisioner.DeviceStatusConnectionError, device.ReasonConnectionError, msg); err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
@@ -200,22 +300,20 @@ func (r *DeviceReconciler) DoReconcile(ctx context.Context, req ctrl.Request) (c
 
 	if gnmiSetErr != nil {
 		r.Error(ctx, gnmiSetErr, "apply Set")
-		if err := r.updateRolloutStatus(ctx, dr, device.Name, provisioner.DeviceStatusFailed); err != nil {
+		msg := fmt.Sprintf("failed to apply revision %s: %v", next.GitRevision, gnmiSetErr)
+		if err := r.failProvision(ctx, d, dr, provisioner.DeviceStatusFailed, device.ReasonProvisionFailed, msg); err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
 	}
 
 	l.V(1).Info("succeeded SetRequest", "response", prototext.Format(resp))
-	oldDevice := device.DeepCopy()
-	device.Status.Checksum = next.Checksum
-	device.Status.LastApplied = newBuf
+	old := d.DeepCopyObject().(client.Object)
+	dev.Status.Checksum = next.Checksum
+	dev.Status.LastApplied = newBuf
 
-	if err := r.Status().Patch(ctx, device, client.MergeFrom(oldDevice)); err != nil {
-		r.Error(ctx, err, "patch Device")
-		return ctrl.Result{}, err
-	}
-	if err := r.updateRolloutStatus(ctx, dr, device.Name, provisioner.DeviceStatusCompleted); err != nil {
+	msg := fmt.Sprintf("provisioned revision %s", next.GitRevision)
+	if err := r.finishProvision(ctx, old, d, dr, provisioner.DeviceStatusCompleted, msg); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -228,13 +326,75 @@ func (r *DeviceReconciler) Error(ctx context.Context, err error, msg string, kvs
 		l = l.WithValues("stacktrace", st)
 	}
 	l.Error(err, msg, kvs...)
-	// TODO Event emission
 }
 
+func (r *DeviceReconciler) event(obj runtime.Object, eventType, reason, msg string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(obj, eventType, reason, msg)
+}
+
+// finishProvision records that the device is provisioned on the Device status conditions and the DeviceRollout,
+// and emits the events to both of them. The Device status is patched from old to d.
+func (r *DeviceReconciler) finishProvision(ctx context.Context, old client.Object, d device.Object, dr provisioner.DeviceRollout, status provisioner.DeviceStatus, msg string) error {
+	dev := d.GetDevice()
+	rolledBack := dr.Status.Phase == provisioner.RolloutPhaseRollback
+	dev.Status.MarkProvisioned(d.GetGeneration(), rolledBack, msg)
+	if err := r.Status().Patch(ctx, d, client.MergeFrom(old)); err != nil {
+		r.Error(ctx, err, "patch Device")
+		return errors.WithStack(err)
+	}
+	if err := r.updateRolloutStatus(ctx, dr, d.GetName(), status); err != nil {
+		return err
+	}
+	reason := device.ReasonProvisioned
+	if rolledBack {
+		reason = device.ReasonRolledBack
+	}
+	r.event(d, core.EventTypeNormal, reason, msg)
+	r.event(&dr, core.EventTypeNormal, provisioner.ReasonDeviceProvisioned, fmt.Sprintf("%s: %s", d.GetName(), msg))
+	return nil
+}
+
+// failProvision records that the device is failed to be provisioned on the Device status conditions and the
+// DeviceRollout, and emits the warning events to both of them.
+func (r *DeviceReconciler) failProvision(ctx context.Context, d device.Object, dr provisioner.DeviceRollout, status provisioner.DeviceStatus, reason, msg string) error {
+	dev := d.GetDevice()
+	old := d.DeepCopyObject().(client.Object)
+	dev.Status.MarkFailed(d.GetGeneration(), reason, msg)
+	if err := r.Status().Patch(ctx, d, client.MergeFrom(old)); err != nil {
+		r.Error(ctx, err, "patch Device")
+		return errors.WithStack(err)
+	}
+	if err := r.updateRolloutStatus(ctx, dr, d.GetName(), status); err != nil {
+		return err
+	}
+	drReason := provisioner.ReasonDeviceFailed
+	if reason == device.ReasonChecksumMismatch {
+		drReason = provisioner.ReasonChecksumMismatch
+	}
+	r.event(d, core.EventTypeWarning, reason, msg)
+	r.event(&dr, core.EventTypeWarning, drReason, fmt.Sprintf("%s: %s", d.GetName(), msg))
+	return nil
+}
+
+// updateRolloutStatus records the device status to the DeviceRollout. It is not recorded if the device is no longer
+// running, since its status is changed meanwhile by the provisioner such as Timeout.
 func (r *DeviceReconciler) updateRolloutStatus(ctx context.Context, dr provisioner.DeviceRollout, name string, status provisioner.DeviceStatus) error {
-	oldDr := dr.DeepCopy()
-	dr.Status.SetDeviceStatus(name, status)
-	if err := r.Status().Patch(ctx, &dr, client.MergeFrom(oldDr)); err != nil {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(&dr), &dr); err != nil {
+			return err
+		}
+		if dr.Status.GetDeviceStatus(name) != provisioner.DeviceStatusRunning {
+			log.FromContext(ctx).Info("device status not updated since it is no longer running", "status", dr.Status.GetDeviceStatus(name))
+			return nil
+		}
+		oldDr := dr.DeepCopy()
+		dr.Status.SetDeviceStatus(name, status)
+		return r.Status().Patch(ctx, &dr, client.MergeFromWithOptions(oldDr, client.MergeFromWithOptimisticLock{}))
+	})
+	if err != nil {
 		r.Error(ctx, err, "update DeviceRollout")
 		return errors.WithStack(err)
 	}
@@ -242,86 +402,179 @@ func (r *DeviceReconciler) updateRolloutStatus(ctx context.Context, dr provision
 }
 
 func (r *DeviceReconciler) forceReplaceLastApplied(ctx context.Context, req ctrl.Request) error {
-	device, err := r.getDevice(ctx, req.NamespacedName)
+	d, err := r.getDevice(ctx, req.NamespacedName)
 	if err != nil {
 		return client.IgnoreNotFound(err)
 	}
+	dev := d.GetDevice()
 
 	var dr provisioner.DeviceRollout
 	if err := r.Get(ctx, types.NamespacedName{
-		Namespace: device.Namespace,
-		Name:      device.Spec.RolloutRef,
+		Namespace: d.GetNamespace(),
+		Name:      dev.Spec.RolloutRef,
 	}, &dr); err != nil {
 		return client.IgnoreNotFound(err)
 	}
 
-	var gr fluxcd.GitRepository
-	if err := r.Get(ctx, types.NamespacedName{
-		Namespace: dr.Namespace,
-		Name:      dr.Name,
-	}, &gr); err != nil {
+	src, err := rolloutsource.New(ctx, r, &dr)
+	if err != nil {
 		return client.IgnoreNotFound(err)
 	}
 
-	dp, checksum, err := fetchArtifact(ctx, gr, device.Name, device.Spec.BaseRevision)
+	dp, checksum, release, err := r.fetchArtifact(ctx, src, &dr, d.GetName(), dev.Spec.BaseRevision)
 	if err != nil {
 		return fmt.Errorf("fetch device config: %w", err)
 	}
-	defer os.RemoveAll(dp.RootDir)
+	defer release()
 
 	buf, err := dp.ReadDeviceConfigFile()
 	if err != nil {
 		return fmt.Errorf("read device config: %w", err)
 	}
 
-	old := device.DeepCopy()
-	device.Status.LastApplied = buf
-	device.Status.Checksum = checksum
-	device.Status.BaseRevision = device.Spec.BaseRevision
-	if err := r.Status().Patch(ctx, device, client.MergeFrom(old)); err != nil {
+	old := d.DeepCopyObject().(client.Object)
+	dev.Status.LastApplied = buf
+	dev.Status.Checksum = checksum
+	dev.Status.BaseRevision = dev.Spec.BaseRevision
+	if err := r.Status().Patch(ctx, d, client.MergeFrom(old)); err != nil {
 		return fmt.Errorf("patch DeviceRollout: %w", client.IgnoreNotFound(err))
 	}
 	return nil
 }
 
-func (r *DeviceReconciler) createSubscriberPodIfNotExist(ctx context.Context, nsName types.NamespacedName) error {
+// reconcileSubscriber reconciles the subscriber Pod of the device, and records the observed state of the subscriber
+// in the device status. The subscriber shards are reconciled by the SubscriberShardReconciler instead if enabled.
+func (r *DeviceReconciler) reconcileSubscriber(ctx context.Context, nsName types.NamespacedName) error {
+	if subscriberConfig.Shards > 0 {
+		// NOTE the subscriber Pod left by the operator running without shards must not report the device twice
+		var p core.Pod
+		if err := r.Get(ctx, types.NamespacedName{Namespace: nsName.Namespace, Name: subscriberPodName(nsName.Name)}, &p); err != nil {
+			return client.IgnoreNotFound(errors.WithStack(err))
+		}
+		if err := r.Delete(ctx, &p); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete subscriber Pod: %w", errors.WithStack(err))
+		}
+		return nil
+	}
+
 	d, err := r.getDevice(ctx, nsName)
 	if err != nil {
 		return client.IgnoreNotFound(err)
 	}
-
-	subscriberPod := newSubscribePod(nsName, &d.Spec)
-	var p core.Pod
-	if err := r.Get(ctx, client.ObjectKeyFromObject(subscriberPod), &p); err == nil {
+	dev := d.GetDevice()
+	if !d.GetDeletionTimestamp().IsZero() {
 		return nil
-	} else if !apierrors.IsNotFound(err) {
-		return fmt.Errorf("get subscriber Pod: %w", err)
 	}
 
-	if err := ctrl.SetControllerReference(d, subscriberPod, r.Scheme); err != nil {
-		return fmt.Errorf("create subscriber Pod: %w", err)
+	status, err := r.reconcileSubscriberPod(ctx, d)
+	if err != nil {
+		return fmt.Errorf("reconcile subscriber Pod: %w", err)
 	}
-	if err := r.Create(ctx, subscriberPod); err != nil {
-		return fmt.Errorf("create subscriber subscriberPod: %w", err)
+	if dev.Status.Subscriber == status {
+		return nil
+	}
+
+	old := d.DeepCopyObject().(client.Object)
+	dev.Status.Subscriber = status
+	if err := r.Status().Patch(ctx, d, client.MergeFrom(old)); err != nil {
+		return fmt.Errorf("patch subscriber status: %w", errors.WithStack(client.IgnoreNotFound(err)))
 	}
 	return nil
 }
 
+// reconcileSubscriberPod creates the subscriber Pod of the device if not exist. The Pod is deleted to be created
+// again on the next reconciliation if it is terminated, or is running with the stale spec or Secrets.
+func (r *DeviceReconciler) reconcileSubscriberPod(ctx context.Context, d device.Object) (device.SubscriberStatus, error) {
+	dev := d.GetDevice()
+	var err error
+	var deviceTLS, credential map[string][]byte
+	if dev.Spec.TLS.SecretName != "" && !dev.Spec.TLS.NoTLS {
+		if deviceTLS, err = getSecretData(ctx, r, d.GetNamespace(), dev.Spec.TLS.SecretName); err != nil {
+			return device.SubscriberStatus{}, fmt.Errorf("get secret for device TLS: %w", err)
+		}
+	}
+	if dev.Spec.ConnectionInfo.SecretName != "" {
+		if credential, err = getSecretData(ctx, r, d.GetNamespace(), dev.Spec.ConnectionInfo.SecretName); err != nil {
+			return device.SubscriberStatus{}, fmt.Errorf("get secret for credential: %w", err)
+		}
+	}
+	aggregatorTLS, err := getAggregatorTLSData(ctx, r, d.GetNamespace())
+	if err != nil {
+		return device.SubscriberStatus{}, err
+	}
+
+	subscriberPod := newSubscribePod(client.ObjectKeyFromObject(d), r.Kind.ModelName(), &dev.Spec, deviceTLS, aggregatorTLS)
+	specHash, err := subscriberSpecHash(&subscriberPod.Spec, credential, deviceTLS, aggregatorTLS)
+	if err != nil {
+		return device.SubscriberStatus{}, fmt.Errorf("hash subscriber Pod spec: %w", err)
+	}
+	subscriberPod.Annotations = map[string]string{AnnotationSubscriberSpecHash: specHash}
+
+	var p core.Pod
+	if err := r.Get(ctx, client.ObjectKeyFromObject(subscriberPod), &p); apierrors.IsNotFound(err) {
+		if err := ctrl.SetControllerReference(d, subscriberPod, r.Scheme); err != nil {
+			return device.SubscriberStatus{}, fmt.Errorf("create subscriber Pod: %w", err)
+		}
+		if err := r.Create(ctx, subscriberPod); err != nil {
+			return device.SubscriberStatus{}, fmt.Errorf("create subscriber Pod: %w", errors.WithStack(err))
+		}
+		return newSubscriberStatus(subscriberPod), nil
+	} else if err != nil {
+		return device.SubscriberStatus{}, fmt.Errorf("get subscriber Pod: %w", errors.WithStack(err))
+	}
+
+	// NOTE the Pod is created again on the reconciliation triggered by its deletion
+	if !p.DeletionTimestamp.IsZero() {
+		return newSubscriberStatus(&p), nil
+	}
+	if reason := needsRestart(&p, specHash); reason != "" {
+		log.FromContext(ctx).Info("recreate subscriber Pod", "reason", reason)
+		if err := r.Delete(ctx, &p, client.Preconditions{UID: &p.UID}); client.IgnoreNotFound(err) != nil {
+			return device.SubscriberStatus{}, fmt.Errorf("delete subscriber Pod: %w", errors.WithStack(err))
+		}
+		status := newSubscriberStatus(&p)
+		status.Ready = false
+		status.Message = "restarting: " + reason
+		return status, nil
+	}
+	return newSubscriberStatus(&p), nil
+}
+
+func getSecretData(ctx context.Context, c client.Reader, namespace, name string) (map[string][]byte, error) {
+	var secret core.Secret
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &secret); err != nil {
+		return nil, fmt.Errorf("get secret %s: %w", name, errors.WithStack(err))
+	}
+	return secret.Data, nil
+}
+
+// getAggregatorTLSData returns the data of the Secret for mTLS to the aggregator, or nil if it is not configured.
+func getAggregatorTLSData(ctx context.Context, c client.Reader, namespace string) (map[string][]byte, error) {
+	if subscriberConfig.AggregatorTLSSecret == "" {
+		return nil, nil
+	}
+	data, err := getSecretData(ctx, c, namespace, subscriberConfig.AggregatorTLSSecret)
+	if err != nil {
+		return nil, fmt.Errorf("get secret for aggregator TLS: %w", err)
+	}
+	return data, nil
+}
+
 func (r *DeviceReconciler) findObjectForDeviceRollout(deviceRollout client.Object) []reconcile.Request {
-	attachedDevices := deviceoperator.NewDeviceList()
 	listOps := &client.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector(device.RefField, deviceRollout.GetName()),
 		Namespace:     deviceRollout.GetNamespace(),
 	}
 
 	ctx := context.TODO()
-	if err := r.List(ctx, attachedDevices, listOps); err != nil {
+	attachedDevices, err := listDevices(ctx, r, r.Kind, listOps)
+	if err != nil {
 		r.Error(ctx, err, "unable to list effected devices")
 		return []reconcile.Request{}
 	}
 
-	requests := make([]reconcile.Request, len(attachedDevices.Items))
-	for i, v := range attachedDevices.Items {
+	requests := make([]reconcile.Request, len(attachedDevices))
+	for i, v := range attachedDevices {
 		requests[i] = reconcile.Request{
 			NamespacedName: types.NamespacedName{
 				Name:      v.GetName(),
@@ -332,104 +585,75 @@ func (r *DeviceReconciler) findObjectForDeviceRollout(deviceRollout client.Objec
 	return requests
 }
 
-func fetchArtifact(ctx context.Context, gr fluxcd.GitRepository, device, revision string) (*kuesta.DevicePath, string, error) {
-	tmpDir, err := ioutil.TempDir("", gr.Name)
+// findObjectsForSecret returns the requests of the devices which use the Secret, so that their subscribers are
+// restarted when the Secret is rotated.
+func (r *DeviceReconciler) findObjectsForSecret(secret client.Object) []reconcile.Request {
+	ctx := context.TODO()
+	devices, err := listSecretDevices(ctx, r, r.Kind, secret)
 	if err != nil {
-		return nil, "", fmt.Errorf("create temp dir: %w", err)
+		r.Error(ctx, err, "unable to list devices using secret")
+		return []reconcile.Request{}
 	}
 
-	if revision == "" {
-		_, err = artifact.FetchArtifact(ctx, gr, tmpDir)
-	} else {
-		_, err = artifact.FetchArtifactAt(ctx, gr, tmpDir, revision)
-	}
-	if err != nil {
-		os.RemoveAll(tmpDir)
-		return nil, "", fmt.Errorf("fetch artifact: %w", err)
+	requests := make([]reconcile.Request, len(devices))
+	for i, v := range devices {
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      v.GetName(),
+				Namespace: v.GetNamespace(),
+			},
+		}
 	}
+	return requests
+}
 
-	dp := &kuesta.DevicePath{RootDir: tmpDir, Device: device}
-	checksum, err := dp.CheckSum()
-	if err != nil {
-		os.RemoveAll(tmpDir)
-		return nil, "", err
+// listSecretDevices returns the devices of the kind which use the Secret. All the devices in the namespace use the
+// Secret for mTLS to the aggregator through their subscribers.
+func listSecretDevices(ctx context.Context, c client.Reader, kind DeviceKind, secret client.Object) ([]device.Object, error) {
+	opts := []client.ListOption{client.InNamespace(secret.GetNamespace())}
+	if secret.GetName() != subscriberConfig.AggregatorTLSSecret {
+		opts = append(opts, client.MatchingFields{device.SecretRefField: secret.GetName()})
 	}
-
-	return dp, checksum, err
+	return listDevices(ctx, c, kind, opts...)
 }
 
-func decodeCueBytes(cctx *cue.Context, bytes []byte) (*model.Device, error) {
-	val, err := kcue.NewValueFromBytes(cctx, bytes)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	var o model.Device
-	if err := val.Decode(&o); err != nil {
+// listDevices returns the devices of the kind.
+func listDevices(ctx context.Context, c client.Reader, kind DeviceKind, opts ...client.ListOption) ([]device.Object, error) {
+	list := kind.NewList()
+	if err := c.List(ctx, list, opts...); err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return &o, nil
-}
-
-func makeSetRequest(newBuf, curBuf []byte) (*gnmiproto.SetRequest, error) {
-	cctx := cuecontext.New()
-
-	newObj, err := decodeCueBytes(cctx, newBuf)
+	items, err := meta.ExtractList(list)
 	if err != nil {
-		return nil, fmt.Errorf("load new device config: %w", err)
+		return nil, errors.WithStack(err)
 	}
-	curObj := &model.Device{}
-	if curBuf != nil {
-		curObj, err = decodeCueBytes(cctx, curBuf)
-		if err != nil {
-			return nil, fmt.Errorf("load current device config: %w", err)
+	devices := make([]device.Object, 0, len(items))
+	for _, o := range items {
+		d, ok := o.(device.Object)
+		if !ok {
+			return nil, errors.WithStack(fmt.Errorf("%T of %s is not a device", o, kind.Kind))
 		}
+		devices = append(devices, d)
 	}
+	return devices, nil
+}
 
-	// TODO enhance performance
-	n, err := ygot.Diff(curObj, newObj, &ygot.DiffPathOpt{
-		MapToSinglePath: true,
-	})
+// fetchArtifact returns the path of the device config in the config repository at the revision and its checksum.
+// The config repository is shared through the artifact cache, so the returned release function must be called once
+// the path is no longer used.
+func (r *DeviceReconciler) fetchArtifact(ctx context.Context, src artifact.Source, dr *provisioner.DeviceRollout, device, revision string) (*kuesta.DevicePath, string, func(), error) {
+	name := fmt.Sprintf("%s/%s/%s", dr.Namespace, dr.SourceKind(), dr.SourceName())
+	dir, release, err := r.ArtifactCache.Acquire(ctx, name, src, revision)
 	if err != nil {
-		return nil, fmt.Errorf("get config diff: %w", err)
+		return nil, "", nil, fmt.Errorf("fetch artifact: %w", err)
 	}
 
-	sr := &gnmiproto.SetRequest{
-		Prefix: n.Prefix,
-		Delete: n.Delete,
-		Update: n.Update,
+	dp := &kuesta.DevicePath{RootDir: dir, Device: device}
+	checksum, err := dp.CheckSum()
+	if err != nil {
+		release()
+		return nil, "", nil, err
 	}
-	return sr, nil
-}
 
-func newSubscribePod(name types.NamespacedName, spec *device.DeviceSpec) *core.Pod {
-	// TODO mount tls secret and add path to PEM files to env vars
-	allowPrivilegeEscalation := false
-
-	return &core.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("subscriber-%s", name.Name),
-			Namespace: name.Namespace,
-		},
-		Spec: core.PodSpec{
-			Containers: []core.Container{
-				{
-					Name:            "kuesta-subscriber",
-					Image:           subscriberImage + ":" + subscriberImageVersion,
-					ImagePullPolicy: core.PullIfNotPresent,
-					Command:         []string{"/bin/subscriber"},
-					Env: []core.EnvVar{
-						{Name: "KUESTA_DEVEL", Value: "true"},
-						{Name: "KUESTA_VERBOSE", Value: "2"},
-						{Name: "KUESTA_ADDR", Value: fmt.Sprintf("%s:%d", spec.Address, spec.Port)},
-						{Name: "KUESTA_DEVICE", Value: name.Name},
-						{Name: "KUESTA_AGGREGATOR_URL", Value: aggregatorUrl},
-						{Name: "KUESTA_SKIP_VERIFY", Value: "true"},
-					},
-					SecurityContext: &core.SecurityContext{
-						AllowPrivilegeEscalation: &allowPrivilegeEscalation,
-					},
-				},
-			},
-		},
-	}
+	return dp, checksum, release, nil
 }
@@ -23,74 +23,24 @@
 package controllers
 
 import (
-	"context"
-
 	deviceoperator "github.com/nttcom/kuesta/device-operator/api/v1alpha1"
-	provisioner "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
-	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/builder"
+	device "github.com/nttcom/kuesta/pkg/device"
+	"github.com/nttcom/kuesta/pkg/model/openconfig"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/handler"
-	"sigs.k8s.io/controller-runtime/pkg/log"
-	"sigs.k8s.io/controller-runtime/pkg/predicate"
-	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
-// OcDemoReconciler reconciles a OcDemo object.
-type OcDemoReconciler struct {
-	client.Client
-	Scheme *runtime.Scheme
-	impl   *DeviceReconciler
-}
-
 //+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=ocdemoes,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=ocdemoes/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=ocdemoes/finalizers,verbs=update
-//+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts,verbs=get;list;watch
-//+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts/status,verbs=get;update;patch
-//+kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories,verbs=get;list;watch
-//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;update;patch;delete
-//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
-
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-func (r *OcDemoReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
-
-	return r.impl.DoReconcile(ctx, req)
-}
-
-// SetupWithManager sets up the controller with the Manager.
-func (r *OcDemoReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.SetupReconciler()
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&deviceoperator.OcDemo{}).
-		Owns(&corev1.Pod{}).
-		Watches(
-			&source.Kind{Type: &provisioner.DeviceRollout{}},
-			handler.EnqueueRequestsFromMapFunc(r.impl.findObjectForDeviceRollout),
-			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
-		).
-		Complete(r)
-}
-
-func (r *OcDemoReconciler) SetupReconciler() {
-	r.impl = &DeviceReconciler{r}
-}
 
-// DeviceReconciler reconciles a OcDemo object.
-type DeviceReconciler struct {
-	*OcDemoReconciler
+// OcDemoKind is the device kind of OcDemo, whose device config is diffed with the OpenConfig model.
+var OcDemoKind = DeviceKind{
+	Kind:      "OcDemo",
+	NewObject: func() device.Object { return deviceoperator.NewDevice() },
+	NewList:   func() client.ObjectList { return deviceoperator.NewDeviceList() },
+	Model:     openconfig.ModelName,
 }
 
-func (r *DeviceReconciler) getDevice(ctx context.Context, nsName types.NamespacedName) (*deviceoperator.OcDemo, error) {
-	device := deviceoperator.NewDevice()
-	if err := r.Get(ctx, nsName, device); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return device, nil
+func init() {
+	RegisterDeviceKind(OcDemoKind)
 }
@@ -34,13 +34,18 @@ import (
 
 	source "github.com/fluxcd/source-controller/api/v1beta2"
 	deviceoperator "github.com/nttcom/kuesta/device-operator/api/v1alpha1"
+	"github.com/nttcom/kuesta/device-operator/controllers"
+	device "github.com/nttcom/kuesta/pkg/device"
 	"github.com/nttcom/kuesta/pkg/testing/gnmihelper"
 	"github.com/nttcom/kuesta/pkg/testing/testhelper"
 	provisioner "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -67,6 +72,23 @@ var _ = Describe("DeviceOperator controller", func() {
 	rev1st := "rev1"
 	rev2nd := "rev2"
 
+	// NOTE the SetRequest of the ygot model addresses the leaves whose values are encoded in the TypedValues
+	setRequestLeaves := func(sr *pb.SetRequest) (map[string]string, []string) {
+		updates := map[string]string{}
+		for _, u := range sr.GetUpdate() {
+			p, err := ygot.PathToString(u.GetPath())
+			Expect(err).NotTo(HaveOccurred())
+			updates[p] = u.GetVal().GetStringVal()
+		}
+		var deletes []string
+		for _, d := range sr.GetDelete() {
+			p, err := ygot.PathToString(d)
+			Expect(err).NotTo(HaveOccurred())
+			deletes = append(deletes, p)
+		}
+		return updates, deletes
+	}
+
 	var testOpe deviceoperator.OcDemo
 	Expect(testhelper.NewTestDataFromFixture("device1.deviceoperator", &testOpe)).NotTo(HaveOccurred())
 	var testDr provisioner.DeviceRollout
@@ -100,6 +122,79 @@ var _ = Describe("DeviceOperator controller", func() {
 		}, timeout, interval).Should(Succeed())
 	})
 
+	getSubscriberPod := func(pod *corev1.Pod) func() error {
+		return func() error {
+			key := types.NamespacedName{
+				Name:      fmt.Sprintf("subscriber-%s", testOpe.Name),
+				Namespace: testOpe.Namespace,
+			}
+			return k8sClient.Get(ctx, key, pod)
+		}
+	}
+
+	It("should report subscriber status", func() {
+		var pod corev1.Pod
+		Eventually(getSubscriberPod(&pod), timeout, interval).Should(Succeed())
+		Eventually(func() error {
+			var d deviceoperator.OcDemo
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(&testOpe), &d); err != nil {
+				return err
+			}
+			if d.Status.Subscriber.Name != pod.Name {
+				return fmt.Errorf("subscriber name is not reported: %+v", d.Status.Subscriber)
+			}
+			if d.Status.Subscriber.SpecHash != pod.Annotations[controllers.AnnotationSubscriberSpecHash] {
+				return fmt.Errorf("subscriber spec hash is not reported: %+v", d.Status.Subscriber)
+			}
+			return nil
+		}, timeout, interval).Should(Succeed())
+	})
+
+	It("should recreate subscriber pod when device spec changed", func() {
+		var pod corev1.Pod
+		Eventually(getSubscriberPod(&pod), timeout, interval).Should(Succeed())
+		oldHash := pod.Annotations[controllers.AnnotationSubscriberSpecHash]
+		Expect(oldHash).NotTo(BeEmpty())
+
+		Eventually(func() error {
+			var d deviceoperator.OcDemo
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(&testOpe), &d); err != nil {
+				return err
+			}
+			d.Spec.Subscription.Mode = "ON_CHANGE"
+			return k8sClient.Update(ctx, &d)
+		}, timeout, interval).Should(Succeed())
+
+		Eventually(func() error {
+			var p corev1.Pod
+			if err := getSubscriberPod(&p)(); err != nil {
+				return err
+			}
+			if p.Annotations[controllers.AnnotationSubscriberSpecHash] == oldHash {
+				return fmt.Errorf("subscriber pod is not recreated")
+			}
+			return nil
+		}, timeout, interval).Should(Succeed())
+	})
+
+	It("should recreate subscriber pod when it failed", func() {
+		var pod corev1.Pod
+		Eventually(getSubscriberPod(&pod), timeout, interval).Should(Succeed())
+		pod.Status.Phase = corev1.PodFailed
+		Expect(k8sClient.Status().Update(ctx, &pod)).NotTo(HaveOccurred())
+
+		Eventually(func() error {
+			var p corev1.Pod
+			if err := getSubscriberPod(&p)(); err != nil {
+				return err
+			}
+			if p.UID == pod.UID {
+				return fmt.Errorf("subscriber pod is not recreated")
+			}
+			return nil
+		}, timeout, interval).Should(Succeed())
+	})
+
 	startRollout := func(config []byte, rev string) func() error {
 		return func() error {
 			var dr provisioner.DeviceRollout
@@ -173,10 +268,10 @@ var _ = Describe("DeviceOperator controller", func() {
 
 		Context("when device config updated", func() {
 			It("should send gNMI SetRequest and change to completed when request succeeded", func() {
-				setCalled := false
+				var setReq *pb.SetRequest
 				m := &gnmihelper.GnmiMock{
 					SetHandler: func(ctx context.Context, request *pb.SetRequest) (*pb.SetResponse, error) {
-						setCalled = true
+						setReq = request
 						return &pb.SetResponse{}, nil
 					},
 				}
@@ -198,8 +293,22 @@ var _ = Describe("DeviceOperator controller", func() {
 					return nil
 				}, timeout, interval).Should(Succeed())
 
-				Expect(setCalled).To(BeTrue())
+				Expect(setReq).NotTo(BeNil())
+				updates, deletes := setRequestLeaves(setReq)
+				Expect(updates).To(Equal(map[string]string{
+					"/interfaces/interface[name=Ethernet1]/name":               "Ethernet1",
+					"/interfaces/interface[name=Ethernet1]/config/description": "foo",
+				}))
+				Expect(deletes).To(BeEmpty())
 				Expect(dr.Status.GetDeviceStatus(testOpe.Name)).To(Equal(provisioner.DeviceStatusCompleted))
+
+				var ope deviceoperator.OcDemo
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&testOpe), &ope)).NotTo(HaveOccurred())
+				ready := meta.FindStatusCondition(ope.Status.Conditions, device.ConditionTypeReady)
+				Expect(ready).NotTo(BeNil())
+				Expect(ready.Status).To(Equal(metav1.ConditionTrue))
+				Expect(ready.Reason).To(Equal(device.ReasonProvisioned))
+				Expect(meta.IsStatusConditionFalse(ope.Status.Conditions, device.ConditionTypeProgressing)).To(BeTrue())
 			})
 		})
 	})
@@ -351,10 +460,10 @@ var _ = Describe("DeviceOperator controller", func() {
 			})
 
 			It("should send gNMI SetRequest and change to completed when request succeeded", func() {
-				setCalled := false
+				var setReq *pb.SetRequest
 				m := &gnmihelper.GnmiMock{
 					SetHandler: func(ctx context.Context, request *pb.SetRequest) (*pb.SetResponse, error) {
-						setCalled = true
+						setReq = request
 						return &pb.SetResponse{}, nil
 					},
 				}
@@ -374,7 +483,12 @@ var _ = Describe("DeviceOperator controller", func() {
 					return nil
 				}, timeout, interval).Should(Succeed())
 
-				Expect(setCalled).To(BeTrue())
+				Expect(setReq).NotTo(BeNil())
+				updates, deletes := setRequestLeaves(setReq)
+				Expect(updates).To(Equal(map[string]string{
+					"/interfaces/interface[name=Ethernet1]/config/description": "bar",
+				}))
+				Expect(deletes).To(BeEmpty())
 				Expect(dr.Status.GetDeviceStatus(testOpe.Name)).To(Equal(provisioner.DeviceStatusCompleted))
 			})
 
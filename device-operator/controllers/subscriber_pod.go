@@ -0,0 +1,364 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/nttcom/kuesta/device-operator/internal"
+	device "github.com/nttcom/kuesta/pkg/device"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// AnnotationSubscriberSpecHash is the annotation of the subscriber Pod which has the hash of the spec with which
+	// the subscriber is running. The Pod is recreated when the hash is changed.
+	AnnotationSubscriberSpecHash = "kuesta.hrk091.dev/subscriber-spec-hash"
+
+	// subscriberHealthPort is the port on which the subscriber serves the liveness and readiness endpoints.
+	subscriberHealthPort = 8081
+
+	subscriberDeviceTLSDir     = "/etc/kuesta/device-tls"
+	subscriberAggregatorTLSDir = "/etc/kuesta/aggregator-tls"
+)
+
+// SubscriberConfig is the operator-wide settings of the subscriber Pods.
+type SubscriberConfig struct {
+	Image            string
+	ImageVersion     string
+	ImagePullPolicy  core.PullPolicy
+	ImagePullSecrets []core.LocalObjectReference
+	Resources        core.ResourceRequirements
+	Devel            bool
+	Verbose          int
+
+	AggregatorURL string
+	// AggregatorTLSSecret is the name of the kubernetes.io/tls Secret in the namespace of the devices, which has
+	// the client certificate to the aggregator and the CA certificate to verify the aggregator.
+	AggregatorTLSSecret  string
+	AggregatorSkipVerify bool
+
+	// Shards is the number of the multi-device subscribers among which the devices are sharded.
+	// A subscriber Pod is created per device if 0.
+	Shards int
+}
+
+// LoadSubscriberConfig loads SubscriberConfig from the env vars.
+func LoadSubscriberConfig() SubscriberConfig {
+	cfg := SubscriberConfig{
+		Image:                internal.MustGetEnv("KUESTA_SUBSCRIBER_IMAGE"),
+		ImageVersion:         internal.MustGetEnv("KUESTA_SUBSCRIBER_IMAGE_VERSION"),
+		ImagePullPolicy:      core.PullPolicy(internal.GetEnv("KUESTA_SUBSCRIBER_IMAGE_PULL_POLICY", string(core.PullIfNotPresent))),
+		Devel:                internal.GetEnvBool("KUESTA_SUBSCRIBER_DEVEL", false),
+		Verbose:              internal.GetEnvInt("KUESTA_SUBSCRIBER_VERBOSE", 0),
+		AggregatorURL:        internal.MustGetEnv("KUESTA_AGGREGATOR_URL"),
+		AggregatorTLSSecret:  internal.GetEnv("KUESTA_SUBSCRIBER_AGGREGATOR_TLS_SECRET", ""),
+		AggregatorSkipVerify: internal.GetEnvBool("KUESTA_SUBSCRIBER_AGGREGATOR_SKIP_VERIFY", false),
+		Shards:               internal.GetEnvInt("KUESTA_SUBSCRIBER_SHARDS", 0),
+	}
+	for _, name := range strings.Split(internal.GetEnv("KUESTA_SUBSCRIBER_IMAGE_PULL_SECRETS", ""), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			cfg.ImagePullSecrets = append(cfg.ImagePullSecrets, core.LocalObjectReference{Name: name})
+		}
+	}
+	for _, q := range []struct {
+		env  string
+		list *core.ResourceList
+		name core.ResourceName
+	}{
+		{"KUESTA_SUBSCRIBER_CPU_REQUEST", &cfg.Resources.Requests, core.ResourceCPU},
+		{"KUESTA_SUBSCRIBER_MEMORY_REQUEST", &cfg.Resources.Requests, core.ResourceMemory},
+		{"KUESTA_SUBSCRIBER_CPU_LIMIT", &cfg.Resources.Limits, core.ResourceCPU},
+		{"KUESTA_SUBSCRIBER_MEMORY_LIMIT", &cfg.Resources.Limits, core.ResourceMemory},
+	} {
+		v := internal.GetEnv(q.env, "")
+		if v == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(v)
+		if err != nil {
+			log.Fatalf("Failed to parse Env var: %s: %v\n", q.env, err)
+		}
+		if *q.list == nil {
+			*q.list = core.ResourceList{}
+		}
+		(*q.list)[q.name] = quantity
+	}
+	return cfg
+}
+
+// newSubscribePod returns the subscriber Pod of the device which decodes the device config with the model.
+// deviceTLS and aggregatorTLS are the data of the Secrets mounted into the Pod, which are nil if not used.
+func newSubscribePod(name types.NamespacedName, model string, spec *device.DeviceSpec, deviceTLS, aggregatorTLS map[string][]byte) *core.Pod {
+	env := []core.EnvVar{
+		{Name: "KUESTA_ADDR", Value: fmt.Sprintf("%s:%d", spec.Address, spec.Port)},
+		{Name: "KUESTA_DEVICE", Value: name.Name},
+		{Name: "KUESTA_MODEL", Value: model},
+	}
+	env = append(env, credentialEnv(&spec.ConnectionInfo)...)
+	env = append(env, deviceTLSEnv(&spec.TLS, deviceTLS)...)
+	env = append(env, subscriptionEnv(&spec.Subscription)...)
+
+	podSpec := newSubscriberPodSpec(aggregatorTLS, env...)
+	if deviceTLS != nil {
+		mountSecret(&podSpec, "device-tls", spec.TLS.SecretName, subscriberDeviceTLSDir)
+	}
+	return &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      subscriberPodName(name.Name),
+			Namespace: name.Namespace,
+		},
+		Spec: podSpec,
+	}
+}
+
+func subscriberPodName(device string) string {
+	return fmt.Sprintf("subscriber-%s", device)
+}
+
+// subscriberSpecHash returns the SHA256 hash of the subscriber Pod spec and the data of the Secrets used by the
+// subscriber, so that the subscriber is restarted when the Secrets are rotated as well as when the spec is changed.
+func subscriberSpecHash(podSpec *core.PodSpec, secrets ...map[string][]byte) (string, error) {
+	hasher := sha256.New()
+	enc := json.NewEncoder(hasher)
+	if err := enc.Encode(podSpec); err != nil {
+		return "", errors.WithStack(err)
+	}
+	// NOTE map keys are encoded in sorted order
+	for _, data := range secrets {
+		if err := enc.Encode(data); err != nil {
+			return "", errors.WithStack(err)
+		}
+	}
+	return fmt.Sprintf("%x", hasher.Sum(nil)), nil
+}
+
+// needsRestart returns the reason why the subscriber Pod must be recreated, or empty if it is up to date.
+func needsRestart(p *core.Pod, specHash string) string {
+	switch {
+	case p.Annotations[AnnotationSubscriberSpecHash] != specHash:
+		return "subscriber spec changed"
+	case p.Status.Phase == core.PodFailed, p.Status.Phase == core.PodSucceeded:
+		return fmt.Sprintf("subscriber Pod terminated: phase=%s", p.Status.Phase)
+	}
+	return ""
+}
+
+// newSubscriberStatus returns the subscriber status of the device observed from the subscriber Pod.
+func newSubscriberStatus(p *core.Pod) device.SubscriberStatus {
+	s := device.SubscriberStatus{
+		Name:     p.Name,
+		Phase:    p.Status.Phase,
+		SpecHash: p.Annotations[AnnotationSubscriberSpecHash],
+		Message:  p.Status.Message,
+	}
+	for _, c := range p.Status.Conditions {
+		if c.Type != core.PodReady {
+			continue
+		}
+		s.Ready = c.Status == core.ConditionTrue
+		if !s.Ready && s.Message == "" {
+			s.Message = c.Message
+		}
+	}
+	for _, cs := range p.Status.ContainerStatuses {
+		s.Restarts += cs.RestartCount
+		if w := cs.State.Waiting; w != nil && w.Reason != "" {
+			s.Message = w.Reason
+			if w.Message != "" {
+				s.Message += ": " + w.Message
+			}
+		}
+	}
+	if !p.DeletionTimestamp.IsZero() {
+		s.Ready = false
+		s.Message = "subscriber Pod is terminating"
+	}
+	return s
+}
+
+// newSubscriberPodSpec returns the spec of the subscriber Pod with the env vars common to all the subscribers
+// followed by the supplied ones.
+func newSubscriberPodSpec(aggregatorTLS map[string][]byte, env ...core.EnvVar) core.PodSpec {
+	cfg := subscriberConfig
+	allowPrivilegeEscalation := false
+
+	common := []core.EnvVar{
+		{Name: "KUESTA_VERBOSE", Value: strconv.Itoa(cfg.Verbose)},
+		{Name: "KUESTA_AGGREGATOR_URL", Value: cfg.AggregatorURL},
+		{Name: "KUESTA_HEALTH_ADDR", Value: fmt.Sprintf(":%d", subscriberHealthPort)},
+	}
+	if cfg.Devel {
+		common = append(common, core.EnvVar{Name: "KUESTA_DEVEL", Value: "true"})
+	}
+	if cfg.AggregatorSkipVerify {
+		common = append(common, core.EnvVar{Name: "KUESTA_SKIP_VERIFY", Value: "true"})
+	}
+	if aggregatorTLS != nil {
+		common = append(common, tlsFileEnv(aggregatorTLS, subscriberAggregatorTLSDir, "KUESTA_TLS_CRT", "KUESTA_TLS_KEY", "KUESTA_TLS_CA", !cfg.AggregatorSkipVerify)...)
+	}
+
+	podSpec := core.PodSpec{
+		ImagePullSecrets: cfg.ImagePullSecrets,
+		Containers: []core.Container{
+			{
+				Name:            "kuesta-subscriber",
+				Image:           cfg.Image + ":" + cfg.ImageVersion,
+				ImagePullPolicy: cfg.ImagePullPolicy,
+				Command:         []string{"/bin/subscriber"},
+				Env:             append(common, env...),
+				Resources:       cfg.Resources,
+				Ports: []core.ContainerPort{
+					{Name: "health", ContainerPort: subscriberHealthPort},
+				},
+				// NOTE the subscriber reconnects to the device by itself, so the liveness probe does not depend on the device
+				LivenessProbe: &core.Probe{
+					ProbeHandler: core.ProbeHandler{
+						HTTPGet: &core.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("health")},
+					},
+					PeriodSeconds: 20,
+				},
+				ReadinessProbe: &core.Probe{
+					ProbeHandler: core.ProbeHandler{
+						HTTPGet: &core.HTTPGetAction{Path: "/readyz", Port: intstr.FromString("health")},
+					},
+					PeriodSeconds: 10,
+				},
+				SecurityContext: &core.SecurityContext{
+					AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+				},
+			},
+		},
+	}
+	if aggregatorTLS != nil {
+		mountSecret(&podSpec, "aggregator-tls", cfg.AggregatorTLSSecret, subscriberAggregatorTLSDir)
+	}
+	return podSpec
+}
+
+// mountSecret mounts the Secret into the subscriber container read-only.
+func mountSecret(podSpec *core.PodSpec, volume, secretName, dir string) {
+	podSpec.Volumes = append(podSpec.Volumes, core.Volume{
+		Name: volume,
+		VolumeSource: core.VolumeSource{
+			Secret: &core.SecretVolumeSource{SecretName: secretName},
+		},
+	})
+	c := &podSpec.Containers[0]
+	c.VolumeMounts = append(c.VolumeMounts, core.VolumeMount{Name: volume, MountPath: dir, ReadOnly: true})
+}
+
+// credentialEnv returns the env vars of the username and password of the device. They are referred from the Secret
+// if specified, which takes precedence over the values in the spec.
+func credentialEnv(c *device.ConnectionInfo) []core.EnvVar {
+	if c.SecretName != "" {
+		ref := func(key string) *core.EnvVarSource {
+			return &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: c.SecretName},
+					Key:                  key,
+				},
+			}
+		}
+		return []core.EnvVar{
+			{Name: "KUESTA_USERNAME", ValueFrom: ref(device.KeyUsername)},
+			{Name: "KUESTA_PASSWORD", ValueFrom: ref(device.KeyPassword)},
+		}
+	}
+	var env []core.EnvVar
+	if c.Username != "" {
+		env = append(env, core.EnvVar{Name: "KUESTA_USERNAME", Value: c.Username})
+	}
+	if c.Password != "" {
+		env = append(env, core.EnvVar{Name: "KUESTA_PASSWORD", Value: c.Password})
+	}
+	return env
+}
+
+// deviceTLSEnv returns the env vars of the TLS parameters to connect to the device. data is the data of the TLS
+// Secret mounted into the Pod.
+func deviceTLSEnv(t *device.TLSSpec, data map[string][]byte) []core.EnvVar {
+	// NOTE set explicitly, since the subscriber falls back to the aggregator ones for compatibility otherwise
+	env := []core.EnvVar{
+		{Name: "KUESTA_DEVICE_NOTLS", Value: strconv.FormatBool(t.NoTLS)},
+		{Name: "KUESTA_DEVICE_SKIP_VERIFY", Value: strconv.FormatBool(t.SkipVerifyServer)},
+	}
+	if t.NoTLS {
+		return env
+	}
+	if t.ServerName != "" {
+		env = append(env, core.EnvVar{Name: "KUESTA_TLS_DEVICE_SERVER_NAME", Value: t.ServerName})
+	}
+	if data != nil {
+		env = append(env, tlsFileEnv(data, subscriberDeviceTLSDir, "KUESTA_TLS_DEVICE_CRT", "KUESTA_TLS_DEVICE_KEY", "KUESTA_TLS_DEVICE_CA", !t.SkipVerifyServer)...)
+	}
+	return env
+}
+
+// tlsFileEnv returns the env vars of the paths to the PEM files of the TLS Secret mounted on the dir. The env vars
+// are set only for the keys present in the Secret.
+func tlsFileEnv(data map[string][]byte, dir, crtEnv, keyEnv, caEnv string, verify bool) []core.EnvVar {
+	var env []core.EnvVar
+	if data[core.TLSCertKey] != nil && data[core.TLSPrivateKeyKey] != nil {
+		env = append(env,
+			core.EnvVar{Name: crtEnv, Value: filepath.Join(dir, core.TLSCertKey)},
+			core.EnvVar{Name: keyEnv, Value: filepath.Join(dir, core.TLSPrivateKeyKey)},
+		)
+	}
+	if verify && data[core.ServiceAccountRootCAKey] != nil {
+		env = append(env, core.EnvVar{Name: caEnv, Value: filepath.Join(dir, core.ServiceAccountRootCAKey)})
+	}
+	return env
+}
+
+// subscriptionEnv returns the env vars of the subscriber to configure the gNMI subscription. Unset fields are
+// omitted so that the subscriber defaults are used.
+func subscriptionEnv(spec *device.SubscriptionSpec) []core.EnvVar {
+	var env []core.EnvVar
+	add := func(name, value string) {
+		if value != "" {
+			env = append(env, core.EnvVar{Name: name, Value: value})
+		}
+	}
+	add("KUESTA_PATHS", strings.Join(spec.Paths, ","))
+	add("KUESTA_GET_PATHS", strings.Join(spec.GetPaths, ","))
+	add("KUESTA_ORIGIN", spec.Origin)
+	add("KUESTA_TARGET", spec.Target)
+	add("KUESTA_MODE", spec.Mode)
+	if spec.SampleInterval.Duration > 0 {
+		add("KUESTA_SAMPLE_INTERVAL", spec.SampleInterval.Duration.String())
+	}
+	add("KUESTA_ENCODING", spec.Encoding)
+	return env
+}
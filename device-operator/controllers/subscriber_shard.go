@@ -0,0 +1,464 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	device "github.com/nttcom/kuesta/pkg/device"
+	"github.com/nttcom/kuesta/pkg/subscriber"
+	"github.com/pkg/errors"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	LabelSubscriberShard = "kuesta.hrk091.dev/subscriber-shard"
+
+	subscriberDevicesDir  = "/etc/kuesta/devices"
+	subscriberShardPrefix = "kuesta-subscriber-"
+
+	// subscriberHealthInterval is the interval to poll the connection state of the devices from the subscribers.
+	subscriberHealthInterval = 30 * time.Second
+)
+
+// shardOf returns the index of the subscriber shard to which the device belongs.
+func shardOf(name string, shards int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return int(h.Sum32() % uint32(shards))
+}
+
+func subscriberShardName(shard int) string {
+	return fmt.Sprintf("%s%d", subscriberShardPrefix, shard)
+}
+
+// parseSubscriberShardName returns the index of the subscriber shard of the name, or false if it is not the name of
+// the subscriber shard.
+func parseSubscriberShardName(name string) (int, bool) {
+	if !strings.HasPrefix(name, subscriberShardPrefix) {
+		return 0, false
+	}
+	shard, err := strconv.Atoi(strings.TrimPrefix(name, subscriberShardPrefix))
+	if err != nil || shard < 0 {
+		return 0, false
+	}
+	return shard, true
+}
+
+// SubscriberShardReconciler reconciles the subscriber shards, each of which is the Deployment of the subscriber and the
+// Secret of the device list of the shard. The shards are keyed by the name of the Deployment, so that the changes of
+// the devices in the same shard are coalesced into a single reconciliation.
+type SubscriberShardReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Kinds are the device kinds reconciled by the operator, whose devices share the subscriber shards. The devices
+	// of the kinds are indexed by the DeviceReconciler.
+	Kinds []DeviceKind
+
+	// DeviceHealth returns the connection state of each device served by the subscriber Pod. The health endpoint of
+	// the Pod is requested if nil.
+	DeviceHealth func(ctx context.Context, pod *core.Pod) (map[string]subscriber.DeviceHealth, error)
+}
+
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+
+// SetupWithManager sets up the controller of the subscriber shards with the Manager.
+func (r *SubscriberShardReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	hasShardLabel := func(o client.Object) bool {
+		_, ok := o.GetLabels()[LabelSubscriberShard]
+		return ok
+	}
+	isShard := predicate.NewPredicateFuncs(hasShardLabel)
+	isNotShard := predicate.NewPredicateFuncs(func(o client.Object) bool { return !hasShardLabel(o) })
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("subscribershard").
+		For(&apps.Deployment{}, builder.WithPredicates(isShard)).
+		Watches(
+			&source.Kind{Type: &core.Secret{}},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(isShard),
+		).
+		Watches(
+			&source.Kind{Type: &core.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(r.findSubscriberShardsForSecret),
+			builder.WithPredicates(isNotShard, predicate.ResourceVersionChangedPredicate{}),
+		)
+	// NOTE the status changes of the devices are not subscribed
+	for _, kind := range r.Kinds {
+		b = b.Watches(
+			&source.Kind{Type: kind.NewObject()},
+			handler.EnqueueRequestsFromMapFunc(findSubscriberShardForDevice),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		)
+	}
+	return b.Complete(r)
+}
+
+// Reconcile writes the devices of the shard to the Secret of the shard, and creates the subscriber Deployment which
+// subscribes to those devices. The subscriber follows the changes of the Secret by itself. The shard is deleted if
+// it is no longer in use since the number of the shards is lowered. The connection state of each device is polled
+// from the subscriber, since the subscriber is ready regardless of the devices.
+func (r *SubscriberShardReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	shard, ok := parseSubscriberShardName(req.Name)
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+	if shard >= subscriberConfig.Shards {
+		log.FromContext(ctx).Info("delete unused subscriber shard", "shard", shard)
+		if err := r.deleteSubscriberShard(ctx, req.NamespacedName); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	devices, err := r.listShardDevices(ctx, req.Namespace, shard)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	deploy, err := r.reconcileSubscriberShard(ctx, req.NamespacedName, shard, devices)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	health, healthErr := r.getDeviceHealth(ctx, req.NamespacedName, shard)
+	if healthErr != nil {
+		log.FromContext(ctx).Info("unable to get device health from subscriber", "error", healthErr.Error())
+	}
+	for _, d := range devices {
+		dev := d.GetDevice()
+		status := newShardSubscriberStatus(deploy, d.GetName(), health, healthErr)
+		if dev.Status.Subscriber == status {
+			continue
+		}
+		old := d.DeepCopyObject().(client.Object)
+		dev.Status.Subscriber = status
+		if err := r.Status().Patch(ctx, d, client.MergeFrom(old)); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("patch subscriber status of %s: %w", d.GetName(), errors.WithStack(err))
+		}
+	}
+	return ctrl.Result{RequeueAfter: subscriberHealthInterval}, nil
+}
+
+// getDeviceHealth returns the connection state of each device from the ready subscriber Pod of the shard, or nil if
+// no Pod is ready.
+func (r *SubscriberShardReconciler) getDeviceHealth(ctx context.Context, name types.NamespacedName, shard int) (map[string]subscriber.DeviceHealth, error) {
+	var pods core.PodList
+	labels := client.MatchingLabels{"app.kubernetes.io/name": "kuesta-subscriber", LabelSubscriberShard: strconv.Itoa(shard)}
+	if err := r.List(ctx, &pods, client.InNamespace(name.Namespace), labels); err != nil {
+		return nil, fmt.Errorf("list subscriber Pods: %w", errors.WithStack(err))
+	}
+	for i := range pods.Items {
+		p := &pods.Items[i]
+		if !p.DeletionTimestamp.IsZero() || p.Status.PodIP == "" || !newSubscriberStatus(p).Ready {
+			continue
+		}
+		if r.DeviceHealth != nil {
+			return r.DeviceHealth(ctx, p)
+		}
+		return requestDeviceHealth(ctx, p)
+	}
+	return nil, nil
+}
+
+// requestDeviceHealth requests the connection state of each device to the health endpoint of the subscriber Pod.
+func requestDeviceHealth(ctx context.Context, p *core.Pod) (map[string]subscriber.DeviceHealth, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(p.Status.PodIP, strconv.Itoa(subscriberHealthPort)), subscriber.PathDevicesHealth)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request device health: %w", errors.WithStack(err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.WithStack(fmt.Errorf("request device health: status=%s", resp.Status))
+	}
+	var health map[string]subscriber.DeviceHealth
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		return nil, fmt.Errorf("decode device health: %w", errors.WithStack(err))
+	}
+	return health, nil
+}
+
+// listShardDevices returns the devices of all the kinds which belong to the shard.
+func (r *SubscriberShardReconciler) listShardDevices(ctx context.Context, namespace string, shard int) ([]shardDevice, error) {
+	// NOTE the shards are shared by all the device kinds, so the device names must be unique among them
+	var devices []shardDevice
+	for _, kind := range r.Kinds {
+		list, err := listDevices(ctx, r, kind, client.InNamespace(namespace))
+		if err != nil {
+			return nil, fmt.Errorf("list devices of %s: %w", kind.Kind, err)
+		}
+		for _, d := range list {
+			if !d.GetDeletionTimestamp().IsZero() || shardOf(d.GetName(), subscriberConfig.Shards) != shard {
+				continue
+			}
+			devices = append(devices, shardDevice{Object: d, kind: kind})
+		}
+	}
+	return devices, nil
+}
+
+// shardDevice is the device which belongs to the shard with its kind.
+type shardDevice struct {
+	device.Object
+	kind DeviceKind
+}
+
+func (r *SubscriberShardReconciler) reconcileSubscriberShard(ctx context.Context, name types.NamespacedName, shard int, devices []shardDevice) (*apps.Deployment, error) {
+	var dl subscriber.DeviceList
+	for _, d := range devices {
+		sd, err := r.subscriberDevice(ctx, d.kind, d)
+		if err != nil {
+			return nil, err
+		}
+		dl.Devices = append(dl.Devices, sd)
+	}
+	buf, err := dl.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	aggregatorTLS, err := getAggregatorTLSData(ctx, r, name.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &core.Secret{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		setSubscriberShardLabels(&secret.ObjectMeta, shard)
+		secret.Data = map[string][]byte{subscriber.FileDevices: buf}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("create or update subscriber Secret: %w", errors.WithStack(err))
+	}
+
+	deploy := &apps.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, deploy, func() error {
+		setSubscriberShardLabels(&deploy.ObjectMeta, shard)
+		return mutateSubscriberDeployment(deploy, name, shard, aggregatorTLS)
+	}); err != nil {
+		return nil, fmt.Errorf("create or update subscriber Deployment: %w", errors.WithStack(err))
+	}
+	return deploy, nil
+}
+
+// deleteSubscriberShard deletes the Deployment and the Secret of the shard so that the devices moved to the other
+// shards are not reported by the stale subscriber.
+func (r *SubscriberShardReconciler) deleteSubscriberShard(ctx context.Context, name types.NamespacedName) error {
+	meta := metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}
+	if err := r.Delete(ctx, &apps.Deployment{ObjectMeta: meta}); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("delete subscriber Deployment: %w", errors.WithStack(err))
+	}
+	if err := r.Delete(ctx, &core.Secret{ObjectMeta: meta}); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("delete subscriber Secret: %w", errors.WithStack(err))
+	}
+	return nil
+}
+
+// subscriberDevice returns the entry of the device list. The credentials are resolved from the Secret if specified.
+func (r *SubscriberShardReconciler) subscriberDevice(ctx context.Context, kind DeviceKind, d device.Object) (subscriber.Device, error) {
+	spec := &d.GetDevice().Spec
+	sd := subscriber.Device{
+		Name:     d.GetName(),
+		Model:    kind.ModelName(),
+		Addr:     fmt.Sprintf("%s:%d", spec.Address, spec.Port),
+		Username: spec.Username,
+		Password: spec.Password,
+		Origin:   spec.Subscription.Origin,
+		Target:   spec.Subscription.Target,
+		Paths:    spec.Subscription.Paths,
+		GetPaths: spec.Subscription.GetPaths,
+		Mode:     spec.Subscription.Mode,
+		Encoding: spec.Subscription.Encoding,
+	}
+	if spec.Subscription.SampleInterval.Duration > 0 {
+		sd.SampleInterval = spec.Subscription.SampleInterval.Duration.String()
+	}
+	if spec.ConnectionInfo.SecretName != "" {
+		var secret core.Secret
+		if err := r.Get(ctx, types.NamespacedName{Namespace: d.GetNamespace(), Name: spec.ConnectionInfo.SecretName}, &secret); err != nil {
+			return subscriber.Device{}, fmt.Errorf("get secret for credential of %s: %w", d.GetName(), errors.WithStack(err))
+		}
+		sd.Username = string(secret.Data[device.KeyUsername])
+		sd.Password = string(secret.Data[device.KeyPassword])
+	}
+
+	// NOTE the certificates are embedded in the device list instead of mounting the Secret of every device
+	t := &spec.TLS
+	sd.TLS = &subscriber.DeviceTLS{NoTLS: t.NoTLS, SkipVerify: t.SkipVerifyServer, ServerName: t.ServerName}
+	if t.SecretName != "" && !t.NoTLS {
+		data, err := getSecretData(ctx, r, d.GetNamespace(), t.SecretName)
+		if err != nil {
+			return subscriber.Device{}, fmt.Errorf("get secret for TLS of %s: %w", d.GetName(), err)
+		}
+		sd.TLS.Crt = string(data[core.TLSCertKey])
+		sd.TLS.Key = string(data[core.TLSPrivateKeyKey])
+		if !t.SkipVerifyServer {
+			sd.TLS.CACrt = string(data[core.ServiceAccountRootCAKey])
+		}
+	}
+	return sd, nil
+}
+
+func setSubscriberShardLabels(meta *metav1.ObjectMeta, shard int) {
+	if meta.Labels == nil {
+		meta.Labels = map[string]string{}
+	}
+	meta.Labels["app.kubernetes.io/name"] = "kuesta-subscriber"
+	meta.Labels[LabelSubscriberShard] = strconv.Itoa(shard)
+}
+
+func mutateSubscriberDeployment(deploy *apps.Deployment, name types.NamespacedName, shard int, aggregatorTLS map[string][]byte) error {
+	labels := map[string]string{
+		"app.kubernetes.io/name": "kuesta-subscriber",
+		LabelSubscriberShard:     strconv.Itoa(shard),
+	}
+	replicas := int32(1)
+	deploy.Spec.Replicas = &replicas
+	// NOTE selector is immutable
+	if deploy.Spec.Selector == nil {
+		deploy.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+	}
+	// NOTE only one subscriber per shard must report the devices at a time
+	deploy.Spec.Strategy = apps.DeploymentStrategy{Type: apps.RecreateDeploymentStrategyType}
+	deploy.Spec.Template.ObjectMeta.Labels = labels
+	deploy.Spec.Template.Spec = newSubscriberPodSpec(aggregatorTLS, core.EnvVar{
+		Name:  "KUESTA_DEVICES_FILE",
+		Value: filepath.Join(subscriberDevicesDir, subscriber.FileDevices),
+	})
+	mountSecret(&deploy.Spec.Template.Spec, "devices", name.Name, subscriberDevicesDir)
+
+	// NOTE the Deployment rolls the Pod on the changes of the template, which are made to include the rotation of
+	// the aggregator TLS Secret. The changes of the devices are followed by the subscriber without rolling.
+	specHash, err := subscriberSpecHash(&deploy.Spec.Template.Spec, aggregatorTLS)
+	if err != nil {
+		return err
+	}
+	if deploy.Spec.Template.ObjectMeta.Annotations == nil {
+		deploy.Spec.Template.ObjectMeta.Annotations = map[string]string{}
+	}
+	deploy.Spec.Template.ObjectMeta.Annotations[AnnotationSubscriberSpecHash] = specHash
+	return nil
+}
+
+// newShardSubscriberStatus returns the subscriber status of the device observed from the subscriber Deployment of
+// its shard and the connection state of the device reported by the subscriber. The device is ready only if the
+// subscriber is connected to it.
+func newShardSubscriberStatus(deploy *apps.Deployment, name string, health map[string]subscriber.DeviceHealth, healthErr error) device.SubscriberStatus {
+	s := device.SubscriberStatus{
+		Name:     deploy.Name,
+		SpecHash: deploy.Spec.Template.Annotations[AnnotationSubscriberSpecHash],
+		Ready:    deploy.Status.ReadyReplicas > 0,
+	}
+	for _, c := range deploy.Status.Conditions {
+		if c.Type == apps.DeploymentAvailable && c.Status != core.ConditionTrue {
+			s.Message = c.Message
+		}
+	}
+	if !s.Ready {
+		return s
+	}
+
+	h, ok := health[name]
+	switch {
+	case healthErr != nil:
+		s.Message = healthErr.Error()
+	case !ok:
+		s.Message = "not subscribed yet"
+	case !h.Connected && h.Error != "":
+		s.Message = "disconnected: " + h.Error
+	case !h.Connected:
+		s.Message = "not connected yet"
+	}
+	s.Ready = healthErr == nil && h.Connected
+	return s
+}
+
+// findSubscriberShardForDevice returns the request of the subscriber shard to which the device belongs.
+func findSubscriberShardForDevice(d client.Object) []reconcile.Request {
+	if subscriberConfig.Shards == 0 {
+		return []reconcile.Request{}
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: d.GetNamespace(),
+		Name:      subscriberShardName(shardOf(d.GetName(), subscriberConfig.Shards)),
+	}}}
+}
+
+// findSubscriberShardsForSecret returns the requests of the subscriber shards to which the devices using the Secret
+// belong, so that the rotated Secret is written to the device list or rolls the subscriber.
+func (r *SubscriberShardReconciler) findSubscriberShardsForSecret(secret client.Object) []reconcile.Request {
+	if subscriberConfig.Shards == 0 {
+		return []reconcile.Request{}
+	}
+	ctx := context.TODO()
+	shards := map[int]struct{}{}
+	for _, kind := range r.Kinds {
+		devices, err := listSecretDevices(ctx, r, kind, secret)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "unable to list devices using secret", "kind", kind.Kind)
+			return []reconcile.Request{}
+		}
+		for _, d := range devices {
+			shards[shardOf(d.GetName(), subscriberConfig.Shards)] = struct{}{}
+		}
+	}
+
+	requests := make([]reconcile.Request, 0, len(shards))
+	for shard := range shards {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: secret.GetNamespace(),
+			Name:      subscriberShardName(shard),
+		}})
+	}
+	return requests
+}
@@ -31,7 +31,6 @@ import (
 	source "github.com/fluxcd/source-controller/api/v1beta2"
 	deviceoperator "github.com/nttcom/kuesta/device-operator/api/v1alpha1"
 	"github.com/nttcom/kuesta/device-operator/controllers"
-	device "github.com/nttcom/kuesta/pkg/device"
 	provisioner "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -102,21 +101,13 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&controllers.OcDemoReconciler{
+	err = (&controllers.DeviceReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
+		Kind:   controllers.OcDemoKind,
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = mgr.GetFieldIndexer().IndexField(context.Background(), &deviceoperator.OcDemo{}, device.RefField, func(rawObj client.Object) []string {
-		d := rawObj.(*deviceoperator.OcDemo)
-		if d.Spec.RolloutRef == "" {
-			return nil
-		}
-		return []string{d.Spec.RolloutRef}
-	})
-	Expect(err).ToNot(HaveOccurred())
-
 	ctx := context.Background()
 	ctx, stopFunc = context.WithCancel(ctx)
 	go func() {
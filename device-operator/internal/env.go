@@ -25,6 +25,7 @@ package internal
 import (
 	"log"
 	"os"
+	"strconv"
 )
 
 func MustGetEnv(name string) string {
@@ -34,3 +35,37 @@ func MustGetEnv(name string) string {
 	}
 	return v
 }
+
+// GetEnvInt returns the integer value of the env var, or the default value if it is not set.
+func GetEnvInt(name string, defaultValue int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return defaultValue
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		log.Fatalf("Failed to parse Env var: %s: %v\n", name, err)
+	}
+	return i
+}
+
+// GetEnv returns the value of the env var, or the default value if it is not set.
+func GetEnv(name, defaultValue string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return defaultValue
+}
+
+// GetEnvBool returns the boolean value of the env var, or the default value if it is not set.
+func GetEnvBool(name string, defaultValue bool) bool {
+	v := os.Getenv(name)
+	if v == "" {
+		return defaultValue
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Fatalf("Failed to parse Env var: %s: %v\n", name, err)
+	}
+	return b
+}
@@ -23,14 +23,15 @@
 package main
 
 import (
-	"context"
 	"flag"
 	"os"
+	"path/filepath"
+	"strings"
 
 	fluxcd "github.com/fluxcd/source-controller/api/v1beta2"
 	deviceoperator "github.com/nttcom/kuesta/device-operator/api/v1alpha1"
 	"github.com/nttcom/kuesta/device-operator/controllers"
-	device "github.com/nttcom/kuesta/pkg/device"
+	"github.com/nttcom/kuesta/pkg/artifact"
 	provisioner "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
 	origzap "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -41,7 +42,6 @@ import (
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	//+kubebuilder:scaffold:imports
@@ -65,11 +65,20 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var artifactCacheDir string
+	var artifactCacheSize int
+	var deviceKinds string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&artifactCacheDir, "artifact-cache-dir", filepath.Join(os.TempDir(), controllers.DefaultArtifactCacheDirName),
+		"The directory where the fetched config repository artifacts are cached.")
+	flag.IntVar(&artifactCacheSize, "artifact-cache-size", artifact.DefaultCacheSize,
+		"The max number of the config repository artifacts kept in the cache.")
+	flag.StringVar(&deviceKinds, "device-kinds", "",
+		"The comma-separated device kinds to reconcile. All the registered device kinds are reconciled if empty.")
 	opts := zap.Options{
 		Development: true,
 		// show caller for debug use
@@ -112,11 +121,39 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controllers.OcDemoReconciler{
+	artifactCache, err := artifact.NewCache(artifactCacheDir, artifactCacheSize)
+	if err != nil {
+		setupLog.Error(err, "unable to create artifact cache")
+		os.Exit(1)
+	}
+
+	var kindNames []string
+	if deviceKinds != "" {
+		kindNames = strings.Split(deviceKinds, ",")
+	}
+	kinds, err := controllers.LookupDeviceKinds(kindNames...)
+	if err != nil {
+		setupLog.Error(err, "unable to find device kinds")
+		os.Exit(1)
+	}
+	for _, kind := range kinds {
+		if err = (&controllers.DeviceReconciler{
+			Client:        mgr.GetClient(),
+			Scheme:        mgr.GetScheme(),
+			Recorder:      mgr.GetEventRecorderFor(strings.ToLower(kind.Kind) + "-controller"),
+			ArtifactCache: artifactCache,
+			Kind:          kind,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", kind.Kind)
+			os.Exit(1)
+		}
+	}
+	if err = (&controllers.SubscriberShardReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
+		Kinds:  kinds,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "OcDemo")
+		setupLog.Error(err, "unable to create controller", "controller", "SubscriberShard")
 		os.Exit(1)
 	}
 	//+kubebuilder:scaffold:builder
@@ -130,16 +167,6 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &deviceoperator.OcDemo{}, device.RefField, func(rawObj client.Object) []string {
-		d := rawObj.(*deviceoperator.OcDemo)
-		if d.Spec.RolloutRef == "" {
-			return nil
-		}
-		return []string{d.Spec.RolloutRef}
-	}); err != nil {
-		os.Exit(1)
-	}
-
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
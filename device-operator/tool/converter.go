@@ -32,8 +32,8 @@ import (
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
-	"github.com/nttcom/kuesta/device-operator/internal/model"
 	kcue "github.com/nttcom/kuesta/pkg/cue"
+	"github.com/nttcom/kuesta/pkg/model/openconfig"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/pkg/errors"
 )
@@ -67,12 +67,12 @@ func validateArgs(args []string) string {
 	return path
 }
 
-func decodeCueBytes(cctx *cue.Context, bytes []byte) (*model.Device, error) {
+func decodeCueBytes(cctx *cue.Context, bytes []byte) (*openconfig.Device, error) {
 	val, err := kcue.NewValueFromBytes(cctx, bytes)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	var o model.Device
+	var o openconfig.Device
 	if err := val.Decode(&o); err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -0,0 +1,160 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	crdKustomization = "config/crd/kustomization.yaml"
+	crdScaffoldMark  = "#+kubebuilder:scaffold:crdkustomizeresource"
+	group            = "kuesta.hrk091.dev"
+)
+
+var reKind = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// params is the parameters of the templates.
+type params struct {
+	Kind   string
+	Plural string
+	Group  string
+	Model  string
+}
+
+func (p params) Lower() string {
+	return strings.ToLower(p.Kind)
+}
+
+func main() {
+	flag.Usage = func() {
+		usage := `Scaffold a new device kind reconciled by the device-operator.
+
+Generates the API type, the controller registering the device kind and the sample of the kind. Run
+'make manifests generate' afterwards to generate the CRD, the RBAC and the deepcopy functions.
+
+Usage:
+  scaffold [flags] KIND
+
+Flags:
+`
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	plural := flag.String("plural", "", "The plural resource name of the kind. The lower-cased kind with 's' suffix is used if empty.")
+	model := flag.String("model", "", "The name of the device model of the kind. The schema-less model is used if empty.")
+	dir := flag.String("dir", ".", "The root directory of the device-operator.")
+	force := flag.Bool("force", false, "Overwrite the existing files.")
+	flag.Parse()
+
+	p, err := newParams(flag.Args(), *plural, *model)
+	mustNil(err)
+	mustNil(scaffold(*dir, p, *force))
+}
+
+func newParams(args []string, plural, model string) (params, error) {
+	if len(args) != 1 {
+		return params{}, errors.New("kind must be specified")
+	}
+	kind := args[0]
+	if !reKind.MatchString(kind) {
+		return params{}, fmt.Errorf("kind must be CamelCase: %s", kind)
+	}
+	if plural == "" {
+		plural = strings.ToLower(kind) + "s"
+	}
+	return params{Kind: kind, Plural: plural, Group: group, Model: model}, nil
+}
+
+func scaffold(dir string, p params, force bool) error {
+	files := []struct {
+		path  string
+		tmpl  *template.Template
+		gofmt bool
+	}{
+		{filepath.Join("api", "v1alpha1", p.Lower()+"_types.go"), typesTemplate, true},
+		{filepath.Join("controllers", p.Lower()+"_controller.go"), controllerTemplate, true},
+		{filepath.Join("config", "samples", "kuesta_v1alpha1_"+p.Lower()+".yaml"), sampleTemplate, false},
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, f.path)
+		if _, err := os.Stat(path); err == nil && !force {
+			return fmt.Errorf("%s already exists", path)
+		}
+		var buf bytes.Buffer
+		if err := f.tmpl.Execute(&buf, p); err != nil {
+			return errors.WithStack(fmt.Errorf("render %s: %w", f.path, err))
+		}
+		b := buf.Bytes()
+		if f.gofmt {
+			var err error
+			if b, err = format.Source(b); err != nil {
+				return errors.WithStack(fmt.Errorf("format %s: %w", f.path, err))
+			}
+		}
+		if err := os.WriteFile(path, b, 0o644); err != nil {
+			return errors.WithStack(err)
+		}
+		fmt.Printf("created %s\n", path)
+	}
+	return addCRDResource(filepath.Join(dir, crdKustomization), p)
+}
+
+// addCRDResource adds the CRD of the kind to the resources of the kustomization.
+func addCRDResource(path string, p params) error {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	entry := fmt.Sprintf("- bases/%s_%s.yaml\n", p.Group, p.Plural)
+	s := string(buf)
+	if strings.Contains(s, entry) {
+		return nil
+	}
+	if !strings.Contains(s, crdScaffoldMark) {
+		return fmt.Errorf("%s has no scaffold marker", path)
+	}
+	s = strings.Replace(s, crdScaffoldMark, entry+crdScaffoldMark, 1)
+	if err := os.WriteFile(path, []byte(s), 0o644); err != nil {
+		return errors.WithStack(err)
+	}
+	fmt.Printf("updated %s\n", path)
+	return nil
+}
+
+func mustNil(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
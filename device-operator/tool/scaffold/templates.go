@@ -0,0 +1,125 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package main
+
+import "text/template"
+
+const boilerplate = `/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+`
+
+var typesTemplate = template.Must(template.New("types").Parse(boilerplate + `
+package v1alpha1
+
+import (
+	device "github.com/nttcom/kuesta/pkg/device"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path={{ .Plural }}
+//+kubebuilder:printcolumn:name="READY",type="string",JSONPath=` + "`" + `.status.conditions[?(@.type=="Ready")].status` + "`" + `
+//+kubebuilder:printcolumn:name="REASON",type="string",JSONPath=` + "`" + `.status.conditions[?(@.type=="Ready")].reason` + "`" + `
+//+kubebuilder:printcolumn:name="SUBSCRIBER",type="boolean",JSONPath=` + "`" + `.status.subscriber.ready` + "`" + `
+
+// {{ .Kind }} is the Schema for the {{ .Plural }} API.
+type {{ .Kind }} struct {
+	metav1.TypeMeta   ` + "`" + `json:",inline"` + "`" + `
+	metav1.ObjectMeta ` + "`" + `json:"metadata,omitempty"` + "`" + `
+
+	device.Device ` + "`" + `json:",inline"` + "`" + `
+}
+
+//+kubebuilder:object:root=true
+
+// {{ .Kind }}List contains a list of {{ .Kind }}.
+type {{ .Kind }}List struct {
+	metav1.TypeMeta ` + "`" + `json:",inline"` + "`" + `
+	metav1.ListMeta ` + "`" + `json:"metadata,omitempty"` + "`" + `
+	Items           []{{ .Kind }} ` + "`" + `json:"items"` + "`" + `
+}
+
+func init() {
+	SchemeBuilder.Register(&{{ .Kind }}{}, &{{ .Kind }}List{})
+}
+`))
+
+var controllerTemplate = template.Must(template.New("controller").Parse(boilerplate + `
+package controllers
+
+import (
+	deviceoperator "github.com/nttcom/kuesta/device-operator/api/v1alpha1"
+	device "github.com/nttcom/kuesta/pkg/device"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups={{ .Group }},resources={{ .Plural }},verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups={{ .Group }},resources={{ .Plural }}/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups={{ .Group }},resources={{ .Plural }}/finalizers,verbs=update
+
+// {{ .Kind }}Kind is the device kind of {{ .Kind }}{{ if .Model }}, whose device config is diffed with the {{ .Model }} model{{ else }}, whose device config is diffed without schema{{ end }}.
+var {{ .Kind }}Kind = DeviceKind{
+	Kind:      "{{ .Kind }}",
+	NewObject: func() device.Object { return &deviceoperator.{{ .Kind }}{} },
+	NewList:   func() client.ObjectList { return &deviceoperator.{{ .Kind }}List{} },
+	Model:     "{{ .Model }}",
+}
+
+func init() {
+	RegisterDeviceKind({{ .Kind }}Kind)
+}
+`))
+
+var sampleTemplate = template.Must(template.New("sample").Parse(`apiVersion: {{ .Group }}/v1alpha1
+kind: {{ .Kind }}
+metadata:
+  name: {{ .Lower }}-sample
+spec:
+  # TODO(user): Change following specs
+  address: test.example.com
+  port: 9339
+  baseRevision: CHANGEME - revision synced with actual device config
+  rolloutRef: CHANGEME - the name of DeviceRollout resource to which this resource belongs
+  tls:
+    skipVerify: true
+`))
@@ -4,10 +4,10 @@ go 1.18
 
 require (
 	cuelang.org/go v0.4.3
+	github.com/cenkalti/backoff/v4 v4.1.1
 	github.com/go-playground/validator/v10 v10.11.0
 	github.com/nttcom/kuesta v0.0.0
 	github.com/openconfig/gnmi v0.0.0-20220617175856-41246b1b3507
-	github.com/openconfig/goyang v1.0.0
 	github.com/openconfig/ygot v0.22.1
 	github.com/pkg/errors v0.9.1
 	github.com/spf13/cobra v1.5.0
@@ -15,12 +15,13 @@ require (
 	github.com/stretchr/testify v1.8.0
 	go.uber.org/zap v1.22.0
 	google.golang.org/grpc v1.46.2
+	google.golang.org/protobuf v1.28.0
 )
 
 replace github.com/nttcom/kuesta v0.0.0 => ../
 
 require (
-	github.com/cenkalti/backoff/v4 v4.1.1 // indirect
+	github.com/ProtonMail/go-crypto v0.0.0-20210428141323-04723f9f07d7 // indirect
 	github.com/cockroachdb/apd/v2 v2.0.1 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/emicklei/proto v1.6.15 // indirect
@@ -45,6 +46,7 @@ require (
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.2 // indirect
 	github.com/mpvl/unique v0.0.0-20150818121801-cbe035fff7de // indirect
+	github.com/openconfig/goyang v1.0.0 // indirect
 	github.com/openconfig/grpctunnel v0.0.0-20220524190229-125331eabdde // indirect
 	github.com/pelletier/go-toml v1.9.5 // indirect
 	github.com/pelletier/go-toml/v2 v2.0.1 // indirect
@@ -62,7 +64,6 @@ require (
 	golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f // indirect
 	golang.org/x/text v0.3.7 // indirect
 	google.golang.org/genproto v0.0.0-20220519153652-3a47de7e79bd // indirect
-	google.golang.org/protobuf v1.28.0 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/ini.v1 v1.66.4 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
@@ -23,30 +23,64 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/nttcom/kuesta/device-subscriber/internal/logger"
 	"github.com/nttcom/kuesta/device-subscriber/internal/validator"
 	"github.com/nttcom/kuesta/pkg/credentials"
+	"github.com/nttcom/kuesta/pkg/subscriber"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// version is the version of the subscriber reported to the aggregator, which is set at build time.
+var version = "dev"
+
 type Config struct {
-	Devel              bool
-	Verbose            uint8
-	Addr               string `validate:"required"`
-	Username           string
-	Password           string
-	Device             string `validate:"required"`
-	AggregatorURL      string `mapstructure:"aggregator-url" validate:"required"`
-	NoTLS              bool   `mapstructure:"notls"`
-	TLSSkipVerify      bool   `mapstructure:"skip-verify"`
-	TLSKeyPath         string `mapstructure:"tls-key"`
-	TLSCrtPath         string `mapstructure:"tls-crt"`
-	TLSCACrtPath       string `mapstructure:"tls-ca"`
-	TLSDeviceCACrtPath string `mapstructure:"tls-device-ca"`
+	Devel                    bool
+	Verbose                  uint8
+	Addr                     string `validate:"required_without=DevicesFile"`
+	Username                 string
+	Password                 string
+	Device                   string        `validate:"required_without=DevicesFile"`
+	DevicesFile              string        `mapstructure:"devices-file"`
+	DevicesReloadInterval    time.Duration `mapstructure:"devices-reload-interval" validate:"min=0"`
+	AggregatorURL            string        `mapstructure:"aggregator-url" validate:"required_without=AggregatorGRPCAddr"`
+	AggregatorGRPCAddr       string        `mapstructure:"aggregator-grpc-addr"`
+	AggregatorToken          string        `mapstructure:"aggregator-token"`
+	Debounce                 time.Duration `validate:"min=0"`
+	Origin                   string
+	Target                   string
+	Paths                    []string
+	GetPaths                 []string `mapstructure:"get-paths"`
+	Mode                     string
+	SampleInterval           time.Duration `mapstructure:"sample-interval" validate:"min=0"`
+	Encoding                 string
+	Model                    string
+	HealthAddr               string        `mapstructure:"health-addr"`
+	ReconnectInitialInterval time.Duration `mapstructure:"reconnect-initial-interval" validate:"min=0"`
+	ReconnectMaxInterval     time.Duration `mapstructure:"reconnect-max-interval" validate:"min=0"`
+	NoTLS                    bool          `mapstructure:"notls"`
+	TLSSkipVerify            bool          `mapstructure:"skip-verify"`
+	TLSKeyPath               string        `mapstructure:"tls-key"`
+	TLSCrtPath               string        `mapstructure:"tls-crt"`
+	TLSCACrtPath             string        `mapstructure:"tls-ca"`
+	DeprecatedTLSCACrtPath   string        `mapstructure:"tls-ca-crt"`
+	TLSServerName            string        `mapstructure:"tls-server-name"`
+	DeviceNoTLS              bool          `mapstructure:"device-notls"`
+	DeviceTLSSkipVerify      bool          `mapstructure:"device-skip-verify"`
+	TLSDeviceKeyPath         string        `mapstructure:"tls-device-key"`
+	TLSDeviceCrtPath         string        `mapstructure:"tls-device-crt"`
+	TLSDeviceCACrtPath       string        `mapstructure:"tls-device-ca"`
+	TLSDeviceServerName      string        `mapstructure:"tls-device-server-name"`
+
+	// PEM-encoded data given by the device list, which take precedence over the paths
+	TLSDeviceKeyData   []byte `mapstructure:"-"`
+	TLSDeviceCrtData   []byte `mapstructure:"-"`
+	TLSDeviceCACrtData []byte `mapstructure:"-"`
 }
 
 func (c *Config) TLSClientConfig() *credentials.TLSClientConfig {
@@ -58,25 +92,132 @@ func (c *Config) TLSClientConfig() *credentials.TLSClientConfig {
 			CACrtPath: c.TLSCACrtPath,
 		},
 		SkipVerifyServer: c.TLSSkipVerify,
+		ServerName:       c.TLSServerName,
 	}
 }
 
+// DeviceTLSClientConfig returns the TLS config to connect to the device. The client certificate to the aggregator
+// is used unless the one to the device is given.
 func (c *Config) DeviceTLSClientConfig() *credentials.TLSClientConfig {
-	return &credentials.TLSClientConfig{
+	cfg := &credentials.TLSClientConfig{
 		TLSConfigBase: credentials.TLSConfigBase{
-			NoTLS:     c.NoTLS,
-			CrtPath:   c.TLSCrtPath,
-			KeyPath:   c.TLSKeyPath,
+			NoTLS:     c.DeviceNoTLS,
+			CrtPath:   c.TLSDeviceCrtPath,
+			KeyPath:   c.TLSDeviceKeyPath,
 			CACrtPath: c.TLSDeviceCACrtPath,
+			CrtData:   c.TLSDeviceCrtData,
+			KeyData:   c.TLSDeviceKeyData,
+			CACrtData: c.TLSDeviceCACrtData,
 		},
-		SkipVerifyServer: c.TLSSkipVerify,
+		SkipVerifyServer: c.DeviceTLSSkipVerify,
+		ServerName:       c.TLSDeviceServerName,
 	}
+	if cfg.CrtPath == "" && cfg.CrtData == nil && cfg.KeyPath == "" && cfg.KeyData == nil {
+		cfg.CrtPath = c.TLSCrtPath
+		cfg.KeyPath = c.TLSKeyPath
+	}
+	return cfg
+}
+
+// ForDevice returns the copy of the config to subscribe the device in the device list. The fields of the device
+// override the config.
+func (c *Config) ForDevice(d subscriber.Device) (Config, error) {
+	cc := *c
+	cc.DevicesFile = ""
+	cc.Device = d.Name
+	cc.Addr = d.Addr
+	if d.Username != "" {
+		cc.Username = d.Username
+	}
+	if d.Password != "" {
+		cc.Password = d.Password
+	}
+	if d.Origin != "" {
+		cc.Origin = d.Origin
+	}
+	if d.Target != "" {
+		cc.Target = d.Target
+	}
+	if d.Paths != nil {
+		cc.Paths = d.Paths
+	}
+	if d.GetPaths != nil {
+		cc.GetPaths = d.GetPaths
+	}
+	if d.Mode != "" {
+		cc.Mode = d.Mode
+	}
+	if d.SampleInterval != "" {
+		v, err := time.ParseDuration(d.SampleInterval)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse sample interval of device %s: %w", d.Name, err)
+		}
+		cc.SampleInterval = v
+	}
+	if d.Encoding != "" {
+		cc.Encoding = d.Encoding
+	}
+	if d.Model != "" {
+		cc.Model = d.Model
+	}
+	if t := d.TLS; t != nil {
+		cc.DeviceNoTLS = t.NoTLS
+		cc.DeviceTLSSkipVerify = t.SkipVerify
+		if t.ServerName != "" {
+			cc.TLSDeviceServerName = t.ServerName
+		}
+		if t.Crt != "" && t.Key != "" {
+			cc.TLSDeviceCrtData = []byte(t.Crt)
+			cc.TLSDeviceKeyData = []byte(t.Key)
+		}
+		if t.CACrt != "" {
+			cc.TLSDeviceCACrtData = []byte(t.CACrt)
+		}
+	}
+	if err := cc.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config of device %s: %w", d.Name, err)
+	}
+	return cc, nil
+}
+
+// ApplyDeprecated applies the deprecated flags for compatibility, and returns the warnings of the deprecated flags
+// in use. notls and skip-verify used to apply to both the aggregator and the device, so that they still apply to
+// the device unless device-notls and device-skip-verify are set. isSet returns true if the flag is set explicitly.
+func (c *Config) ApplyDeprecated(isSet func(key string) bool) []string {
+	var warnings []string
+	if c.DeprecatedTLSCACrtPath != "" {
+		if c.TLSCACrtPath == "" {
+			c.TLSCACrtPath = c.DeprecatedTLSCACrtPath
+		}
+		warnings = append(warnings, "tls-ca-crt is deprecated, use tls-ca instead")
+	}
+	if c.NoTLS && !isSet("device-notls") {
+		c.DeviceNoTLS = true
+		warnings = append(warnings, "notls applies to the device as well since device-notls is not set, which is deprecated: set device-notls explicitly")
+	}
+	if c.TLSSkipVerify && !isSet("device-skip-verify") {
+		c.DeviceTLSSkipVerify = true
+		warnings = append(warnings, "skip-verify applies to the device as well since device-skip-verify is not set, which is deprecated: set device-skip-verify explicitly")
+	}
+	return warnings
 }
 
 // Validate validates exposed fields according to the `validate` tag.
 func (c *Config) Validate() error {
 	if c.TLSSkipVerify && c.TLSCACrtPath != "" {
-		return fmt.Errorf("skip-verify and tls-ca-crt flags are mutually exclusive")
+		return fmt.Errorf("skip-verify and tls-ca flags are mutually exclusive")
+	}
+	if c.DeviceTLSSkipVerify && (c.TLSDeviceCACrtPath != "" || c.TLSDeviceCACrtData != nil) {
+		return fmt.Errorf("device-skip-verify and tls-device-ca flags are mutually exclusive")
+	}
+	if _, err := c.SubscribeRequest(); err != nil {
+		return fmt.Errorf("invalid subscription: %w", err)
+	}
+	if _, err := c.GetRequest(); err != nil {
+		return fmt.Errorf("invalid get paths: %w", err)
+	}
+	if _, err := ygotModel(c.Model); err != nil {
+		return fmt.Errorf("invalid model: %w", err)
 	}
 	return validator.Validate(c)
 }
@@ -85,6 +226,12 @@ func (c *Config) Validate() error {
 func (c *Config) Mask() *Config {
 	cc := *c
 	cc.Password = "***"
+	if cc.TLSDeviceKeyData != nil {
+		cc.TLSDeviceKeyData = []byte("***")
+	}
+	if cc.AggregatorToken != "" {
+		cc.AggregatorToken = "***"
+	}
 	return &cc
 }
 
@@ -93,15 +240,20 @@ func NewRootCmd() *cobra.Command {
 		Use:          "kuesta-subscribe",
 		Short:        "kuesta-subscribe subscribes Network Element Configuration Update.",
 		SilenceUsage: true,
+		Version:      version,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var cfg Config
 			if err := viper.Unmarshal(&cfg); err != nil {
 				return err
 			}
+			warnings := cfg.ApplyDeprecated(viper.IsSet)
 			if err := cfg.Validate(); err != nil {
 				return err
 			}
 			logger.Setup(cfg.Devel, cfg.Verbose)
+			for _, w := range warnings {
+				logger.FromContext(context.Background()).Warnw(w)
+			}
 			return Run(cfg)
 		},
 	}
@@ -112,10 +264,36 @@ func NewRootCmd() *cobra.Command {
 	cmd.Flags().StringP("username", "u", "admin", "Username of the target device")
 	cmd.Flags().StringP("password", "p", "admin", "Password of the target device")
 	cmd.Flags().StringP("device", "d", "", "Name of the target device")
+	cmd.Flags().StringP("devices-file", "", "", "Path to the JSON file of the devices to subscribe. If set, addr and device are ignored, and the devices are subscribed in one process")
+	cmd.Flags().DurationP("devices-reload-interval", "", 10*time.Second, "Interval to check the changes of devices-file")
 	cmd.Flags().StringP("aggregator-url", "", "", "URL of the aggregator")
-	cmd.Flags().BoolP("notls", "", false, "Run server without TLS.")
-	cmd.Flags().BoolP("skip-verify", "", false, "Skip TLS verification and allow insecure transport.")
-	cmd.Flags().StringP("tls-ca-crt", "", "", "Path to the TLS server certificate file.")
+	cmd.Flags().StringP("aggregator-grpc-addr", "", "", "Address of the aggregator gRPC API. If set, device config is reported over gRPC stream instead of aggregator-url")
+	cmd.Flags().StringP("aggregator-token", "", "", "Bearer token to authenticate to the aggregator")
+	cmd.Flags().DurationP("debounce", "", time.Second, "Period to wait for subsequent notifications before reporting the changes to the aggregator")
+	cmd.Flags().StringP("origin", "", "", "Origin of the gNMI paths to subscribe and get")
+	cmd.Flags().StringP("target", "", "", "Target of the gNMI paths to subscribe and get")
+	cmd.Flags().StringSliceP("paths", "", nil, "gNMI paths to subscribe, such as /interfaces/interface[name=Ethernet1]. The root path is subscribed if empty")
+	cmd.Flags().StringSliceP("get-paths", "", nil, "gNMI paths to get the entire device config on resync. The root path is used if empty")
+	cmd.Flags().StringP("mode", "", "TARGET_DEFINED", "Subscription mode, one of TARGET_DEFINED, ON_CHANGE and SAMPLE")
+	cmd.Flags().DurationP("sample-interval", "", 0, "Sample interval of SAMPLE subscription mode. The device decides the interval if 0")
+	cmd.Flags().StringP("encoding", "", "JSON_IETF", "Encoding of the gNMI values, one of JSON_IETF, JSON and PROTO")
+	cmd.Flags().StringP("model", "", DefaultModel, "Name of the device model with which the device config is decoded")
+	cmd.Flags().StringP("health-addr", "", ":8081", "Address to serve the liveness (/healthz) and readiness (/readyz) endpoints. Disabled if empty")
+	cmd.Flags().DurationP("reconnect-initial-interval", "", time.Second, "Initial interval to wait before reconnecting to the device, which grows exponentially with jitter")
+	cmd.Flags().DurationP("reconnect-max-interval", "", time.Minute, "Maximum interval to wait before reconnecting to the device")
+	cmd.Flags().BoolP("notls", "", false, "Connect to the aggregator without TLS. It also applies to the device unless device-notls is set, which is deprecated.")
+	cmd.Flags().BoolP("skip-verify", "", false, "Skip TLS verification of the aggregator and allow insecure transport. It also applies to the device unless device-skip-verify is set, which is deprecated.")
+	cmd.Flags().StringP("tls-ca", "", "", "Path to the CA certificate file to verify the aggregator.")
+	cmd.Flags().StringP("tls-ca-crt", "", "", "Deprecated: use tls-ca instead.")
+	cmd.Flags().StringP("tls-crt", "", "", "Path to the TLS client certificate file, which is used as the identity to the aggregator.")
+	cmd.Flags().StringP("tls-key", "", "", "Path to the TLS client private key file.")
+	cmd.Flags().StringP("tls-server-name", "", "", "Server name to verify the aggregator certificate.")
+	cmd.Flags().BoolP("device-notls", "", false, "Connect to the device without TLS.")
+	cmd.Flags().BoolP("device-skip-verify", "", false, "Skip TLS verification of the device and allow insecure transport.")
+	cmd.Flags().StringP("tls-device-ca", "", "", "Path to the CA certificate file to verify the device.")
+	cmd.Flags().StringP("tls-device-crt", "", "", "Path to the TLS client certificate file to the device. tls-crt is used if not set.")
+	cmd.Flags().StringP("tls-device-key", "", "", "Path to the TLS client private key file to the device. tls-key is used if not set.")
+	cmd.Flags().StringP("tls-device-server-name", "", "", "Server name to verify the device certificate.")
 
 	cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
 	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
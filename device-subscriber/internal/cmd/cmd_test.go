@@ -24,7 +24,9 @@ package cmd
 
 import (
 	"testing"
+	"time"
 
+	"github.com/nttcom/kuesta/pkg/subscriber"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,6 +65,66 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"ok: aggregator-grpc-addr instead of aggregator-url",
+			func(cfg *Config) {
+				cfg.AggregatorURL = ""
+				cfg.AggregatorGRPCAddr = "localhost:8001"
+			},
+			false,
+		},
+		{
+			"err: unknown subscription mode",
+			func(cfg *Config) {
+				cfg.Mode = "unknown"
+			},
+			true,
+		},
+		{
+			"err: invalid get path",
+			func(cfg *Config) {
+				cfg.GetPaths = []string{"/interfaces/interface[name]"}
+			},
+			true,
+		},
+		{
+			"err: device-skip-verify with tls-device-ca",
+			func(cfg *Config) {
+				cfg.DeviceTLSSkipVerify = true
+				cfg.TLSDeviceCACrtPath = "ca.crt"
+			},
+			true,
+		},
+		{
+			"ok: devices-file instead of device and addr",
+			func(cfg *Config) {
+				cfg.Device = ""
+				cfg.Addr = ""
+				cfg.DevicesFile = "devices.json"
+			},
+			false,
+		},
+		{
+			"ok: openconfig model",
+			func(cfg *Config) {
+				cfg.Model = "openconfig"
+			},
+			false,
+		},
+		{
+			"err: unknown model",
+			func(cfg *Config) {
+				cfg.Model = "unknown"
+			},
+			true,
+		},
+		{
+			"err: schema-less model",
+			func(cfg *Config) {
+				cfg.Model = "json"
+			},
+			true,
+		},
 		{
 			"err: aggregator-url is empty",
 			func(cfg *Config) {
@@ -85,6 +147,82 @@ func TestConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestConfig_ForDevice(t *testing.T) {
+	cfg := &Config{
+		Username:      "admin",
+		Password:      "admin",
+		AggregatorURL: "http://localhost:8000",
+		DevicesFile:   "devices.json",
+		Mode:          "ON_CHANGE",
+		Paths:         []string{"/interfaces"},
+	}
+
+	got, err := cfg.ForDevice(subscriber.Device{
+		Name:           "device1",
+		Addr:           ":9339",
+		Password:       "secret",
+		Mode:           "SAMPLE",
+		SampleInterval: "1m",
+	})
+	assert.Nil(t, err)
+	want := Config{
+		Device:         "device1",
+		Addr:           ":9339",
+		Username:       "admin",
+		Password:       "secret",
+		AggregatorURL:  "http://localhost:8000",
+		Mode:           "SAMPLE",
+		SampleInterval: time.Minute,
+		Paths:          []string{"/interfaces"},
+	}
+	assert.Equal(t, want, got)
+
+	got, err = cfg.ForDevice(subscriber.Device{
+		Name: "device1",
+		Addr: ":9339",
+		TLS:  &subscriber.DeviceTLS{ServerName: "device1.example.com", Crt: "crt", Key: "key", CACrt: "ca"},
+	})
+	assert.Nil(t, err)
+	tlsCfg := got.DeviceTLSClientConfig()
+	assert.Equal(t, "device1.example.com", tlsCfg.ServerName)
+	assert.Equal(t, []byte("crt"), tlsCfg.CrtData)
+	assert.Equal(t, []byte("key"), tlsCfg.KeyData)
+	assert.Equal(t, []byte("ca"), tlsCfg.CACrtData)
+	assert.False(t, tlsCfg.SkipVerifyServer)
+
+	got, err = cfg.ForDevice(subscriber.Device{Name: "device1", Addr: ":9339", Model: "openconfig"})
+	assert.Nil(t, err)
+	assert.Equal(t, "openconfig", got.Model)
+
+	_, err = cfg.ForDevice(subscriber.Device{Name: "device1", Addr: ":9339", SampleInterval: "1 minute"})
+	assert.Error(t, err)
+	_, err = cfg.ForDevice(subscriber.Device{Name: "device1", Addr: ":9339", Encoding: "ascii"})
+	assert.Error(t, err)
+}
+
+func TestConfig_DeviceTLSClientConfig(t *testing.T) {
+	cfg := &Config{
+		NoTLS:              true,
+		TLSSkipVerify:      true,
+		TLSCrtPath:         "client.crt",
+		TLSKeyPath:         "client.key",
+		TLSDeviceCACrtPath: "device-ca.crt",
+	}
+	got := cfg.DeviceTLSClientConfig()
+	// the settings to the aggregator are not applied to the device except the client certificate
+	assert.False(t, got.NoTLS)
+	assert.False(t, got.SkipVerifyServer)
+	assert.Equal(t, "client.crt", got.CrtPath)
+	assert.Equal(t, "client.key", got.KeyPath)
+	assert.Equal(t, "device-ca.crt", got.CACrtPath)
+
+	cfg.TLSDeviceCrtPath = "device.crt"
+	cfg.TLSDeviceKeyPath = "device.key"
+	got = cfg.DeviceTLSClientConfig()
+	assert.Equal(t, "device.crt", got.CrtPath)
+	assert.Equal(t, "device.key", got.KeyPath)
+}
+
 func TestNewRootCmd(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -121,3 +259,68 @@ func TestNewRootCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_ApplyDeprecated(t *testing.T) {
+	isSet := func(keys ...string) func(string) bool {
+		return func(key string) bool {
+			for _, k := range keys {
+				if k == key {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	tests := []struct {
+		name         string
+		given        Config
+		isSet        func(string) bool
+		want         Config
+		wantWarnings int
+	}{
+		{
+			"ok: nothing deprecated",
+			Config{NoTLS: false, TLSCACrtPath: "ca.crt"},
+			isSet(),
+			Config{NoTLS: false, TLSCACrtPath: "ca.crt"},
+			0,
+		},
+		{
+			"ok: tls-ca-crt",
+			Config{DeprecatedTLSCACrtPath: "ca.crt"},
+			isSet(),
+			Config{DeprecatedTLSCACrtPath: "ca.crt", TLSCACrtPath: "ca.crt"},
+			1,
+		},
+		{
+			"ok: tls-ca takes precedence over tls-ca-crt",
+			Config{DeprecatedTLSCACrtPath: "old.crt", TLSCACrtPath: "new.crt"},
+			isSet(),
+			Config{DeprecatedTLSCACrtPath: "old.crt", TLSCACrtPath: "new.crt"},
+			1,
+		},
+		{
+			"ok: notls and skip-verify apply to device",
+			Config{NoTLS: true, TLSSkipVerify: true},
+			isSet(),
+			Config{NoTLS: true, TLSSkipVerify: true, DeviceNoTLS: true, DeviceTLSSkipVerify: true},
+			2,
+		},
+		{
+			"ok: device flags set explicitly",
+			Config{NoTLS: true, TLSSkipVerify: true},
+			isSet("device-notls", "device-skip-verify"),
+			Config{NoTLS: true, TLSSkipVerify: true},
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.given
+			warnings := cfg.ApplyDeprecated(tt.isSet)
+			assert.Equal(t, tt.want, cfg)
+			assert.Len(t, warnings, tt.wantWarnings)
+		})
+	}
+}
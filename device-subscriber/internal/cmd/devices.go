@@ -0,0 +1,175 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/nttcom/kuesta/device-subscriber/internal/logger"
+	"github.com/nttcom/kuesta/pkg/subscriber"
+	"github.com/pkg/errors"
+)
+
+// DeviceManager runs the gNMI session of each device in its own goroutine, and starts, restarts or stops
+// the sessions according to the device list.
+type DeviceManager struct {
+	cfg    Config
+	health *HealthGroup
+	run    func(ctx context.Context, cfg Config, health *Health) error
+
+	mu       sync.Mutex
+	sessions map[string]*deviceSession
+}
+
+type deviceSession struct {
+	cfg    Config
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewDeviceManager creates DeviceManager. The fields of each device in the device list override the supplied config.
+func NewDeviceManager(cfg Config, health *HealthGroup) *DeviceManager {
+	return &DeviceManager{
+		cfg:      cfg,
+		health:   health,
+		run:      RunDevice,
+		sessions: map[string]*deviceSession{},
+	}
+}
+
+// Watch reads the device list file at the interval, and updates the sessions when the file is changed.
+// It stops all the sessions and returns when ctx is done.
+func (m *DeviceManager) Watch(ctx context.Context, path string, interval time.Duration) error {
+	l := logger.FromContext(ctx)
+	defer m.Stop()
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	var last []byte
+	for {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			logger.ErrorWithStack(ctx, errors.WithStack(err), "read device list", "path", path)
+		} else if !bytes.Equal(buf, last) {
+			if dl, err := subscriber.ParseDeviceList(buf); err != nil {
+				// NOTE keep the current sessions until the device list is fixed
+				logger.ErrorWithStack(ctx, err, "parse device list", "path", path)
+			} else {
+				l.Infow("device list loaded", "path", path, "devices", len(dl.Devices))
+				m.Update(ctx, dl)
+			}
+			last = buf
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// Update starts the sessions of the new devices, restarts those whose config is changed, and stops those removed
+// from the device list.
+func (m *DeviceManager) Update(ctx context.Context, dl *subscriber.DeviceList) {
+	desired := map[string]Config{}
+	for _, d := range dl.Devices {
+		cfg, err := m.cfg.ForDevice(d)
+		if err != nil {
+			logger.ErrorWithStack(ctx, err, "skip device")
+			continue
+		}
+		desired[d.Name] = cfg
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name, s := range m.sessions {
+		if cfg, ok := desired[name]; !ok || !reflect.DeepEqual(cfg, s.cfg) {
+			m.stop(name, s)
+		}
+	}
+	for name, cfg := range desired {
+		if _, ok := m.sessions[name]; !ok {
+			m.start(ctx, name, cfg)
+		}
+	}
+}
+
+// Devices returns the names of the devices whose sessions are running.
+func (m *DeviceManager) Devices() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	names := make([]string, 0, len(m.sessions))
+	for name := range m.sessions {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Stop stops all the sessions.
+func (m *DeviceManager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// cancel all first not to wait for each session one by one
+	for _, s := range m.sessions {
+		s.cancel()
+	}
+	for name, s := range m.sessions {
+		m.stop(name, s)
+	}
+}
+
+func (m *DeviceManager) start(ctx context.Context, name string, cfg Config) {
+	l := logger.FromContext(ctx).With("device", name)
+	ctx, cancel := context.WithCancel(logger.WithLogger(ctx, l))
+	s := &deviceSession{cfg: cfg, cancel: cancel, done: make(chan struct{})}
+	h := m.health.Add(name)
+	m.sessions[name] = s
+
+	l.Infow("start device session")
+	go func() {
+		defer close(s.done)
+		if err := m.run(ctx, cfg, h); err != nil {
+			logger.ErrorWithStack(ctx, fmt.Errorf("device session stopped: %w", err), "run device session")
+			h.SetDisconnected(err)
+		}
+	}()
+}
+
+func (m *DeviceManager) stop(name string, s *deviceSession) {
+	s.cancel()
+	<-s.done
+	delete(m.sessions, name)
+	m.health.Remove(name)
+	DeleteDeviceMetrics(name)
+}
@@ -0,0 +1,150 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nttcom/kuesta/pkg/subscriber"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+type sessionRecorder struct {
+	mu      sync.Mutex
+	started map[string]Config
+	stopped []string
+}
+
+func (r *sessionRecorder) run(ctx context.Context, cfg Config, h *Health) error {
+	r.mu.Lock()
+	r.started[cfg.Device] = cfg
+	r.mu.Unlock()
+	h.SetConnected()
+	<-ctx.Done()
+	r.mu.Lock()
+	r.stopped = append(r.stopped, cfg.Device)
+	r.mu.Unlock()
+	return nil
+}
+
+func newTestDeviceManager() (*DeviceManager, *sessionRecorder) {
+	rec := &sessionRecorder{started: map[string]Config{}}
+	m := NewDeviceManager(Config{AggregatorURL: "http://localhost:8000", Mode: "ON_CHANGE"}, NewHealthGroup())
+	m.run = rec.run
+	return m, rec
+}
+
+func sortedDevices(m *DeviceManager) []string {
+	names := m.Devices()
+	sort.Strings(names)
+	return names
+}
+
+func TestDeviceManager_Update(t *testing.T) {
+	ctx := context.Background()
+	m, rec := newTestDeviceManager()
+	defer m.Stop()
+
+	m.Update(ctx, &subscriber.DeviceList{Devices: []subscriber.Device{
+		{Name: "device1", Addr: ":9339"},
+		{Name: "device2", Addr: ":9340", Mode: "SAMPLE", SampleInterval: "10s"},
+		{Name: "invalid", Addr: ":9341", Mode: "unknown"},
+	}})
+	assert.Equal(t, []string{"device1", "device2"}, sortedDevices(m))
+
+	assert.Eventually(t, func() bool {
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		return len(rec.started) == 2
+	}, time.Second, 10*time.Millisecond)
+	rec.mu.Lock()
+	assert.Equal(t, "ON_CHANGE", rec.started["device1"].Mode)
+	assert.Equal(t, "SAMPLE", rec.started["device2"].Mode)
+	assert.Equal(t, 10*time.Second, rec.started["device2"].SampleInterval)
+	rec.mu.Unlock()
+
+	// device1 is removed, device2 is restarted with the new address, and device3 is added
+	m.Update(ctx, &subscriber.DeviceList{Devices: []subscriber.Device{
+		{Name: "device2", Addr: ":9342"},
+		{Name: "device3", Addr: ":9343"},
+	}})
+	assert.Equal(t, []string{"device2", "device3"}, sortedDevices(m))
+	rec.mu.Lock()
+	stopped := append([]string{}, rec.stopped...)
+	rec.mu.Unlock()
+	sort.Strings(stopped)
+	assert.Equal(t, []string{"device1", "device2"}, stopped)
+
+	assert.Eventually(t, func() bool {
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		return rec.started["device2"].Addr == ":9342" && rec.started["device3"].Addr == ":9343"
+	}, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return len(m.health.Disconnected()) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDeviceManager_Watch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, subscriber.FileDevices)
+	write := func(s string) {
+		testhelper.ExitOnErr(t, os.WriteFile(path, []byte(s), 0o600))
+	}
+	write(`{"devices": [{"name": "device1", "addr": ":9339"}]}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m, rec := newTestDeviceManager()
+	done := make(chan error)
+	go func() {
+		done <- m.Watch(ctx, path, 10*time.Millisecond)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return len(m.Devices()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// the sessions are kept while the device list is broken
+	write(`{"devices": [`)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, []string{"device1"}, m.Devices())
+
+	write(`{"devices": [{"name": "device1", "addr": ":9339"}, {"name": "device2", "addr": ":9340"}]}`)
+	assert.Eventually(t, func() bool {
+		return len(m.Devices()) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.Nil(t, <-done)
+	assert.Empty(t, m.Devices())
+	rec.mu.Lock()
+	assert.Len(t, rec.stopped, 2)
+	rec.mu.Unlock()
+}
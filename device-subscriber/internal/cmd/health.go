@@ -0,0 +1,199 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nttcom/kuesta/device-subscriber/internal/logger"
+	"github.com/nttcom/kuesta/pkg/subscriber"
+	"github.com/pkg/errors"
+)
+
+// Health holds the connection state of the gNMI subscription to the device.
+type Health struct {
+	mu        sync.RWMutex
+	connected bool
+	lastErr   error
+}
+
+// NewHealth creates Health in the disconnected state.
+func NewHealth() *Health {
+	return &Health{}
+}
+
+// SetConnected marks the subscription as established.
+func (h *Health) SetConnected() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.connected = true
+	h.lastErr = nil
+}
+
+// SetDisconnected marks the subscription as lost with the supplied error.
+func (h *Health) SetDisconnected(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.connected = false
+	h.lastErr = err
+}
+
+// Connected returns whether the subscription is established, and the error of the last disconnection if not.
+func (h *Health) Connected() (bool, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.connected, h.lastErr
+}
+
+// HealthGroup holds Health of the gNMI session of each device.
+type HealthGroup struct {
+	// ProcessReadiness makes the readiness endpoint succeed as long as the subscriber is running regardless of
+	// the devices, so that one unreachable device does not make the subscriber of the other devices unready.
+	ProcessReadiness bool
+
+	mu      sync.RWMutex
+	devices map[string]*Health
+}
+
+// NewHealthGroup creates the empty HealthGroup.
+func NewHealthGroup() *HealthGroup {
+	return &HealthGroup{devices: map[string]*Health{}}
+}
+
+// Add returns the new Health of the device, which replaces the existing one.
+func (g *HealthGroup) Add(device string) *Health {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	h := NewHealth()
+	g.devices[device] = h
+	return h
+}
+
+// Remove removes Health of the device.
+func (g *HealthGroup) Remove(device string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.devices, device)
+}
+
+// Disconnected returns the errors of the devices whose gNMI sessions are not established.
+func (g *HealthGroup) Disconnected() map[string]error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	m := map[string]error{}
+	for name, h := range g.devices {
+		if connected, err := h.Connected(); !connected {
+			m[name] = err
+		}
+	}
+	return m
+}
+
+// Devices returns the connection state of each device.
+func (g *HealthGroup) Devices() map[string]subscriber.DeviceHealth {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	m := make(map[string]subscriber.DeviceHealth, len(g.devices))
+	for name, h := range g.devices {
+		connected, err := h.Connected()
+		dh := subscriber.DeviceHealth{Connected: connected}
+		if err != nil {
+			dh.Error = err.Error()
+		}
+		m[name] = dh
+	}
+	return m
+}
+
+// Handler returns the http.Handler serving /healthz, /readyz, /devices and /debug/vars. The liveness endpoint
+// succeeds as long as the subscriber is running, since the subscriber reconnects by itself, whereas the readiness
+// endpoint succeeds only while the subscriptions to all the devices are established unless ProcessReadiness is set.
+// The connection state of each device is served on /devices.
+func (g *HealthGroup) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		var disconnected map[string]error
+		if !g.ProcessReadiness {
+			disconnected = g.Disconnected()
+		}
+		if len(disconnected) == 0 {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, "ok")
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+		names := make([]string, 0, len(disconnected))
+		for name := range disconnected {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := disconnected[name]; err != nil {
+				fmt.Fprintf(w, "%s: disconnected: %v\n", name, err)
+			} else {
+				fmt.Fprintf(w, "%s: not connected yet\n", name)
+			}
+		}
+	})
+	mux.HandleFunc(subscriber.PathDevicesHealth, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(g.Devices()); err != nil {
+			logger.ErrorWithStack(r.Context(), errors.WithStack(err), "encode device health")
+		}
+	})
+	mux.Handle("/debug/vars", expvar.Handler())
+	return mux
+}
+
+// ServeHealth serves the liveness and readiness endpoints on the supplied address until ctx is done.
+func ServeHealth(ctx context.Context, addr string, h http.Handler) error {
+	l := logger.FromContext(ctx)
+	s := &http.Server{
+		Addr:              addr,
+		Handler:           h,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		if err := s.Close(); err != nil {
+			l.Errorw("close health server", "error", err)
+		}
+	}()
+
+	l.Infow("start health server", "addr", addr)
+	if err := s.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serve health endpoints: %w", errors.WithStack(err))
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nttcom/kuesta/pkg/subscriber"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthGroup_Handler(t *testing.T) {
+	g := NewHealthGroup()
+	h := g.Add("device1")
+	hs := httptest.NewServer(g.Handler())
+	defer hs.Close()
+
+	get := func(path string) int {
+		resp, err := http.Get(hs.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get("/healthz"))
+	assert.Equal(t, http.StatusServiceUnavailable, get("/readyz"))
+
+	h.SetConnected()
+	assert.Equal(t, http.StatusOK, get("/healthz"))
+	assert.Equal(t, http.StatusOK, get("/readyz"))
+
+	h.SetDisconnected(fmt.Errorf("dummy"))
+	assert.Equal(t, http.StatusOK, get("/healthz"))
+	assert.Equal(t, http.StatusServiceUnavailable, get("/readyz"))
+	connected, err := h.Connected()
+	assert.False(t, connected)
+	assert.EqualError(t, err, "dummy")
+
+	// ready only when all devices are connected
+	h.SetConnected()
+	h2 := g.Add("device2")
+	assert.Equal(t, http.StatusServiceUnavailable, get("/readyz"))
+	assert.Equal(t, map[string]error{"device2": nil}, g.Disconnected())
+	h2.SetConnected()
+	assert.Equal(t, http.StatusOK, get("/readyz"))
+	g.Remove("device2")
+	assert.Equal(t, http.StatusOK, get("/readyz"))
+	assert.Equal(t, http.StatusOK, get("/debug/vars"))
+}
+
+func TestHealthGroup_Handler_ProcessReadiness(t *testing.T) {
+	g := NewHealthGroup()
+	g.ProcessReadiness = true
+	g.Add("device1").SetConnected()
+	g.Add("device2").SetDisconnected(fmt.Errorf("dummy"))
+	g.Add("device3")
+	hs := httptest.NewServer(g.Handler())
+	defer hs.Close()
+
+	resp, err := http.Get(hs.URL + "/readyz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Get(hs.URL + subscriber.PathDevicesHealth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var got map[string]subscriber.DeviceHealth
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]subscriber.DeviceHealth{
+		"device1": {Connected: true},
+		"device2": {Connected: false, Error: "dummy"},
+		"device3": {Connected: false},
+	}, got)
+}
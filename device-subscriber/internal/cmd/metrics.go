@@ -0,0 +1,55 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"expvar"
+)
+
+// SubscriberDevices holds DeviceMetrics of each device. It is exposed on /debug/vars of the health server.
+var SubscriberDevices = expvar.NewMap("kuesta_subscriber_devices")
+
+// DeviceMetrics are the metrics of the gNMI session to the device.
+type DeviceMetrics struct {
+	Connected     expvar.Int
+	Reconnects    expvar.Int
+	Resyncs       expvar.Int
+	Notifications expvar.Int
+}
+
+// NewDeviceMetrics creates DeviceMetrics of the device and publishes it, which replaces the existing one.
+func NewDeviceMetrics(device string) *DeviceMetrics {
+	m := &DeviceMetrics{}
+	v := new(expvar.Map).Init()
+	v.Set("connected", &m.Connected)
+	v.Set("reconnects", &m.Reconnects)
+	v.Set("resyncs", &m.Resyncs)
+	v.Set("notifications", &m.Notifications)
+	SubscriberDevices.Set(device, v)
+	return m
+}
+
+// DeleteDeviceMetrics unpublishes DeviceMetrics of the device.
+func DeleteDeviceMetrics(device string) {
+	SubscriberDevices.Delete(device)
+}
@@ -0,0 +1,137 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+)
+
+// SubscribeRequest returns the gNMI SubscribeRequest of the configured paths, origin, target, mode and encoding.
+// The root path is subscribed if no path is configured.
+func (c *Config) SubscribeRequest() (*gnmi.SubscribeRequest, error) {
+	mode, err := parseSubscriptionMode(c.Mode)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := parseEncoding(c.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := parsePaths(c.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var subs []*gnmi.Subscription
+	for _, p := range paths {
+		sub := &gnmi.Subscription{Path: p, Mode: mode}
+		if mode == gnmi.SubscriptionMode_SAMPLE {
+			sub.SampleInterval = uint64(c.SampleInterval.Nanoseconds())
+		}
+		subs = append(subs, sub)
+	}
+	return &gnmi.SubscribeRequest{
+		Request: &gnmi.SubscribeRequest_Subscribe{
+			Subscribe: &gnmi.SubscriptionList{
+				Prefix:       c.pathPrefix(),
+				Subscription: subs,
+				Mode:         gnmi.SubscriptionList_STREAM,
+				Encoding:     enc,
+			},
+		},
+	}, nil
+}
+
+// GetRequest returns the gNMI GetRequest to fetch the entire device config on resync. The root path is requested
+// if no get path is configured.
+func (c *Config) GetRequest() (*gnmi.GetRequest, error) {
+	enc, err := parseEncoding(c.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := parsePaths(c.GetPaths)
+	if err != nil {
+		return nil, err
+	}
+	return &gnmi.GetRequest{
+		Prefix:   c.pathPrefix(),
+		Path:     paths,
+		Encoding: enc,
+	}, nil
+}
+
+func (c *Config) pathPrefix() *gnmi.Path {
+	if c.Origin == "" && c.Target == "" {
+		return nil
+	}
+	return &gnmi.Path{Origin: c.Origin, Target: c.Target}
+}
+
+// parsePaths parses the gNMI path strings such as `/interfaces/interface[name=Ethernet1]`.
+func parsePaths(ss []string) ([]*gnmi.Path, error) {
+	if len(ss) == 0 {
+		return []*gnmi.Path{{}}, nil
+	}
+	paths := make([]*gnmi.Path, 0, len(ss))
+	for _, s := range ss {
+		p, err := ygot.StringToStructuredPath(s)
+		if err != nil {
+			return nil, errors.WithStack(fmt.Errorf("parse path %q: %w", s, err))
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+func parseSubscriptionMode(s string) (gnmi.SubscriptionMode, error) {
+	if s == "" {
+		return gnmi.SubscriptionMode_TARGET_DEFINED, nil
+	}
+	v, ok := gnmi.SubscriptionMode_value[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]
+	if !ok {
+		return 0, errors.WithStack(fmt.Errorf("unknown subscription mode: %s", s))
+	}
+	return gnmi.SubscriptionMode(v), nil
+}
+
+func parseEncoding(s string) (gnmi.Encoding, error) {
+	if s == "" {
+		return gnmi.Encoding_JSON_IETF, nil
+	}
+	v, ok := gnmi.Encoding_value[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]
+	if !ok {
+		return 0, errors.WithStack(fmt.Errorf("unknown encoding: %s", s))
+	}
+	// NOTE ASCII and BYTES are not supported since they cannot be applied to the device model
+	switch e := gnmi.Encoding(v); e {
+	case gnmi.Encoding_JSON, gnmi.Encoding_JSON_IETF, gnmi.Encoding_PROTO:
+		return e, nil
+	default:
+		return 0, errors.WithStack(fmt.Errorf("unsupported encoding: %s", s))
+	}
+}
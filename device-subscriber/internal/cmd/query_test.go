@@ -0,0 +1,169 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConfig_SubscribeRequest(t *testing.T) {
+	ifPath := &pb.Path{Elem: []*pb.PathElem{
+		{Name: "interfaces"},
+		{Name: "interface", Key: map[string]string{"name": "Ethernet1"}},
+	}}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    *pb.SubscriptionList
+		wantErr bool
+	}{
+		{
+			"ok: default",
+			Config{},
+			&pb.SubscriptionList{
+				Subscription: []*pb.Subscription{{Path: &pb.Path{}, Mode: pb.SubscriptionMode_TARGET_DEFINED}},
+				Mode:         pb.SubscriptionList_STREAM,
+				Encoding:     pb.Encoding_JSON_IETF,
+			},
+			false,
+		},
+		{
+			"ok: sample",
+			Config{
+				Origin:         "openconfig",
+				Target:         "device1",
+				Paths:          []string{"/interfaces/interface[name=Ethernet1]"},
+				Mode:           "sample",
+				SampleInterval: 10 * time.Second,
+				Encoding:       "proto",
+			},
+			&pb.SubscriptionList{
+				Prefix: &pb.Path{Origin: "openconfig", Target: "device1"},
+				Subscription: []*pb.Subscription{
+					{Path: ifPath, Mode: pb.SubscriptionMode_SAMPLE, SampleInterval: uint64(10 * time.Second)},
+				},
+				Mode:     pb.SubscriptionList_STREAM,
+				Encoding: pb.Encoding_PROTO,
+			},
+			false,
+		},
+		{
+			"ok: on change",
+			Config{
+				Paths:    []string{"/interfaces/interface[name=Ethernet1]"},
+				Mode:     "ON_CHANGE",
+				Encoding: "JSON",
+			},
+			&pb.SubscriptionList{
+				Subscription: []*pb.Subscription{{Path: ifPath, Mode: pb.SubscriptionMode_ON_CHANGE}},
+				Mode:         pb.SubscriptionList_STREAM,
+				Encoding:     pb.Encoding_JSON,
+			},
+			false,
+		},
+		{
+			"err: unknown mode",
+			Config{Mode: "poll"},
+			nil,
+			true,
+		},
+		{
+			"err: unsupported encoding",
+			Config{Encoding: "ascii"},
+			nil,
+			true,
+		},
+		{
+			"err: invalid path",
+			Config{Paths: []string{"/interfaces/interface[=Ethernet1]"}},
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.SubscribeRequest()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.True(t, proto.Equal(tt.want, got.GetSubscribe()), "got %v", got)
+		})
+	}
+}
+
+func TestConfig_GetRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    *pb.GetRequest
+		wantErr bool
+	}{
+		{
+			"ok: default",
+			Config{},
+			&pb.GetRequest{Path: []*pb.Path{{}}, Encoding: pb.Encoding_JSON_IETF},
+			false,
+		},
+		{
+			"ok: get paths",
+			Config{
+				Target:   "device1",
+				GetPaths: []string{"/interfaces", "/system"},
+				Encoding: "json",
+			},
+			&pb.GetRequest{
+				Prefix:   &pb.Path{Target: "device1"},
+				Path:     []*pb.Path{{Elem: []*pb.PathElem{{Name: "interfaces"}}}, {Elem: []*pb.PathElem{{Name: "system"}}}},
+				Encoding: pb.Encoding_JSON,
+			},
+			false,
+		},
+		{
+			"err: unknown encoding",
+			Config{Encoding: "xml"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.GetRequest()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.True(t, proto.Equal(tt.want, got), "got %v", got)
+		})
+	}
+}
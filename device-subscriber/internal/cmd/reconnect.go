@@ -0,0 +1,132 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/nttcom/kuesta/device-subscriber/internal/logger"
+	gnmiclient "github.com/openconfig/gnmi/client/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Reconnector keeps the gNMI subscription to the device. Once the subscription is lost, it reports the connection
+// error to the aggregator, and reconnects after the backoff followed by the full resync of the device config.
+type Reconnector struct {
+	Dial             func(ctx context.Context) (*gnmiclient.Client, error)
+	SubscribeRequest *gnmi.SubscribeRequest
+	GetRequest       *gnmi.GetRequest
+	Model            string
+	Reporter         Reporter
+	Health           *Health
+	Metrics          *DeviceMetrics
+	BackOff          backoff.BackOff
+	Debounce         time.Duration
+}
+
+// NewBackOff returns the jittered exponential backoff which never gives up.
+func NewBackOff(initial, max time.Duration) backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	if initial > 0 {
+		b.InitialInterval = initial
+	}
+	if max > 0 {
+		b.MaxInterval = max
+	}
+	b.MaxElapsedTime = 0
+	b.Reset()
+	return b
+}
+
+// Run subscribes to the device and reconnects until ctx is done.
+func (r *Reconnector) Run(ctx context.Context) error {
+	l := logger.FromContext(ctx)
+	r.BackOff.Reset()
+	for {
+		connected, err := r.runOnce(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		r.Health.SetDisconnected(err)
+		r.Metrics.Connected.Set(0)
+		logger.ErrorWithStack(ctx, err, "gNMI subscription lost")
+		if rerr := r.Reporter.ReportError(ctx, fmt.Errorf("disconnected: %w", err)); rerr != nil {
+			logger.ErrorWithStack(ctx, rerr, "report connection error")
+		}
+
+		if connected {
+			r.BackOff.Reset()
+		}
+		d := r.BackOff.NextBackOff()
+		if d == backoff.Stop {
+			return fmt.Errorf("give up reconnecting: %w", err)
+		}
+		l.Infow("reconnecting", "after", d)
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil
+		case <-t.C:
+		}
+		r.Metrics.Reconnects.Add(1)
+	}
+}
+
+// runOnce connects to the device, syncs the entire config and subscribes until the subscription ends. It returns
+// whether the subscription was established.
+func (r *Reconnector) runOnce(ctx context.Context) (bool, error) {
+	c, err := r.Dial(ctx)
+	if err != nil {
+		return false, fmt.Errorf("create gNMI client: %w", err)
+	}
+	s, err := NewSubscriber(c, r.Reporter, r.Model, r.GetRequest, r.Debounce)
+	if err != nil {
+		_ = c.Close()
+		return false, err
+	}
+	defer s.Stop()
+
+	// NOTE the notifications might be lost while disconnected, so resync the entire config on every connection
+	if err := s.Resync(ctx); err != nil {
+		_ = c.Close()
+		return false, fmt.Errorf("sync: %w", err)
+	}
+	r.Metrics.Resyncs.Add(1)
+	r.Health.SetConnected()
+	r.Metrics.Connected.Set(1)
+
+	h := func(msg proto.Message) error {
+		r.Metrics.Notifications.Add(1)
+		return s.HandleResponse(msg)
+	}
+	if err := Subscribe(ctx, c, r.SubscribeRequest, h); err != nil {
+		return true, err
+	}
+	return true, errors.WithStack(fmt.Errorf("gNMI subscription closed by the device"))
+}
@@ -0,0 +1,134 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nttcom/kuesta/pkg/testing/gnmihelper"
+	gclient "github.com/openconfig/gnmi/client"
+	gnmiclient "github.com/openconfig/gnmi/client/gnmi"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type cancelReporter struct {
+	*reporterMock
+	n      int
+	cancel func()
+}
+
+func (r *cancelReporter) Report(ctx context.Context, config []byte) error {
+	err := r.reporterMock.Report(ctx, config)
+	if len(r.snapshots) == r.n {
+		r.cancel()
+	}
+	return err
+}
+
+func TestReconnector_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getCount := 0
+	m := &gnmihelper.GnmiMock{
+		GetHandler: func(_ context.Context, request *pb.GetRequest) (*pb.GetResponse, error) {
+			getCount++
+			v := &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: testDeviceConfig}}
+			return &pb.GetResponse{Notification: []*pb.Notification{{Update: []*pb.Update{{Path: &pb.Path{}, Val: v}}}}}, nil
+		},
+		SubscribeHandler: func(stream pb.GNMI_SubscribeServer) error {
+			// close the subscription as the device reboots
+			return stream.Send(&pb.SubscribeResponse{Response: &pb.SubscribeResponse_SyncResponse{SyncResponse: true}})
+		},
+	}
+
+	var servers []*grpc.Server
+	defer func() {
+		for _, gs := range servers {
+			gs.Stop()
+		}
+	}()
+	dialCount := 0
+	r := &reporterMock{}
+	h := NewHealth()
+	// stop after the resync on reconnection is reported
+	cr := &cancelReporter{reporterMock: r, n: 2, cancel: cancel}
+	rc := &Reconnector{
+		Dial: func(ctx context.Context) (*gnmiclient.Client, error) {
+			dialCount++
+			if dialCount == 1 {
+				return nil, fmt.Errorf("connection refused")
+			}
+			gs, conn := gnmihelper.NewGnmiServer(ctx, m)
+			servers = append(servers, gs)
+			return gnmiclient.NewFromConn(ctx, conn, gclient.Destination{})
+		},
+		Reporter: cr,
+		Health:   h,
+		Metrics:  NewDeviceMetrics("device1"),
+		BackOff:  NewBackOff(time.Millisecond, 10*time.Millisecond),
+		Debounce: time.Hour,
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- rc.Run(ctx)
+	}()
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("reconnector did not stop")
+	}
+
+	assert.Equal(t, 3, dialCount)
+	assert.Equal(t, 2, getCount)
+	assert.Len(t, r.snapshots, 2)
+	if assert.Len(t, r.errors, 2) {
+		assert.ErrorContains(t, r.errors[0], "connection refused")
+		assert.ErrorContains(t, r.errors[1], "closed by the device")
+	}
+	connected, _ := h.Connected()
+	assert.True(t, connected)
+	assert.Equal(t, int64(2), rc.Metrics.Resyncs.Value())
+	assert.Equal(t, int64(2), rc.Metrics.Reconnects.Value())
+	assert.Equal(t, int64(1), rc.Metrics.Connected.Value())
+}
+
+func TestNewBackOff(t *testing.T) {
+	b := NewBackOff(100*time.Millisecond, time.Second)
+	prev := time.Duration(0)
+	for i := 0; i < 20; i++ {
+		d := b.NextBackOff()
+		// jitter is within the randomization factor of the max interval
+		assert.LessOrEqual(t, d, 1500*time.Millisecond)
+		assert.Greater(t, d, time.Duration(0))
+		prev = d
+	}
+	assert.Greater(t, prev, 100*time.Millisecond)
+}
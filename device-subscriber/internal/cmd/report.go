@@ -0,0 +1,209 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nttcom/kuesta/pkg/aggregatorpb"
+	"github.com/nttcom/kuesta/pkg/credentials"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	grpccredentials "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// Reporter reports the actual device config and the sync error to the aggregator.
+type Reporter interface {
+	Report(ctx context.Context, config []byte) error
+	ReportError(ctx context.Context, syncErr error) error
+	Close() error
+}
+
+// IncrementalReporter reports the changes of the device config instead of the entire config.
+type IncrementalReporter interface {
+	Reporter
+	ReportNotifications(ctx context.Context, notifications ...*gnmi.Notification) error
+}
+
+// NewReporter creates the Reporter which uses gRPC streaming API if aggregator-grpc-addr is set, otherwise HTTP commit API.
+func NewReporter(ctx context.Context, cfg Config) (Reporter, error) {
+	if cfg.AggregatorGRPCAddr != "" {
+		return NewGRPCReporter(ctx, cfg)
+	}
+	return &HTTPReporter{cfg: cfg}, nil
+}
+
+// HTTPReporter reports the device config by HTTP POST to the commit API.
+type HTTPReporter struct {
+	cfg Config
+}
+
+func (r *HTTPReporter) Report(ctx context.Context, config []byte) error {
+	return PostDeviceConfig(ctx, r.cfg, config)
+}
+
+func (r *HTTPReporter) ReportError(ctx context.Context, syncErr error) error {
+	return postSaveConfigRequest(ctx, r.cfg, SaveConfigRequest{
+		Device:            r.cfg.Device,
+		SubscriberVersion: version,
+		SyncError:         syncErr.Error(),
+	})
+}
+
+func (r *HTTPReporter) Close() error {
+	return nil
+}
+
+// GRPCReporter reports the device config over the long-lived stream of the aggregator gRPC API, and waits for
+// the acknowledgement of each report. The stream is re-opened on the next report once it is broken.
+type GRPCReporter struct {
+	cfg  Config
+	conn *grpc.ClientConn
+
+	mu     sync.Mutex
+	id     uint64
+	stream aggregatorpb.Aggregator_StreamClient
+	cancel context.CancelFunc
+}
+
+// NewGRPCReporter creates GRPCReporter connecting to aggregator-grpc-addr.
+func NewGRPCReporter(ctx context.Context, cfg Config, opts ...grpc.DialOption) (*GRPCReporter, error) {
+	if cfg.NoTLS {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	} else {
+		tlsCfg := cfg.TLSClientConfig()
+		c, err := credentials.NewTLSConfig(tlsCfg.Certificates(false), tlsCfg.VerifyServer())
+		if err != nil {
+			return nil, fmt.Errorf("new tls config: %w", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(grpccredentials.NewTLS(c)))
+	}
+	conn, err := grpc.DialContext(ctx, cfg.AggregatorGRPCAddr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("dial aggregator: %w", errors.WithStack(err))
+	}
+	return &GRPCReporter{cfg: cfg, conn: conn}, nil
+}
+
+func (r *GRPCReporter) Report(ctx context.Context, config []byte) error {
+	return r.Send(ctx, &aggregatorpb.StreamRequest{
+		Update: &aggregatorpb.StreamRequest_Snapshot{Snapshot: &aggregatorpb.Snapshot{Config: config}},
+	})
+}
+
+// ReportNotifications sends the changes of the device config, whose paths address the labels of the reported config.
+func (r *GRPCReporter) ReportNotifications(ctx context.Context, notifications ...*gnmi.Notification) error {
+	return r.Send(ctx, &aggregatorpb.StreamRequest{
+		Update: &aggregatorpb.StreamRequest_Notifications{Notifications: &aggregatorpb.Notifications{Notification: notifications}},
+	})
+}
+
+func (r *GRPCReporter) ReportError(ctx context.Context, syncErr error) error {
+	return r.Send(ctx, &aggregatorpb.StreamRequest{SyncError: syncErr.Error()})
+}
+
+// Send sends the StreamRequest of the device with the new id, and waits for its acknowledgement.
+func (r *GRPCReporter) Send(ctx context.Context, req *aggregatorpb.StreamRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stream == nil {
+		if err := r.open(ctx); err != nil {
+			return err
+		}
+	}
+	r.id++
+	req.Id = r.id
+	req.Device = r.cfg.Device
+	req.SubscriberVersion = version
+
+	// abort the stream when ctx is done before the acknowledgement, since Recv does not watch ctx
+	done := make(chan struct{})
+	defer close(done)
+	go func(cancel context.CancelFunc) {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-done:
+		}
+	}(r.cancel)
+
+	if err := r.stream.Send(req); err != nil {
+		r.reset()
+		return fmt.Errorf("send to aggregator: %w", errors.WithStack(err))
+	}
+	for {
+		resp, err := r.stream.Recv()
+		if err != nil {
+			r.reset()
+			return fmt.Errorf("receive from aggregator: %w", errors.WithStack(err))
+		}
+		if resp.GetId() != req.Id {
+			continue
+		}
+		if resp.GetError() != "" {
+			return errors.WithStack(fmt.Errorf("rejected by aggregator: %s", resp.GetError()))
+		}
+		return nil
+	}
+}
+
+func (r *GRPCReporter) open(ctx context.Context) error {
+	// NOTE the stream outlives the context of each report
+	sctx, cancel := context.WithCancel(context.Background())
+	if r.cfg.AggregatorToken != "" {
+		sctx = metadata.AppendToOutgoingContext(sctx, "authorization", "Bearer "+r.cfg.AggregatorToken)
+	}
+	stream, err := aggregatorpb.NewAggregatorClient(r.conn).Stream(sctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("open aggregator stream: %w", errors.WithStack(err))
+	}
+	r.stream = stream
+	r.cancel = cancel
+	return nil
+}
+
+func (r *GRPCReporter) reset() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.stream = nil
+	r.cancel = nil
+}
+
+// Close closes the stream and the connection to the aggregator.
+func (r *GRPCReporter) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stream != nil {
+		_ = r.stream.CloseSend()
+	}
+	r.reset()
+	return r.conn.Close()
+}
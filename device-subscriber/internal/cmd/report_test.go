@@ -0,0 +1,142 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nttcom/kuesta/pkg/aggregatorpb"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type aggregatorMock struct {
+	aggregatorpb.UnimplementedAggregatorServer
+	handler func(md metadata.MD, req *aggregatorpb.StreamRequest) string
+}
+
+func (m *aggregatorMock) Stream(stream aggregatorpb.Aggregator_StreamServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		resp := &aggregatorpb.StreamResponse{Id: req.Id, Device: req.Device, Error: m.handler(md, req)}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func newTestGRPCReporter(t *testing.T, cfg Config, m *aggregatorMock) *GRPCReporter {
+	lis := bufconn.Listen(1024 * 1024)
+	gs := grpc.NewServer()
+	aggregatorpb.RegisterAggregatorServer(gs, m)
+	go func() {
+		_ = gs.Serve(lis)
+	}()
+	t.Cleanup(gs.Stop)
+
+	cfg.NoTLS = true
+	cfg.AggregatorGRPCAddr = "bufnet"
+	r, err := NewGRPCReporter(context.Background(), cfg,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }))
+	testhelper.ExitOnErr(t, err)
+	t.Cleanup(func() { _ = r.Close() })
+	return r
+}
+
+func TestGRPCReporter_Report(t *testing.T) {
+	deviceConfig := "dummy"
+
+	t.Run("ok", func(t *testing.T) {
+		var ids []uint64
+		r := newTestGRPCReporter(t, Config{Device: "device1", AggregatorToken: "dummy-token"}, &aggregatorMock{
+			handler: func(md metadata.MD, req *aggregatorpb.StreamRequest) string {
+				assert.Equal(t, []string{"Bearer dummy-token"}, md.Get("authorization"))
+				assert.Equal(t, "device1", req.Device)
+				assert.Equal(t, []byte(deviceConfig), req.GetSnapshot().GetConfig())
+				ids = append(ids, req.Id)
+				return ""
+			},
+		})
+
+		assert.Nil(t, r.Report(context.Background(), []byte(deviceConfig)))
+		assert.Nil(t, r.Report(context.Background(), []byte(deviceConfig)))
+		assert.Equal(t, []uint64{1, 2}, ids)
+	})
+
+	t.Run("ok: sync error", func(t *testing.T) {
+		r := newTestGRPCReporter(t, Config{Device: "device1"}, &aggregatorMock{
+			handler: func(md metadata.MD, req *aggregatorpb.StreamRequest) string {
+				assert.Nil(t, req.GetUpdate())
+				assert.Equal(t, "failed", req.SyncError)
+				assert.Equal(t, "dev", req.SubscriberVersion)
+				return ""
+			},
+		})
+
+		assert.Nil(t, r.ReportError(context.Background(), errors.New("failed")))
+	})
+
+	t.Run("err: rejected", func(t *testing.T) {
+		r := newTestGRPCReporter(t, Config{Device: "device1"}, &aggregatorMock{
+			handler: func(md metadata.MD, req *aggregatorpb.StreamRequest) string {
+				return "failed"
+			},
+		})
+
+		err := r.Report(context.Background(), []byte(deviceConfig))
+		assert.Error(t, err)
+	})
+}
+
+func TestHTTPReporter_ReportError(t *testing.T) {
+	cfg := Config{Device: "device1"}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SaveConfigRequest
+		testhelper.ExitOnErr(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "device1", req.Device)
+		assert.Nil(t, req.Config)
+		assert.Equal(t, "failed", req.SyncError)
+	}))
+	defer s.Close()
+	cfg.AggregatorURL = s.URL
+
+	r := &HTTPReporter{cfg: cfg}
+	assert.Nil(t, r.ReportError(context.Background(), errors.New("failed")))
+}
@@ -31,18 +31,16 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sync"
 	"time"
 
-	"cuelang.org/go/cue"
-	"cuelang.org/go/cue/cuecontext"
 	"github.com/nttcom/kuesta/device-subscriber/internal/logger"
-	"github.com/nttcom/kuesta/device-subscriber/internal/model"
 	"github.com/nttcom/kuesta/pkg/credentials"
-	kcue "github.com/nttcom/kuesta/pkg/cue"
 	gclient "github.com/openconfig/gnmi/client"
 	gnmiclient "github.com/openconfig/gnmi/client/gnmi"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 )
 
 func Run(cfg Config) error {
@@ -50,61 +48,90 @@ func Run(cfg Config) error {
 	l := logger.FromContext(ctx)
 	l.Infow("start main run", "cfg", cfg.Mask())
 
+	health := NewHealthGroup()
+	// NOTE the subscriber of multiple devices reports the state of each device on /devices instead of the readiness
+	health.ProcessReadiness = cfg.DevicesFile != ""
+	if cfg.HealthAddr != "" {
+		go func() {
+			if err := ServeHealth(ctx, cfg.HealthAddr, health.Handler()); err != nil {
+				logger.ErrorWithStack(ctx, err, "health server stopped")
+			}
+		}()
+	}
+
+	if cfg.DevicesFile != "" {
+		m := NewDeviceManager(cfg, health)
+		return m.Watch(ctx, cfg.DevicesFile, cfg.DevicesReloadInterval)
+	}
+	return RunDevice(ctx, cfg, health.Add(cfg.Device))
+}
+
+// RunDevice subscribes to the device and reports its config until ctx is done.
+func RunDevice(ctx context.Context, cfg Config, health *Health) error {
 	dest, err := gNMIDestination(cfg)
 	if err != nil {
 		return fmt.Errorf("setup gnmi destination: %w", err)
 	}
-
-	c, err := gnmiclient.New(ctx, dest)
+	subReq, err := cfg.SubscribeRequest()
 	if err != nil {
-		return fmt.Errorf("create gNMI client: %w", errors.WithStack(err))
+		return fmt.Errorf("setup subscribe request: %w", err)
 	}
-
-	fn := func() error {
-		return Sync(ctx, cfg, c.(*gnmiclient.Client))
-	}
-	if err := fn(); err != nil {
-		logger.ErrorWithStack(ctx, err, "initial sync")
+	getReq, err := cfg.GetRequest()
+	if err != nil {
+		return fmt.Errorf("setup get request: %w", err)
 	}
-	if err := Subscribe(ctx, c, fn); err != nil {
-		return err
+
+	reporter, err := NewReporter(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("create reporter: %w", err)
 	}
-	return nil
+	defer reporter.Close()
+
+	r := &Reconnector{
+		Dial: func(ctx context.Context) (*gnmiclient.Client, error) {
+			c, err := gnmiclient.New(ctx, dest)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			return c.(*gnmiclient.Client), nil
+		},
+		SubscribeRequest: subReq,
+		GetRequest:       getReq,
+		Model:            cfg.Model,
+		Reporter:         reporter,
+		Health:           health,
+		Metrics:          NewDeviceMetrics(cfg.Device),
+		BackOff:          NewBackOff(cfg.ReconnectInitialInterval, cfg.ReconnectMaxInterval),
+		Debounce:         cfg.Debounce,
+	}
+	return r.Run(ctx)
 }
 
-func Subscribe(ctx context.Context, c gclient.Impl, fn func() error) error {
+// Subscribe opens the gNMI subscription with the supplied SubscribeRequest, and passes each SubscribeResponse to
+// the handler until the stream ends. The root path is subscribed if req is nil.
+func Subscribe(ctx context.Context, c gclient.Impl, req *gnmi.SubscribeRequest, h gclient.ProtoHandler) error {
 	l := logger.FromContext(ctx)
 
 	query := gclient.Query{
-		Type: gclient.Stream,
-		NotificationHandler: func(noti gclient.Notification) error {
-			if err, ok := noti.(error); ok {
-				return fmt.Errorf("error received: %w", err)
-			}
-			// NOTE Run something if needed
-			return nil
-		},
+		Type:         gclient.Stream,
+		ProtoHandler: h,
+		SubReq:       req,
 	}
 
-	l.Infow("subscribe starting")
-	if err := c.Subscribe(ctx, query); err != nil {
-		return fmt.Errorf("open subscribe channel: %w", errors.WithStack(err))
-	}
-	l.Infow("subscribe started")
-
 	defer func() {
 		if err := c.Close(); err != nil {
 			l.Errorf("close gNMI subscription: %w", err)
 		}
 	}()
 
+	l.Infow("subscribe starting")
+	if err := c.Subscribe(ctx, query); err != nil {
+		return fmt.Errorf("open subscribe channel: %w", errors.WithStack(err))
+	}
+	l.Infow("subscribe started")
+
 	for {
 		recvErr := c.Recv()
-		l.Infow("recv hooked")
-		if err := fn(); err != nil {
-			logger.ErrorWithStack(ctx, err, "handle notification")
-		}
-
 		if errors.Is(recvErr, io.EOF) {
 			l.Debugw("EOF received")
 			return nil
@@ -114,34 +141,162 @@ func Subscribe(ctx context.Context, c gclient.Impl, fn func() error) error {
 	}
 }
 
-func Sync(ctx context.Context, cfg Config, client *gnmiclient.Client) error {
-	l := logger.FromContext(ctx)
-	l.Infow("sync started")
+// Subscriber applies the notifications received on the gNMI subscription to the in-memory device config, and reports
+// the changes to the aggregator after the debounce period. The entire config is fetched by gNMI Get only when
+// sync_response is received or the notification cannot be applied.
+type Subscriber struct {
+	client   *gnmiclient.Client
+	reporter Reporter
+	getReq   *gnmi.GetRequest
+	debounce time.Duration
+
+	mu       sync.Mutex
+	tree     *DeviceTree
+	reported map[string]any // tree last reported, nil if the entire config must be reported
+	resync   bool
+	timer    *time.Timer
+	stopped  bool
+}
 
-	buf, err := GetEntireConfig(ctx, client)
+// NewSubscriber creates Subscriber which decodes the device config with the model. The entire config is fetched
+// with getReq, or from the root path in JSON IETF if getReq is nil.
+func NewSubscriber(client *gnmiclient.Client, reporter Reporter, model string, getReq *gnmi.GetRequest, debounce time.Duration) (*Subscriber, error) {
+	tree, err := NewDeviceTree(model)
 	if err != nil {
-		return fmt.Errorf("get device config: %w", err)
+		return nil, err
 	}
+	if getReq == nil {
+		getReq = &gnmi.GetRequest{
+			Path:     []*gnmi.Path{{}},
+			Encoding: gnmi.Encoding_JSON_IETF,
+		}
+	}
+	return &Subscriber{
+		client:   client,
+		reporter: reporter,
+		getReq:   getReq,
+		debounce: debounce,
+		tree:     tree,
+	}, nil
+}
+
+// HandleResponse handles the SubscribeResponse received on the gNMI subscription.
+func (s *Subscriber) HandleResponse(msg proto.Message) error {
+	ctx := context.Background()
+	l := logger.FromContext(ctx)
+
+	resp, ok := msg.(*gnmi.SubscribeResponse)
+	if !ok {
+		return errors.WithStack(fmt.Errorf("unexpected message: %T", msg))
+	}
+	switch v := resp.GetResponse().(type) {
+	case *gnmi.SubscribeResponse_Update:
+		s.mu.Lock()
+		if err := s.tree.Apply(v.Update); err != nil {
+			l.Warnw("failed to apply notification, entire config will be synced", "error", err.Error())
+			s.resync = true
+		}
+		s.mu.Unlock()
+	case *gnmi.SubscribeResponse_SyncResponse:
+		l.Infow("sync response received")
+		s.mu.Lock()
+		s.resync = true
+		s.mu.Unlock()
+	case *gnmi.SubscribeResponse_Error:
+		return errors.WithStack(fmt.Errorf("error in response: %s", v.Error.GetMessage()))
+	default:
+		return nil
+	}
+	s.schedule(ctx)
+	return nil
+}
 
-	// unmarshal
-	var obj model.Device
-	schema, err := model.Schema()
+// schedule reports the changes after the debounce period unless it is already scheduled.
+func (s *Subscriber) schedule(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.timer != nil || s.stopped {
+		return
+	}
+	s.timer = time.AfterFunc(s.debounce, func() {
+		if err := s.Flush(ctx); err != nil {
+			logger.ErrorWithStack(ctx, err, "report device config")
+			if rerr := s.reporter.ReportError(ctx, err); rerr != nil {
+				logger.ErrorWithStack(ctx, rerr, "report sync error")
+			}
+		}
+	})
+}
+
+// Stop cancels the scheduled report.
+func (s *Subscriber) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = true
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+}
+
+// Flush reports the changes since the last report. The entire config is fetched and reported if resync is required.
+func (s *Subscriber) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	s.timer = nil
+	resync := s.resync
+	s.mu.Unlock()
+
+	if resync {
+		return s.Resync(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tree, err := s.tree.Tree()
 	if err != nil {
 		return err
 	}
-	if err := schema.Unmarshal(buf, &obj); err != nil {
-		return fmt.Errorf("decode JSON IETF val of gNMI update: %w", err)
+	ir, ok := s.reporter.(IncrementalReporter)
+	if !ok || s.reported == nil {
+		return s.reportSnapshot(ctx, tree)
 	}
 
-	// convert to CUE
-	cctx := cuecontext.New()
-	v := cctx.Encode(obj)
-	b, err := kcue.FormatCue(v, cue.Final())
+	n, err := DiffTree(s.reported, tree)
 	if err != nil {
-		return fmt.Errorf("encode cue.Value to bytes: %w", err)
+		return err
+	}
+	if len(n.GetUpdate()) == 0 && len(n.GetDelete()) == 0 {
+		return nil
 	}
+	if err := ir.ReportNotifications(ctx, n); err != nil {
+		// report the entire config next time since the changes may be partially applied
+		s.reported = nil
+		return err
+	}
+	s.reported = tree
+	return nil
+}
+
+// Resync fetches the entire device config by gNMI Get and reports it.
+func (s *Subscriber) Resync(ctx context.Context) error {
+	l := logger.FromContext(ctx)
+	l.Infow("sync started")
 
-	if err := PostDeviceConfig(ctx, cfg, b); err != nil {
+	// NOTE hold the lock during Get so that the notifications received meanwhile are applied to the new tree
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	notifications, err := GetConfig(ctx, s.client, s.getReq)
+	if err != nil {
+		return fmt.Errorf("get device config: %w", err)
+	}
+	if err := s.tree.LoadNotifications(notifications...); err != nil {
+		return err
+	}
+	s.resync = false
+	tree, err := s.tree.Tree()
+	if err != nil {
+		return err
+	}
+	if err := s.reportSnapshot(ctx, tree); err != nil {
 		return err
 	}
 
@@ -149,13 +304,37 @@ func Sync(ctx context.Context, cfg Config, client *gnmiclient.Client) error {
 	return nil
 }
 
+func (s *Subscriber) reportSnapshot(ctx context.Context, tree map[string]any) error {
+	b, err := s.tree.Snapshot()
+	if err != nil {
+		return err
+	}
+	if err := s.reporter.Report(ctx, b); err != nil {
+		s.reported = nil
+		return err
+	}
+	s.reported = tree
+	return nil
+}
+
 type SaveConfigRequest struct {
-	Device string `json:"device"`
-	Config string `json:"config"`
+	Device            string  `json:"device"`
+	Config            *string `json:"config,omitempty"`
+	SubscriberVersion string  `json:"subscriberVersion,omitempty"`
+	SyncError         string  `json:"syncError,omitempty"`
 }
 
 // PostDeviceConfig sends HTTP POST with supplied device config.
 func PostDeviceConfig(ctx context.Context, cfg Config, data []byte) error {
+	config := string(data)
+	return postSaveConfigRequest(ctx, cfg, SaveConfigRequest{
+		Device:            cfg.Device,
+		Config:            &config,
+		SubscriberVersion: version,
+	})
+}
+
+func postSaveConfigRequest(ctx context.Context, cfg Config, body SaveConfigRequest) error {
 	l := logger.FromContext(ctx)
 
 	u, err := url.Parse(cfg.AggregatorURL)
@@ -163,10 +342,6 @@ func PostDeviceConfig(ctx context.Context, cfg Config, data []byte) error {
 		return fmt.Errorf("url parse error: %w", errors.WithStack(err))
 	}
 	u.Path = path.Join(u.Path, "commit")
-	body := SaveConfigRequest{
-		Device: cfg.Device,
-		Config: string(data),
-	}
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(&body); err != nil {
 		return fmt.Errorf("json encode error: %w", errors.WithStack(err))
@@ -181,6 +356,9 @@ func PostDeviceConfig(ctx context.Context, cfg Config, data []byte) error {
 		return fmt.Errorf("create http request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if cfg.AggregatorToken != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.AggregatorToken)
+	}
 	resp, err := c.Do(req)
 	if err != nil {
 		return fmt.Errorf("post: %w", errors.WithStack(err))
@@ -207,10 +385,10 @@ func gNMIDestination(cfg Config) (gclient.Destination, error) {
 			Password: cfg.Password,
 		},
 	}
-	if cfg.NoTLS {
+	tlsDeviceCfg := cfg.DeviceTLSClientConfig()
+	if tlsDeviceCfg.NoTLS {
 		return dest, nil
 	}
-	tlsDeviceCfg := cfg.DeviceTLSClientConfig()
 	tlsCfg, err := credentials.NewTLSConfig(tlsDeviceCfg.Certificates(false), tlsDeviceCfg.VerifyServer())
 	if err != nil {
 		return gclient.Destination{}, fmt.Errorf("get tls config: %w", err)
@@ -234,40 +412,16 @@ func httpClient(cfg *credentials.TLSClientConfig) (*http.Client, error) {
 	return c, nil
 }
 
-// ExtractJsonIetfVal extracts the JSON IETF field of the supplied TypedValue.
-func ExtractJsonIetfVal(tv *gnmi.TypedValue) ([]byte, error) {
-	v, ok := tv.GetValue().(*gnmi.TypedValue_JsonIetfVal)
-	if !ok {
-		return nil, errors.WithStack(fmt.Errorf("value did not contain IETF JSON"))
-	}
-	return v.JsonIetfVal, nil
-}
-
-// GetEntireConfig requests gNMI GetRequest and returns entire device config as.
-func GetEntireConfig(ctx context.Context, client *gnmiclient.Client) ([]byte, error) {
-	req := gnmi.GetRequest{
-		Path: []*gnmi.Path{
-			{}, // TODO consider to specify target and path
-		},
-		Encoding: gnmi.Encoding_JSON_IETF,
-	}
-
-	resp, err := client.Get(ctx, &req)
+// GetConfig requests gNMI GetRequest and returns the notifications of the response.
+func GetConfig(ctx context.Context, client *gnmiclient.Client, req *gnmi.GetRequest) ([]*gnmi.Notification, error) {
+	resp, err := client.Get(ctx, req)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-
-	// resolve gnmi TypedValue
-	var tv *gnmi.TypedValue
-	for _, v := range resp.GetNotification() {
-		for _, u := range v.GetUpdate() {
-			tv = u.GetVal()
-			break
+	for _, n := range resp.GetNotification() {
+		if len(n.GetUpdate()) > 0 {
+			return resp.GetNotification(), nil
 		}
 	}
-	if tv == nil {
-		return nil, errors.WithStack(fmt.Errorf("no content from gNMI server"))
-	}
-
-	return ExtractJsonIetfVal(tv)
+	return nil, errors.WithStack(fmt.Errorf("no content from gNMI server"))
 }
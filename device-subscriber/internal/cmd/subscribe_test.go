@@ -39,6 +39,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestSubscribe(t *testing.T) {
@@ -75,15 +76,16 @@ func TestSubscribe(t *testing.T) {
 	testhelper.ExitOnErr(t, err)
 
 	count := 0
-	err = Subscribe(ctx, client, func() error {
+	err = Subscribe(ctx, client, nil, func(msg proto.Message) error {
+		assert.NotNil(t, msg.(*pb.SubscribeResponse).GetUpdate())
 		count++
 		return nil
 	})
 	assert.Nil(t, err)
-	assert.Equal(t, 3+1, count)
+	assert.Equal(t, 3, count)
 }
 
-func TestSync(t *testing.T) {
+func TestSubscriber_Resync(t *testing.T) {
 	config := []byte(`{
   "openconfig-interfaces:interfaces": {
     "interface": [
@@ -119,8 +121,13 @@ func TestSync(t *testing.T) {
 	}
 }`
 
+	getReq := &pb.GetRequest{
+		Path:     []*pb.Path{{Target: "target1", Elem: []*pb.PathElem{{Name: "interfaces"}}}},
+		Encoding: pb.Encoding_JSON_IETF,
+	}
 	m := &gnmihelper.GnmiMock{
 		GetHandler: func(ctx context.Context, request *pb.GetRequest) (*pb.GetResponse, error) {
+			assert.True(t, proto.Equal(getReq, request))
 			v := gnmi.TypedValue{
 				Value: &gnmi.TypedValue_JsonIetfVal{
 					JsonIetfVal: config,
@@ -152,16 +159,22 @@ func TestSync(t *testing.T) {
 		var req SaveConfigRequest
 		testhelper.ExitOnErr(t, json.NewDecoder(r.Body).Decode(&req))
 		assert.Equal(t, req.Device, cfg.Device)
-		assert.Equal(t, want, req.Config)
+		assert.Equal(t, want, *req.Config)
 	}))
 	cfg.AggregatorURL = hs.URL
 
-	err = Sync(ctx, cfg, client)
+	s, err := NewSubscriber(client, &HTTPReporter{cfg: cfg}, DefaultModel, getReq, 0)
+	testhelper.ExitOnErr(t, err)
+	err = s.Resync(ctx)
 	assert.Nil(t, err)
 }
 
-func TestGetEntireConfig(t *testing.T) {
+func TestGetConfig(t *testing.T) {
 	config := []byte("dummy")
+	req := &pb.GetRequest{
+		Path:     []*pb.Path{{Elem: []*pb.PathElem{{Name: "interfaces"}}}},
+		Encoding: pb.Encoding_JSON_IETF,
+	}
 
 	tests := []struct {
 		name    string
@@ -219,12 +232,13 @@ func TestGetEntireConfig(t *testing.T) {
 
 			c, err := gnmiclient.NewFromConn(ctx, conn, gclient.Destination{})
 			testhelper.ExitOnErr(t, err)
-			got, err := GetEntireConfig(ctx, c)
+			got, err := GetConfig(ctx, c, req)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.Nil(t, err)
-				assert.Equal(t, config, got)
+				assert.Len(t, got, 1)
+				assert.Equal(t, config, got[0].GetUpdate()[0].GetVal().GetJsonIetfVal())
 			}
 		})
 	}
@@ -241,7 +255,22 @@ func TestPostDeviceConfig(t *testing.T) {
 			var req SaveConfigRequest
 			testhelper.ExitOnErr(t, json.NewDecoder(r.Body).Decode(&req))
 			assert.Equal(t, req.Device, cfg.Device)
-			assert.Equal(t, req.Config, deviceConfig)
+			assert.Equal(t, deviceConfig, *req.Config)
+			assert.Equal(t, "dev", req.SubscriberVersion)
+		}))
+		cfg.AggregatorURL = s.URL
+
+		err := PostDeviceConfig(context.Background(), cfg, []byte(deviceConfig))
+		assert.Nil(t, err)
+	})
+
+	t.Run("ok: with bearer token", func(t *testing.T) {
+		cfg := Config{
+			Device:          "device1",
+			AggregatorToken: "dummy-token",
+		}
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer dummy-token", r.Header.Get("Authorization"))
 		}))
 		cfg.AggregatorURL = s.URL
 
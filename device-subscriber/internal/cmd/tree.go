@@ -0,0 +1,235 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	kcue "github.com/nttcom/kuesta/pkg/cue"
+	"github.com/nttcom/kuesta/pkg/model"
+	"github.com/nttcom/kuesta/pkg/model/openconfig"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultModel is the name of the device model used if not specified.
+const DefaultModel = openconfig.ModelName
+
+// DeviceTree is the in-memory device config kept up-to-date by gNMI notifications.
+type DeviceTree struct {
+	model  *model.YgotModel
+	schema *ytypes.Schema
+}
+
+// NewDeviceTree creates the empty DeviceTree of the device model registered with the name, or of the DefaultModel
+// if the name is empty.
+func NewDeviceTree(name string) (*DeviceTree, error) {
+	m, err := ygotModel(name)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := m.Schema()
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceTree{model: m, schema: schema}, nil
+}
+
+// ygotModel returns the device model registered with the name. The model must have the ygot schema, since
+// the notifications are applied through the schema.
+func ygotModel(name string) (*model.YgotModel, error) {
+	if name == "" {
+		name = DefaultModel
+	}
+	m, err := model.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	ym, ok := m.(*model.YgotModel)
+	if !ok {
+		return nil, errors.WithStack(fmt.Errorf("model %s has no ygot schema", name))
+	}
+	return ym, nil
+}
+
+// Load replaces the entire device config with the supplied JSON IETF value.
+func (t *DeviceTree) Load(buf []byte) error {
+	obj := t.model.NewRoot()
+	if err := t.schema.Unmarshal(buf, obj); err != nil {
+		return fmt.Errorf("decode JSON IETF val of gNMI update: %w", err)
+	}
+	t.schema.Root = obj
+	return nil
+}
+
+// LoadNotifications replaces the entire device config with the supplied notifications, which are usually
+// the response of gNMI Get.
+func (t *DeviceTree) LoadNotifications(notifications ...*gnmi.Notification) error {
+	t.schema.Root = t.model.NewRoot()
+	for _, n := range notifications {
+		if err := t.Apply(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply applies the deletes and then the updates of the gNMI notification.
+func (t *DeviceTree) Apply(n *gnmi.Notification) error {
+	rootSchema := t.schema.RootSchema()
+	for _, d := range n.GetDelete() {
+		p := schemaPath(n.GetPrefix(), d)
+		if err := ytypes.DeleteNode(rootSchema, t.schema.Root, p); err != nil {
+			return errors.WithStack(fmt.Errorf("delete %s: %w", pathString(p), err))
+		}
+	}
+	for _, u := range n.GetUpdate() {
+		p := schemaPath(n.GetPrefix(), u.GetPath())
+		if err := ytypes.SetNode(rootSchema, t.schema.Root, p, setNodeVal(u.GetVal()), &ytypes.InitMissingElements{}); err != nil {
+			return errors.WithStack(fmt.Errorf("update %s: %w", pathString(p), err))
+		}
+	}
+	return nil
+}
+
+// Tree returns the device config as the tree labeled with the field names, which is the same form as the CUE
+// reported to the aggregator.
+func (t *DeviceTree) Tree() (map[string]any, error) {
+	v := cuecontext.New().Encode(t.schema.Root)
+	if v.Err() != nil {
+		return nil, errors.WithStack(fmt.Errorf("encode device config: %w", v.Err()))
+	}
+	tree := map[string]any{}
+	if err := v.Decode(&tree); err != nil {
+		return nil, errors.WithStack(fmt.Errorf("decode device config: %w", err))
+	}
+	return tree, nil
+}
+
+// Snapshot returns the entire device config in CUE format.
+func (t *DeviceTree) Snapshot() ([]byte, error) {
+	v := cuecontext.New().Encode(t.schema.Root)
+	b, err := kcue.FormatCue(v, cue.Final())
+	if err != nil {
+		return nil, fmt.Errorf("encode cue.Value to bytes: %w", err)
+	}
+	return b, nil
+}
+
+// setNodeVal returns the TypedValue which ytypes.SetNode accepts. JSON values are passed as JSON IETF since
+// ytypes.SetNode decodes only JSON IETF into the non-leaf nodes, and the module names are optional in both.
+func setNodeVal(v *gnmi.TypedValue) *gnmi.TypedValue {
+	if buf := v.GetJsonVal(); buf != nil {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: buf}}
+	}
+	return v
+}
+
+// schemaPath joins the prefix and the path, and strips the module names from the path elements so that
+// they match the schema paths of the generated model.
+func schemaPath(prefix, path *gnmi.Path) *gnmi.Path {
+	p := &gnmi.Path{}
+	for _, e := range append(append([]*gnmi.PathElem{}, prefix.GetElem()...), path.GetElem()...) {
+		e = proto.Clone(e).(*gnmi.PathElem)
+		if i := strings.Index(e.Name, ":"); i >= 0 {
+			e.Name = e.Name[i+1:]
+		}
+		p.Elem = append(p.Elem, e)
+	}
+	return p
+}
+
+func pathString(p *gnmi.Path) string {
+	s, err := ygot.PathToString(p)
+	if err != nil {
+		return p.String()
+	}
+	return s
+}
+
+// DiffTree returns the gNMI notification which turns the old tree into the new one. The paths of the notification
+// address the labels of the tree, and the updated values are encoded in JSON.
+func DiffTree(old, new map[string]any) (*gnmi.Notification, error) {
+	n := &gnmi.Notification{}
+	if err := diffTree(n, nil, old, new); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func diffTree(n *gnmi.Notification, path []*gnmi.PathElem, old, new map[string]any) error {
+	for _, k := range sortedKeys(old) {
+		if _, ok := new[k]; !ok {
+			n.Delete = append(n.Delete, &gnmi.Path{Elem: appendElem(path, k)})
+		}
+	}
+	for _, k := range sortedKeys(new) {
+		nv := new[k]
+		ov, ok := old[k]
+		if ok && reflect.DeepEqual(ov, nv) {
+			continue
+		}
+		om, oIsMap := ov.(map[string]any)
+		nm, nIsMap := nv.(map[string]any)
+		if ok && oIsMap && nIsMap {
+			if err := diffTree(n, appendElem(path, k), om, nm); err != nil {
+				return err
+			}
+			continue
+		}
+		// NOTE the value replaces the old one unless both are maps
+		buf, err := json.Marshal(nv)
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("encode %s: %w", k, err))
+		}
+		n.Update = append(n.Update, &gnmi.Update{
+			Path: &gnmi.Path{Elem: appendElem(path, k)},
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: buf}},
+		})
+	}
+	return nil
+}
+
+func appendElem(path []*gnmi.PathElem, name string) []*gnmi.PathElem {
+	p := make([]*gnmi.PathElem, 0, len(path)+1)
+	p = append(p, path...)
+	return append(p, &gnmi.PathElem{Name: name})
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,252 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nttcom/kuesta/pkg/testing/gnmihelper"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	gclient "github.com/openconfig/gnmi/client"
+	gnmiclient "github.com/openconfig/gnmi/client/gnmi"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+)
+
+var testDeviceConfig = []byte(`{
+  "openconfig-interfaces:interfaces": {
+    "interface": [
+      {
+        "config": {"description": "foo", "mtu": 9000, "name": "Ethernet1"},
+        "name": "Ethernet1"
+      }
+    ]
+  }
+}`)
+
+func mtuUpdate(mtu uint64) *pb.Notification {
+	return &pb.Notification{
+		Prefix: &pb.Path{Elem: []*pb.PathElem{
+			{Name: "openconfig-interfaces:interfaces"},
+			{Name: "interface", Key: map[string]string{"name": "Ethernet1"}},
+		}},
+		Update: []*pb.Update{{
+			Path: &pb.Path{Elem: []*pb.PathElem{{Name: "config"}, {Name: "mtu"}}},
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: mtu}},
+		}},
+	}
+}
+
+func TestDeviceTree_Apply(t *testing.T) {
+	tree, err := NewDeviceTree(DefaultModel)
+	testhelper.ExitOnErr(t, err)
+	testhelper.ExitOnErr(t, tree.Load(testDeviceConfig))
+
+	err = tree.Apply(mtuUpdate(1500))
+	assert.Nil(t, err)
+	err = tree.Apply(&pb.Notification{
+		Delete: []*pb.Path{{Elem: []*pb.PathElem{
+			{Name: "interfaces"},
+			{Name: "interface", Key: map[string]string{"name": "Ethernet1"}},
+			{Name: "config"},
+			{Name: "description"},
+		}}},
+		Update: []*pb.Update{{
+			Path: &pb.Path{Elem: []*pb.PathElem{
+				{Name: "interfaces"},
+				{Name: "interface", Key: map[string]string{"name": "Ethernet2"}},
+				{Name: "config"},
+				{Name: "mtu"},
+			}},
+			Val: &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 1500}},
+		}},
+	})
+	assert.Nil(t, err)
+
+	got, err := tree.Tree()
+	assert.Nil(t, err)
+	want := map[string]any{
+		"Interface": map[string]any{
+			"Ethernet1": map[string]any{"Name": "Ethernet1", "Mtu": 1500, "AdminStatus": 0, "OperStatus": 0, "Type": 0},
+			"Ethernet2": map[string]any{"Name": "Ethernet2", "Mtu": 1500, "AdminStatus": 0, "OperStatus": 0, "Type": 0},
+		},
+	}
+	assert.Equal(t, want, got)
+
+	err = tree.Apply(&pb.Notification{
+		Update: []*pb.Update{{
+			Path: &pb.Path{Elem: []*pb.PathElem{{Name: "unknown"}}},
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 1}},
+		}},
+	})
+	assert.Error(t, err)
+}
+
+func TestDeviceTree_LoadNotifications(t *testing.T) {
+	tree, err := NewDeviceTree(DefaultModel)
+	testhelper.ExitOnErr(t, err)
+	testhelper.ExitOnErr(t, tree.Load(testDeviceConfig))
+
+	ifPath := func(name string, elems ...string) *pb.Path {
+		p := &pb.Path{Elem: []*pb.PathElem{
+			{Name: "interfaces"},
+			{Name: "interface", Key: map[string]string{"name": name}},
+		}}
+		for _, e := range elems {
+			p.Elem = append(p.Elem, &pb.PathElem{Name: e})
+		}
+		return p
+	}
+	err = tree.LoadNotifications(
+		&pb.Notification{Update: []*pb.Update{{
+			Path: ifPath("Ethernet2"),
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonVal{JsonVal: []byte(`{"name": "Ethernet2", "config": {"name": "Ethernet2", "mtu": 9000}}`)}},
+		}}},
+		&pb.Notification{Update: []*pb.Update{{
+			Path: ifPath("Ethernet3", "config", "mtu"),
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 1500}},
+		}}},
+	)
+	assert.Nil(t, err)
+
+	got, err := tree.Tree()
+	assert.Nil(t, err)
+	// the config loaded before is replaced
+	want := map[string]any{
+		"Interface": map[string]any{
+			"Ethernet2": map[string]any{"Name": "Ethernet2", "Mtu": 9000, "AdminStatus": 0, "OperStatus": 0, "Type": 0},
+			"Ethernet3": map[string]any{"Name": "Ethernet3", "Mtu": 1500, "AdminStatus": 0, "OperStatus": 0, "Type": 0},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestDiffTree(t *testing.T) {
+	old := map[string]any{
+		"Interface": map[string]any{
+			"Ethernet1": map[string]any{"Name": "Ethernet1", "Mtu": 9000, "Description": "foo"},
+			"Ethernet2": map[string]any{"Name": "Ethernet2"},
+		},
+		"Hostname": "foo",
+	}
+	new := map[string]any{
+		"Interface": map[string]any{
+			"Ethernet1": map[string]any{"Name": "Ethernet1", "Mtu": 1500, "Description": "foo"},
+			"Ethernet3": map[string]any{"Name": "Ethernet3"},
+		},
+		"Hostname": "foo",
+	}
+	elems := func(names ...string) *pb.Path {
+		p := &pb.Path{}
+		for _, n := range names {
+			p.Elem = append(p.Elem, &pb.PathElem{Name: n})
+		}
+		return p
+	}
+	jsonVal := func(s string) *pb.TypedValue {
+		return &pb.TypedValue{Value: &pb.TypedValue_JsonVal{JsonVal: []byte(s)}}
+	}
+	want := &pb.Notification{
+		Delete: []*pb.Path{elems("Interface", "Ethernet2")},
+		Update: []*pb.Update{
+			{Path: elems("Interface", "Ethernet1", "Mtu"), Val: jsonVal(`1500`)},
+			{Path: elems("Interface", "Ethernet3"), Val: jsonVal(`{"Name":"Ethernet3"}`)},
+		},
+	}
+
+	got, err := DiffTree(old, new)
+	assert.Nil(t, err)
+	assert.Equal(t, want.String(), got.String())
+}
+
+type reporterMock struct {
+	snapshots     [][]byte
+	notifications []*pb.Notification
+	errors        []error
+}
+
+func (r *reporterMock) Report(ctx context.Context, config []byte) error {
+	r.snapshots = append(r.snapshots, config)
+	return nil
+}
+
+func (r *reporterMock) ReportNotifications(ctx context.Context, notifications ...*pb.Notification) error {
+	r.notifications = append(r.notifications, notifications...)
+	return nil
+}
+
+func (r *reporterMock) ReportError(ctx context.Context, syncErr error) error {
+	r.errors = append(r.errors, syncErr)
+	return nil
+}
+
+func (r *reporterMock) Close() error {
+	return nil
+}
+
+func TestSubscriber(t *testing.T) {
+	getCount := 0
+	m := &gnmihelper.GnmiMock{
+		GetHandler: func(ctx context.Context, request *pb.GetRequest) (*pb.GetResponse, error) {
+			getCount++
+			v := &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: testDeviceConfig}}
+			return &pb.GetResponse{Notification: []*pb.Notification{{Update: []*pb.Update{{Path: &pb.Path{}, Val: v}}}}}, nil
+		},
+	}
+	ctx := context.Background()
+	gs, conn := gnmihelper.NewGnmiServer(ctx, m)
+	defer gs.Stop()
+	client, err := gnmiclient.NewFromConn(ctx, conn, gclient.Destination{})
+	testhelper.ExitOnErr(t, err)
+
+	r := &reporterMock{}
+	s, err := NewSubscriber(client, r, DefaultModel, nil, time.Hour)
+	testhelper.ExitOnErr(t, err)
+	defer s.Stop()
+
+	testhelper.ExitOnErr(t, s.Resync(ctx))
+	assert.Equal(t, 1, getCount)
+	assert.Len(t, r.snapshots, 1)
+
+	// changes are reported incrementally without gNMI Get
+	testhelper.ExitOnErr(t, s.HandleResponse(&pb.SubscribeResponse{Response: &pb.SubscribeResponse_Update{Update: mtuUpdate(1500)}}))
+	testhelper.ExitOnErr(t, s.HandleResponse(&pb.SubscribeResponse{Response: &pb.SubscribeResponse_Update{Update: mtuUpdate(1400)}}))
+	assert.Nil(t, s.Flush(ctx))
+	assert.Equal(t, 1, getCount)
+	if assert.Len(t, r.notifications, 1) {
+		assert.Equal(t, "1400", string(r.notifications[0].GetUpdate()[0].GetVal().GetJsonVal()))
+	}
+
+	// nothing is reported without changes
+	assert.Nil(t, s.Flush(ctx))
+	assert.Len(t, r.notifications, 1)
+	assert.Len(t, r.snapshots, 1)
+
+	// entire config is fetched on sync_response
+	testhelper.ExitOnErr(t, s.HandleResponse(&pb.SubscribeResponse{Response: &pb.SubscribeResponse_SyncResponse{SyncResponse: true}}))
+	assert.Nil(t, s.Flush(ctx))
+	assert.Equal(t, 2, getCount)
+	assert.Len(t, r.snapshots, 2)
+}
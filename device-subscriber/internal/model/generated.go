@@ -1,6758 +0,0 @@
-/*
- Copyright (c) 2022 NTT Communications Corporation
-
- Permission is hereby granted, free of charge, to any person obtaining a copy
- of this software and associated documentation files (the "Software"), to deal
- in the Software without restriction, including without limitation the rights
- to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
- copies of the Software, and to permit persons to whom the Software is
- furnished to do so, subject to the following conditions:
-
- The above copyright notice and this permission notice shall be included in
- all copies or substantial portions of the Software.
-
- THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
- IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
- FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
- AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
- LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
- OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
- THE SOFTWARE.
-*/
-
-/*
-Package model is a generated package which contains definitions
-of structs which represent a YANG schema. The generated schema can be
-compressed by a series of transformations (compression was true
-in this case).
-
-This package was generated by /Users/hirokiokui/work/go/pkg/mod/github.com/openconfig/ygot@v0.24.0/genutil/names.go
-using the following YANG input files:
-	- yang/openconfig-interfaces.yang
-	- yang/openconfig-if-ip.yang
-Imported modules were sourced from:
-	- yang/...
-*/
-package model
-
-import (
-	"encoding/json"
-	"fmt"
-	"reflect"
-
-	"github.com/openconfig/goyang/pkg/yang"
-	"github.com/openconfig/ygot/ygot"
-	"github.com/openconfig/ygot/ytypes"
-)
-
-// Binary is a type that is used for fields that have a YANG type of
-// binary. It is used such that binary fields can be distinguished from
-// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
-// []byte in reflection).
-type Binary []byte
-
-// YANGEmpty is a type that is used for fields that have a YANG type of
-// empty. It is used such that empty fields can be distinguished from boolean fields
-// in the generated code.
-type YANGEmpty bool
-
-var (
-	SchemaTree map[string]*yang.Entry
-	ΛEnumTypes map[string][]reflect.Type
-)
-
-func init() {
-	var err error
-	initΛEnumTypes()
-	if SchemaTree, err = UnzipSchema(); err != nil {
-		panic("schema error: " + err.Error())
-	}
-}
-
-// Schema returns the details of the generated schema.
-func Schema() (*ytypes.Schema, error) {
-	uzp, err := UnzipSchema()
-	if err != nil {
-		return nil, fmt.Errorf("cannot unzip schema, %v", err)
-	}
-
-	return &ytypes.Schema{
-		Root:       &Device{},
-		SchemaTree: uzp,
-		Unmarshal:  Unmarshal,
-	}, nil
-}
-
-// UnzipSchema unzips the zipped schema and returns a map of yang.Entry nodes,
-// keyed by the name of the struct that the yang.Entry describes the schema for.
-func UnzipSchema() (map[string]*yang.Entry, error) {
-	var schemaTree map[string]*yang.Entry
-	var err error
-	if schemaTree, err = ygot.GzipToSchema(ySchema); err != nil {
-		return nil, fmt.Errorf("could not unzip the schema; %v", err)
-	}
-	return schemaTree, nil
-}
-
-// Unmarshal unmarshals data, which must be RFC7951 JSON format, into
-// destStruct, which must be non-nil and the correct GoStruct type. It returns
-// an error if the destStruct is not found in the schema or the data cannot be
-// unmarshaled. The supplied options (opts) are used to control the behaviour
-// of the unmarshal function - for example, determining whether errors are
-// thrown for unknown fields in the input JSON.
-func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
-	tn := reflect.TypeOf(destStruct).Elem().Name()
-	schema, ok := SchemaTree[tn]
-	if !ok {
-		return fmt.Errorf("could not find schema for type %s", tn)
-	}
-	var jsonTree interface{}
-	if err := json.Unmarshal([]byte(data), &jsonTree); err != nil {
-		return err
-	}
-	return ytypes.Unmarshal(schema, destStruct, jsonTree, opts...)
-}
-
-// Device represents the /device YANG schema element.
-type Device struct {
-	Interface map[string]*Interface `path:"interfaces/interface" module:"openconfig-interfaces/openconfig-interfaces"`
-	Vlan      map[uint16]*Vlan      `path:"vlans/vlan" module:"openconfig-vlan/openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Device implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Device) IsYANGGoStruct() {}
-
-// NewInterface creates a new entry in the Interface list of the
-// Device struct. The keys of the list are populated from the input
-// arguments.
-func (t *Device) NewInterface(Name string) (*Interface, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Interface == nil {
-		t.Interface = make(map[string]*Interface)
-	}
-
-	key := Name
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Interface[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
-	}
-
-	t.Interface[key] = &Interface{
-		Name: &Name,
-	}
-
-	return t.Interface[key], nil
-}
-
-// NewVlan creates a new entry in the Vlan list of the
-// Device struct. The keys of the list are populated from the input
-// arguments.
-func (t *Device) NewVlan(VlanId uint16) (*Vlan, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Vlan == nil {
-		t.Vlan = make(map[uint16]*Vlan)
-	}
-
-	key := VlanId
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Vlan[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Vlan", key)
-	}
-
-	t.Vlan[key] = &Vlan{
-		VlanId: &VlanId,
-	}
-
-	return t.Vlan[key], nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Device) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Device) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Device) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Device.
-func (*Device) ΛBelongingModule() string {
-	return ""
-}
-
-// Interface represents the /openconfig-interfaces/interfaces/interface YANG schema element.
-type Interface struct {
-	AdminStatus  E_Interface_AdminStatus            `path:"state/admin-status" module:"openconfig-interfaces/openconfig-interfaces"`
-	Aggregation  *Interface_Aggregation             `path:"aggregation" module:"openconfig-if-aggregate"`
-	Counters     *Interface_Counters                `path:"state/counters" module:"openconfig-interfaces/openconfig-interfaces"`
-	Description  *string                            `path:"config/description" module:"openconfig-interfaces/openconfig-interfaces"`
-	Enabled      *bool                              `path:"config/enabled" module:"openconfig-interfaces/openconfig-interfaces"`
-	Ethernet     *Interface_Ethernet                `path:"ethernet" module:"openconfig-if-ethernet"`
-	HoldTime     *Interface_HoldTime                `path:"hold-time" module:"openconfig-interfaces"`
-	Ifindex      *uint32                            `path:"state/ifindex" module:"openconfig-interfaces/openconfig-interfaces"`
-	LastChange   *uint32                            `path:"state/last-change" module:"openconfig-interfaces/openconfig-interfaces"`
-	Mtu          *uint16                            `path:"config/mtu" module:"openconfig-interfaces/openconfig-interfaces"`
-	Name         *string                            `path:"config/name|name" module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces"`
-	OperStatus   E_Interface_OperStatus             `path:"state/oper-status" module:"openconfig-interfaces/openconfig-interfaces"`
-	RoutedVlan   *Interface_RoutedVlan              `path:"routed-vlan" module:"openconfig-vlan"`
-	Subinterface map[uint32]*Interface_Subinterface `path:"subinterfaces/subinterface" module:"openconfig-interfaces/openconfig-interfaces"`
-	Type         E_IETFInterfaces_InterfaceType     `path:"config/type" module:"openconfig-interfaces/openconfig-interfaces"`
-}
-
-// IsYANGGoStruct ensures that Interface implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface) IsYANGGoStruct() {}
-
-// NewSubinterface creates a new entry in the Subinterface list of the
-// Interface struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface) NewSubinterface(Index uint32) (*Interface_Subinterface, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Subinterface == nil {
-		t.Subinterface = make(map[uint32]*Interface_Subinterface)
-	}
-
-	key := Index
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Subinterface[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Subinterface", key)
-	}
-
-	t.Subinterface[key] = &Interface_Subinterface{
-		Index: &Index,
-	}
-
-	return t.Subinterface[key], nil
-}
-
-// ΛListKeyMap returns the keys of the Interface struct, which is a YANG list entry.
-func (t *Interface) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Name == nil {
-		return nil, fmt.Errorf("nil value for key Name")
-	}
-
-	return map[string]interface{}{
-		"name": *t.Name,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface.
-func (*Interface) ΛBelongingModule() string {
-	return "openconfig-interfaces"
-}
-
-// Interface_Aggregation represents the /openconfig-interfaces/interfaces/interface/aggregation YANG schema element.
-type Interface_Aggregation struct {
-	LagSpeed     *uint32                                 `path:"state/lag-speed" module:"openconfig-if-aggregate/openconfig-if-aggregate"`
-	LagType      E_OpenconfigIfAggregate_AggregationType `path:"config/lag-type" module:"openconfig-if-aggregate/openconfig-if-aggregate"`
-	Member       []string                                `path:"state/member" module:"openconfig-if-aggregate/openconfig-if-aggregate"`
-	MinLinks     *uint16                                 `path:"config/min-links" module:"openconfig-if-aggregate/openconfig-if-aggregate"`
-	SwitchedVlan *Interface_Aggregation_SwitchedVlan     `path:"switched-vlan" module:"openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Interface_Aggregation implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Aggregation) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Aggregation) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Aggregation"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Aggregation) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Aggregation) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Aggregation.
-func (*Interface_Aggregation) ΛBelongingModule() string {
-	return "openconfig-if-aggregate"
-}
-
-// Interface_Aggregation_SwitchedVlan represents the /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan YANG schema element.
-type Interface_Aggregation_SwitchedVlan struct {
-	AccessVlan    Interface_Aggregation_SwitchedVlan_AccessVlan_Union   `path:"config/access-vlan" module:"openconfig-vlan/openconfig-vlan"`
-	InterfaceMode E_OpenconfigVlanTypes_VlanModeType                    `path:"config/interface-mode" module:"openconfig-vlan/openconfig-vlan"`
-	NativeVlan    Interface_Aggregation_SwitchedVlan_NativeVlan_Union   `path:"config/native-vlan" module:"openconfig-vlan/openconfig-vlan"`
-	TrunkVlans    []Interface_Aggregation_SwitchedVlan_TrunkVlans_Union `path:"config/trunk-vlans" module:"openconfig-vlan/openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Interface_Aggregation_SwitchedVlan implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Aggregation_SwitchedVlan) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Aggregation_SwitchedVlan) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Aggregation_SwitchedVlan"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Aggregation_SwitchedVlan) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Aggregation_SwitchedVlan) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Aggregation_SwitchedVlan.
-func (*Interface_Aggregation_SwitchedVlan) ΛBelongingModule() string {
-	return "openconfig-vlan"
-}
-
-// Interface_Aggregation_SwitchedVlan_AccessVlan_Union is an interface that is implemented by valid types for the union
-// for the leaf /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/access-vlan within the YANG schema.
-type Interface_Aggregation_SwitchedVlan_AccessVlan_Union interface {
-	Is_Interface_Aggregation_SwitchedVlan_AccessVlan_Union()
-}
-
-// Interface_Aggregation_SwitchedVlan_AccessVlan_Union_String is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/access-vlan
-// is to be set to a string value.
-type Interface_Aggregation_SwitchedVlan_AccessVlan_Union_String struct {
-	String string
-}
-
-// Is_Interface_Aggregation_SwitchedVlan_AccessVlan_Union ensures that Interface_Aggregation_SwitchedVlan_AccessVlan_Union_String
-// implements the Interface_Aggregation_SwitchedVlan_AccessVlan_Union interface.
-func (*Interface_Aggregation_SwitchedVlan_AccessVlan_Union_String) Is_Interface_Aggregation_SwitchedVlan_AccessVlan_Union() {
-}
-
-// Interface_Aggregation_SwitchedVlan_AccessVlan_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/access-vlan
-// is to be set to a uint16 value.
-type Interface_Aggregation_SwitchedVlan_AccessVlan_Union_Uint16 struct {
-	Uint16 uint16
-}
-
-// Is_Interface_Aggregation_SwitchedVlan_AccessVlan_Union ensures that Interface_Aggregation_SwitchedVlan_AccessVlan_Union_Uint16
-// implements the Interface_Aggregation_SwitchedVlan_AccessVlan_Union interface.
-func (*Interface_Aggregation_SwitchedVlan_AccessVlan_Union_Uint16) Is_Interface_Aggregation_SwitchedVlan_AccessVlan_Union() {
-}
-
-// To_Interface_Aggregation_SwitchedVlan_AccessVlan_Union takes an input interface{} and attempts to convert it to a struct
-// which implements the Interface_Aggregation_SwitchedVlan_AccessVlan_Union union. It returns an error if the interface{} supplied
-// cannot be converted to a type within the union.
-func (t *Interface_Aggregation_SwitchedVlan) To_Interface_Aggregation_SwitchedVlan_AccessVlan_Union(i interface{}) (Interface_Aggregation_SwitchedVlan_AccessVlan_Union, error) {
-	switch v := i.(type) {
-	case string:
-		return &Interface_Aggregation_SwitchedVlan_AccessVlan_Union_String{v}, nil
-	case uint16:
-		return &Interface_Aggregation_SwitchedVlan_AccessVlan_Union_Uint16{v}, nil
-	default:
-		return nil, fmt.Errorf("cannot convert %v to Interface_Aggregation_SwitchedVlan_AccessVlan_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
-	}
-}
-
-// Interface_Aggregation_SwitchedVlan_NativeVlan_Union is an interface that is implemented by valid types for the union
-// for the leaf /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/native-vlan within the YANG schema.
-type Interface_Aggregation_SwitchedVlan_NativeVlan_Union interface {
-	Is_Interface_Aggregation_SwitchedVlan_NativeVlan_Union()
-}
-
-// Interface_Aggregation_SwitchedVlan_NativeVlan_Union_String is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/native-vlan
-// is to be set to a string value.
-type Interface_Aggregation_SwitchedVlan_NativeVlan_Union_String struct {
-	String string
-}
-
-// Is_Interface_Aggregation_SwitchedVlan_NativeVlan_Union ensures that Interface_Aggregation_SwitchedVlan_NativeVlan_Union_String
-// implements the Interface_Aggregation_SwitchedVlan_NativeVlan_Union interface.
-func (*Interface_Aggregation_SwitchedVlan_NativeVlan_Union_String) Is_Interface_Aggregation_SwitchedVlan_NativeVlan_Union() {
-}
-
-// Interface_Aggregation_SwitchedVlan_NativeVlan_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/native-vlan
-// is to be set to a uint16 value.
-type Interface_Aggregation_SwitchedVlan_NativeVlan_Union_Uint16 struct {
-	Uint16 uint16
-}
-
-// Is_Interface_Aggregation_SwitchedVlan_NativeVlan_Union ensures that Interface_Aggregation_SwitchedVlan_NativeVlan_Union_Uint16
-// implements the Interface_Aggregation_SwitchedVlan_NativeVlan_Union interface.
-func (*Interface_Aggregation_SwitchedVlan_NativeVlan_Union_Uint16) Is_Interface_Aggregation_SwitchedVlan_NativeVlan_Union() {
-}
-
-// To_Interface_Aggregation_SwitchedVlan_NativeVlan_Union takes an input interface{} and attempts to convert it to a struct
-// which implements the Interface_Aggregation_SwitchedVlan_NativeVlan_Union union. It returns an error if the interface{} supplied
-// cannot be converted to a type within the union.
-func (t *Interface_Aggregation_SwitchedVlan) To_Interface_Aggregation_SwitchedVlan_NativeVlan_Union(i interface{}) (Interface_Aggregation_SwitchedVlan_NativeVlan_Union, error) {
-	switch v := i.(type) {
-	case string:
-		return &Interface_Aggregation_SwitchedVlan_NativeVlan_Union_String{v}, nil
-	case uint16:
-		return &Interface_Aggregation_SwitchedVlan_NativeVlan_Union_Uint16{v}, nil
-	default:
-		return nil, fmt.Errorf("cannot convert %v to Interface_Aggregation_SwitchedVlan_NativeVlan_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
-	}
-}
-
-// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union is an interface that is implemented by valid types for the union
-// for the leaf /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/trunk-vlans within the YANG schema.
-type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface {
-	Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union()
-}
-
-// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/trunk-vlans
-// is to be set to a string value.
-type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String struct {
-	String string
-}
-
-// Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union ensures that Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String
-// implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface.
-func (*Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String) Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union() {
-}
-
-// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/config/trunk-vlans
-// is to be set to a uint16 value.
-type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16 struct {
-	Uint16 uint16
-}
-
-// Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union ensures that Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16
-// implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface.
-func (*Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16) Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union() {
-}
-
-// To_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union takes an input interface{} and attempts to convert it to a struct
-// which implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union union. It returns an error if the interface{} supplied
-// cannot be converted to a type within the union.
-func (t *Interface_Aggregation_SwitchedVlan) To_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union(i interface{}) (Interface_Aggregation_SwitchedVlan_TrunkVlans_Union, error) {
-	switch v := i.(type) {
-	case string:
-		return &Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String{v}, nil
-	case uint16:
-		return &Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16{v}, nil
-	default:
-		return nil, fmt.Errorf("cannot convert %v to Interface_Aggregation_SwitchedVlan_TrunkVlans_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
-	}
-}
-
-// Interface_Counters represents the /openconfig-interfaces/interfaces/interface/state/counters YANG schema element.
-type Interface_Counters struct {
-	InBroadcastPkts  *uint64 `path:"in-broadcast-pkts" module:"openconfig-interfaces"`
-	InDiscards       *uint64 `path:"in-discards" module:"openconfig-interfaces"`
-	InErrors         *uint64 `path:"in-errors" module:"openconfig-interfaces"`
-	InMulticastPkts  *uint64 `path:"in-multicast-pkts" module:"openconfig-interfaces"`
-	InOctets         *uint64 `path:"in-octets" module:"openconfig-interfaces"`
-	InUnicastPkts    *uint64 `path:"in-unicast-pkts" module:"openconfig-interfaces"`
-	InUnknownProtos  *uint32 `path:"in-unknown-protos" module:"openconfig-interfaces"`
-	LastClear        *string `path:"last-clear" module:"openconfig-interfaces"`
-	OutBroadcastPkts *uint64 `path:"out-broadcast-pkts" module:"openconfig-interfaces"`
-	OutDiscards      *uint64 `path:"out-discards" module:"openconfig-interfaces"`
-	OutErrors        *uint64 `path:"out-errors" module:"openconfig-interfaces"`
-	OutMulticastPkts *uint64 `path:"out-multicast-pkts" module:"openconfig-interfaces"`
-	OutOctets        *uint64 `path:"out-octets" module:"openconfig-interfaces"`
-	OutUnicastPkts   *uint64 `path:"out-unicast-pkts" module:"openconfig-interfaces"`
-}
-
-// IsYANGGoStruct ensures that Interface_Counters implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Counters) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Counters) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Counters"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Counters.
-func (*Interface_Counters) ΛBelongingModule() string {
-	return "openconfig-interfaces"
-}
-
-// Interface_Ethernet represents the /openconfig-interfaces/interfaces/interface/ethernet YANG schema element.
-type Interface_Ethernet struct {
-	AggregateId          *string                               `path:"config/aggregate-id" module:"openconfig-if-ethernet/openconfig-if-aggregate"`
-	AutoNegotiate        *bool                                 `path:"config/auto-negotiate" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	Counters             *Interface_Ethernet_Counters          `path:"state/counters" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	DuplexMode           E_Ethernet_DuplexMode                 `path:"config/duplex-mode" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	EffectiveSpeed       *uint32                               `path:"state/effective-speed" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	EnableFlowControl    *bool                                 `path:"config/enable-flow-control" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	HwMacAddress         *string                               `path:"state/hw-mac-address" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	MacAddress           *string                               `path:"config/mac-address" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	NegotiatedDuplexMode E_Ethernet_NegotiatedDuplexMode       `path:"state/negotiated-duplex-mode" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	NegotiatedPortSpeed  E_OpenconfigIfEthernet_ETHERNET_SPEED `path:"state/negotiated-port-speed" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	PortSpeed            E_OpenconfigIfEthernet_ETHERNET_SPEED `path:"config/port-speed" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
-	SwitchedVlan         *Interface_Ethernet_SwitchedVlan      `path:"switched-vlan" module:"openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Interface_Ethernet implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Ethernet) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Ethernet) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Ethernet"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Ethernet) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Ethernet) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Ethernet.
-func (*Interface_Ethernet) ΛBelongingModule() string {
-	return "openconfig-if-ethernet"
-}
-
-// Interface_Ethernet_Counters represents the /openconfig-interfaces/interfaces/interface/ethernet/state/counters YANG schema element.
-type Interface_Ethernet_Counters struct {
-	In_8021QFrames      *uint64 `path:"in-8021q-frames" module:"openconfig-if-ethernet"`
-	InCrcErrors         *uint64 `path:"in-crc-errors" module:"openconfig-if-ethernet"`
-	InFragmentFrames    *uint64 `path:"in-fragment-frames" module:"openconfig-if-ethernet"`
-	InJabberFrames      *uint64 `path:"in-jabber-frames" module:"openconfig-if-ethernet"`
-	InMacControlFrames  *uint64 `path:"in-mac-control-frames" module:"openconfig-if-ethernet"`
-	InMacPauseFrames    *uint64 `path:"in-mac-pause-frames" module:"openconfig-if-ethernet"`
-	InOversizeFrames    *uint64 `path:"in-oversize-frames" module:"openconfig-if-ethernet"`
-	Out_8021QFrames     *uint64 `path:"out-8021q-frames" module:"openconfig-if-ethernet"`
-	OutMacControlFrames *uint64 `path:"out-mac-control-frames" module:"openconfig-if-ethernet"`
-	OutMacPauseFrames   *uint64 `path:"out-mac-pause-frames" module:"openconfig-if-ethernet"`
-}
-
-// IsYANGGoStruct ensures that Interface_Ethernet_Counters implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Ethernet_Counters) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Ethernet_Counters) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Ethernet_Counters"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Ethernet_Counters) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Ethernet_Counters) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Ethernet_Counters.
-func (*Interface_Ethernet_Counters) ΛBelongingModule() string {
-	return "openconfig-if-ethernet"
-}
-
-// Interface_Ethernet_SwitchedVlan represents the /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan YANG schema element.
-type Interface_Ethernet_SwitchedVlan struct {
-	AccessVlan    Interface_Ethernet_SwitchedVlan_AccessVlan_Union   `path:"config/access-vlan" module:"openconfig-vlan/openconfig-vlan"`
-	InterfaceMode E_OpenconfigVlanTypes_VlanModeType                 `path:"config/interface-mode" module:"openconfig-vlan/openconfig-vlan"`
-	NativeVlan    Interface_Ethernet_SwitchedVlan_NativeVlan_Union   `path:"config/native-vlan" module:"openconfig-vlan/openconfig-vlan"`
-	TrunkVlans    []Interface_Ethernet_SwitchedVlan_TrunkVlans_Union `path:"config/trunk-vlans" module:"openconfig-vlan/openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Interface_Ethernet_SwitchedVlan implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Ethernet_SwitchedVlan) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Ethernet_SwitchedVlan) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Ethernet_SwitchedVlan"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Ethernet_SwitchedVlan) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Ethernet_SwitchedVlan) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Ethernet_SwitchedVlan.
-func (*Interface_Ethernet_SwitchedVlan) ΛBelongingModule() string {
-	return "openconfig-vlan"
-}
-
-// Interface_Ethernet_SwitchedVlan_AccessVlan_Union is an interface that is implemented by valid types for the union
-// for the leaf /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/access-vlan within the YANG schema.
-type Interface_Ethernet_SwitchedVlan_AccessVlan_Union interface {
-	Is_Interface_Ethernet_SwitchedVlan_AccessVlan_Union()
-}
-
-// Interface_Ethernet_SwitchedVlan_AccessVlan_Union_String is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/access-vlan
-// is to be set to a string value.
-type Interface_Ethernet_SwitchedVlan_AccessVlan_Union_String struct {
-	String string
-}
-
-// Is_Interface_Ethernet_SwitchedVlan_AccessVlan_Union ensures that Interface_Ethernet_SwitchedVlan_AccessVlan_Union_String
-// implements the Interface_Ethernet_SwitchedVlan_AccessVlan_Union interface.
-func (*Interface_Ethernet_SwitchedVlan_AccessVlan_Union_String) Is_Interface_Ethernet_SwitchedVlan_AccessVlan_Union() {
-}
-
-// Interface_Ethernet_SwitchedVlan_AccessVlan_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/access-vlan
-// is to be set to a uint16 value.
-type Interface_Ethernet_SwitchedVlan_AccessVlan_Union_Uint16 struct {
-	Uint16 uint16
-}
-
-// Is_Interface_Ethernet_SwitchedVlan_AccessVlan_Union ensures that Interface_Ethernet_SwitchedVlan_AccessVlan_Union_Uint16
-// implements the Interface_Ethernet_SwitchedVlan_AccessVlan_Union interface.
-func (*Interface_Ethernet_SwitchedVlan_AccessVlan_Union_Uint16) Is_Interface_Ethernet_SwitchedVlan_AccessVlan_Union() {
-}
-
-// To_Interface_Ethernet_SwitchedVlan_AccessVlan_Union takes an input interface{} and attempts to convert it to a struct
-// which implements the Interface_Ethernet_SwitchedVlan_AccessVlan_Union union. It returns an error if the interface{} supplied
-// cannot be converted to a type within the union.
-func (t *Interface_Ethernet_SwitchedVlan) To_Interface_Ethernet_SwitchedVlan_AccessVlan_Union(i interface{}) (Interface_Ethernet_SwitchedVlan_AccessVlan_Union, error) {
-	switch v := i.(type) {
-	case string:
-		return &Interface_Ethernet_SwitchedVlan_AccessVlan_Union_String{v}, nil
-	case uint16:
-		return &Interface_Ethernet_SwitchedVlan_AccessVlan_Union_Uint16{v}, nil
-	default:
-		return nil, fmt.Errorf("cannot convert %v to Interface_Ethernet_SwitchedVlan_AccessVlan_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
-	}
-}
-
-// Interface_Ethernet_SwitchedVlan_NativeVlan_Union is an interface that is implemented by valid types for the union
-// for the leaf /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/native-vlan within the YANG schema.
-type Interface_Ethernet_SwitchedVlan_NativeVlan_Union interface {
-	Is_Interface_Ethernet_SwitchedVlan_NativeVlan_Union()
-}
-
-// Interface_Ethernet_SwitchedVlan_NativeVlan_Union_String is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/native-vlan
-// is to be set to a string value.
-type Interface_Ethernet_SwitchedVlan_NativeVlan_Union_String struct {
-	String string
-}
-
-// Is_Interface_Ethernet_SwitchedVlan_NativeVlan_Union ensures that Interface_Ethernet_SwitchedVlan_NativeVlan_Union_String
-// implements the Interface_Ethernet_SwitchedVlan_NativeVlan_Union interface.
-func (*Interface_Ethernet_SwitchedVlan_NativeVlan_Union_String) Is_Interface_Ethernet_SwitchedVlan_NativeVlan_Union() {
-}
-
-// Interface_Ethernet_SwitchedVlan_NativeVlan_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/native-vlan
-// is to be set to a uint16 value.
-type Interface_Ethernet_SwitchedVlan_NativeVlan_Union_Uint16 struct {
-	Uint16 uint16
-}
-
-// Is_Interface_Ethernet_SwitchedVlan_NativeVlan_Union ensures that Interface_Ethernet_SwitchedVlan_NativeVlan_Union_Uint16
-// implements the Interface_Ethernet_SwitchedVlan_NativeVlan_Union interface.
-func (*Interface_Ethernet_SwitchedVlan_NativeVlan_Union_Uint16) Is_Interface_Ethernet_SwitchedVlan_NativeVlan_Union() {
-}
-
-// To_Interface_Ethernet_SwitchedVlan_NativeVlan_Union takes an input interface{} and attempts to convert it to a struct
-// which implements the Interface_Ethernet_SwitchedVlan_NativeVlan_Union union. It returns an error if the interface{} supplied
-// cannot be converted to a type within the union.
-func (t *Interface_Ethernet_SwitchedVlan) To_Interface_Ethernet_SwitchedVlan_NativeVlan_Union(i interface{}) (Interface_Ethernet_SwitchedVlan_NativeVlan_Union, error) {
-	switch v := i.(type) {
-	case string:
-		return &Interface_Ethernet_SwitchedVlan_NativeVlan_Union_String{v}, nil
-	case uint16:
-		return &Interface_Ethernet_SwitchedVlan_NativeVlan_Union_Uint16{v}, nil
-	default:
-		return nil, fmt.Errorf("cannot convert %v to Interface_Ethernet_SwitchedVlan_NativeVlan_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
-	}
-}
-
-// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union is an interface that is implemented by valid types for the union
-// for the leaf /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/trunk-vlans within the YANG schema.
-type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface {
-	Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union()
-}
-
-// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/trunk-vlans
-// is to be set to a string value.
-type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String struct {
-	String string
-}
-
-// Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union ensures that Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String
-// implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface.
-func (*Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String) Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union() {
-}
-
-// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/config/trunk-vlans
-// is to be set to a uint16 value.
-type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16 struct {
-	Uint16 uint16
-}
-
-// Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union ensures that Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16
-// implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface.
-func (*Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16) Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union() {
-}
-
-// To_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union takes an input interface{} and attempts to convert it to a struct
-// which implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union union. It returns an error if the interface{} supplied
-// cannot be converted to a type within the union.
-func (t *Interface_Ethernet_SwitchedVlan) To_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union(i interface{}) (Interface_Ethernet_SwitchedVlan_TrunkVlans_Union, error) {
-	switch v := i.(type) {
-	case string:
-		return &Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String{v}, nil
-	case uint16:
-		return &Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16{v}, nil
-	default:
-		return nil, fmt.Errorf("cannot convert %v to Interface_Ethernet_SwitchedVlan_TrunkVlans_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
-	}
-}
-
-// Interface_HoldTime represents the /openconfig-interfaces/interfaces/interface/hold-time YANG schema element.
-type Interface_HoldTime struct {
-	Down *uint32 `path:"config/down" module:"openconfig-interfaces/openconfig-interfaces"`
-	Up   *uint32 `path:"config/up" module:"openconfig-interfaces/openconfig-interfaces"`
-}
-
-// IsYANGGoStruct ensures that Interface_HoldTime implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_HoldTime) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_HoldTime) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_HoldTime"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_HoldTime) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_HoldTime) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_HoldTime.
-func (*Interface_HoldTime) ΛBelongingModule() string {
-	return "openconfig-interfaces"
-}
-
-// Interface_RoutedVlan represents the /openconfig-interfaces/interfaces/interface/routed-vlan YANG schema element.
-type Interface_RoutedVlan struct {
-	Ipv4 *Interface_RoutedVlan_Ipv4      `path:"ipv4" module:"openconfig-if-ip"`
-	Ipv6 *Interface_RoutedVlan_Ipv6      `path:"ipv6" module:"openconfig-if-ip"`
-	Vlan Interface_RoutedVlan_Vlan_Union `path:"config/vlan" module:"openconfig-vlan/openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan.
-func (*Interface_RoutedVlan) ΛBelongingModule() string {
-	return "openconfig-vlan"
-}
-
-// Interface_RoutedVlan_Vlan_Union is an interface that is implemented by valid types for the union
-// for the leaf /openconfig-interfaces/interfaces/interface/routed-vlan/config/vlan within the YANG schema.
-type Interface_RoutedVlan_Vlan_Union interface {
-	Is_Interface_RoutedVlan_Vlan_Union()
-}
-
-// Interface_RoutedVlan_Vlan_Union_String is used when /openconfig-interfaces/interfaces/interface/routed-vlan/config/vlan
-// is to be set to a string value.
-type Interface_RoutedVlan_Vlan_Union_String struct {
-	String string
-}
-
-// Is_Interface_RoutedVlan_Vlan_Union ensures that Interface_RoutedVlan_Vlan_Union_String
-// implements the Interface_RoutedVlan_Vlan_Union interface.
-func (*Interface_RoutedVlan_Vlan_Union_String) Is_Interface_RoutedVlan_Vlan_Union() {}
-
-// Interface_RoutedVlan_Vlan_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/routed-vlan/config/vlan
-// is to be set to a uint16 value.
-type Interface_RoutedVlan_Vlan_Union_Uint16 struct {
-	Uint16 uint16
-}
-
-// Is_Interface_RoutedVlan_Vlan_Union ensures that Interface_RoutedVlan_Vlan_Union_Uint16
-// implements the Interface_RoutedVlan_Vlan_Union interface.
-func (*Interface_RoutedVlan_Vlan_Union_Uint16) Is_Interface_RoutedVlan_Vlan_Union() {}
-
-// To_Interface_RoutedVlan_Vlan_Union takes an input interface{} and attempts to convert it to a struct
-// which implements the Interface_RoutedVlan_Vlan_Union union. It returns an error if the interface{} supplied
-// cannot be converted to a type within the union.
-func (t *Interface_RoutedVlan) To_Interface_RoutedVlan_Vlan_Union(i interface{}) (Interface_RoutedVlan_Vlan_Union, error) {
-	switch v := i.(type) {
-	case string:
-		return &Interface_RoutedVlan_Vlan_Union_String{v}, nil
-	case uint16:
-		return &Interface_RoutedVlan_Vlan_Union_Uint16{v}, nil
-	default:
-		return nil, fmt.Errorf("cannot convert %v to Interface_RoutedVlan_Vlan_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
-	}
-}
-
-// Interface_RoutedVlan_Ipv4 represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4 YANG schema element.
-type Interface_RoutedVlan_Ipv4 struct {
-	Address    map[string]*Interface_RoutedVlan_Ipv4_Address  `path:"addresses/address" module:"openconfig-if-ip/openconfig-if-ip"`
-	Enabled    *bool                                          `path:"config/enabled" module:"openconfig-if-ip/openconfig-if-ip"`
-	Mtu        *uint16                                        `path:"config/mtu" module:"openconfig-if-ip/openconfig-if-ip"`
-	Neighbor   map[string]*Interface_RoutedVlan_Ipv4_Neighbor `path:"neighbors/neighbor" module:"openconfig-if-ip/openconfig-if-ip"`
-	Unnumbered *Interface_RoutedVlan_Ipv4_Unnumbered          `path:"unnumbered" module:"openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4 implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv4) IsYANGGoStruct() {}
-
-// NewAddress creates a new entry in the Address list of the
-// Interface_RoutedVlan_Ipv4 struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_RoutedVlan_Ipv4) NewAddress(Ip string) (*Interface_RoutedVlan_Ipv4_Address, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Address == nil {
-		t.Address = make(map[string]*Interface_RoutedVlan_Ipv4_Address)
-	}
-
-	key := Ip
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Address[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Address", key)
-	}
-
-	t.Address[key] = &Interface_RoutedVlan_Ipv4_Address{
-		Ip: &Ip,
-	}
-
-	return t.Address[key], nil
-}
-
-// NewNeighbor creates a new entry in the Neighbor list of the
-// Interface_RoutedVlan_Ipv4 struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_RoutedVlan_Ipv4) NewNeighbor(Ip string) (*Interface_RoutedVlan_Ipv4_Neighbor, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Neighbor == nil {
-		t.Neighbor = make(map[string]*Interface_RoutedVlan_Ipv4_Neighbor)
-	}
-
-	key := Ip
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Neighbor[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
-	}
-
-	t.Neighbor[key] = &Interface_RoutedVlan_Ipv4_Neighbor{
-		Ip: &Ip,
-	}
-
-	return t.Neighbor[key], nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv4) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv4.
-func (*Interface_RoutedVlan_Ipv4) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv4_Address represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/addresses/address YANG schema element.
-type Interface_RoutedVlan_Ipv4_Address struct {
-	Ip           *string                                                `path:"config/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-	Origin       E_OpenconfigIfIp_IpAddressOrigin                       `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
-	PrefixLength *uint8                                                 `path:"config/prefix-length" module:"openconfig-if-ip/openconfig-if-ip"`
-	VrrpGroup    map[uint8]*Interface_RoutedVlan_Ipv4_Address_VrrpGroup `path:"vrrp/vrrp-group" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Address implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv4_Address) IsYANGGoStruct() {}
-
-// NewVrrpGroup creates a new entry in the VrrpGroup list of the
-// Interface_RoutedVlan_Ipv4_Address struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_RoutedVlan_Ipv4_Address) NewVrrpGroup(VirtualRouterId uint8) (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.VrrpGroup == nil {
-		t.VrrpGroup = make(map[uint8]*Interface_RoutedVlan_Ipv4_Address_VrrpGroup)
-	}
-
-	key := VirtualRouterId
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.VrrpGroup[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list VrrpGroup", key)
-	}
-
-	t.VrrpGroup[key] = &Interface_RoutedVlan_Ipv4_Address_VrrpGroup{
-		VirtualRouterId: &VirtualRouterId,
-	}
-
-	return t.VrrpGroup[key], nil
-}
-
-// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv4_Address struct, which is a YANG list entry.
-func (t *Interface_RoutedVlan_Ipv4_Address) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Ip == nil {
-		return nil, fmt.Errorf("nil value for key Ip")
-	}
-
-	return map[string]interface{}{
-		"ip": *t.Ip,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Address) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Address"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Address) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv4_Address) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv4_Address.
-func (*Interface_RoutedVlan_Ipv4_Address) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv4_Address_VrrpGroup represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/addresses/address/vrrp/vrrp-group YANG schema element.
-type Interface_RoutedVlan_Ipv4_Address_VrrpGroup struct {
-	AcceptMode            *bool                                                          `path:"config/accept-mode" module:"openconfig-if-ip/openconfig-if-ip"`
-	AdvertisementInterval *uint16                                                        `path:"config/advertisement-interval" module:"openconfig-if-ip/openconfig-if-ip"`
-	CurrentPriority       *uint8                                                         `path:"state/current-priority" module:"openconfig-if-ip/openconfig-if-ip"`
-	InterfaceTracking     *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking `path:"interface-tracking" module:"openconfig-if-ip"`
-	Preempt               *bool                                                          `path:"config/preempt" module:"openconfig-if-ip/openconfig-if-ip"`
-	PreemptDelay          *uint16                                                        `path:"config/preempt-delay" module:"openconfig-if-ip/openconfig-if-ip"`
-	Priority              *uint8                                                         `path:"config/priority" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualAddress        []string                                                       `path:"config/virtual-address" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualRouterId       *uint8                                                         `path:"config/virtual-router-id|virtual-router-id" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Address_VrrpGroup implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv4_Address_VrrpGroup struct, which is a YANG list entry.
-func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.VirtualRouterId == nil {
-		return nil, fmt.Errorf("nil value for key VirtualRouterId")
-	}
-
-	return map[string]interface{}{
-		"virtual-router-id": *t.VirtualRouterId,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Address_VrrpGroup"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv4_Address_VrrpGroup.
-func (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/addresses/address/vrrp/vrrp-group/interface-tracking YANG schema element.
-type Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking struct {
-	PriorityDecrement *uint8  `path:"config/priority-decrement" module:"openconfig-if-ip/openconfig-if-ip"`
-	TrackInterface    *string `path:"config/track-interface" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking.
-func (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv4_Neighbor represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/neighbors/neighbor YANG schema element.
-type Interface_RoutedVlan_Ipv4_Neighbor struct {
-	Ip               *string                         `path:"config/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-	LinkLayerAddress *string                         `path:"config/link-layer-address" module:"openconfig-if-ip/openconfig-if-ip"`
-	Origin           E_OpenconfigIfIp_NeighborOrigin `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Neighbor implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv4_Neighbor) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv4_Neighbor struct, which is a YANG list entry.
-func (t *Interface_RoutedVlan_Ipv4_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Ip == nil {
-		return nil, fmt.Errorf("nil value for key Ip")
-	}
-
-	return map[string]interface{}{
-		"ip": *t.Ip,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Neighbor) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Neighbor"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv4_Neighbor) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv4_Neighbor.
-func (*Interface_RoutedVlan_Ipv4_Neighbor) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv4_Unnumbered represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/unnumbered YANG schema element.
-type Interface_RoutedVlan_Ipv4_Unnumbered struct {
-	Enabled      *bool                                              `path:"config/enabled" module:"openconfig-if-ip/openconfig-if-ip"`
-	InterfaceRef *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef `path:"interface-ref" module:"openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Unnumbered implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv4_Unnumbered) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Unnumbered) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Unnumbered"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Unnumbered) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv4_Unnumbered) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv4_Unnumbered.
-func (*Interface_RoutedVlan_Ipv4_Unnumbered) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/unnumbered/interface-ref YANG schema element.
-type Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef struct {
-	Interface    *string `path:"config/interface" module:"openconfig-if-ip/openconfig-if-ip"`
-	Subinterface *uint32 `path:"config/subinterface" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef.
-func (*Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv6 represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6 YANG schema element.
-type Interface_RoutedVlan_Ipv6 struct {
-	Address                map[string]*Interface_RoutedVlan_Ipv6_Address  `path:"addresses/address" module:"openconfig-if-ip/openconfig-if-ip"`
-	DupAddrDetectTransmits *uint32                                        `path:"config/dup-addr-detect-transmits" module:"openconfig-if-ip/openconfig-if-ip"`
-	Enabled                *bool                                          `path:"config/enabled" module:"openconfig-if-ip/openconfig-if-ip"`
-	Mtu                    *uint32                                        `path:"config/mtu" module:"openconfig-if-ip/openconfig-if-ip"`
-	Neighbor               map[string]*Interface_RoutedVlan_Ipv6_Neighbor `path:"neighbors/neighbor" module:"openconfig-if-ip/openconfig-if-ip"`
-	Unnumbered             *Interface_RoutedVlan_Ipv6_Unnumbered          `path:"unnumbered" module:"openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6 implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv6) IsYANGGoStruct() {}
-
-// NewAddress creates a new entry in the Address list of the
-// Interface_RoutedVlan_Ipv6 struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_RoutedVlan_Ipv6) NewAddress(Ip string) (*Interface_RoutedVlan_Ipv6_Address, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Address == nil {
-		t.Address = make(map[string]*Interface_RoutedVlan_Ipv6_Address)
-	}
-
-	key := Ip
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Address[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Address", key)
-	}
-
-	t.Address[key] = &Interface_RoutedVlan_Ipv6_Address{
-		Ip: &Ip,
-	}
-
-	return t.Address[key], nil
-}
-
-// NewNeighbor creates a new entry in the Neighbor list of the
-// Interface_RoutedVlan_Ipv6 struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_RoutedVlan_Ipv6) NewNeighbor(Ip string) (*Interface_RoutedVlan_Ipv6_Neighbor, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Neighbor == nil {
-		t.Neighbor = make(map[string]*Interface_RoutedVlan_Ipv6_Neighbor)
-	}
-
-	key := Ip
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Neighbor[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
-	}
-
-	t.Neighbor[key] = &Interface_RoutedVlan_Ipv6_Neighbor{
-		Ip: &Ip,
-	}
-
-	return t.Neighbor[key], nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv6) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv6.
-func (*Interface_RoutedVlan_Ipv6) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv6_Address represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/addresses/address YANG schema element.
-type Interface_RoutedVlan_Ipv6_Address struct {
-	Ip           *string                                                `path:"config/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-	Origin       E_OpenconfigIfIp_IpAddressOrigin                       `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
-	PrefixLength *uint8                                                 `path:"config/prefix-length" module:"openconfig-if-ip/openconfig-if-ip"`
-	Status       E_Address_Status                                       `path:"state/status" module:"openconfig-if-ip/openconfig-if-ip"`
-	VrrpGroup    map[uint8]*Interface_RoutedVlan_Ipv6_Address_VrrpGroup `path:"vrrp/vrrp-group" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Address implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv6_Address) IsYANGGoStruct() {}
-
-// NewVrrpGroup creates a new entry in the VrrpGroup list of the
-// Interface_RoutedVlan_Ipv6_Address struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_RoutedVlan_Ipv6_Address) NewVrrpGroup(VirtualRouterId uint8) (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.VrrpGroup == nil {
-		t.VrrpGroup = make(map[uint8]*Interface_RoutedVlan_Ipv6_Address_VrrpGroup)
-	}
-
-	key := VirtualRouterId
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.VrrpGroup[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list VrrpGroup", key)
-	}
-
-	t.VrrpGroup[key] = &Interface_RoutedVlan_Ipv6_Address_VrrpGroup{
-		VirtualRouterId: &VirtualRouterId,
-	}
-
-	return t.VrrpGroup[key], nil
-}
-
-// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv6_Address struct, which is a YANG list entry.
-func (t *Interface_RoutedVlan_Ipv6_Address) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Ip == nil {
-		return nil, fmt.Errorf("nil value for key Ip")
-	}
-
-	return map[string]interface{}{
-		"ip": *t.Ip,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Address) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Address"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Address) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv6_Address) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv6_Address.
-func (*Interface_RoutedVlan_Ipv6_Address) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv6_Address_VrrpGroup represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/addresses/address/vrrp/vrrp-group YANG schema element.
-type Interface_RoutedVlan_Ipv6_Address_VrrpGroup struct {
-	AcceptMode            *bool                                                          `path:"config/accept-mode" module:"openconfig-if-ip/openconfig-if-ip"`
-	AdvertisementInterval *uint16                                                        `path:"config/advertisement-interval" module:"openconfig-if-ip/openconfig-if-ip"`
-	CurrentPriority       *uint8                                                         `path:"state/current-priority" module:"openconfig-if-ip/openconfig-if-ip"`
-	InterfaceTracking     *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking `path:"interface-tracking" module:"openconfig-if-ip"`
-	Preempt               *bool                                                          `path:"config/preempt" module:"openconfig-if-ip/openconfig-if-ip"`
-	PreemptDelay          *uint16                                                        `path:"config/preempt-delay" module:"openconfig-if-ip/openconfig-if-ip"`
-	Priority              *uint8                                                         `path:"config/priority" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualAddress        []string                                                       `path:"config/virtual-address" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualLinkLocal      *string                                                        `path:"config/virtual-link-local" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualRouterId       *uint8                                                         `path:"config/virtual-router-id|virtual-router-id" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Address_VrrpGroup implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv6_Address_VrrpGroup struct, which is a YANG list entry.
-func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.VirtualRouterId == nil {
-		return nil, fmt.Errorf("nil value for key VirtualRouterId")
-	}
-
-	return map[string]interface{}{
-		"virtual-router-id": *t.VirtualRouterId,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Address_VrrpGroup"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv6_Address_VrrpGroup.
-func (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/addresses/address/vrrp/vrrp-group/interface-tracking YANG schema element.
-type Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking struct {
-	PriorityDecrement *uint8  `path:"config/priority-decrement" module:"openconfig-if-ip/openconfig-if-ip"`
-	TrackInterface    *string `path:"config/track-interface" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking.
-func (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv6_Neighbor represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/neighbors/neighbor YANG schema element.
-type Interface_RoutedVlan_Ipv6_Neighbor struct {
-	Ip               *string                         `path:"config/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-	IsRouter         YANGEmpty                       `path:"state/is-router" module:"openconfig-if-ip/openconfig-if-ip"`
-	LinkLayerAddress *string                         `path:"config/link-layer-address" module:"openconfig-if-ip/openconfig-if-ip"`
-	NeighborState    E_Neighbor_NeighborState        `path:"state/neighbor-state" module:"openconfig-if-ip/openconfig-if-ip"`
-	Origin           E_OpenconfigIfIp_NeighborOrigin `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Neighbor implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv6_Neighbor) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv6_Neighbor struct, which is a YANG list entry.
-func (t *Interface_RoutedVlan_Ipv6_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Ip == nil {
-		return nil, fmt.Errorf("nil value for key Ip")
-	}
-
-	return map[string]interface{}{
-		"ip": *t.Ip,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Neighbor) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Neighbor"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv6_Neighbor) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv6_Neighbor.
-func (*Interface_RoutedVlan_Ipv6_Neighbor) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv6_Unnumbered represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/unnumbered YANG schema element.
-type Interface_RoutedVlan_Ipv6_Unnumbered struct {
-	Enabled      *bool                                              `path:"config/enabled" module:"openconfig-if-ip/openconfig-if-ip"`
-	InterfaceRef *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef `path:"interface-ref" module:"openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Unnumbered implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv6_Unnumbered) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Unnumbered) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Unnumbered"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Unnumbered) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv6_Unnumbered) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv6_Unnumbered.
-func (*Interface_RoutedVlan_Ipv6_Unnumbered) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/unnumbered/interface-ref YANG schema element.
-type Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef struct {
-	Interface    *string `path:"config/interface" module:"openconfig-if-ip/openconfig-if-ip"`
-	Subinterface *uint32 `path:"config/subinterface" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef.
-func (*Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface YANG schema element.
-type Interface_Subinterface struct {
-	AdminStatus E_Interface_AdminStatus          `path:"state/admin-status" module:"openconfig-interfaces/openconfig-interfaces"`
-	Counters    *Interface_Subinterface_Counters `path:"state/counters" module:"openconfig-interfaces/openconfig-interfaces"`
-	Description *string                          `path:"config/description" module:"openconfig-interfaces/openconfig-interfaces"`
-	Enabled     *bool                            `path:"config/enabled" module:"openconfig-interfaces/openconfig-interfaces"`
-	Ifindex     *uint32                          `path:"state/ifindex" module:"openconfig-interfaces/openconfig-interfaces"`
-	Index       *uint32                          `path:"config/index|index" module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces"`
-	Ipv4        *Interface_Subinterface_Ipv4     `path:"ipv4" module:"openconfig-if-ip"`
-	Ipv6        *Interface_Subinterface_Ipv6     `path:"ipv6" module:"openconfig-if-ip"`
-	LastChange  *uint32                          `path:"state/last-change" module:"openconfig-interfaces/openconfig-interfaces"`
-	Name        *string                          `path:"config/name" module:"openconfig-interfaces/openconfig-interfaces"`
-	OperStatus  E_Interface_OperStatus           `path:"state/oper-status" module:"openconfig-interfaces/openconfig-interfaces"`
-	Vlan        *Interface_Subinterface_Vlan     `path:"vlan" module:"openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_Subinterface struct, which is a YANG list entry.
-func (t *Interface_Subinterface) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Index == nil {
-		return nil, fmt.Errorf("nil value for key Index")
-	}
-
-	return map[string]interface{}{
-		"index": *t.Index,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface.
-func (*Interface_Subinterface) ΛBelongingModule() string {
-	return "openconfig-interfaces"
-}
-
-// Interface_Subinterface_Counters represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/state/counters YANG schema element.
-type Interface_Subinterface_Counters struct {
-	InBroadcastPkts  *uint64 `path:"in-broadcast-pkts" module:"openconfig-interfaces"`
-	InDiscards       *uint64 `path:"in-discards" module:"openconfig-interfaces"`
-	InErrors         *uint64 `path:"in-errors" module:"openconfig-interfaces"`
-	InMulticastPkts  *uint64 `path:"in-multicast-pkts" module:"openconfig-interfaces"`
-	InOctets         *uint64 `path:"in-octets" module:"openconfig-interfaces"`
-	InUnicastPkts    *uint64 `path:"in-unicast-pkts" module:"openconfig-interfaces"`
-	InUnknownProtos  *uint32 `path:"in-unknown-protos" module:"openconfig-interfaces"`
-	LastClear        *string `path:"last-clear" module:"openconfig-interfaces"`
-	OutBroadcastPkts *uint64 `path:"out-broadcast-pkts" module:"openconfig-interfaces"`
-	OutDiscards      *uint64 `path:"out-discards" module:"openconfig-interfaces"`
-	OutErrors        *uint64 `path:"out-errors" module:"openconfig-interfaces"`
-	OutMulticastPkts *uint64 `path:"out-multicast-pkts" module:"openconfig-interfaces"`
-	OutOctets        *uint64 `path:"out-octets" module:"openconfig-interfaces"`
-	OutUnicastPkts   *uint64 `path:"out-unicast-pkts" module:"openconfig-interfaces"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Counters implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Counters) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Counters) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Counters"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Counters) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Counters) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Counters.
-func (*Interface_Subinterface_Counters) ΛBelongingModule() string {
-	return "openconfig-interfaces"
-}
-
-// Interface_Subinterface_Ipv4 represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4 YANG schema element.
-type Interface_Subinterface_Ipv4 struct {
-	Address    map[string]*Interface_Subinterface_Ipv4_Address  `path:"addresses/address" module:"openconfig-if-ip/openconfig-if-ip"`
-	Enabled    *bool                                            `path:"config/enabled" module:"openconfig-if-ip/openconfig-if-ip"`
-	Mtu        *uint16                                          `path:"config/mtu" module:"openconfig-if-ip/openconfig-if-ip"`
-	Neighbor   map[string]*Interface_Subinterface_Ipv4_Neighbor `path:"neighbors/neighbor" module:"openconfig-if-ip/openconfig-if-ip"`
-	Unnumbered *Interface_Subinterface_Ipv4_Unnumbered          `path:"unnumbered" module:"openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4 implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv4) IsYANGGoStruct() {}
-
-// NewAddress creates a new entry in the Address list of the
-// Interface_Subinterface_Ipv4 struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_Subinterface_Ipv4) NewAddress(Ip string) (*Interface_Subinterface_Ipv4_Address, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Address == nil {
-		t.Address = make(map[string]*Interface_Subinterface_Ipv4_Address)
-	}
-
-	key := Ip
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Address[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Address", key)
-	}
-
-	t.Address[key] = &Interface_Subinterface_Ipv4_Address{
-		Ip: &Ip,
-	}
-
-	return t.Address[key], nil
-}
-
-// NewNeighbor creates a new entry in the Neighbor list of the
-// Interface_Subinterface_Ipv4 struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_Subinterface_Ipv4) NewNeighbor(Ip string) (*Interface_Subinterface_Ipv4_Neighbor, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Neighbor == nil {
-		t.Neighbor = make(map[string]*Interface_Subinterface_Ipv4_Neighbor)
-	}
-
-	key := Ip
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Neighbor[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
-	}
-
-	t.Neighbor[key] = &Interface_Subinterface_Ipv4_Neighbor{
-		Ip: &Ip,
-	}
-
-	return t.Neighbor[key], nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv4) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv4.
-func (*Interface_Subinterface_Ipv4) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv4_Address represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/addresses/address YANG schema element.
-type Interface_Subinterface_Ipv4_Address struct {
-	Ip           *string                                                  `path:"config/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-	Origin       E_OpenconfigIfIp_IpAddressOrigin                         `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
-	PrefixLength *uint8                                                   `path:"config/prefix-length" module:"openconfig-if-ip/openconfig-if-ip"`
-	VrrpGroup    map[uint8]*Interface_Subinterface_Ipv4_Address_VrrpGroup `path:"vrrp/vrrp-group" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Address implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv4_Address) IsYANGGoStruct() {}
-
-// NewVrrpGroup creates a new entry in the VrrpGroup list of the
-// Interface_Subinterface_Ipv4_Address struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_Subinterface_Ipv4_Address) NewVrrpGroup(VirtualRouterId uint8) (*Interface_Subinterface_Ipv4_Address_VrrpGroup, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.VrrpGroup == nil {
-		t.VrrpGroup = make(map[uint8]*Interface_Subinterface_Ipv4_Address_VrrpGroup)
-	}
-
-	key := VirtualRouterId
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.VrrpGroup[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list VrrpGroup", key)
-	}
-
-	t.VrrpGroup[key] = &Interface_Subinterface_Ipv4_Address_VrrpGroup{
-		VirtualRouterId: &VirtualRouterId,
-	}
-
-	return t.VrrpGroup[key], nil
-}
-
-// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv4_Address struct, which is a YANG list entry.
-func (t *Interface_Subinterface_Ipv4_Address) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Ip == nil {
-		return nil, fmt.Errorf("nil value for key Ip")
-	}
-
-	return map[string]interface{}{
-		"ip": *t.Ip,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Address) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Address"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Address) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv4_Address) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv4_Address.
-func (*Interface_Subinterface_Ipv4_Address) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv4_Address_VrrpGroup represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/addresses/address/vrrp/vrrp-group YANG schema element.
-type Interface_Subinterface_Ipv4_Address_VrrpGroup struct {
-	AcceptMode            *bool                                                            `path:"config/accept-mode" module:"openconfig-if-ip/openconfig-if-ip"`
-	AdvertisementInterval *uint16                                                          `path:"config/advertisement-interval" module:"openconfig-if-ip/openconfig-if-ip"`
-	CurrentPriority       *uint8                                                           `path:"state/current-priority" module:"openconfig-if-ip/openconfig-if-ip"`
-	InterfaceTracking     *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking `path:"interface-tracking" module:"openconfig-if-ip"`
-	Preempt               *bool                                                            `path:"config/preempt" module:"openconfig-if-ip/openconfig-if-ip"`
-	PreemptDelay          *uint16                                                          `path:"config/preempt-delay" module:"openconfig-if-ip/openconfig-if-ip"`
-	Priority              *uint8                                                           `path:"config/priority" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualAddress        []string                                                         `path:"config/virtual-address" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualRouterId       *uint8                                                           `path:"config/virtual-router-id|virtual-router-id" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Address_VrrpGroup implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv4_Address_VrrpGroup) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv4_Address_VrrpGroup struct, which is a YANG list entry.
-func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.VirtualRouterId == nil {
-		return nil, fmt.Errorf("nil value for key VirtualRouterId")
-	}
-
-	return map[string]interface{}{
-		"virtual-router-id": *t.VirtualRouterId,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Address_VrrpGroup"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv4_Address_VrrpGroup.
-func (*Interface_Subinterface_Ipv4_Address_VrrpGroup) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/addresses/address/vrrp/vrrp-group/interface-tracking YANG schema element.
-type Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking struct {
-	PriorityDecrement *uint8  `path:"config/priority-decrement" module:"openconfig-if-ip/openconfig-if-ip"`
-	TrackInterface    *string `path:"config/track-interface" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking.
-func (*Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv4_Neighbor represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/neighbors/neighbor YANG schema element.
-type Interface_Subinterface_Ipv4_Neighbor struct {
-	Ip               *string                         `path:"config/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-	LinkLayerAddress *string                         `path:"config/link-layer-address" module:"openconfig-if-ip/openconfig-if-ip"`
-	Origin           E_OpenconfigIfIp_NeighborOrigin `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Neighbor implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv4_Neighbor) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv4_Neighbor struct, which is a YANG list entry.
-func (t *Interface_Subinterface_Ipv4_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Ip == nil {
-		return nil, fmt.Errorf("nil value for key Ip")
-	}
-
-	return map[string]interface{}{
-		"ip": *t.Ip,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Neighbor) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Neighbor"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv4_Neighbor) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv4_Neighbor.
-func (*Interface_Subinterface_Ipv4_Neighbor) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv4_Unnumbered represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/unnumbered YANG schema element.
-type Interface_Subinterface_Ipv4_Unnumbered struct {
-	Enabled      *bool                                                `path:"config/enabled" module:"openconfig-if-ip/openconfig-if-ip"`
-	InterfaceRef *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef `path:"interface-ref" module:"openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Unnumbered implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv4_Unnumbered) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Unnumbered) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Unnumbered"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Unnumbered) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv4_Unnumbered) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv4_Unnumbered.
-func (*Interface_Subinterface_Ipv4_Unnumbered) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/unnumbered/interface-ref YANG schema element.
-type Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef struct {
-	Interface    *string `path:"config/interface" module:"openconfig-if-ip/openconfig-if-ip"`
-	Subinterface *uint32 `path:"config/subinterface" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef.
-func (*Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv6 represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6 YANG schema element.
-type Interface_Subinterface_Ipv6 struct {
-	Address                map[string]*Interface_Subinterface_Ipv6_Address  `path:"addresses/address" module:"openconfig-if-ip/openconfig-if-ip"`
-	DupAddrDetectTransmits *uint32                                          `path:"config/dup-addr-detect-transmits" module:"openconfig-if-ip/openconfig-if-ip"`
-	Enabled                *bool                                            `path:"config/enabled" module:"openconfig-if-ip/openconfig-if-ip"`
-	Mtu                    *uint32                                          `path:"config/mtu" module:"openconfig-if-ip/openconfig-if-ip"`
-	Neighbor               map[string]*Interface_Subinterface_Ipv6_Neighbor `path:"neighbors/neighbor" module:"openconfig-if-ip/openconfig-if-ip"`
-	Unnumbered             *Interface_Subinterface_Ipv6_Unnumbered          `path:"unnumbered" module:"openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6 implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv6) IsYANGGoStruct() {}
-
-// NewAddress creates a new entry in the Address list of the
-// Interface_Subinterface_Ipv6 struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_Subinterface_Ipv6) NewAddress(Ip string) (*Interface_Subinterface_Ipv6_Address, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Address == nil {
-		t.Address = make(map[string]*Interface_Subinterface_Ipv6_Address)
-	}
-
-	key := Ip
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Address[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Address", key)
-	}
-
-	t.Address[key] = &Interface_Subinterface_Ipv6_Address{
-		Ip: &Ip,
-	}
-
-	return t.Address[key], nil
-}
-
-// NewNeighbor creates a new entry in the Neighbor list of the
-// Interface_Subinterface_Ipv6 struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_Subinterface_Ipv6) NewNeighbor(Ip string) (*Interface_Subinterface_Ipv6_Neighbor, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.Neighbor == nil {
-		t.Neighbor = make(map[string]*Interface_Subinterface_Ipv6_Neighbor)
-	}
-
-	key := Ip
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.Neighbor[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
-	}
-
-	t.Neighbor[key] = &Interface_Subinterface_Ipv6_Neighbor{
-		Ip: &Ip,
-	}
-
-	return t.Neighbor[key], nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv6) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv6.
-func (*Interface_Subinterface_Ipv6) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv6_Address represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/addresses/address YANG schema element.
-type Interface_Subinterface_Ipv6_Address struct {
-	Ip           *string                                                  `path:"config/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-	Origin       E_OpenconfigIfIp_IpAddressOrigin                         `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
-	PrefixLength *uint8                                                   `path:"config/prefix-length" module:"openconfig-if-ip/openconfig-if-ip"`
-	Status       E_Address_Status                                         `path:"state/status" module:"openconfig-if-ip/openconfig-if-ip"`
-	VrrpGroup    map[uint8]*Interface_Subinterface_Ipv6_Address_VrrpGroup `path:"vrrp/vrrp-group" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Address implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv6_Address) IsYANGGoStruct() {}
-
-// NewVrrpGroup creates a new entry in the VrrpGroup list of the
-// Interface_Subinterface_Ipv6_Address struct. The keys of the list are populated from the input
-// arguments.
-func (t *Interface_Subinterface_Ipv6_Address) NewVrrpGroup(VirtualRouterId uint8) (*Interface_Subinterface_Ipv6_Address_VrrpGroup, error) {
-
-	// Initialise the list within the receiver struct if it has not already been
-	// created.
-	if t.VrrpGroup == nil {
-		t.VrrpGroup = make(map[uint8]*Interface_Subinterface_Ipv6_Address_VrrpGroup)
-	}
-
-	key := VirtualRouterId
-
-	// Ensure that this key has not already been used in the
-	// list. Keyed YANG lists do not allow duplicate keys to
-	// be created.
-	if _, ok := t.VrrpGroup[key]; ok {
-		return nil, fmt.Errorf("duplicate key %v for list VrrpGroup", key)
-	}
-
-	t.VrrpGroup[key] = &Interface_Subinterface_Ipv6_Address_VrrpGroup{
-		VirtualRouterId: &VirtualRouterId,
-	}
-
-	return t.VrrpGroup[key], nil
-}
-
-// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv6_Address struct, which is a YANG list entry.
-func (t *Interface_Subinterface_Ipv6_Address) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Ip == nil {
-		return nil, fmt.Errorf("nil value for key Ip")
-	}
-
-	return map[string]interface{}{
-		"ip": *t.Ip,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Address) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Address"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Address) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv6_Address) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv6_Address.
-func (*Interface_Subinterface_Ipv6_Address) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv6_Address_VrrpGroup represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/addresses/address/vrrp/vrrp-group YANG schema element.
-type Interface_Subinterface_Ipv6_Address_VrrpGroup struct {
-	AcceptMode            *bool                                                            `path:"config/accept-mode" module:"openconfig-if-ip/openconfig-if-ip"`
-	AdvertisementInterval *uint16                                                          `path:"config/advertisement-interval" module:"openconfig-if-ip/openconfig-if-ip"`
-	CurrentPriority       *uint8                                                           `path:"state/current-priority" module:"openconfig-if-ip/openconfig-if-ip"`
-	InterfaceTracking     *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking `path:"interface-tracking" module:"openconfig-if-ip"`
-	Preempt               *bool                                                            `path:"config/preempt" module:"openconfig-if-ip/openconfig-if-ip"`
-	PreemptDelay          *uint16                                                          `path:"config/preempt-delay" module:"openconfig-if-ip/openconfig-if-ip"`
-	Priority              *uint8                                                           `path:"config/priority" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualAddress        []string                                                         `path:"config/virtual-address" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualLinkLocal      *string                                                          `path:"config/virtual-link-local" module:"openconfig-if-ip/openconfig-if-ip"`
-	VirtualRouterId       *uint8                                                           `path:"config/virtual-router-id|virtual-router-id" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Address_VrrpGroup implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv6_Address_VrrpGroup) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv6_Address_VrrpGroup struct, which is a YANG list entry.
-func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.VirtualRouterId == nil {
-		return nil, fmt.Errorf("nil value for key VirtualRouterId")
-	}
-
-	return map[string]interface{}{
-		"virtual-router-id": *t.VirtualRouterId,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Address_VrrpGroup"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv6_Address_VrrpGroup.
-func (*Interface_Subinterface_Ipv6_Address_VrrpGroup) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/addresses/address/vrrp/vrrp-group/interface-tracking YANG schema element.
-type Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking struct {
-	PriorityDecrement *uint8  `path:"config/priority-decrement" module:"openconfig-if-ip/openconfig-if-ip"`
-	TrackInterface    *string `path:"config/track-interface" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking.
-func (*Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv6_Neighbor represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/neighbors/neighbor YANG schema element.
-type Interface_Subinterface_Ipv6_Neighbor struct {
-	Ip               *string                         `path:"config/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
-	IsRouter         YANGEmpty                       `path:"state/is-router" module:"openconfig-if-ip/openconfig-if-ip"`
-	LinkLayerAddress *string                         `path:"config/link-layer-address" module:"openconfig-if-ip/openconfig-if-ip"`
-	NeighborState    E_Neighbor_NeighborState        `path:"state/neighbor-state" module:"openconfig-if-ip/openconfig-if-ip"`
-	Origin           E_OpenconfigIfIp_NeighborOrigin `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Neighbor implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv6_Neighbor) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv6_Neighbor struct, which is a YANG list entry.
-func (t *Interface_Subinterface_Ipv6_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.Ip == nil {
-		return nil, fmt.Errorf("nil value for key Ip")
-	}
-
-	return map[string]interface{}{
-		"ip": *t.Ip,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Neighbor) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Neighbor"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Neighbor) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv6_Neighbor) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv6_Neighbor.
-func (*Interface_Subinterface_Ipv6_Neighbor) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv6_Unnumbered represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/unnumbered YANG schema element.
-type Interface_Subinterface_Ipv6_Unnumbered struct {
-	Enabled      *bool                                                `path:"config/enabled" module:"openconfig-if-ip/openconfig-if-ip"`
-	InterfaceRef *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef `path:"interface-ref" module:"openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Unnumbered implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv6_Unnumbered) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Unnumbered) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Unnumbered"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Unnumbered) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv6_Unnumbered) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv6_Unnumbered.
-func (*Interface_Subinterface_Ipv6_Unnumbered) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/unnumbered/interface-ref YANG schema element.
-type Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef struct {
-	Interface    *string `path:"config/interface" module:"openconfig-if-ip/openconfig-if-ip"`
-	Subinterface *uint32 `path:"config/subinterface" module:"openconfig-if-ip/openconfig-if-ip"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) ΛEnumTypeMap() map[string][]reflect.Type {
-	return ΛEnumTypes
-}
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef.
-func (*Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) ΛBelongingModule() string {
-	return "openconfig-if-ip"
-}
-
-// Interface_Subinterface_Vlan represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan YANG schema element.
-type Interface_Subinterface_Vlan struct {
-	VlanId Interface_Subinterface_Vlan_VlanId_Union `path:"config/vlan-id" module:"openconfig-vlan/openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Interface_Subinterface_Vlan implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Interface_Subinterface_Vlan) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Vlan) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Interface_Subinterface_Vlan"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Interface_Subinterface_Vlan) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Interface_Subinterface_Vlan) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Interface_Subinterface_Vlan.
-func (*Interface_Subinterface_Vlan) ΛBelongingModule() string {
-	return "openconfig-vlan"
-}
-
-// Interface_Subinterface_Vlan_VlanId_Union is an interface that is implemented by valid types for the union
-// for the leaf /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/config/vlan-id within the YANG schema.
-type Interface_Subinterface_Vlan_VlanId_Union interface {
-	Is_Interface_Subinterface_Vlan_VlanId_Union()
-}
-
-// Interface_Subinterface_Vlan_VlanId_Union_String is used when /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/config/vlan-id
-// is to be set to a string value.
-type Interface_Subinterface_Vlan_VlanId_Union_String struct {
-	String string
-}
-
-// Is_Interface_Subinterface_Vlan_VlanId_Union ensures that Interface_Subinterface_Vlan_VlanId_Union_String
-// implements the Interface_Subinterface_Vlan_VlanId_Union interface.
-func (*Interface_Subinterface_Vlan_VlanId_Union_String) Is_Interface_Subinterface_Vlan_VlanId_Union() {
-}
-
-// Interface_Subinterface_Vlan_VlanId_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/config/vlan-id
-// is to be set to a uint16 value.
-type Interface_Subinterface_Vlan_VlanId_Union_Uint16 struct {
-	Uint16 uint16
-}
-
-// Is_Interface_Subinterface_Vlan_VlanId_Union ensures that Interface_Subinterface_Vlan_VlanId_Union_Uint16
-// implements the Interface_Subinterface_Vlan_VlanId_Union interface.
-func (*Interface_Subinterface_Vlan_VlanId_Union_Uint16) Is_Interface_Subinterface_Vlan_VlanId_Union() {
-}
-
-// To_Interface_Subinterface_Vlan_VlanId_Union takes an input interface{} and attempts to convert it to a struct
-// which implements the Interface_Subinterface_Vlan_VlanId_Union union. It returns an error if the interface{} supplied
-// cannot be converted to a type within the union.
-func (t *Interface_Subinterface_Vlan) To_Interface_Subinterface_Vlan_VlanId_Union(i interface{}) (Interface_Subinterface_Vlan_VlanId_Union, error) {
-	switch v := i.(type) {
-	case string:
-		return &Interface_Subinterface_Vlan_VlanId_Union_String{v}, nil
-	case uint16:
-		return &Interface_Subinterface_Vlan_VlanId_Union_Uint16{v}, nil
-	default:
-		return nil, fmt.Errorf("cannot convert %v to Interface_Subinterface_Vlan_VlanId_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
-	}
-}
-
-// Vlan represents the /openconfig-vlan/vlans/vlan YANG schema element.
-type Vlan struct {
-	Member []*Vlan_Member                   `path:"members/member" module:"openconfig-vlan/openconfig-vlan"`
-	Name   *string                          `path:"config/name" module:"openconfig-vlan/openconfig-vlan"`
-	Status E_Vlan_Status                    `path:"config/status" module:"openconfig-vlan/openconfig-vlan"`
-	Tpid   E_OpenconfigVlanTypes_TPID_TYPES `path:"config/tpid" module:"openconfig-vlan/openconfig-vlan"`
-	VlanId *uint16                          `path:"config/vlan-id|vlan-id" module:"openconfig-vlan/openconfig-vlan|openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Vlan implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Vlan) IsYANGGoStruct() {}
-
-// ΛListKeyMap returns the keys of the Vlan struct, which is a YANG list entry.
-func (t *Vlan) ΛListKeyMap() (map[string]interface{}, error) {
-	if t.VlanId == nil {
-		return nil, fmt.Errorf("nil value for key VlanId")
-	}
-
-	return map[string]interface{}{
-		"vlan-id": *t.VlanId,
-	}, nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Vlan) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Vlan"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Vlan) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Vlan) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Vlan.
-func (*Vlan) ΛBelongingModule() string {
-	return "openconfig-vlan"
-}
-
-// Vlan_Member represents the /openconfig-vlan/vlans/vlan/members/member YANG schema element.
-type Vlan_Member struct {
-	InterfaceRef *Vlan_Member_InterfaceRef `path:"interface-ref" module:"openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Vlan_Member implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Vlan_Member) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Vlan_Member) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Vlan_Member"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Vlan_Member) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Vlan_Member) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Vlan_Member.
-func (*Vlan_Member) ΛBelongingModule() string {
-	return "openconfig-vlan"
-}
-
-// Vlan_Member_InterfaceRef represents the /openconfig-vlan/vlans/vlan/members/member/interface-ref YANG schema element.
-type Vlan_Member_InterfaceRef struct {
-	Interface    *string `path:"state/interface" module:"openconfig-vlan/openconfig-vlan"`
-	Subinterface *uint32 `path:"state/subinterface" module:"openconfig-vlan/openconfig-vlan"`
-}
-
-// IsYANGGoStruct ensures that Vlan_Member_InterfaceRef implements the yang.GoStruct
-// interface. This allows functions that need to handle this struct to
-// identify it as being generated by ygen.
-func (*Vlan_Member_InterfaceRef) IsYANGGoStruct() {}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Vlan_Member_InterfaceRef) ΛValidate(opts ...ygot.ValidationOption) error {
-	if err := ytypes.Validate(SchemaTree["Vlan_Member_InterfaceRef"], t, opts...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Validate validates s against the YANG schema corresponding to its type.
-func (t *Vlan_Member_InterfaceRef) Validate(opts ...ygot.ValidationOption) error {
-	return t.ΛValidate(opts...)
-}
-
-// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
-// that are included in the generated code.
-func (t *Vlan_Member_InterfaceRef) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
-
-// ΛBelongingModule returns the name of the module that defines the namespace
-// of Vlan_Member_InterfaceRef.
-func (*Vlan_Member_InterfaceRef) ΛBelongingModule() string {
-	return "openconfig-vlan"
-}
-
-// E_Address_Status is a derived int64 type which is used to represent
-// the enumerated node Address_Status. An additional value named
-// Address_Status_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_Address_Status int64
-
-// IsYANGGoEnum ensures that Address_Status implements the yang.GoEnum
-// interface. This ensures that Address_Status can be identified as a
-// mapped type for a YANG enumeration.
-func (E_Address_Status) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  Address_Status.
-func (E_Address_Status) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
-
-// String returns a logging-friendly string for E_Address_Status.
-func (e E_Address_Status) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_Address_Status")
-}
-
-const (
-	// Address_Status_UNSET corresponds to the value UNSET of Address_Status
-	Address_Status_UNSET E_Address_Status = 0
-	// Address_Status_PREFERRED corresponds to the value PREFERRED of Address_Status
-	Address_Status_PREFERRED E_Address_Status = 1
-	// Address_Status_DEPRECATED corresponds to the value DEPRECATED of Address_Status
-	Address_Status_DEPRECATED E_Address_Status = 2
-	// Address_Status_INVALID corresponds to the value INVALID of Address_Status
-	Address_Status_INVALID E_Address_Status = 3
-	// Address_Status_INACCESSIBLE corresponds to the value INACCESSIBLE of Address_Status
-	Address_Status_INACCESSIBLE E_Address_Status = 4
-	// Address_Status_UNKNOWN corresponds to the value UNKNOWN of Address_Status
-	Address_Status_UNKNOWN E_Address_Status = 5
-	// Address_Status_TENTATIVE corresponds to the value TENTATIVE of Address_Status
-	Address_Status_TENTATIVE E_Address_Status = 6
-	// Address_Status_DUPLICATE corresponds to the value DUPLICATE of Address_Status
-	Address_Status_DUPLICATE E_Address_Status = 7
-	// Address_Status_OPTIMISTIC corresponds to the value OPTIMISTIC of Address_Status
-	Address_Status_OPTIMISTIC E_Address_Status = 8
-)
-
-// E_Ethernet_DuplexMode is a derived int64 type which is used to represent
-// the enumerated node Ethernet_DuplexMode. An additional value named
-// Ethernet_DuplexMode_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_Ethernet_DuplexMode int64
-
-// IsYANGGoEnum ensures that Ethernet_DuplexMode implements the yang.GoEnum
-// interface. This ensures that Ethernet_DuplexMode can be identified as a
-// mapped type for a YANG enumeration.
-func (E_Ethernet_DuplexMode) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  Ethernet_DuplexMode.
-func (E_Ethernet_DuplexMode) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
-
-// String returns a logging-friendly string for E_Ethernet_DuplexMode.
-func (e E_Ethernet_DuplexMode) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_Ethernet_DuplexMode")
-}
-
-const (
-	// Ethernet_DuplexMode_UNSET corresponds to the value UNSET of Ethernet_DuplexMode
-	Ethernet_DuplexMode_UNSET E_Ethernet_DuplexMode = 0
-	// Ethernet_DuplexMode_FULL corresponds to the value FULL of Ethernet_DuplexMode
-	Ethernet_DuplexMode_FULL E_Ethernet_DuplexMode = 1
-	// Ethernet_DuplexMode_HALF corresponds to the value HALF of Ethernet_DuplexMode
-	Ethernet_DuplexMode_HALF E_Ethernet_DuplexMode = 2
-)
-
-// E_Ethernet_NegotiatedDuplexMode is a derived int64 type which is used to represent
-// the enumerated node Ethernet_NegotiatedDuplexMode. An additional value named
-// Ethernet_NegotiatedDuplexMode_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_Ethernet_NegotiatedDuplexMode int64
-
-// IsYANGGoEnum ensures that Ethernet_NegotiatedDuplexMode implements the yang.GoEnum
-// interface. This ensures that Ethernet_NegotiatedDuplexMode can be identified as a
-// mapped type for a YANG enumeration.
-func (E_Ethernet_NegotiatedDuplexMode) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  Ethernet_NegotiatedDuplexMode.
-func (E_Ethernet_NegotiatedDuplexMode) ΛMap() map[string]map[int64]ygot.EnumDefinition {
-	return ΛEnum
-}
-
-// String returns a logging-friendly string for E_Ethernet_NegotiatedDuplexMode.
-func (e E_Ethernet_NegotiatedDuplexMode) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_Ethernet_NegotiatedDuplexMode")
-}
-
-const (
-	// Ethernet_NegotiatedDuplexMode_UNSET corresponds to the value UNSET of Ethernet_NegotiatedDuplexMode
-	Ethernet_NegotiatedDuplexMode_UNSET E_Ethernet_NegotiatedDuplexMode = 0
-	// Ethernet_NegotiatedDuplexMode_FULL corresponds to the value FULL of Ethernet_NegotiatedDuplexMode
-	Ethernet_NegotiatedDuplexMode_FULL E_Ethernet_NegotiatedDuplexMode = 1
-	// Ethernet_NegotiatedDuplexMode_HALF corresponds to the value HALF of Ethernet_NegotiatedDuplexMode
-	Ethernet_NegotiatedDuplexMode_HALF E_Ethernet_NegotiatedDuplexMode = 2
-)
-
-// E_IETFInterfaces_InterfaceType is a derived int64 type which is used to represent
-// the enumerated node IETFInterfaces_InterfaceType. An additional value named
-// IETFInterfaces_InterfaceType_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_IETFInterfaces_InterfaceType int64
-
-// IsYANGGoEnum ensures that IETFInterfaces_InterfaceType implements the yang.GoEnum
-// interface. This ensures that IETFInterfaces_InterfaceType can be identified as a
-// mapped type for a YANG enumeration.
-func (E_IETFInterfaces_InterfaceType) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  IETFInterfaces_InterfaceType.
-func (E_IETFInterfaces_InterfaceType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
-
-// String returns a logging-friendly string for E_IETFInterfaces_InterfaceType.
-func (e E_IETFInterfaces_InterfaceType) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_IETFInterfaces_InterfaceType")
-}
-
-const (
-	// IETFInterfaces_InterfaceType_UNSET corresponds to the value UNSET of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_UNSET E_IETFInterfaces_InterfaceType = 0
-	// IETFInterfaces_InterfaceType_a12MppSwitch corresponds to the value a12MppSwitch of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_a12MppSwitch E_IETFInterfaces_InterfaceType = 1
-	// IETFInterfaces_InterfaceType_aal2 corresponds to the value aal2 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aal2 E_IETFInterfaces_InterfaceType = 2
-	// IETFInterfaces_InterfaceType_aal5 corresponds to the value aal5 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aal5 E_IETFInterfaces_InterfaceType = 3
-	// IETFInterfaces_InterfaceType_actelisMetaLOOP corresponds to the value actelisMetaLOOP of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_actelisMetaLOOP E_IETFInterfaces_InterfaceType = 4
-	// IETFInterfaces_InterfaceType_adsl corresponds to the value adsl of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_adsl E_IETFInterfaces_InterfaceType = 5
-	// IETFInterfaces_InterfaceType_adsl2 corresponds to the value adsl2 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_adsl2 E_IETFInterfaces_InterfaceType = 6
-	// IETFInterfaces_InterfaceType_adsl2plus corresponds to the value adsl2plus of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_adsl2plus E_IETFInterfaces_InterfaceType = 7
-	// IETFInterfaces_InterfaceType_aflane8023 corresponds to the value aflane8023 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aflane8023 E_IETFInterfaces_InterfaceType = 8
-	// IETFInterfaces_InterfaceType_aflane8025 corresponds to the value aflane8025 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aflane8025 E_IETFInterfaces_InterfaceType = 9
-	// IETFInterfaces_InterfaceType_aluELP corresponds to the value aluELP of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aluELP E_IETFInterfaces_InterfaceType = 10
-	// IETFInterfaces_InterfaceType_aluEpon corresponds to the value aluEpon of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aluEpon E_IETFInterfaces_InterfaceType = 11
-	// IETFInterfaces_InterfaceType_aluEponLogicalLink corresponds to the value aluEponLogicalLink of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aluEponLogicalLink E_IETFInterfaces_InterfaceType = 12
-	// IETFInterfaces_InterfaceType_aluEponOnu corresponds to the value aluEponOnu of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aluEponOnu E_IETFInterfaces_InterfaceType = 13
-	// IETFInterfaces_InterfaceType_aluEponPhysicalUni corresponds to the value aluEponPhysicalUni of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aluEponPhysicalUni E_IETFInterfaces_InterfaceType = 14
-	// IETFInterfaces_InterfaceType_aluGponOnu corresponds to the value aluGponOnu of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aluGponOnu E_IETFInterfaces_InterfaceType = 15
-	// IETFInterfaces_InterfaceType_aluGponPhysicalUni corresponds to the value aluGponPhysicalUni of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aluGponPhysicalUni E_IETFInterfaces_InterfaceType = 16
-	// IETFInterfaces_InterfaceType_arap corresponds to the value arap of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_arap E_IETFInterfaces_InterfaceType = 17
-	// IETFInterfaces_InterfaceType_arcnet corresponds to the value arcnet of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_arcnet E_IETFInterfaces_InterfaceType = 18
-	// IETFInterfaces_InterfaceType_arcnetPlus corresponds to the value arcnetPlus of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_arcnetPlus E_IETFInterfaces_InterfaceType = 19
-	// IETFInterfaces_InterfaceType_async corresponds to the value async of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_async E_IETFInterfaces_InterfaceType = 20
-	// IETFInterfaces_InterfaceType_atm corresponds to the value atm of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atm E_IETFInterfaces_InterfaceType = 21
-	// IETFInterfaces_InterfaceType_atmDxi corresponds to the value atmDxi of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmDxi E_IETFInterfaces_InterfaceType = 22
-	// IETFInterfaces_InterfaceType_atmFuni corresponds to the value atmFuni of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmFuni E_IETFInterfaces_InterfaceType = 23
-	// IETFInterfaces_InterfaceType_atmIma corresponds to the value atmIma of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmIma E_IETFInterfaces_InterfaceType = 24
-	// IETFInterfaces_InterfaceType_atmLogical corresponds to the value atmLogical of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmLogical E_IETFInterfaces_InterfaceType = 25
-	// IETFInterfaces_InterfaceType_atmRadio corresponds to the value atmRadio of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmRadio E_IETFInterfaces_InterfaceType = 26
-	// IETFInterfaces_InterfaceType_atmSubInterface corresponds to the value atmSubInterface of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmSubInterface E_IETFInterfaces_InterfaceType = 27
-	// IETFInterfaces_InterfaceType_atmVciEndPt corresponds to the value atmVciEndPt of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmVciEndPt E_IETFInterfaces_InterfaceType = 28
-	// IETFInterfaces_InterfaceType_atmVirtual corresponds to the value atmVirtual of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmVirtual E_IETFInterfaces_InterfaceType = 29
-	// IETFInterfaces_InterfaceType_atmbond corresponds to the value atmbond of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_atmbond E_IETFInterfaces_InterfaceType = 30
-	// IETFInterfaces_InterfaceType_aviciOpticalEther corresponds to the value aviciOpticalEther of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_aviciOpticalEther E_IETFInterfaces_InterfaceType = 31
-	// IETFInterfaces_InterfaceType_basicISDN corresponds to the value basicISDN of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_basicISDN E_IETFInterfaces_InterfaceType = 32
-	// IETFInterfaces_InterfaceType_bgppolicyaccounting corresponds to the value bgppolicyaccounting of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_bgppolicyaccounting E_IETFInterfaces_InterfaceType = 33
-	// IETFInterfaces_InterfaceType_bits corresponds to the value bits of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_bits E_IETFInterfaces_InterfaceType = 34
-	// IETFInterfaces_InterfaceType_bridge corresponds to the value bridge of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_bridge E_IETFInterfaces_InterfaceType = 35
-	// IETFInterfaces_InterfaceType_bsc corresponds to the value bsc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_bsc E_IETFInterfaces_InterfaceType = 36
-	// IETFInterfaces_InterfaceType_cableDownstreamRfPort corresponds to the value cableDownstreamRfPort of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_cableDownstreamRfPort E_IETFInterfaces_InterfaceType = 37
-	// IETFInterfaces_InterfaceType_capwapDot11Bss corresponds to the value capwapDot11Bss of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_capwapDot11Bss E_IETFInterfaces_InterfaceType = 38
-	// IETFInterfaces_InterfaceType_capwapDot11Profile corresponds to the value capwapDot11Profile of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_capwapDot11Profile E_IETFInterfaces_InterfaceType = 39
-	// IETFInterfaces_InterfaceType_capwapWtpVirtualRadio corresponds to the value capwapWtpVirtualRadio of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_capwapWtpVirtualRadio E_IETFInterfaces_InterfaceType = 40
-	// IETFInterfaces_InterfaceType_cblVectaStar corresponds to the value cblVectaStar of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_cblVectaStar E_IETFInterfaces_InterfaceType = 41
-	// IETFInterfaces_InterfaceType_cctEmul corresponds to the value cctEmul of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_cctEmul E_IETFInterfaces_InterfaceType = 42
-	// IETFInterfaces_InterfaceType_ces corresponds to the value ces of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ces E_IETFInterfaces_InterfaceType = 43
-	// IETFInterfaces_InterfaceType_channel corresponds to the value channel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_channel E_IETFInterfaces_InterfaceType = 44
-	// IETFInterfaces_InterfaceType_ciscoISLvlan corresponds to the value ciscoISLvlan of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ciscoISLvlan E_IETFInterfaces_InterfaceType = 45
-	// IETFInterfaces_InterfaceType_cnr corresponds to the value cnr of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_cnr E_IETFInterfaces_InterfaceType = 46
-	// IETFInterfaces_InterfaceType_coffee corresponds to the value coffee of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_coffee E_IETFInterfaces_InterfaceType = 47
-	// IETFInterfaces_InterfaceType_compositeLink corresponds to the value compositeLink of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_compositeLink E_IETFInterfaces_InterfaceType = 48
-	// IETFInterfaces_InterfaceType_dcn corresponds to the value dcn of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dcn E_IETFInterfaces_InterfaceType = 49
-	// IETFInterfaces_InterfaceType_ddnX25 corresponds to the value ddnX25 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ddnX25 E_IETFInterfaces_InterfaceType = 50
-	// IETFInterfaces_InterfaceType_digitalPowerline corresponds to the value digitalPowerline of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_digitalPowerline E_IETFInterfaces_InterfaceType = 51
-	// IETFInterfaces_InterfaceType_digitalWrapperOverheadChannel corresponds to the value digitalWrapperOverheadChannel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_digitalWrapperOverheadChannel E_IETFInterfaces_InterfaceType = 52
-	// IETFInterfaces_InterfaceType_dlsw corresponds to the value dlsw of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dlsw E_IETFInterfaces_InterfaceType = 53
-	// IETFInterfaces_InterfaceType_docsCableDownstream corresponds to the value docsCableDownstream of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_docsCableDownstream E_IETFInterfaces_InterfaceType = 54
-	// IETFInterfaces_InterfaceType_docsCableMCmtsDownstream corresponds to the value docsCableMCmtsDownstream of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_docsCableMCmtsDownstream E_IETFInterfaces_InterfaceType = 55
-	// IETFInterfaces_InterfaceType_docsCableMaclayer corresponds to the value docsCableMaclayer of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_docsCableMaclayer E_IETFInterfaces_InterfaceType = 56
-	// IETFInterfaces_InterfaceType_docsCableUpstream corresponds to the value docsCableUpstream of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_docsCableUpstream E_IETFInterfaces_InterfaceType = 57
-	// IETFInterfaces_InterfaceType_docsCableUpstreamChannel corresponds to the value docsCableUpstreamChannel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_docsCableUpstreamChannel E_IETFInterfaces_InterfaceType = 58
-	// IETFInterfaces_InterfaceType_docsCableUpstreamRfPort corresponds to the value docsCableUpstreamRfPort of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_docsCableUpstreamRfPort E_IETFInterfaces_InterfaceType = 59
-	// IETFInterfaces_InterfaceType_ds0 corresponds to the value ds0 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ds0 E_IETFInterfaces_InterfaceType = 60
-	// IETFInterfaces_InterfaceType_ds0Bundle corresponds to the value ds0Bundle of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ds0Bundle E_IETFInterfaces_InterfaceType = 61
-	// IETFInterfaces_InterfaceType_ds1 corresponds to the value ds1 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ds1 E_IETFInterfaces_InterfaceType = 62
-	// IETFInterfaces_InterfaceType_ds1FDL corresponds to the value ds1FDL of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ds1FDL E_IETFInterfaces_InterfaceType = 63
-	// IETFInterfaces_InterfaceType_ds3 corresponds to the value ds3 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ds3 E_IETFInterfaces_InterfaceType = 64
-	// IETFInterfaces_InterfaceType_dtm corresponds to the value dtm of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dtm E_IETFInterfaces_InterfaceType = 65
-	// IETFInterfaces_InterfaceType_dvbAsiIn corresponds to the value dvbAsiIn of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dvbAsiIn E_IETFInterfaces_InterfaceType = 66
-	// IETFInterfaces_InterfaceType_dvbAsiOut corresponds to the value dvbAsiOut of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dvbAsiOut E_IETFInterfaces_InterfaceType = 67
-	// IETFInterfaces_InterfaceType_dvbRccDownstream corresponds to the value dvbRccDownstream of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dvbRccDownstream E_IETFInterfaces_InterfaceType = 68
-	// IETFInterfaces_InterfaceType_dvbRccMacLayer corresponds to the value dvbRccMacLayer of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dvbRccMacLayer E_IETFInterfaces_InterfaceType = 69
-	// IETFInterfaces_InterfaceType_dvbRccUpstream corresponds to the value dvbRccUpstream of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dvbRccUpstream E_IETFInterfaces_InterfaceType = 70
-	// IETFInterfaces_InterfaceType_dvbRcsMacLayer corresponds to the value dvbRcsMacLayer of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dvbRcsMacLayer E_IETFInterfaces_InterfaceType = 71
-	// IETFInterfaces_InterfaceType_dvbRcsTdma corresponds to the value dvbRcsTdma of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dvbRcsTdma E_IETFInterfaces_InterfaceType = 72
-	// IETFInterfaces_InterfaceType_dvbTdm corresponds to the value dvbTdm of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_dvbTdm E_IETFInterfaces_InterfaceType = 73
-	// IETFInterfaces_InterfaceType_e1 corresponds to the value e1 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_e1 E_IETFInterfaces_InterfaceType = 74
-	// IETFInterfaces_InterfaceType_econet corresponds to the value econet of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_econet E_IETFInterfaces_InterfaceType = 75
-	// IETFInterfaces_InterfaceType_eon corresponds to the value eon of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_eon E_IETFInterfaces_InterfaceType = 76
-	// IETFInterfaces_InterfaceType_eplrs corresponds to the value eplrs of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_eplrs E_IETFInterfaces_InterfaceType = 77
-	// IETFInterfaces_InterfaceType_escon corresponds to the value escon of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_escon E_IETFInterfaces_InterfaceType = 78
-	// IETFInterfaces_InterfaceType_ethernet3Mbit corresponds to the value ethernet3Mbit of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ethernet3Mbit E_IETFInterfaces_InterfaceType = 79
-	// IETFInterfaces_InterfaceType_ethernetCsmacd corresponds to the value ethernetCsmacd of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ethernetCsmacd E_IETFInterfaces_InterfaceType = 80
-	// IETFInterfaces_InterfaceType_fast corresponds to the value fast of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_fast E_IETFInterfaces_InterfaceType = 81
-	// IETFInterfaces_InterfaceType_fastEther corresponds to the value fastEther of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_fastEther E_IETFInterfaces_InterfaceType = 82
-	// IETFInterfaces_InterfaceType_fastEtherFX corresponds to the value fastEtherFX of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_fastEtherFX E_IETFInterfaces_InterfaceType = 83
-	// IETFInterfaces_InterfaceType_fcipLink corresponds to the value fcipLink of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_fcipLink E_IETFInterfaces_InterfaceType = 84
-	// IETFInterfaces_InterfaceType_fddi corresponds to the value fddi of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_fddi E_IETFInterfaces_InterfaceType = 85
-	// IETFInterfaces_InterfaceType_fibreChannel corresponds to the value fibreChannel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_fibreChannel E_IETFInterfaces_InterfaceType = 86
-	// IETFInterfaces_InterfaceType_frDlciEndPt corresponds to the value frDlciEndPt of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_frDlciEndPt E_IETFInterfaces_InterfaceType = 87
-	// IETFInterfaces_InterfaceType_frForward corresponds to the value frForward of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_frForward E_IETFInterfaces_InterfaceType = 88
-	// IETFInterfaces_InterfaceType_frameRelay corresponds to the value frameRelay of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_frameRelay E_IETFInterfaces_InterfaceType = 89
-	// IETFInterfaces_InterfaceType_frameRelayInterconnect corresponds to the value frameRelayInterconnect of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_frameRelayInterconnect E_IETFInterfaces_InterfaceType = 90
-	// IETFInterfaces_InterfaceType_frameRelayMPI corresponds to the value frameRelayMPI of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_frameRelayMPI E_IETFInterfaces_InterfaceType = 91
-	// IETFInterfaces_InterfaceType_frameRelayService corresponds to the value frameRelayService of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_frameRelayService E_IETFInterfaces_InterfaceType = 92
-	// IETFInterfaces_InterfaceType_frf16MfrBundle corresponds to the value frf16MfrBundle of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_frf16MfrBundle E_IETFInterfaces_InterfaceType = 93
-	// IETFInterfaces_InterfaceType_g703at2mb corresponds to the value g703at2mb of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_g703at2mb E_IETFInterfaces_InterfaceType = 94
-	// IETFInterfaces_InterfaceType_g703at64k corresponds to the value g703at64k of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_g703at64k E_IETFInterfaces_InterfaceType = 95
-	// IETFInterfaces_InterfaceType_g9981 corresponds to the value g9981 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_g9981 E_IETFInterfaces_InterfaceType = 96
-	// IETFInterfaces_InterfaceType_g9982 corresponds to the value g9982 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_g9982 E_IETFInterfaces_InterfaceType = 97
-	// IETFInterfaces_InterfaceType_g9983 corresponds to the value g9983 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_g9983 E_IETFInterfaces_InterfaceType = 98
-	// IETFInterfaces_InterfaceType_gfp corresponds to the value gfp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_gfp E_IETFInterfaces_InterfaceType = 99
-	// IETFInterfaces_InterfaceType_gigabitEthernet corresponds to the value gigabitEthernet of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_gigabitEthernet E_IETFInterfaces_InterfaceType = 100
-	// IETFInterfaces_InterfaceType_gpon corresponds to the value gpon of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_gpon E_IETFInterfaces_InterfaceType = 101
-	// IETFInterfaces_InterfaceType_gr303IDT corresponds to the value gr303IDT of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_gr303IDT E_IETFInterfaces_InterfaceType = 102
-	// IETFInterfaces_InterfaceType_gr303RDT corresponds to the value gr303RDT of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_gr303RDT E_IETFInterfaces_InterfaceType = 103
-	// IETFInterfaces_InterfaceType_gtp corresponds to the value gtp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_gtp E_IETFInterfaces_InterfaceType = 104
-	// IETFInterfaces_InterfaceType_h323Gatekeeper corresponds to the value h323Gatekeeper of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_h323Gatekeeper E_IETFInterfaces_InterfaceType = 105
-	// IETFInterfaces_InterfaceType_h323Proxy corresponds to the value h323Proxy of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_h323Proxy E_IETFInterfaces_InterfaceType = 106
-	// IETFInterfaces_InterfaceType_hdh1822 corresponds to the value hdh1822 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hdh1822 E_IETFInterfaces_InterfaceType = 107
-	// IETFInterfaces_InterfaceType_hdlc corresponds to the value hdlc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hdlc E_IETFInterfaces_InterfaceType = 108
-	// IETFInterfaces_InterfaceType_hdsl2 corresponds to the value hdsl2 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hdsl2 E_IETFInterfaces_InterfaceType = 109
-	// IETFInterfaces_InterfaceType_hiperlan2 corresponds to the value hiperlan2 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hiperlan2 E_IETFInterfaces_InterfaceType = 110
-	// IETFInterfaces_InterfaceType_hippi corresponds to the value hippi of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hippi E_IETFInterfaces_InterfaceType = 111
-	// IETFInterfaces_InterfaceType_hippiInterface corresponds to the value hippiInterface of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hippiInterface E_IETFInterfaces_InterfaceType = 112
-	// IETFInterfaces_InterfaceType_homepna corresponds to the value homepna of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_homepna E_IETFInterfaces_InterfaceType = 113
-	// IETFInterfaces_InterfaceType_hostPad corresponds to the value hostPad of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hostPad E_IETFInterfaces_InterfaceType = 114
-	// IETFInterfaces_InterfaceType_hssi corresponds to the value hssi of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hssi E_IETFInterfaces_InterfaceType = 115
-	// IETFInterfaces_InterfaceType_hyperchannel corresponds to the value hyperchannel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_hyperchannel E_IETFInterfaces_InterfaceType = 116
-	// IETFInterfaces_InterfaceType_iana_interface_type corresponds to the value iana_interface_type of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iana_interface_type E_IETFInterfaces_InterfaceType = 117
-	// IETFInterfaces_InterfaceType_ibm370parChan corresponds to the value ibm370parChan of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ibm370parChan E_IETFInterfaces_InterfaceType = 118
-	// IETFInterfaces_InterfaceType_idsl corresponds to the value idsl of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_idsl E_IETFInterfaces_InterfaceType = 119
-	// IETFInterfaces_InterfaceType_ieee1394 corresponds to the value ieee1394 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ieee1394 E_IETFInterfaces_InterfaceType = 120
-	// IETFInterfaces_InterfaceType_ieee80211 corresponds to the value ieee80211 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ieee80211 E_IETFInterfaces_InterfaceType = 121
-	// IETFInterfaces_InterfaceType_ieee80212 corresponds to the value ieee80212 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ieee80212 E_IETFInterfaces_InterfaceType = 122
-	// IETFInterfaces_InterfaceType_ieee802154 corresponds to the value ieee802154 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ieee802154 E_IETFInterfaces_InterfaceType = 123
-	// IETFInterfaces_InterfaceType_ieee80216WMAN corresponds to the value ieee80216WMAN of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ieee80216WMAN E_IETFInterfaces_InterfaceType = 124
-	// IETFInterfaces_InterfaceType_ieee8023adLag corresponds to the value ieee8023adLag of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ieee8023adLag E_IETFInterfaces_InterfaceType = 125
-	// IETFInterfaces_InterfaceType_if_gsn corresponds to the value if_gsn of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_if_gsn E_IETFInterfaces_InterfaceType = 126
-	// IETFInterfaces_InterfaceType_ifPwType corresponds to the value ifPwType of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ifPwType E_IETFInterfaces_InterfaceType = 127
-	// IETFInterfaces_InterfaceType_ifVfiType corresponds to the value ifVfiType of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ifVfiType E_IETFInterfaces_InterfaceType = 128
-	// IETFInterfaces_InterfaceType_ilan corresponds to the value ilan of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ilan E_IETFInterfaces_InterfaceType = 129
-	// IETFInterfaces_InterfaceType_imt corresponds to the value imt of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_imt E_IETFInterfaces_InterfaceType = 130
-	// IETFInterfaces_InterfaceType_infiniband corresponds to the value infiniband of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_infiniband E_IETFInterfaces_InterfaceType = 131
-	// IETFInterfaces_InterfaceType_interleave corresponds to the value interleave of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_interleave E_IETFInterfaces_InterfaceType = 132
-	// IETFInterfaces_InterfaceType_ip corresponds to the value ip of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ip E_IETFInterfaces_InterfaceType = 133
-	// IETFInterfaces_InterfaceType_ipForward corresponds to the value ipForward of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ipForward E_IETFInterfaces_InterfaceType = 134
-	// IETFInterfaces_InterfaceType_ipOverAtm corresponds to the value ipOverAtm of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ipOverAtm E_IETFInterfaces_InterfaceType = 135
-	// IETFInterfaces_InterfaceType_ipOverCdlc corresponds to the value ipOverCdlc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ipOverCdlc E_IETFInterfaces_InterfaceType = 136
-	// IETFInterfaces_InterfaceType_ipOverClaw corresponds to the value ipOverClaw of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ipOverClaw E_IETFInterfaces_InterfaceType = 137
-	// IETFInterfaces_InterfaceType_ipSwitch corresponds to the value ipSwitch of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ipSwitch E_IETFInterfaces_InterfaceType = 138
-	// IETFInterfaces_InterfaceType_isdn corresponds to the value isdn of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_isdn E_IETFInterfaces_InterfaceType = 139
-	// IETFInterfaces_InterfaceType_isdns corresponds to the value isdns of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_isdns E_IETFInterfaces_InterfaceType = 140
-	// IETFInterfaces_InterfaceType_isdnu corresponds to the value isdnu of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_isdnu E_IETFInterfaces_InterfaceType = 141
-	// IETFInterfaces_InterfaceType_iso88022llc corresponds to the value iso88022llc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iso88022llc E_IETFInterfaces_InterfaceType = 142
-	// IETFInterfaces_InterfaceType_iso88023Csmacd corresponds to the value iso88023Csmacd of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iso88023Csmacd E_IETFInterfaces_InterfaceType = 143
-	// IETFInterfaces_InterfaceType_iso88024TokenBus corresponds to the value iso88024TokenBus of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iso88024TokenBus E_IETFInterfaces_InterfaceType = 144
-	// IETFInterfaces_InterfaceType_iso88025CRFPInt corresponds to the value iso88025CRFPInt of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iso88025CRFPInt E_IETFInterfaces_InterfaceType = 145
-	// IETFInterfaces_InterfaceType_iso88025Dtr corresponds to the value iso88025Dtr of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iso88025Dtr E_IETFInterfaces_InterfaceType = 146
-	// IETFInterfaces_InterfaceType_iso88025Fiber corresponds to the value iso88025Fiber of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iso88025Fiber E_IETFInterfaces_InterfaceType = 147
-	// IETFInterfaces_InterfaceType_iso88025TokenRing corresponds to the value iso88025TokenRing of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iso88025TokenRing E_IETFInterfaces_InterfaceType = 148
-	// IETFInterfaces_InterfaceType_iso88026Man corresponds to the value iso88026Man of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_iso88026Man E_IETFInterfaces_InterfaceType = 149
-	// IETFInterfaces_InterfaceType_isup corresponds to the value isup of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_isup E_IETFInterfaces_InterfaceType = 150
-	// IETFInterfaces_InterfaceType_l2vlan corresponds to the value l2vlan of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_l2vlan E_IETFInterfaces_InterfaceType = 151
-	// IETFInterfaces_InterfaceType_l3ipvlan corresponds to the value l3ipvlan of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_l3ipvlan E_IETFInterfaces_InterfaceType = 152
-	// IETFInterfaces_InterfaceType_l3ipxvlan corresponds to the value l3ipxvlan of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_l3ipxvlan E_IETFInterfaces_InterfaceType = 153
-	// IETFInterfaces_InterfaceType_lapb corresponds to the value lapb of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_lapb E_IETFInterfaces_InterfaceType = 154
-	// IETFInterfaces_InterfaceType_lapd corresponds to the value lapd of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_lapd E_IETFInterfaces_InterfaceType = 155
-	// IETFInterfaces_InterfaceType_lapf corresponds to the value lapf of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_lapf E_IETFInterfaces_InterfaceType = 156
-	// IETFInterfaces_InterfaceType_linegroup corresponds to the value linegroup of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_linegroup E_IETFInterfaces_InterfaceType = 157
-	// IETFInterfaces_InterfaceType_lmp corresponds to the value lmp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_lmp E_IETFInterfaces_InterfaceType = 158
-	// IETFInterfaces_InterfaceType_localTalk corresponds to the value localTalk of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_localTalk E_IETFInterfaces_InterfaceType = 159
-	// IETFInterfaces_InterfaceType_macSecControlledIF corresponds to the value macSecControlledIF of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_macSecControlledIF E_IETFInterfaces_InterfaceType = 160
-	// IETFInterfaces_InterfaceType_macSecUncontrolledIF corresponds to the value macSecUncontrolledIF of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_macSecUncontrolledIF E_IETFInterfaces_InterfaceType = 161
-	// IETFInterfaces_InterfaceType_mediaMailOverIp corresponds to the value mediaMailOverIp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_mediaMailOverIp E_IETFInterfaces_InterfaceType = 162
-	// IETFInterfaces_InterfaceType_mfSigLink corresponds to the value mfSigLink of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_mfSigLink E_IETFInterfaces_InterfaceType = 163
-	// IETFInterfaces_InterfaceType_miox25 corresponds to the value miox25 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_miox25 E_IETFInterfaces_InterfaceType = 164
-	// IETFInterfaces_InterfaceType_mocaVersion1 corresponds to the value mocaVersion1 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_mocaVersion1 E_IETFInterfaces_InterfaceType = 165
-	// IETFInterfaces_InterfaceType_modem corresponds to the value modem of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_modem E_IETFInterfaces_InterfaceType = 166
-	// IETFInterfaces_InterfaceType_mpc corresponds to the value mpc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_mpc E_IETFInterfaces_InterfaceType = 167
-	// IETFInterfaces_InterfaceType_mpegTransport corresponds to the value mpegTransport of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_mpegTransport E_IETFInterfaces_InterfaceType = 168
-	// IETFInterfaces_InterfaceType_mpls corresponds to the value mpls of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_mpls E_IETFInterfaces_InterfaceType = 169
-	// IETFInterfaces_InterfaceType_mplsTunnel corresponds to the value mplsTunnel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_mplsTunnel E_IETFInterfaces_InterfaceType = 170
-	// IETFInterfaces_InterfaceType_msdsl corresponds to the value msdsl of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_msdsl E_IETFInterfaces_InterfaceType = 171
-	// IETFInterfaces_InterfaceType_mvl corresponds to the value mvl of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_mvl E_IETFInterfaces_InterfaceType = 172
-	// IETFInterfaces_InterfaceType_myrinet corresponds to the value myrinet of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_myrinet E_IETFInterfaces_InterfaceType = 173
-	// IETFInterfaces_InterfaceType_nfas corresponds to the value nfas of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_nfas E_IETFInterfaces_InterfaceType = 174
-	// IETFInterfaces_InterfaceType_nsip corresponds to the value nsip of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_nsip E_IETFInterfaces_InterfaceType = 175
-	// IETFInterfaces_InterfaceType_opticalChannel corresponds to the value opticalChannel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_opticalChannel E_IETFInterfaces_InterfaceType = 176
-	// IETFInterfaces_InterfaceType_opticalChannelGroup corresponds to the value opticalChannelGroup of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_opticalChannelGroup E_IETFInterfaces_InterfaceType = 177
-	// IETFInterfaces_InterfaceType_opticalTransport corresponds to the value opticalTransport of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_opticalTransport E_IETFInterfaces_InterfaceType = 178
-	// IETFInterfaces_InterfaceType_other corresponds to the value other of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_other E_IETFInterfaces_InterfaceType = 179
-	// IETFInterfaces_InterfaceType_otnOdu corresponds to the value otnOdu of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_otnOdu E_IETFInterfaces_InterfaceType = 180
-	// IETFInterfaces_InterfaceType_otnOtu corresponds to the value otnOtu of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_otnOtu E_IETFInterfaces_InterfaceType = 181
-	// IETFInterfaces_InterfaceType_para corresponds to the value para of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_para E_IETFInterfaces_InterfaceType = 182
-	// IETFInterfaces_InterfaceType_pdnEtherLoop1 corresponds to the value pdnEtherLoop1 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_pdnEtherLoop1 E_IETFInterfaces_InterfaceType = 183
-	// IETFInterfaces_InterfaceType_pdnEtherLoop2 corresponds to the value pdnEtherLoop2 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_pdnEtherLoop2 E_IETFInterfaces_InterfaceType = 184
-	// IETFInterfaces_InterfaceType_pip corresponds to the value pip of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_pip E_IETFInterfaces_InterfaceType = 185
-	// IETFInterfaces_InterfaceType_plc corresponds to the value plc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_plc E_IETFInterfaces_InterfaceType = 186
-	// IETFInterfaces_InterfaceType_pon155 corresponds to the value pon155 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_pon155 E_IETFInterfaces_InterfaceType = 187
-	// IETFInterfaces_InterfaceType_pon622 corresponds to the value pon622 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_pon622 E_IETFInterfaces_InterfaceType = 188
-	// IETFInterfaces_InterfaceType_pos corresponds to the value pos of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_pos E_IETFInterfaces_InterfaceType = 189
-	// IETFInterfaces_InterfaceType_ppp corresponds to the value ppp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ppp E_IETFInterfaces_InterfaceType = 190
-	// IETFInterfaces_InterfaceType_pppMultilinkBundle corresponds to the value pppMultilinkBundle of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_pppMultilinkBundle E_IETFInterfaces_InterfaceType = 191
-	// IETFInterfaces_InterfaceType_primaryISDN corresponds to the value primaryISDN of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_primaryISDN E_IETFInterfaces_InterfaceType = 192
-	// IETFInterfaces_InterfaceType_propAtm corresponds to the value propAtm of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propAtm E_IETFInterfaces_InterfaceType = 193
-	// IETFInterfaces_InterfaceType_propBWAp2Mp corresponds to the value propBWAp2Mp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propBWAp2Mp E_IETFInterfaces_InterfaceType = 194
-	// IETFInterfaces_InterfaceType_propCnls corresponds to the value propCnls of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propCnls E_IETFInterfaces_InterfaceType = 195
-	// IETFInterfaces_InterfaceType_propDocsWirelessDownstream corresponds to the value propDocsWirelessDownstream of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propDocsWirelessDownstream E_IETFInterfaces_InterfaceType = 196
-	// IETFInterfaces_InterfaceType_propDocsWirelessMaclayer corresponds to the value propDocsWirelessMaclayer of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propDocsWirelessMaclayer E_IETFInterfaces_InterfaceType = 197
-	// IETFInterfaces_InterfaceType_propDocsWirelessUpstream corresponds to the value propDocsWirelessUpstream of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propDocsWirelessUpstream E_IETFInterfaces_InterfaceType = 198
-	// IETFInterfaces_InterfaceType_propMultiplexor corresponds to the value propMultiplexor of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propMultiplexor E_IETFInterfaces_InterfaceType = 199
-	// IETFInterfaces_InterfaceType_propPointToPointSerial corresponds to the value propPointToPointSerial of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propPointToPointSerial E_IETFInterfaces_InterfaceType = 200
-	// IETFInterfaces_InterfaceType_propVirtual corresponds to the value propVirtual of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propVirtual E_IETFInterfaces_InterfaceType = 201
-	// IETFInterfaces_InterfaceType_propWirelessP2P corresponds to the value propWirelessP2P of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_propWirelessP2P E_IETFInterfaces_InterfaceType = 202
-	// IETFInterfaces_InterfaceType_proteon10Mbit corresponds to the value proteon10Mbit of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_proteon10Mbit E_IETFInterfaces_InterfaceType = 203
-	// IETFInterfaces_InterfaceType_proteon80Mbit corresponds to the value proteon80Mbit of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_proteon80Mbit E_IETFInterfaces_InterfaceType = 204
-	// IETFInterfaces_InterfaceType_q2931 corresponds to the value q2931 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_q2931 E_IETFInterfaces_InterfaceType = 205
-	// IETFInterfaces_InterfaceType_qam corresponds to the value qam of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_qam E_IETFInterfaces_InterfaceType = 206
-	// IETFInterfaces_InterfaceType_qllc corresponds to the value qllc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_qllc E_IETFInterfaces_InterfaceType = 207
-	// IETFInterfaces_InterfaceType_radioMAC corresponds to the value radioMAC of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_radioMAC E_IETFInterfaces_InterfaceType = 208
-	// IETFInterfaces_InterfaceType_radsl corresponds to the value radsl of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_radsl E_IETFInterfaces_InterfaceType = 209
-	// IETFInterfaces_InterfaceType_reachDSL corresponds to the value reachDSL of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_reachDSL E_IETFInterfaces_InterfaceType = 210
-	// IETFInterfaces_InterfaceType_regular1822 corresponds to the value regular1822 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_regular1822 E_IETFInterfaces_InterfaceType = 211
-	// IETFInterfaces_InterfaceType_rfc1483 corresponds to the value rfc1483 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_rfc1483 E_IETFInterfaces_InterfaceType = 212
-	// IETFInterfaces_InterfaceType_rfc877x25 corresponds to the value rfc877x25 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_rfc877x25 E_IETFInterfaces_InterfaceType = 213
-	// IETFInterfaces_InterfaceType_rpr corresponds to the value rpr of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_rpr E_IETFInterfaces_InterfaceType = 214
-	// IETFInterfaces_InterfaceType_rs232 corresponds to the value rs232 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_rs232 E_IETFInterfaces_InterfaceType = 215
-	// IETFInterfaces_InterfaceType_rsrb corresponds to the value rsrb of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_rsrb E_IETFInterfaces_InterfaceType = 216
-	// IETFInterfaces_InterfaceType_sdlc corresponds to the value sdlc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sdlc E_IETFInterfaces_InterfaceType = 217
-	// IETFInterfaces_InterfaceType_sdsl corresponds to the value sdsl of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sdsl E_IETFInterfaces_InterfaceType = 218
-	// IETFInterfaces_InterfaceType_shdsl corresponds to the value shdsl of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_shdsl E_IETFInterfaces_InterfaceType = 219
-	// IETFInterfaces_InterfaceType_sip corresponds to the value sip of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sip E_IETFInterfaces_InterfaceType = 220
-	// IETFInterfaces_InterfaceType_sipSig corresponds to the value sipSig of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sipSig E_IETFInterfaces_InterfaceType = 221
-	// IETFInterfaces_InterfaceType_sipTg corresponds to the value sipTg of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sipTg E_IETFInterfaces_InterfaceType = 222
-	// IETFInterfaces_InterfaceType_sixToFour corresponds to the value sixToFour of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sixToFour E_IETFInterfaces_InterfaceType = 223
-	// IETFInterfaces_InterfaceType_slip corresponds to the value slip of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_slip E_IETFInterfaces_InterfaceType = 224
-	// IETFInterfaces_InterfaceType_smdsDxi corresponds to the value smdsDxi of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_smdsDxi E_IETFInterfaces_InterfaceType = 225
-	// IETFInterfaces_InterfaceType_smdsIcip corresponds to the value smdsIcip of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_smdsIcip E_IETFInterfaces_InterfaceType = 226
-	// IETFInterfaces_InterfaceType_softwareLoopback corresponds to the value softwareLoopback of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_softwareLoopback E_IETFInterfaces_InterfaceType = 227
-	// IETFInterfaces_InterfaceType_sonet corresponds to the value sonet of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sonet E_IETFInterfaces_InterfaceType = 228
-	// IETFInterfaces_InterfaceType_sonetOverheadChannel corresponds to the value sonetOverheadChannel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sonetOverheadChannel E_IETFInterfaces_InterfaceType = 229
-	// IETFInterfaces_InterfaceType_sonetPath corresponds to the value sonetPath of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sonetPath E_IETFInterfaces_InterfaceType = 230
-	// IETFInterfaces_InterfaceType_sonetVT corresponds to the value sonetVT of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_sonetVT E_IETFInterfaces_InterfaceType = 231
-	// IETFInterfaces_InterfaceType_srp corresponds to the value srp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_srp E_IETFInterfaces_InterfaceType = 232
-	// IETFInterfaces_InterfaceType_ss7SigLink corresponds to the value ss7SigLink of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ss7SigLink E_IETFInterfaces_InterfaceType = 233
-	// IETFInterfaces_InterfaceType_stackToStack corresponds to the value stackToStack of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_stackToStack E_IETFInterfaces_InterfaceType = 234
-	// IETFInterfaces_InterfaceType_starLan corresponds to the value starLan of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_starLan E_IETFInterfaces_InterfaceType = 235
-	// IETFInterfaces_InterfaceType_tdlc corresponds to the value tdlc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_tdlc E_IETFInterfaces_InterfaceType = 236
-	// IETFInterfaces_InterfaceType_teLink corresponds to the value teLink of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_teLink E_IETFInterfaces_InterfaceType = 237
-	// IETFInterfaces_InterfaceType_termPad corresponds to the value termPad of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_termPad E_IETFInterfaces_InterfaceType = 238
-	// IETFInterfaces_InterfaceType_tr008 corresponds to the value tr008 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_tr008 E_IETFInterfaces_InterfaceType = 239
-	// IETFInterfaces_InterfaceType_transpHdlc corresponds to the value transpHdlc of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_transpHdlc E_IETFInterfaces_InterfaceType = 240
-	// IETFInterfaces_InterfaceType_tunnel corresponds to the value tunnel of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_tunnel E_IETFInterfaces_InterfaceType = 241
-	// IETFInterfaces_InterfaceType_ultra corresponds to the value ultra of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_ultra E_IETFInterfaces_InterfaceType = 242
-	// IETFInterfaces_InterfaceType_usb corresponds to the value usb of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_usb E_IETFInterfaces_InterfaceType = 243
-	// IETFInterfaces_InterfaceType_v11 corresponds to the value v11 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_v11 E_IETFInterfaces_InterfaceType = 244
-	// IETFInterfaces_InterfaceType_v35 corresponds to the value v35 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_v35 E_IETFInterfaces_InterfaceType = 245
-	// IETFInterfaces_InterfaceType_v36 corresponds to the value v36 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_v36 E_IETFInterfaces_InterfaceType = 246
-	// IETFInterfaces_InterfaceType_v37 corresponds to the value v37 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_v37 E_IETFInterfaces_InterfaceType = 247
-	// IETFInterfaces_InterfaceType_vdsl corresponds to the value vdsl of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_vdsl E_IETFInterfaces_InterfaceType = 248
-	// IETFInterfaces_InterfaceType_vdsl2 corresponds to the value vdsl2 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_vdsl2 E_IETFInterfaces_InterfaceType = 249
-	// IETFInterfaces_InterfaceType_virtualIpAddress corresponds to the value virtualIpAddress of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_virtualIpAddress E_IETFInterfaces_InterfaceType = 250
-	// IETFInterfaces_InterfaceType_virtualTg corresponds to the value virtualTg of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_virtualTg E_IETFInterfaces_InterfaceType = 251
-	// IETFInterfaces_InterfaceType_vmwareNicTeam corresponds to the value vmwareNicTeam of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_vmwareNicTeam E_IETFInterfaces_InterfaceType = 252
-	// IETFInterfaces_InterfaceType_vmwareVirtualNic corresponds to the value vmwareVirtualNic of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_vmwareVirtualNic E_IETFInterfaces_InterfaceType = 253
-	// IETFInterfaces_InterfaceType_voiceDID corresponds to the value voiceDID of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceDID E_IETFInterfaces_InterfaceType = 254
-	// IETFInterfaces_InterfaceType_voiceEBS corresponds to the value voiceEBS of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceEBS E_IETFInterfaces_InterfaceType = 255
-	// IETFInterfaces_InterfaceType_voiceEM corresponds to the value voiceEM of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceEM E_IETFInterfaces_InterfaceType = 256
-	// IETFInterfaces_InterfaceType_voiceEMFGD corresponds to the value voiceEMFGD of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceEMFGD E_IETFInterfaces_InterfaceType = 257
-	// IETFInterfaces_InterfaceType_voiceEncap corresponds to the value voiceEncap of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceEncap E_IETFInterfaces_InterfaceType = 258
-	// IETFInterfaces_InterfaceType_voiceFGDEANA corresponds to the value voiceFGDEANA of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceFGDEANA E_IETFInterfaces_InterfaceType = 259
-	// IETFInterfaces_InterfaceType_voiceFGDOS corresponds to the value voiceFGDOS of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceFGDOS E_IETFInterfaces_InterfaceType = 260
-	// IETFInterfaces_InterfaceType_voiceFXO corresponds to the value voiceFXO of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceFXO E_IETFInterfaces_InterfaceType = 261
-	// IETFInterfaces_InterfaceType_voiceFXS corresponds to the value voiceFXS of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceFXS E_IETFInterfaces_InterfaceType = 262
-	// IETFInterfaces_InterfaceType_voiceOverAtm corresponds to the value voiceOverAtm of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceOverAtm E_IETFInterfaces_InterfaceType = 263
-	// IETFInterfaces_InterfaceType_voiceOverCable corresponds to the value voiceOverCable of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceOverCable E_IETFInterfaces_InterfaceType = 264
-	// IETFInterfaces_InterfaceType_voiceOverFrameRelay corresponds to the value voiceOverFrameRelay of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceOverFrameRelay E_IETFInterfaces_InterfaceType = 265
-	// IETFInterfaces_InterfaceType_voiceOverIp corresponds to the value voiceOverIp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_voiceOverIp E_IETFInterfaces_InterfaceType = 266
-	// IETFInterfaces_InterfaceType_wwanPP corresponds to the value wwanPP of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_wwanPP E_IETFInterfaces_InterfaceType = 267
-	// IETFInterfaces_InterfaceType_wwanPP2 corresponds to the value wwanPP2 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_wwanPP2 E_IETFInterfaces_InterfaceType = 268
-	// IETFInterfaces_InterfaceType_x213 corresponds to the value x213 of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_x213 E_IETFInterfaces_InterfaceType = 269
-	// IETFInterfaces_InterfaceType_x25huntGroup corresponds to the value x25huntGroup of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_x25huntGroup E_IETFInterfaces_InterfaceType = 270
-	// IETFInterfaces_InterfaceType_x25mlp corresponds to the value x25mlp of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_x25mlp E_IETFInterfaces_InterfaceType = 271
-	// IETFInterfaces_InterfaceType_x25ple corresponds to the value x25ple of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_x25ple E_IETFInterfaces_InterfaceType = 272
-	// IETFInterfaces_InterfaceType_x86Laps corresponds to the value x86Laps of IETFInterfaces_InterfaceType
-	IETFInterfaces_InterfaceType_x86Laps E_IETFInterfaces_InterfaceType = 273
-)
-
-// E_Interface_AdminStatus is a derived int64 type which is used to represent
-// the enumerated node Interface_AdminStatus. An additional value named
-// Interface_AdminStatus_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_Interface_AdminStatus int64
-
-// IsYANGGoEnum ensures that Interface_AdminStatus implements the yang.GoEnum
-// interface. This ensures that Interface_AdminStatus can be identified as a
-// mapped type for a YANG enumeration.
-func (E_Interface_AdminStatus) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  Interface_AdminStatus.
-func (E_Interface_AdminStatus) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
-
-// String returns a logging-friendly string for E_Interface_AdminStatus.
-func (e E_Interface_AdminStatus) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_Interface_AdminStatus")
-}
-
-const (
-	// Interface_AdminStatus_UNSET corresponds to the value UNSET of Interface_AdminStatus
-	Interface_AdminStatus_UNSET E_Interface_AdminStatus = 0
-	// Interface_AdminStatus_UP corresponds to the value UP of Interface_AdminStatus
-	Interface_AdminStatus_UP E_Interface_AdminStatus = 1
-	// Interface_AdminStatus_DOWN corresponds to the value DOWN of Interface_AdminStatus
-	Interface_AdminStatus_DOWN E_Interface_AdminStatus = 2
-	// Interface_AdminStatus_TESTING corresponds to the value TESTING of Interface_AdminStatus
-	Interface_AdminStatus_TESTING E_Interface_AdminStatus = 3
-)
-
-// E_Interface_OperStatus is a derived int64 type which is used to represent
-// the enumerated node Interface_OperStatus. An additional value named
-// Interface_OperStatus_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_Interface_OperStatus int64
-
-// IsYANGGoEnum ensures that Interface_OperStatus implements the yang.GoEnum
-// interface. This ensures that Interface_OperStatus can be identified as a
-// mapped type for a YANG enumeration.
-func (E_Interface_OperStatus) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  Interface_OperStatus.
-func (E_Interface_OperStatus) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
-
-// String returns a logging-friendly string for E_Interface_OperStatus.
-func (e E_Interface_OperStatus) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_Interface_OperStatus")
-}
-
-const (
-	// Interface_OperStatus_UNSET corresponds to the value UNSET of Interface_OperStatus
-	Interface_OperStatus_UNSET E_Interface_OperStatus = 0
-	// Interface_OperStatus_UP corresponds to the value UP of Interface_OperStatus
-	Interface_OperStatus_UP E_Interface_OperStatus = 1
-	// Interface_OperStatus_DOWN corresponds to the value DOWN of Interface_OperStatus
-	Interface_OperStatus_DOWN E_Interface_OperStatus = 2
-	// Interface_OperStatus_TESTING corresponds to the value TESTING of Interface_OperStatus
-	Interface_OperStatus_TESTING E_Interface_OperStatus = 3
-	// Interface_OperStatus_UNKNOWN corresponds to the value UNKNOWN of Interface_OperStatus
-	Interface_OperStatus_UNKNOWN E_Interface_OperStatus = 4
-	// Interface_OperStatus_DORMANT corresponds to the value DORMANT of Interface_OperStatus
-	Interface_OperStatus_DORMANT E_Interface_OperStatus = 5
-	// Interface_OperStatus_NOT_PRESENT corresponds to the value NOT_PRESENT of Interface_OperStatus
-	Interface_OperStatus_NOT_PRESENT E_Interface_OperStatus = 6
-	// Interface_OperStatus_LOWER_LAYER_DOWN corresponds to the value LOWER_LAYER_DOWN of Interface_OperStatus
-	Interface_OperStatus_LOWER_LAYER_DOWN E_Interface_OperStatus = 7
-)
-
-// E_Neighbor_NeighborState is a derived int64 type which is used to represent
-// the enumerated node Neighbor_NeighborState. An additional value named
-// Neighbor_NeighborState_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_Neighbor_NeighborState int64
-
-// IsYANGGoEnum ensures that Neighbor_NeighborState implements the yang.GoEnum
-// interface. This ensures that Neighbor_NeighborState can be identified as a
-// mapped type for a YANG enumeration.
-func (E_Neighbor_NeighborState) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  Neighbor_NeighborState.
-func (E_Neighbor_NeighborState) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
-
-// String returns a logging-friendly string for E_Neighbor_NeighborState.
-func (e E_Neighbor_NeighborState) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_Neighbor_NeighborState")
-}
-
-const (
-	// Neighbor_NeighborState_UNSET corresponds to the value UNSET of Neighbor_NeighborState
-	Neighbor_NeighborState_UNSET E_Neighbor_NeighborState = 0
-	// Neighbor_NeighborState_INCOMPLETE corresponds to the value INCOMPLETE of Neighbor_NeighborState
-	Neighbor_NeighborState_INCOMPLETE E_Neighbor_NeighborState = 1
-	// Neighbor_NeighborState_REACHABLE corresponds to the value REACHABLE of Neighbor_NeighborState
-	Neighbor_NeighborState_REACHABLE E_Neighbor_NeighborState = 2
-	// Neighbor_NeighborState_STALE corresponds to the value STALE of Neighbor_NeighborState
-	Neighbor_NeighborState_STALE E_Neighbor_NeighborState = 3
-	// Neighbor_NeighborState_DELAY corresponds to the value DELAY of Neighbor_NeighborState
-	Neighbor_NeighborState_DELAY E_Neighbor_NeighborState = 4
-	// Neighbor_NeighborState_PROBE corresponds to the value PROBE of Neighbor_NeighborState
-	Neighbor_NeighborState_PROBE E_Neighbor_NeighborState = 5
-)
-
-// E_OpenconfigIfAggregate_AggregationType is a derived int64 type which is used to represent
-// the enumerated node OpenconfigIfAggregate_AggregationType. An additional value named
-// OpenconfigIfAggregate_AggregationType_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_OpenconfigIfAggregate_AggregationType int64
-
-// IsYANGGoEnum ensures that OpenconfigIfAggregate_AggregationType implements the yang.GoEnum
-// interface. This ensures that OpenconfigIfAggregate_AggregationType can be identified as a
-// mapped type for a YANG enumeration.
-func (E_OpenconfigIfAggregate_AggregationType) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  OpenconfigIfAggregate_AggregationType.
-func (E_OpenconfigIfAggregate_AggregationType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
-	return ΛEnum
-}
-
-// String returns a logging-friendly string for E_OpenconfigIfAggregate_AggregationType.
-func (e E_OpenconfigIfAggregate_AggregationType) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfAggregate_AggregationType")
-}
-
-const (
-	// OpenconfigIfAggregate_AggregationType_UNSET corresponds to the value UNSET of OpenconfigIfAggregate_AggregationType
-	OpenconfigIfAggregate_AggregationType_UNSET E_OpenconfigIfAggregate_AggregationType = 0
-	// OpenconfigIfAggregate_AggregationType_LACP corresponds to the value LACP of OpenconfigIfAggregate_AggregationType
-	OpenconfigIfAggregate_AggregationType_LACP E_OpenconfigIfAggregate_AggregationType = 1
-	// OpenconfigIfAggregate_AggregationType_STATIC corresponds to the value STATIC of OpenconfigIfAggregate_AggregationType
-	OpenconfigIfAggregate_AggregationType_STATIC E_OpenconfigIfAggregate_AggregationType = 2
-)
-
-// E_OpenconfigIfEthernet_ETHERNET_SPEED is a derived int64 type which is used to represent
-// the enumerated node OpenconfigIfEthernet_ETHERNET_SPEED. An additional value named
-// OpenconfigIfEthernet_ETHERNET_SPEED_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_OpenconfigIfEthernet_ETHERNET_SPEED int64
-
-// IsYANGGoEnum ensures that OpenconfigIfEthernet_ETHERNET_SPEED implements the yang.GoEnum
-// interface. This ensures that OpenconfigIfEthernet_ETHERNET_SPEED can be identified as a
-// mapped type for a YANG enumeration.
-func (E_OpenconfigIfEthernet_ETHERNET_SPEED) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  OpenconfigIfEthernet_ETHERNET_SPEED.
-func (E_OpenconfigIfEthernet_ETHERNET_SPEED) ΛMap() map[string]map[int64]ygot.EnumDefinition {
-	return ΛEnum
-}
-
-// String returns a logging-friendly string for E_OpenconfigIfEthernet_ETHERNET_SPEED.
-func (e E_OpenconfigIfEthernet_ETHERNET_SPEED) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfEthernet_ETHERNET_SPEED")
-}
-
-const (
-	// OpenconfigIfEthernet_ETHERNET_SPEED_UNSET corresponds to the value UNSET of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_UNSET E_OpenconfigIfEthernet_ETHERNET_SPEED = 0
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB corresponds to the value SPEED_100GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 1
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100MB corresponds to the value SPEED_100MB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 2
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB corresponds to the value SPEED_10GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 3
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10MB corresponds to the value SPEED_10MB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 4
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB corresponds to the value SPEED_1GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 5
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB corresponds to the value SPEED_25GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 6
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB corresponds to the value SPEED_40GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 7
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB corresponds to the value SPEED_50GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 8
-	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN corresponds to the value SPEED_UNKNOWN of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN E_OpenconfigIfEthernet_ETHERNET_SPEED = 9
-)
-
-// E_OpenconfigIfIp_IpAddressOrigin is a derived int64 type which is used to represent
-// the enumerated node OpenconfigIfIp_IpAddressOrigin. An additional value named
-// OpenconfigIfIp_IpAddressOrigin_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_OpenconfigIfIp_IpAddressOrigin int64
-
-// IsYANGGoEnum ensures that OpenconfigIfIp_IpAddressOrigin implements the yang.GoEnum
-// interface. This ensures that OpenconfigIfIp_IpAddressOrigin can be identified as a
-// mapped type for a YANG enumeration.
-func (E_OpenconfigIfIp_IpAddressOrigin) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  OpenconfigIfIp_IpAddressOrigin.
-func (E_OpenconfigIfIp_IpAddressOrigin) ΛMap() map[string]map[int64]ygot.EnumDefinition {
-	return ΛEnum
-}
-
-// String returns a logging-friendly string for E_OpenconfigIfIp_IpAddressOrigin.
-func (e E_OpenconfigIfIp_IpAddressOrigin) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfIp_IpAddressOrigin")
-}
-
-const (
-	// OpenconfigIfIp_IpAddressOrigin_UNSET corresponds to the value UNSET of OpenconfigIfIp_IpAddressOrigin
-	OpenconfigIfIp_IpAddressOrigin_UNSET E_OpenconfigIfIp_IpAddressOrigin = 0
-	// OpenconfigIfIp_IpAddressOrigin_OTHER corresponds to the value OTHER of OpenconfigIfIp_IpAddressOrigin
-	OpenconfigIfIp_IpAddressOrigin_OTHER E_OpenconfigIfIp_IpAddressOrigin = 1
-	// OpenconfigIfIp_IpAddressOrigin_STATIC corresponds to the value STATIC of OpenconfigIfIp_IpAddressOrigin
-	OpenconfigIfIp_IpAddressOrigin_STATIC E_OpenconfigIfIp_IpAddressOrigin = 2
-	// OpenconfigIfIp_IpAddressOrigin_DHCP corresponds to the value DHCP of OpenconfigIfIp_IpAddressOrigin
-	OpenconfigIfIp_IpAddressOrigin_DHCP E_OpenconfigIfIp_IpAddressOrigin = 3
-	// OpenconfigIfIp_IpAddressOrigin_LINK_LAYER corresponds to the value LINK_LAYER of OpenconfigIfIp_IpAddressOrigin
-	OpenconfigIfIp_IpAddressOrigin_LINK_LAYER E_OpenconfigIfIp_IpAddressOrigin = 4
-	// OpenconfigIfIp_IpAddressOrigin_RANDOM corresponds to the value RANDOM of OpenconfigIfIp_IpAddressOrigin
-	OpenconfigIfIp_IpAddressOrigin_RANDOM E_OpenconfigIfIp_IpAddressOrigin = 5
-)
-
-// E_OpenconfigIfIp_NeighborOrigin is a derived int64 type which is used to represent
-// the enumerated node OpenconfigIfIp_NeighborOrigin. An additional value named
-// OpenconfigIfIp_NeighborOrigin_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_OpenconfigIfIp_NeighborOrigin int64
-
-// IsYANGGoEnum ensures that OpenconfigIfIp_NeighborOrigin implements the yang.GoEnum
-// interface. This ensures that OpenconfigIfIp_NeighborOrigin can be identified as a
-// mapped type for a YANG enumeration.
-func (E_OpenconfigIfIp_NeighborOrigin) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  OpenconfigIfIp_NeighborOrigin.
-func (E_OpenconfigIfIp_NeighborOrigin) ΛMap() map[string]map[int64]ygot.EnumDefinition {
-	return ΛEnum
-}
-
-// String returns a logging-friendly string for E_OpenconfigIfIp_NeighborOrigin.
-func (e E_OpenconfigIfIp_NeighborOrigin) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfIp_NeighborOrigin")
-}
-
-const (
-	// OpenconfigIfIp_NeighborOrigin_UNSET corresponds to the value UNSET of OpenconfigIfIp_NeighborOrigin
-	OpenconfigIfIp_NeighborOrigin_UNSET E_OpenconfigIfIp_NeighborOrigin = 0
-	// OpenconfigIfIp_NeighborOrigin_OTHER corresponds to the value OTHER of OpenconfigIfIp_NeighborOrigin
-	OpenconfigIfIp_NeighborOrigin_OTHER E_OpenconfigIfIp_NeighborOrigin = 1
-	// OpenconfigIfIp_NeighborOrigin_STATIC corresponds to the value STATIC of OpenconfigIfIp_NeighborOrigin
-	OpenconfigIfIp_NeighborOrigin_STATIC E_OpenconfigIfIp_NeighborOrigin = 2
-	// OpenconfigIfIp_NeighborOrigin_DYNAMIC corresponds to the value DYNAMIC of OpenconfigIfIp_NeighborOrigin
-	OpenconfigIfIp_NeighborOrigin_DYNAMIC E_OpenconfigIfIp_NeighborOrigin = 3
-)
-
-// E_OpenconfigVlanTypes_TPID_TYPES is a derived int64 type which is used to represent
-// the enumerated node OpenconfigVlanTypes_TPID_TYPES. An additional value named
-// OpenconfigVlanTypes_TPID_TYPES_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_OpenconfigVlanTypes_TPID_TYPES int64
-
-// IsYANGGoEnum ensures that OpenconfigVlanTypes_TPID_TYPES implements the yang.GoEnum
-// interface. This ensures that OpenconfigVlanTypes_TPID_TYPES can be identified as a
-// mapped type for a YANG enumeration.
-func (E_OpenconfigVlanTypes_TPID_TYPES) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  OpenconfigVlanTypes_TPID_TYPES.
-func (E_OpenconfigVlanTypes_TPID_TYPES) ΛMap() map[string]map[int64]ygot.EnumDefinition {
-	return ΛEnum
-}
-
-// String returns a logging-friendly string for E_OpenconfigVlanTypes_TPID_TYPES.
-func (e E_OpenconfigVlanTypes_TPID_TYPES) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_OpenconfigVlanTypes_TPID_TYPES")
-}
-
-const (
-	// OpenconfigVlanTypes_TPID_TYPES_UNSET corresponds to the value UNSET of OpenconfigVlanTypes_TPID_TYPES
-	OpenconfigVlanTypes_TPID_TYPES_UNSET E_OpenconfigVlanTypes_TPID_TYPES = 0
-	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X9200 corresponds to the value TPID_0X9200 of OpenconfigVlanTypes_TPID_TYPES
-	OpenconfigVlanTypes_TPID_TYPES_TPID_0X9200 E_OpenconfigVlanTypes_TPID_TYPES = 1
-	// OpenconfigVlanTypes_TPID_TYPES_TPID_0x8100 corresponds to the value TPID_0x8100 of OpenconfigVlanTypes_TPID_TYPES
-	OpenconfigVlanTypes_TPID_TYPES_TPID_0x8100 E_OpenconfigVlanTypes_TPID_TYPES = 2
-	// OpenconfigVlanTypes_TPID_TYPES_TPID_0x8A88 corresponds to the value TPID_0x8A88 of OpenconfigVlanTypes_TPID_TYPES
-	OpenconfigVlanTypes_TPID_TYPES_TPID_0x8A88 E_OpenconfigVlanTypes_TPID_TYPES = 3
-	// OpenconfigVlanTypes_TPID_TYPES_TPID_0x9100 corresponds to the value TPID_0x9100 of OpenconfigVlanTypes_TPID_TYPES
-	OpenconfigVlanTypes_TPID_TYPES_TPID_0x9100 E_OpenconfigVlanTypes_TPID_TYPES = 4
-)
-
-// E_OpenconfigVlanTypes_VlanModeType is a derived int64 type which is used to represent
-// the enumerated node OpenconfigVlanTypes_VlanModeType. An additional value named
-// OpenconfigVlanTypes_VlanModeType_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_OpenconfigVlanTypes_VlanModeType int64
-
-// IsYANGGoEnum ensures that OpenconfigVlanTypes_VlanModeType implements the yang.GoEnum
-// interface. This ensures that OpenconfigVlanTypes_VlanModeType can be identified as a
-// mapped type for a YANG enumeration.
-func (E_OpenconfigVlanTypes_VlanModeType) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  OpenconfigVlanTypes_VlanModeType.
-func (E_OpenconfigVlanTypes_VlanModeType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
-	return ΛEnum
-}
-
-// String returns a logging-friendly string for E_OpenconfigVlanTypes_VlanModeType.
-func (e E_OpenconfigVlanTypes_VlanModeType) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_OpenconfigVlanTypes_VlanModeType")
-}
-
-const (
-	// OpenconfigVlanTypes_VlanModeType_UNSET corresponds to the value UNSET of OpenconfigVlanTypes_VlanModeType
-	OpenconfigVlanTypes_VlanModeType_UNSET E_OpenconfigVlanTypes_VlanModeType = 0
-	// OpenconfigVlanTypes_VlanModeType_ACCESS corresponds to the value ACCESS of OpenconfigVlanTypes_VlanModeType
-	OpenconfigVlanTypes_VlanModeType_ACCESS E_OpenconfigVlanTypes_VlanModeType = 1
-	// OpenconfigVlanTypes_VlanModeType_TRUNK corresponds to the value TRUNK of OpenconfigVlanTypes_VlanModeType
-	OpenconfigVlanTypes_VlanModeType_TRUNK E_OpenconfigVlanTypes_VlanModeType = 2
-)
-
-// E_Vlan_Status is a derived int64 type which is used to represent
-// the enumerated node Vlan_Status. An additional value named
-// Vlan_Status_UNSET is added to the enumeration which is used as
-// the nil value, indicating that the enumeration was not explicitly set by
-// the program importing the generated structures.
-type E_Vlan_Status int64
-
-// IsYANGGoEnum ensures that Vlan_Status implements the yang.GoEnum
-// interface. This ensures that Vlan_Status can be identified as a
-// mapped type for a YANG enumeration.
-func (E_Vlan_Status) IsYANGGoEnum() {}
-
-// ΛMap returns the value lookup map associated with  Vlan_Status.
-func (E_Vlan_Status) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
-
-// String returns a logging-friendly string for E_Vlan_Status.
-func (e E_Vlan_Status) String() string {
-	return ygot.EnumLogString(e, int64(e), "E_Vlan_Status")
-}
-
-const (
-	// Vlan_Status_UNSET corresponds to the value UNSET of Vlan_Status
-	Vlan_Status_UNSET E_Vlan_Status = 0
-	// Vlan_Status_ACTIVE corresponds to the value ACTIVE of Vlan_Status
-	Vlan_Status_ACTIVE E_Vlan_Status = 1
-	// Vlan_Status_SUSPENDED corresponds to the value SUSPENDED of Vlan_Status
-	Vlan_Status_SUSPENDED E_Vlan_Status = 2
-)
-
-// ΛEnum is a map, keyed by the name of the type defined for each enum in the
-// generated Go code, which provides a mapping between the constant int64 value
-// of each value of the enumeration, and the string that is used to represent it
-// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
-// valid YANG identifier.
-var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
-	"E_Address_Status": {
-		1: {Name: "PREFERRED"},
-		2: {Name: "DEPRECATED"},
-		3: {Name: "INVALID"},
-		4: {Name: "INACCESSIBLE"},
-		5: {Name: "UNKNOWN"},
-		6: {Name: "TENTATIVE"},
-		7: {Name: "DUPLICATE"},
-		8: {Name: "OPTIMISTIC"},
-	},
-	"E_Ethernet_DuplexMode": {
-		1: {Name: "FULL"},
-		2: {Name: "HALF"},
-	},
-	"E_Ethernet_NegotiatedDuplexMode": {
-		1: {Name: "FULL"},
-		2: {Name: "HALF"},
-	},
-	"E_IETFInterfaces_InterfaceType": {
-		1:   {Name: "a12MppSwitch", DefiningModule: "iana-if-type"},
-		2:   {Name: "aal2", DefiningModule: "iana-if-type"},
-		3:   {Name: "aal5", DefiningModule: "iana-if-type"},
-		4:   {Name: "actelisMetaLOOP", DefiningModule: "iana-if-type"},
-		5:   {Name: "adsl", DefiningModule: "iana-if-type"},
-		6:   {Name: "adsl2", DefiningModule: "iana-if-type"},
-		7:   {Name: "adsl2plus", DefiningModule: "iana-if-type"},
-		8:   {Name: "aflane8023", DefiningModule: "iana-if-type"},
-		9:   {Name: "aflane8025", DefiningModule: "iana-if-type"},
-		10:  {Name: "aluELP", DefiningModule: "iana-if-type"},
-		11:  {Name: "aluEpon", DefiningModule: "iana-if-type"},
-		12:  {Name: "aluEponLogicalLink", DefiningModule: "iana-if-type"},
-		13:  {Name: "aluEponOnu", DefiningModule: "iana-if-type"},
-		14:  {Name: "aluEponPhysicalUni", DefiningModule: "iana-if-type"},
-		15:  {Name: "aluGponOnu", DefiningModule: "iana-if-type"},
-		16:  {Name: "aluGponPhysicalUni", DefiningModule: "iana-if-type"},
-		17:  {Name: "arap", DefiningModule: "iana-if-type"},
-		18:  {Name: "arcnet", DefiningModule: "iana-if-type"},
-		19:  {Name: "arcnetPlus", DefiningModule: "iana-if-type"},
-		20:  {Name: "async", DefiningModule: "iana-if-type"},
-		21:  {Name: "atm", DefiningModule: "iana-if-type"},
-		22:  {Name: "atmDxi", DefiningModule: "iana-if-type"},
-		23:  {Name: "atmFuni", DefiningModule: "iana-if-type"},
-		24:  {Name: "atmIma", DefiningModule: "iana-if-type"},
-		25:  {Name: "atmLogical", DefiningModule: "iana-if-type"},
-		26:  {Name: "atmRadio", DefiningModule: "iana-if-type"},
-		27:  {Name: "atmSubInterface", DefiningModule: "iana-if-type"},
-		28:  {Name: "atmVciEndPt", DefiningModule: "iana-if-type"},
-		29:  {Name: "atmVirtual", DefiningModule: "iana-if-type"},
-		30:  {Name: "atmbond", DefiningModule: "iana-if-type"},
-		31:  {Name: "aviciOpticalEther", DefiningModule: "iana-if-type"},
-		32:  {Name: "basicISDN", DefiningModule: "iana-if-type"},
-		33:  {Name: "bgppolicyaccounting", DefiningModule: "iana-if-type"},
-		34:  {Name: "bits", DefiningModule: "iana-if-type"},
-		35:  {Name: "bridge", DefiningModule: "iana-if-type"},
-		36:  {Name: "bsc", DefiningModule: "iana-if-type"},
-		37:  {Name: "cableDownstreamRfPort", DefiningModule: "iana-if-type"},
-		38:  {Name: "capwapDot11Bss", DefiningModule: "iana-if-type"},
-		39:  {Name: "capwapDot11Profile", DefiningModule: "iana-if-type"},
-		40:  {Name: "capwapWtpVirtualRadio", DefiningModule: "iana-if-type"},
-		41:  {Name: "cblVectaStar", DefiningModule: "iana-if-type"},
-		42:  {Name: "cctEmul", DefiningModule: "iana-if-type"},
-		43:  {Name: "ces", DefiningModule: "iana-if-type"},
-		44:  {Name: "channel", DefiningModule: "iana-if-type"},
-		45:  {Name: "ciscoISLvlan", DefiningModule: "iana-if-type"},
-		46:  {Name: "cnr", DefiningModule: "iana-if-type"},
-		47:  {Name: "coffee", DefiningModule: "iana-if-type"},
-		48:  {Name: "compositeLink", DefiningModule: "iana-if-type"},
-		49:  {Name: "dcn", DefiningModule: "iana-if-type"},
-		50:  {Name: "ddnX25", DefiningModule: "iana-if-type"},
-		51:  {Name: "digitalPowerline", DefiningModule: "iana-if-type"},
-		52:  {Name: "digitalWrapperOverheadChannel", DefiningModule: "iana-if-type"},
-		53:  {Name: "dlsw", DefiningModule: "iana-if-type"},
-		54:  {Name: "docsCableDownstream", DefiningModule: "iana-if-type"},
-		55:  {Name: "docsCableMCmtsDownstream", DefiningModule: "iana-if-type"},
-		56:  {Name: "docsCableMaclayer", DefiningModule: "iana-if-type"},
-		57:  {Name: "docsCableUpstream", DefiningModule: "iana-if-type"},
-		58:  {Name: "docsCableUpstreamChannel", DefiningModule: "iana-if-type"},
-		59:  {Name: "docsCableUpstreamRfPort", DefiningModule: "iana-if-type"},
-		60:  {Name: "ds0", DefiningModule: "iana-if-type"},
-		61:  {Name: "ds0Bundle", DefiningModule: "iana-if-type"},
-		62:  {Name: "ds1", DefiningModule: "iana-if-type"},
-		63:  {Name: "ds1FDL", DefiningModule: "iana-if-type"},
-		64:  {Name: "ds3", DefiningModule: "iana-if-type"},
-		65:  {Name: "dtm", DefiningModule: "iana-if-type"},
-		66:  {Name: "dvbAsiIn", DefiningModule: "iana-if-type"},
-		67:  {Name: "dvbAsiOut", DefiningModule: "iana-if-type"},
-		68:  {Name: "dvbRccDownstream", DefiningModule: "iana-if-type"},
-		69:  {Name: "dvbRccMacLayer", DefiningModule: "iana-if-type"},
-		70:  {Name: "dvbRccUpstream", DefiningModule: "iana-if-type"},
-		71:  {Name: "dvbRcsMacLayer", DefiningModule: "iana-if-type"},
-		72:  {Name: "dvbRcsTdma", DefiningModule: "iana-if-type"},
-		73:  {Name: "dvbTdm", DefiningModule: "iana-if-type"},
-		74:  {Name: "e1", DefiningModule: "iana-if-type"},
-		75:  {Name: "econet", DefiningModule: "iana-if-type"},
-		76:  {Name: "eon", DefiningModule: "iana-if-type"},
-		77:  {Name: "eplrs", DefiningModule: "iana-if-type"},
-		78:  {Name: "escon", DefiningModule: "iana-if-type"},
-		79:  {Name: "ethernet3Mbit", DefiningModule: "iana-if-type"},
-		80:  {Name: "ethernetCsmacd", DefiningModule: "iana-if-type"},
-		81:  {Name: "fast", DefiningModule: "iana-if-type"},
-		82:  {Name: "fastEther", DefiningModule: "iana-if-type"},
-		83:  {Name: "fastEtherFX", DefiningModule: "iana-if-type"},
-		84:  {Name: "fcipLink", DefiningModule: "iana-if-type"},
-		85:  {Name: "fddi", DefiningModule: "iana-if-type"},
-		86:  {Name: "fibreChannel", DefiningModule: "iana-if-type"},
-		87:  {Name: "frDlciEndPt", DefiningModule: "iana-if-type"},
-		88:  {Name: "frForward", DefiningModule: "iana-if-type"},
-		89:  {Name: "frameRelay", DefiningModule: "iana-if-type"},
-		90:  {Name: "frameRelayInterconnect", DefiningModule: "iana-if-type"},
-		91:  {Name: "frameRelayMPI", DefiningModule: "iana-if-type"},
-		92:  {Name: "frameRelayService", DefiningModule: "iana-if-type"},
-		93:  {Name: "frf16MfrBundle", DefiningModule: "iana-if-type"},
-		94:  {Name: "g703at2mb", DefiningModule: "iana-if-type"},
-		95:  {Name: "g703at64k", DefiningModule: "iana-if-type"},
-		96:  {Name: "g9981", DefiningModule: "iana-if-type"},
-		97:  {Name: "g9982", DefiningModule: "iana-if-type"},
-		98:  {Name: "g9983", DefiningModule: "iana-if-type"},
-		99:  {Name: "gfp", DefiningModule: "iana-if-type"},
-		100: {Name: "gigabitEthernet", DefiningModule: "iana-if-type"},
-		101: {Name: "gpon", DefiningModule: "iana-if-type"},
-		102: {Name: "gr303IDT", DefiningModule: "iana-if-type"},
-		103: {Name: "gr303RDT", DefiningModule: "iana-if-type"},
-		104: {Name: "gtp", DefiningModule: "iana-if-type"},
-		105: {Name: "h323Gatekeeper", DefiningModule: "iana-if-type"},
-		106: {Name: "h323Proxy", DefiningModule: "iana-if-type"},
-		107: {Name: "hdh1822", DefiningModule: "iana-if-type"},
-		108: {Name: "hdlc", DefiningModule: "iana-if-type"},
-		109: {Name: "hdsl2", DefiningModule: "iana-if-type"},
-		110: {Name: "hiperlan2", DefiningModule: "iana-if-type"},
-		111: {Name: "hippi", DefiningModule: "iana-if-type"},
-		112: {Name: "hippiInterface", DefiningModule: "iana-if-type"},
-		113: {Name: "homepna", DefiningModule: "iana-if-type"},
-		114: {Name: "hostPad", DefiningModule: "iana-if-type"},
-		115: {Name: "hssi", DefiningModule: "iana-if-type"},
-		116: {Name: "hyperchannel", DefiningModule: "iana-if-type"},
-		117: {Name: "iana-interface-type", DefiningModule: "iana-if-type"},
-		118: {Name: "ibm370parChan", DefiningModule: "iana-if-type"},
-		119: {Name: "idsl", DefiningModule: "iana-if-type"},
-		120: {Name: "ieee1394", DefiningModule: "iana-if-type"},
-		121: {Name: "ieee80211", DefiningModule: "iana-if-type"},
-		122: {Name: "ieee80212", DefiningModule: "iana-if-type"},
-		123: {Name: "ieee802154", DefiningModule: "iana-if-type"},
-		124: {Name: "ieee80216WMAN", DefiningModule: "iana-if-type"},
-		125: {Name: "ieee8023adLag", DefiningModule: "iana-if-type"},
-		126: {Name: "if-gsn", DefiningModule: "iana-if-type"},
-		127: {Name: "ifPwType", DefiningModule: "iana-if-type"},
-		128: {Name: "ifVfiType", DefiningModule: "iana-if-type"},
-		129: {Name: "ilan", DefiningModule: "iana-if-type"},
-		130: {Name: "imt", DefiningModule: "iana-if-type"},
-		131: {Name: "infiniband", DefiningModule: "iana-if-type"},
-		132: {Name: "interleave", DefiningModule: "iana-if-type"},
-		133: {Name: "ip", DefiningModule: "iana-if-type"},
-		134: {Name: "ipForward", DefiningModule: "iana-if-type"},
-		135: {Name: "ipOverAtm", DefiningModule: "iana-if-type"},
-		136: {Name: "ipOverCdlc", DefiningModule: "iana-if-type"},
-		137: {Name: "ipOverClaw", DefiningModule: "iana-if-type"},
-		138: {Name: "ipSwitch", DefiningModule: "iana-if-type"},
-		139: {Name: "isdn", DefiningModule: "iana-if-type"},
-		140: {Name: "isdns", DefiningModule: "iana-if-type"},
-		141: {Name: "isdnu", DefiningModule: "iana-if-type"},
-		142: {Name: "iso88022llc", DefiningModule: "iana-if-type"},
-		143: {Name: "iso88023Csmacd", DefiningModule: "iana-if-type"},
-		144: {Name: "iso88024TokenBus", DefiningModule: "iana-if-type"},
-		145: {Name: "iso88025CRFPInt", DefiningModule: "iana-if-type"},
-		146: {Name: "iso88025Dtr", DefiningModule: "iana-if-type"},
-		147: {Name: "iso88025Fiber", DefiningModule: "iana-if-type"},
-		148: {Name: "iso88025TokenRing", DefiningModule: "iana-if-type"},
-		149: {Name: "iso88026Man", DefiningModule: "iana-if-type"},
-		150: {Name: "isup", DefiningModule: "iana-if-type"},
-		151: {Name: "l2vlan", DefiningModule: "iana-if-type"},
-		152: {Name: "l3ipvlan", DefiningModule: "iana-if-type"},
-		153: {Name: "l3ipxvlan", DefiningModule: "iana-if-type"},
-		154: {Name: "lapb", DefiningModule: "iana-if-type"},
-		155: {Name: "lapd", DefiningModule: "iana-if-type"},
-		156: {Name: "lapf", DefiningModule: "iana-if-type"},
-		157: {Name: "linegroup", DefiningModule: "iana-if-type"},
-		158: {Name: "lmp", DefiningModule: "iana-if-type"},
-		159: {Name: "localTalk", DefiningModule: "iana-if-type"},
-		160: {Name: "macSecControlledIF", DefiningModule: "iana-if-type"},
-		161: {Name: "macSecUncontrolledIF", DefiningModule: "iana-if-type"},
-		162: {Name: "mediaMailOverIp", DefiningModule: "iana-if-type"},
-		163: {Name: "mfSigLink", DefiningModule: "iana-if-type"},
-		164: {Name: "miox25", DefiningModule: "iana-if-type"},
-		165: {Name: "mocaVersion1", DefiningModule: "iana-if-type"},
-		166: {Name: "modem", DefiningModule: "iana-if-type"},
-		167: {Name: "mpc", DefiningModule: "iana-if-type"},
-		168: {Name: "mpegTransport", DefiningModule: "iana-if-type"},
-		169: {Name: "mpls", DefiningModule: "iana-if-type"},
-		170: {Name: "mplsTunnel", DefiningModule: "iana-if-type"},
-		171: {Name: "msdsl", DefiningModule: "iana-if-type"},
-		172: {Name: "mvl", DefiningModule: "iana-if-type"},
-		173: {Name: "myrinet", DefiningModule: "iana-if-type"},
-		174: {Name: "nfas", DefiningModule: "iana-if-type"},
-		175: {Name: "nsip", DefiningModule: "iana-if-type"},
-		176: {Name: "opticalChannel", DefiningModule: "iana-if-type"},
-		177: {Name: "opticalChannelGroup", DefiningModule: "iana-if-type"},
-		178: {Name: "opticalTransport", DefiningModule: "iana-if-type"},
-		179: {Name: "other", DefiningModule: "iana-if-type"},
-		180: {Name: "otnOdu", DefiningModule: "iana-if-type"},
-		181: {Name: "otnOtu", DefiningModule: "iana-if-type"},
-		182: {Name: "para", DefiningModule: "iana-if-type"},
-		183: {Name: "pdnEtherLoop1", DefiningModule: "iana-if-type"},
-		184: {Name: "pdnEtherLoop2", DefiningModule: "iana-if-type"},
-		185: {Name: "pip", DefiningModule: "iana-if-type"},
-		186: {Name: "plc", DefiningModule: "iana-if-type"},
-		187: {Name: "pon155", DefiningModule: "iana-if-type"},
-		188: {Name: "pon622", DefiningModule: "iana-if-type"},
-		189: {Name: "pos", DefiningModule: "iana-if-type"},
-		190: {Name: "ppp", DefiningModule: "iana-if-type"},
-		191: {Name: "pppMultilinkBundle", DefiningModule: "iana-if-type"},
-		192: {Name: "primaryISDN", DefiningModule: "iana-if-type"},
-		193: {Name: "propAtm", DefiningModule: "iana-if-type"},
-		194: {Name: "propBWAp2Mp", DefiningModule: "iana-if-type"},
-		195: {Name: "propCnls", DefiningModule: "iana-if-type"},
-		196: {Name: "propDocsWirelessDownstream", DefiningModule: "iana-if-type"},
-		197: {Name: "propDocsWirelessMaclayer", DefiningModule: "iana-if-type"},
-		198: {Name: "propDocsWirelessUpstream", DefiningModule: "iana-if-type"},
-		199: {Name: "propMultiplexor", DefiningModule: "iana-if-type"},
-		200: {Name: "propPointToPointSerial", DefiningModule: "iana-if-type"},
-		201: {Name: "propVirtual", DefiningModule: "iana-if-type"},
-		202: {Name: "propWirelessP2P", DefiningModule: "iana-if-type"},
-		203: {Name: "proteon10Mbit", DefiningModule: "iana-if-type"},
-		204: {Name: "proteon80Mbit", DefiningModule: "iana-if-type"},
-		205: {Name: "q2931", DefiningModule: "iana-if-type"},
-		206: {Name: "qam", DefiningModule: "iana-if-type"},
-		207: {Name: "qllc", DefiningModule: "iana-if-type"},
-		208: {Name: "radioMAC", DefiningModule: "iana-if-type"},
-		209: {Name: "radsl", DefiningModule: "iana-if-type"},
-		210: {Name: "reachDSL", DefiningModule: "iana-if-type"},
-		211: {Name: "regular1822", DefiningModule: "iana-if-type"},
-		212: {Name: "rfc1483", DefiningModule: "iana-if-type"},
-		213: {Name: "rfc877x25", DefiningModule: "iana-if-type"},
-		214: {Name: "rpr", DefiningModule: "iana-if-type"},
-		215: {Name: "rs232", DefiningModule: "iana-if-type"},
-		216: {Name: "rsrb", DefiningModule: "iana-if-type"},
-		217: {Name: "sdlc", DefiningModule: "iana-if-type"},
-		218: {Name: "sdsl", DefiningModule: "iana-if-type"},
-		219: {Name: "shdsl", DefiningModule: "iana-if-type"},
-		220: {Name: "sip", DefiningModule: "iana-if-type"},
-		221: {Name: "sipSig", DefiningModule: "iana-if-type"},
-		222: {Name: "sipTg", DefiningModule: "iana-if-type"},
-		223: {Name: "sixToFour", DefiningModule: "iana-if-type"},
-		224: {Name: "slip", DefiningModule: "iana-if-type"},
-		225: {Name: "smdsDxi", DefiningModule: "iana-if-type"},
-		226: {Name: "smdsIcip", DefiningModule: "iana-if-type"},
-		227: {Name: "softwareLoopback", DefiningModule: "iana-if-type"},
-		228: {Name: "sonet", DefiningModule: "iana-if-type"},
-		229: {Name: "sonetOverheadChannel", DefiningModule: "iana-if-type"},
-		230: {Name: "sonetPath", DefiningModule: "iana-if-type"},
-		231: {Name: "sonetVT", DefiningModule: "iana-if-type"},
-		232: {Name: "srp", DefiningModule: "iana-if-type"},
-		233: {Name: "ss7SigLink", DefiningModule: "iana-if-type"},
-		234: {Name: "stackToStack", DefiningModule: "iana-if-type"},
-		235: {Name: "starLan", DefiningModule: "iana-if-type"},
-		236: {Name: "tdlc", DefiningModule: "iana-if-type"},
-		237: {Name: "teLink", DefiningModule: "iana-if-type"},
-		238: {Name: "termPad", DefiningModule: "iana-if-type"},
-		239: {Name: "tr008", DefiningModule: "iana-if-type"},
-		240: {Name: "transpHdlc", DefiningModule: "iana-if-type"},
-		241: {Name: "tunnel", DefiningModule: "iana-if-type"},
-		242: {Name: "ultra", DefiningModule: "iana-if-type"},
-		243: {Name: "usb", DefiningModule: "iana-if-type"},
-		244: {Name: "v11", DefiningModule: "iana-if-type"},
-		245: {Name: "v35", DefiningModule: "iana-if-type"},
-		246: {Name: "v36", DefiningModule: "iana-if-type"},
-		247: {Name: "v37", DefiningModule: "iana-if-type"},
-		248: {Name: "vdsl", DefiningModule: "iana-if-type"},
-		249: {Name: "vdsl2", DefiningModule: "iana-if-type"},
-		250: {Name: "virtualIpAddress", DefiningModule: "iana-if-type"},
-		251: {Name: "virtualTg", DefiningModule: "iana-if-type"},
-		252: {Name: "vmwareNicTeam", DefiningModule: "iana-if-type"},
-		253: {Name: "vmwareVirtualNic", DefiningModule: "iana-if-type"},
-		254: {Name: "voiceDID", DefiningModule: "iana-if-type"},
-		255: {Name: "voiceEBS", DefiningModule: "iana-if-type"},
-		256: {Name: "voiceEM", DefiningModule: "iana-if-type"},
-		257: {Name: "voiceEMFGD", DefiningModule: "iana-if-type"},
-		258: {Name: "voiceEncap", DefiningModule: "iana-if-type"},
-		259: {Name: "voiceFGDEANA", DefiningModule: "iana-if-type"},
-		260: {Name: "voiceFGDOS", DefiningModule: "iana-if-type"},
-		261: {Name: "voiceFXO", DefiningModule: "iana-if-type"},
-		262: {Name: "voiceFXS", DefiningModule: "iana-if-type"},
-		263: {Name: "voiceOverAtm", DefiningModule: "iana-if-type"},
-		264: {Name: "voiceOverCable", DefiningModule: "iana-if-type"},
-		265: {Name: "voiceOverFrameRelay", DefiningModule: "iana-if-type"},
-		266: {Name: "voiceOverIp", DefiningModule: "iana-if-type"},
-		267: {Name: "wwanPP", DefiningModule: "iana-if-type"},
-		268: {Name: "wwanPP2", DefiningModule: "iana-if-type"},
-		269: {Name: "x213", DefiningModule: "iana-if-type"},
-		270: {Name: "x25huntGroup", DefiningModule: "iana-if-type"},
-		271: {Name: "x25mlp", DefiningModule: "iana-if-type"},
-		272: {Name: "x25ple", DefiningModule: "iana-if-type"},
-		273: {Name: "x86Laps", DefiningModule: "iana-if-type"},
-	},
-	"E_Interface_AdminStatus": {
-		1: {Name: "UP"},
-		2: {Name: "DOWN"},
-		3: {Name: "TESTING"},
-	},
-	"E_Interface_OperStatus": {
-		1: {Name: "UP"},
-		2: {Name: "DOWN"},
-		3: {Name: "TESTING"},
-		4: {Name: "UNKNOWN"},
-		5: {Name: "DORMANT"},
-		6: {Name: "NOT_PRESENT"},
-		7: {Name: "LOWER_LAYER_DOWN"},
-	},
-	"E_Neighbor_NeighborState": {
-		1: {Name: "INCOMPLETE"},
-		2: {Name: "REACHABLE"},
-		3: {Name: "STALE"},
-		4: {Name: "DELAY"},
-		5: {Name: "PROBE"},
-	},
-	"E_OpenconfigIfAggregate_AggregationType": {
-		1: {Name: "LACP"},
-		2: {Name: "STATIC"},
-	},
-	"E_OpenconfigIfEthernet_ETHERNET_SPEED": {
-		1: {Name: "SPEED_100GB", DefiningModule: "openconfig-if-ethernet"},
-		2: {Name: "SPEED_100MB", DefiningModule: "openconfig-if-ethernet"},
-		3: {Name: "SPEED_10GB", DefiningModule: "openconfig-if-ethernet"},
-		4: {Name: "SPEED_10MB", DefiningModule: "openconfig-if-ethernet"},
-		5: {Name: "SPEED_1GB", DefiningModule: "openconfig-if-ethernet"},
-		6: {Name: "SPEED_25GB", DefiningModule: "openconfig-if-ethernet"},
-		7: {Name: "SPEED_40GB", DefiningModule: "openconfig-if-ethernet"},
-		8: {Name: "SPEED_50GB", DefiningModule: "openconfig-if-ethernet"},
-		9: {Name: "SPEED_UNKNOWN", DefiningModule: "openconfig-if-ethernet"},
-	},
-	"E_OpenconfigIfIp_IpAddressOrigin": {
-		1: {Name: "OTHER"},
-		2: {Name: "STATIC"},
-		3: {Name: "DHCP"},
-		4: {Name: "LINK_LAYER"},
-		5: {Name: "RANDOM"},
-	},
-	"E_OpenconfigIfIp_NeighborOrigin": {
-		1: {Name: "OTHER"},
-		2: {Name: "STATIC"},
-		3: {Name: "DYNAMIC"},
-	},
-	"E_OpenconfigVlanTypes_TPID_TYPES": {
-		1: {Name: "TPID_0X9200", DefiningModule: "openconfig-vlan-types"},
-		2: {Name: "TPID_0x8100", DefiningModule: "openconfig-vlan-types"},
-		3: {Name: "TPID_0x8A88", DefiningModule: "openconfig-vlan-types"},
-		4: {Name: "TPID_0x9100", DefiningModule: "openconfig-vlan-types"},
-	},
-	"E_OpenconfigVlanTypes_VlanModeType": {
-		1: {Name: "ACCESS"},
-		2: {Name: "TRUNK"},
-	},
-	"E_Vlan_Status": {
-		1: {Name: "ACTIVE"},
-		2: {Name: "SUSPENDED"},
-	},
-}
-
-var (
-	// ySchema is a byte slice contain a gzip compressed representation of the
-	// YANG schema from which the Go code was generated. When uncompressed the
-	// contents of the byte slice is a JSON document containing an object, keyed
-	// on the name of the generated struct, and containing the JSON marshalled
-	// contents of a goyang yang.Entry struct, which defines the schema for the
-	// fields within the struct.
-	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xfd, 0x6b, 0x53, 0xdb, 0xc8,
-		0xd6, 0x3e, 0x0e, 0xbf, 0xcf, 0xa7, 0x50, 0xb9, 0x6a, 0xd7, 0x86, 0xd9, 0x31, 0xf1, 0x19, 0x70,
-		0xd5, 0x5d, 0x53, 0x84, 0x43, 0x86, 0xda, 0x10, 0x5c, 0xc0, 0x64, 0xe6, 0xf7, 0x04, 0xee, 0x54,
-		0x5b, 0x6a, 0xd9, 0xfd, 0x44, 0x6a, 0x69, 0xba, 0x5b, 0x1c, 0xee, 0x84, 0xef, 0xfe, 0x2f, 0xc9,
-		0xb6, 0x30, 0x18, 0x82, 0x25, 0x75, 0xcb, 0x5e, 0xf6, 0xca, 0x8b, 0xc4, 0x10, 0xab, 0x25, 0xf5,
-		0x61, 0xad, 0x6b, 0x5d, 0xeb, 0xf4, 0xe3, 0x9d, 0x65, 0x59, 0x56, 0xe5, 0x33, 0xf1, 0x69, 0xa5,
-		0x6b, 0x55, 0x1c, 0x7a, 0xc3, 0x6c, 0x5a, 0x79, 0x3f, 0xfa, 0xed, 0x7f, 0x19, 0x77, 0x2a, 0x5d,
-		0xab, 0x3e, 0xfe, 0x71, 0x3f, 0xe0, 0x2e, 0x1b, 0x54, 0xba, 0x56, 0x6d, 0xfc, 0x8b, 0x03, 0x26,
-		0x2a, 0x5d, 0x6b, 0x34, 0x44, 0xf2, 0x0b, 0xc6, 0x15, 0x15, 0x2e, 0xb1, 0xa9, 0x7c, 0xf2, 0xfb,
-		0x27, 0xb7, 0x98, 0xfa, 0xce, 0xfb, 0xa7, 0xdf, 0x78, 0x7a, 0xbb, 0xf4, 0xd7, 0xcf, 0x6f, 0x9b,
-		0xfe, 0x47, 0x4f, 0x50, 0x97, 0xdd, 0xcd, 0xdc, 0xe9, 0xc9, 0xdd, 0x02, 0xbb, 0xca, 0xdc, 0x67,
-		0x37, 0x4a, 0xbe, 0x70, 0x11, 0x44, 0xc2, 0xa6, 0x2f, 0x5e, 0x3c, 0x7a, 0x18, 0x7a, 0x7f, 0x1b,
-		0x88, 0xf8, 0x79, 0x2a, 0xe1, 0xe8, 0x3e, 0xef, 0x5f, 0xfe, 0xe2, 0x1f, 0x44, 0xee, 0x89, 0x41,
-		0xe4, 0x53, 0xae, 0x2a, 0x5d, 0x4b, 0x89, 0x88, 0xbe, 0xf2, 0xc5, 0xa9, 0x6f, 0x8d, 0x1f, 0x6b,
-		0xe6, 0x7b, 0x0f, 0x4f, 0x7e, 0xf3, 0xf0, 0xec, 0x7d, 0x9f, 0x4f, 0xf7, 0xec, 0xb4, 0xbf, 0xfe,
-		0x3a, 0x33, 0xb3, 0xff, 0xda, 0xeb, 0xbc, 0xbc, 0x08, 0x6f, 0x2e, 0xc6, 0x3c, 0x8b, 0x32, 0xf7,
-		0xe2, 0xcc, 0xbb, 0x48, 0x99, 0x17, 0x2b, 0xf3, 0xa2, 0x65, 0x59, 0xbc, 0x97, 0x17, 0xf1, 0x95,
-		0xc5, 0x7c, 0x73, 0x51, 0xd3, 0x2f, 0x90, 0xc1, 0x40, 0xd0, 0x01, 0x51, 0x2c, 0xe0, 0x6f, 0x4f,
-		0xc4, 0x64, 0x66, 0xa7, 0x2f, 0x7a, 0xe3, 0xcd, 0x7e, 0xbd, 0xe0, 0x73, 0x2f, 0x7c, 0x96, 0x0d,
-		0xf0, 0xd2, 0x46, 0xf0, 0xc8, 0xe0, 0x8d, 0x27, 0xcd, 0xb2, 0x23, 0x72, 0xef, 0x8c, 0xdc, 0x3b,
-		0xe4, 0xb5, 0x9d, 0x12, 0xbf, 0xd7, 0x9b, 0x17, 0x3e, 0xfc, 0xf2, 0x1b, 0x0f, 0x6f, 0xcc, 0xf8,
-		0x5b, 0x5b, 0x28, 0xfd, 0xa2, 0x3d, 0x59, 0xc3, 0x39, 0x27, 0x6f, 0xb2, 0x3c, 0xe3, 0xeb, 0xe6,
-		0x9c, 0x80, 0xf9, 0x36, 0x54, 0xe6, 0x8d, 0x95, 0x67, 0x83, 0xe5, 0xdf, 0x68, 0x79, 0x37, 0x5c,
-		0xe1, 0x8d, 0x57, 0x78, 0x03, 0x16, 0xda, 0x88, 0xf3, 0x6d, 0xc8, 0x39, 0x37, 0x66, 0xe6, 0x0d,
-		0x9a, 0x5e, 0xe0, 0x91, 0x41, 0x55, 0xdd, 0x87, 0x39, 0xa6, 0x7d, 0xb2, 0xd0, 0xe9, 0x08, 0x19,
-		0x27, 0x6d, 0xbc, 0x79, 0x6b, 0x19, 0x2f, 0xcb, 0xba, 0x89, 0x8b, 0x6c, 0xe6, 0xe2, 0x9b, 0xba,
-		0xe8, 0xe6, 0xd6, 0xb6, 0xc9, 0xb5, 0x6d, 0x76, 0x2d, 0x9b, 0x3e, 0xdb, 0xe6, 0xcf, 0x78, 0x08,
-		0xd2, 0x27, 0xbc, 0xcc, 0xb3, 0xaf, 0x5f, 0x53, 0xee, 0x79, 0xf6, 0xf8, 0x13, 0x21, 0xdd, 0xca,
-		0x71, 0xed, 0x21, 0x8f, 0xfc, 0xf8, 0x0d, 0xcc, 0xce, 0xd3, 0x1e, 0xe7, 0x81, 0x9a, 0x0f, 0xf6,
-		0xbc, 0x78, 0xfd, 0xfd, 0x20, 0x50, 0xd5, 0xc0, 0xae, 0xda, 0x81, 0x1f, 0x0a, 0x2a, 0x25, 0x75,
-		0xaa, 0x1e, 0x25, 0x6e, 0x8e, 0x07, 0x7f, 0x67, 0xe0, 0x15, 0x2b, 0x3e, 0xe3, 0x55, 0x8f, 0xf1,
-		0xef, 0x32, 0xbf, 0x8c, 0x7b, 0x1c, 0x02, 0x85, 0x1c, 0x0a, 0xb9, 0x15, 0x13, 0x72, 0x11, 0xe3,
-		0xaa, 0xde, 0x29, 0x20, 0xda, 0x3a, 0x39, 0x2e, 0x3d, 0x27, 0x7c, 0x10, 0xdf, 0xfd, 0x6b, 0xae,
-		0x75, 0xc9, 0xb7, 0xbf, 0x92, 0x1b, 0x9f, 0x32, 0x9e, 0x7b, 0x83, 0xa6, 0x83, 0x7c, 0x21, 0x5e,
-		0x44, 0xb3, 0x1f, 0xcf, 0x99, 0x71, 0x8e, 0x04, 0xb1, 0x63, 0xb9, 0x7b, 0xc0, 0x06, 0x4c, 0x49,
-		0x0d, 0x03, 0x7e, 0x4e, 0xb4, 0xd5, 0x4d, 0xfc, 0x6c, 0x2e, 0xf1, 0x24, 0xcd, 0x3d, 0xda, 0xc3,
-		0xfb, 0x02, 0x53, 0x4c, 0xee, 0xf4, 0x4d, 0x71, 0xa7, 0xdd, 0x6e, 0xb6, 0x57, 0x77, 0x9a, 0xdf,
-		0x95, 0x73, 0xd5, 0x35, 0x02, 0x08, 0x93, 0x66, 0x57, 0xce, 0x09, 0xa8, 0x48, 0x7b, 0x48, 0x7d,
-		0x12, 0x12, 0x35, 0x8c, 0x05, 0xf1, 0x87, 0x20, 0xa4, 0x7c, 0xc4, 0x00, 0x54, 0x1f, 0xb9, 0xdb,
-		0x0f, 0x2f, 0x7d, 0xfc, 0x30, 0x85, 0x4c, 0x3f, 0x8c, 0x49, 0x83, 0x77, 0x7a, 0xde, 0x7b, 0x8e,
-		0x77, 0xae, 0x48, 0x45, 0x14, 0xcd, 0xce, 0x6e, 0x8c, 0x2e, 0x33, 0x4c, 0x6e, 0x34, 0x90, 0xdc,
-		0x40, 0x72, 0xe3, 0x91, 0xdc, 0x90, 0x21, 0xa5, 0x4e, 0x31, 0x76, 0x63, 0x34, 0x04, 0x22, 0x7f,
-		0x44, 0xfe, 0x2b, 0x88, 0xfc, 0x9b, 0x8d, 0x02, 0xc8, 0x7f, 0x1b, 0x91, 0x3f, 0x22, 0x7f, 0x53,
-		0xc8, 0xbf, 0xd5, 0xd8, 0x6d, 0xed, 0x76, 0xb6, 0x1b, 0xbb, 0x08, 0xff, 0x4b, 0x86, 0xff, 0x46,
-		0x68, 0x38, 0xf4, 0x34, 0xa0, 0x2a, 0x46, 0x55, 0xfc, 0xda, 0x7a, 0x03, 0xf5, 0x34, 0x98, 0x21,
-		0xec, 0xa9, 0xdf, 0xa7, 0xa2, 0x00, 0x5b, 0x3f, 0xba, 0x1e, 0xa5, 0x04, 0x4a, 0x89, 0x15, 0x93,
-		0x12, 0x7d, 0x22, 0xe9, 0x23, 0x35, 0x54, 0x15, 0xd4, 0x2d, 0x22, 0x27, 0xf2, 0xa0, 0xf7, 0x5e,
-		0xca, 0x55, 0xd9, 0x55, 0xe6, 0x76, 0xa7, 0xb8, 0xa9, 0x67, 0xbf, 0x18, 0xff, 0xcc, 0xe3, 0x27,
-		0x37, 0x3a, 0xab, 0x27, 0x4c, 0xaa, 0x3d, 0xa5, 0x44, 0xbe, 0x99, 0x3d, 0x65, 0xfc, 0xd0, 0xa3,
-		0xf1, 0xbe, 0xc9, 0x09, 0x0c, 0x63, 0xcc, 0x3b, 0x35, 0x42, 0x7d, 0xa7, 0xd5, 0xea, 0x6c, 0xb7,
-		0x5a, 0xb5, 0xed, 0xe6, 0x76, 0x6d, 0xb7, 0xdd, 0xae, 0x77, 0xea, 0x39, 0xe0, 0x6b, 0xe5, 0x4c,
-		0x38, 0x54, 0x50, 0xe7, 0xe3, 0x7d, 0xa5, 0x6b, 0xf1, 0xc8, 0xf3, 0x8c, 0xce, 0x20, 0xbd, 0x53,
-		0x82, 0x54, 0x23, 0x2e, 0x15, 0xe9, 0x7b, 0x39, 0x77, 0xe8, 0xed, 0x90, 0xf2, 0x45, 0x18, 0x75,
-		0x4f, 0x25, 0x61, 0x77, 0x02, 0x11, 0xad, 0xff, 0xb1, 0xfe, 0x7d, 0x71, 0xb9, 0x77, 0x79, 0xbc,
-		0xff, 0xef, 0x4a, 0x01, 0x7b, 0xa6, 0xa0, 0x94, 0x7c, 0x49, 0x5a, 0x26, 0x13, 0x55, 0xd0, 0xfe,
-		0xd0, 0x25, 0x33, 0x7f, 0x21, 0x3b, 0x5f, 0x9c, 0xc9, 0x85, 0xd8, 0x94, 0x07, 0x54, 0xda, 0x82,
-		0x85, 0xb9, 0xbd, 0x0b, 0x2f, 0x6e, 0x97, 0xcb, 0x21, 0xb5, 0x24, 0xf3, 0x43, 0x8f, 0x5a, 0x1e,
-		0x93, 0xca, 0x0a, 0x5c, 0x6b, 0x84, 0x1a, 0xac, 0x47, 0x89, 0x66, 0x31, 0x69, 0xc5, 0x86, 0xe3,
-		0x0d, 0xbd, 0xe2, 0xf1, 0xaa, 0x59, 0x6a, 0x48, 0xad, 0x09, 0x4e, 0xa3, 0xf1, 0xff, 0x4a, 0x45,
-		0x14, 0xb3, 0x89, 0xe7, 0xdd, 0x5b, 0x23, 0x0e, 0x3e, 0x12, 0x99, 0x89, 0x42, 0x53, 0x7b, 0xee,
-		0xf9, 0xbe, 0x73, 0xa6, 0xa6, 0xf1, 0x7d, 0xf1, 0x91, 0x75, 0x6f, 0xc1, 0x99, 0x6d, 0x68, 0x72,
-		0x85, 0x0a, 0x3d, 0xe9, 0x03, 0x5a, 0xfb, 0xf3, 0x60, 0x78, 0x0c, 0xba, 0x41, 0x24, 0x8f, 0x48,
-		0xfe, 0xd5, 0xf5, 0xc6, 0xa0, 0x9b, 0xdc, 0xbc, 0x30, 0x52, 0xef, 0xaf, 0x98, 0x21, 0x18, 0x74,
-		0xb3, 0x26, 0x7a, 0x78, 0x85, 0x62, 0x57, 0x46, 0x21, 0x21, 0x65, 0x86, 0xae, 0xdc, 0x32, 0x65,
-		0x0f, 0xa9, 0x53, 0xbd, 0xf1, 0x08, 0xcf, 0x11, 0xc2, 0xf2, 0xe4, 0xf2, 0xd5, 0xc8, 0xd3, 0xc9,
-		0xf0, 0x2a, 0xd6, 0x4a, 0xc5, 0xb2, 0x24, 0x2f, 0x0e, 0x25, 0x98, 0x25, 0x63, 0x4a, 0xd9, 0xcc,
-		0x42, 0x67, 0x4a, 0x2d, 0xcb, 0xb9, 0x75, 0x97, 0x06, 0x4b, 0x67, 0xdc, 0xd2, 0xeb, 0x03, 0xa6,
-		0xb3, 0x6d, 0xf9, 0x72, 0xd0, 0x74, 0xd6, 0xa3, 0x90, 0x5e, 0x48, 0x6c, 0x9b, 0x4a, 0x99, 0x4d,
-		0x92, 0xbf, 0xba, 0x6b, 0xa6, 0x07, 0xcb, 0x39, 0xd7, 0xf9, 0x0c, 0xcf, 0xc2, 0x87, 0x46, 0xc7,
-		0xe1, 0xd1, 0x78, 0x88, 0x4c, 0xb2, 0xa7, 0x85, 0x0e, 0x55, 0x69, 0xfc, 0x69, 0xbe, 0x43, 0x56,
-		0x10, 0x9e, 0xe6, 0xdc, 0x33, 0xb9, 0x4d, 0xd9, 0x59, 0x93, 0x96, 0x17, 0xe3, 0x16, 0x53, 0x5d,
-		0xb3, 0x5b, 0x60, 0x8c, 0xf1, 0xeb, 0x7c, 0x2d, 0xb4, 0x9e, 0x1a, 0x98, 0xd7, 0xc9, 0xa4, 0xc4,
-		0x5b, 0xa1, 0xca, 0x1c, 0x1d, 0x94, 0x6b, 0x7e, 0xc3, 0x5f, 0x33, 0x11, 0xa0, 0x7f, 0xba, 0xb4,
-		0x12, 0x05, 0xaf, 0x5a, 0xb5, 0xf5, 0xf7, 0x7a, 0xc7, 0xd5, 0x6d, 0xe1, 0x9a, 0xb3, 0x78, 0x35,
-		0x12, 0x0d, 0x46, 0x88, 0x87, 0x57, 0x97, 0xac, 0x55, 0xdb, 0x6d, 0xe1, 0xaa, 0x15, 0x52, 0x0c,
-		0xfa, 0x47, 0xb9, 0x7e, 0xb7, 0xc0, 0xbd, 0xa7, 0x51, 0x36, 0xff, 0xc3, 0xf8, 0x3f, 0x7a, 0x65,
-		0x73, 0x7d, 0x47, 0xc3, 0x58, 0x3d, 0xa2, 0x14, 0x15, 0x5c, 0x9b, 0x78, 0xae, 0x6c, 0xb4, 0x6a,
-		0xbb, 0x5f, 0x6b, 0xd5, 0xd6, 0xf5, 0xcf, 0x56, 0xed, 0x6b, 0xad, 0xba, 0x73, 0xfd, 0xb5, 0x56,
-		0xdd, 0xbd, 0xfe, 0xf9, 0xb5, 0x5e, 0x6d, 0x8e, 0x3e, 0xfe, 0x68, 0x3e, 0xc4, 0x3f, 0xed, 0x8e,
-		0x7f, 0xaa, 0xbf, 0x6f, 0x8c, 0x7f, 0xde, 0xbc, 0xba, 0xda, 0xda, 0x28, 0x70, 0xf9, 0xcf, 0xab,
-		0xab, 0xdf, 0x36, 0x2b, 0x8b, 0xde, 0x70, 0xef, 0xca, 0xbd, 0x6f, 0x5e, 0x24, 0xa6, 0x21, 0x0c,
-		0x23, 0x1d, 0xab, 0x50, 0x38, 0x86, 0x81, 0xa3, 0xf6, 0x18, 0xb3, 0xe4, 0x07, 0x4e, 0x12, 0x53,
-		0xb0, 0xb7, 0xbf, 0x7f, 0x78, 0x71, 0xf1, 0x6f, 0x1d, 0x87, 0x4f, 0xa3, 0xc7, 0xdc, 0xd2, 0x1f,
-		0xad, 0x61, 0xcc, 0xea, 0x78, 0xd1, 0xfa, 0x78, 0x7d, 0xa2, 0xdf, 0x2d, 0x01, 0x68, 0xd0, 0x1a,
-		0xcc, 0x31, 0xb3, 0xc9, 0xf6, 0x12, 0x0b, 0xde, 0xfa, 0x72, 0xb2, 0xf7, 0xd9, 0x22, 0x52, 0xb2,
-		0x01, 0xa7, 0x8e, 0xa5, 0x82, 0x24, 0x2a, 0xe0, 0xd5, 0x5a, 0x78, 0xcb, 0xb2, 0xeb, 0x2c, 0xa3,
-		0xf1, 0x1a, 0xc6, 0x37, 0xe1, 0xcc, 0x46, 0x9c, 0x73, 0x31, 0x96, 0x04, 0xd2, 0x3c, 0xac, 0x85,
-		0x86, 0x29, 0x98, 0xa7, 0x9d, 0x8e, 0xa3, 0x2d, 0x5f, 0x3b, 0xff, 0x04, 0xe6, 0x98, 0x82, 0x67,
-		0xb2, 0xb1, 0x38, 0x63, 0xf8, 0x6c, 0x3c, 0x24, 0x0d, 0x91, 0x34, 0x44, 0xd2, 0x30, 0x0f, 0x3f,
-		0x16, 0x1f, 0x9f, 0xbc, 0x59, 0x2f, 0x33, 0x26, 0x58, 0x01, 0xbe, 0x20, 0x67, 0x16, 0x0c, 0x8a,
-		0xe5, 0x42, 0x62, 0x99, 0x27, 0x6c, 0x89, 0x26, 0x2f, 0xce, 0xf4, 0x60, 0x28, 0x90, 0x51, 0x20,
-		0xa3, 0x40, 0xce, 0xb8, 0x63, 0xd0, 0x8b, 0xf3, 0x9a, 0x96, 0x42, 0x2f, 0xce, 0xbc, 0x0f, 0x86,
-		0x5e, 0x9c, 0x27, 0x9b, 0x08, 0xbd, 0x38, 0xe8, 0xc5, 0xd1, 0x49, 0x56, 0x58, 0xe8, 0xc5, 0x41,
-		0x2f, 0x0e, 0x7a, 0x71, 0x96, 0x93, 0x63, 0x5b, 0x2f, 0x2f, 0xce, 0xe5, 0xf9, 0x9f, 0x9f, 0xff,
-		0x8b, 0x4e, 0x1c, 0x0d, 0x03, 0xff, 0xda, 0x89, 0x33, 0x9e, 0xe7, 0xd5, 0xf7, 0xe1, 0x7c, 0x4e,
-		0x94, 0xee, 0xc8, 0x6d, 0xc0, 0xa4, 0x75, 0x43, 0x3c, 0xe6, 0x58, 0x6e, 0x20, 0xe2, 0xa9, 0xe6,
-		0xdf, 0xad, 0x64, 0x3e, 0xd0, 0x97, 0x63, 0x76, 0x2f, 0xce, 0xec, 0xc7, 0x8c, 0x8b, 0x82, 0x3e,
-		0x1d, 0x24, 0x0f, 0xcb, 0x22, 0x0f, 0x93, 0x1d, 0x98, 0x50, 0x35, 0xb2, 0x38, 0x79, 0x38, 0x3d,
-		0x18, 0x92, 0x87, 0x48, 0x1e, 0x22, 0x79, 0x88, 0xe4, 0x21, 0x92, 0x87, 0x48, 0x1e, 0x22, 0x79,
-		0x88, 0xe4, 0x21, 0x92, 0x87, 0xab, 0x48, 0x1e, 0x26, 0xb2, 0x59, 0x24, 0xa2, 0x10, 0xf9, 0xc3,
-		0x5f, 0xf1, 0x87, 0x57, 0x57, 0x5b, 0x05, 0x06, 0xa8, 0xe0, 0x86, 0x43, 0xb6, 0x7a, 0xad, 0xd8,
-		0xea, 0x65, 0xdb, 0x70, 0xfa, 0x85, 0xdc, 0xae, 0x86, 0xb1, 0xb4, 0x40, 0x75, 0x03, 0x18, 0xf4,
-		0xb1, 0x61, 0x8d, 0x60, 0x7c, 0xa0, 0x93, 0xb3, 0xd3, 0x78, 0x68, 0x8d, 0x1d, 0xde, 0xa5, 0x50,
-		0x19, 0xcb, 0x21, 0x05, 0xf4, 0x48, 0x03, 0xcd, 0x98, 0x1b, 0x37, 0xba, 0xf6, 0x8d, 0x7e, 0x75,
-		0xf5, 0xdb, 0xcf, 0x02, 0xbb, 0x6d, 0xb3, 0xf0, 0x5e, 0x5f, 0x34, 0xbe, 0xd2, 0xbc, 0xd1, 0xd1,
-		0x59, 0x3c, 0xd7, 0xbe, 0x2b, 0x54, 0xbb, 0x7a, 0x9a, 0x31, 0x29, 0x56, 0xc3, 0x7a, 0xda, 0x90,
-		0xd7, 0x5e, 0xcb, 0x3a, 0x1d, 0xbc, 0x50, 0x4d, 0xeb, 0xa2, 0x33, 0x8d, 0x6e, 0xf9, 0x7c, 0xf7,
-		0x40, 0xb7, 0x3c, 0xba, 0xe5, 0xbb, 0x56, 0x65, 0xcf, 0xf3, 0x82, 0x5b, 0xea, 0x24, 0x2e, 0x60,
-		0x69, 0xf9, 0xe4, 0xde, 0xea, 0x53, 0x4b, 0x86, 0xd4, 0x66, 0x2e, 0xa3, 0xcf, 0x7d, 0xc1, 0x57,
-		0xfc, 0xd1, 0x19, 0xbc, 0x85, 0x2e, 0xfa, 0x72, 0xd2, 0x2d, 0xf3, 0x2f, 0x10, 0xba, 0xeb, 0x4b,
-		0xd4, 0x43, 0xab, 0xe4, 0xae, 0x5f, 0xea, 0x9e, 0xc2, 0x9a, 0xca, 0x93, 0x4e, 0x97, 0xfb, 0xcc,
-		0xd4, 0x68, 0x37, 0xfb, 0x2c, 0x65, 0x29, 0xaf, 0x9e, 0xad, 0x01, 0xef, 0x0b, 0xe6, 0xde, 0xfc,
-		0x8d, 0x78, 0x67, 0x2c, 0xba, 0xbc, 0xa5, 0x20, 0x1b, 0x58, 0x0a, 0x72, 0xa1, 0x3a, 0x04, 0x4b,
-		0x41, 0xce, 0xbb, 0x6b, 0xb0, 0x14, 0xa4, 0x85, 0x71, 0x40, 0x1a, 0x40, 0x19, 0xc6, 0x01, 0x15,
-		0x65, 0x0f, 0x31, 0x0e, 0xe8, 0x57, 0xd3, 0x83, 0x71, 0x40, 0x59, 0x07, 0xc5, 0x38, 0x20, 0x03,
-		0xd4, 0xc3, 0x34, 0x7d, 0x88, 0x71, 0x40, 0xe5, 0xac, 0x1a, 0xc6, 0x01, 0x61, 0x58, 0x46, 0x8e,
-		0x01, 0x31, 0x89, 0x10, 0x04, 0x4b, 0x84, 0xa5, 0x20, 0x17, 0x6b, 0x67, 0xbc, 0x64, 0x6f, 0x60,
-		0x29, 0x48, 0xad, 0xa0, 0x01, 0x4b, 0x41, 0xce, 0xbd, 0xf3, 0xb0, 0x14, 0x64, 0x99, 0x90, 0x06,
-		0x98, 0x1f, 0x02, 0x2b, 0x27, 0x22, 0xc7, 0x86, 0x1c, 0x1b, 0x56, 0x4e, 0x84, 0x5d, 0x39, 0x11,
-		0x0b, 0x0d, 0xa2, 0xfc, 0x42, 0xf9, 0xb5, 0x56, 0xf2, 0x0b, 0x7d, 0x04, 0xaf, 0x09, 0x75, 0xf4,
-		0x11, 0xcc, 0xfb, 0x60, 0xe8, 0x23, 0x78, 0xb2, 0x89, 0xd0, 0x47, 0x80, 0x3e, 0x02, 0x9d, 0xa6,
-		0xb0, 0x85, 0x3e, 0x02, 0xf4, 0x11, 0xa0, 0x8f, 0xc0, 0x30, 0x83, 0x83, 0x3e, 0x82, 0x37, 0xf8,
-		0x21, 0xcc, 0x68, 0xc0, 0x8c, 0x06, 0x2c, 0x34, 0x38, 0xcf, 0x06, 0xc4, 0x42, 0x83, 0x8b, 0x00,
-		0x38, 0xe8, 0x31, 0x98, 0x5d, 0x55, 0xac, 0xcb, 0x87, 0x5c, 0x1b, 0x72, 0x6d, 0xc8, 0xb5, 0x21,
-		0xd7, 0x86, 0x5c, 0x1b, 0x72, 0x6d, 0xc8, 0xb5, 0x21, 0xd7, 0x86, 0x5c, 0x5b, 0x56, 0xd9, 0x8c,
-		0x75, 0xf9, 0xb0, 0x2e, 0x5f, 0x59, 0x1b, 0x0e, 0xc9, 0x5d, 0xac, 0xcb, 0xb7, 0x88, 0x0d, 0x87,
-		0x75, 0xf9, 0xf2, 0x4c, 0x1e, 0xd6, 0xe5, 0xc3, 0xba, 0x7c, 0x1a, 0xa4, 0x81, 0x66, 0xcc, 0x8d,
-		0x1b, 0x1d, 0xeb, 0xf2, 0x61, 0x5d, 0x3e, 0x33, 0xf7, 0xc7, 0xba, 0x7c, 0x58, 0x97, 0xef, 0xad,
-		0xb1, 0xd0, 0x8b, 0x5d, 0x40, 0x2d, 0xa0, 0x17, 0xdb, 0x0c, 0x30, 0xc0, 0xba, 0x7c, 0x85, 0x36,
-		0x23, 0xd6, 0xe5, 0x5b, 0xb4, 0xc6, 0x5f, 0x79, 0xef, 0xf6, 0xda, 0x95, 0xb1, 0x1b, 0x55, 0x7f,
-		0x33, 0x55, 0xc5, 0xee, 0x9d, 0xc6, 0x69, 0xcc, 0x3b, 0x7d, 0x26, 0xa6, 0xad, 0x92, 0xa9, 0x3c,
-		0x9f, 0x88, 0x6c, 0xc5, 0xc7, 0x42, 0xfa, 0x78, 0x32, 0xf8, 0xb7, 0xbd, 0xc7, 0xc1, 0xbf, 0x5d,
-		0x8c, 0x07, 0xff, 0x32, 0xb7, 0x47, 0xfa, 0xed, 0xa9, 0xfd, 0xf5, 0x37, 0xde, 0x98, 0xf4, 0xca,
-		0x5e, 0x34, 0x88, 0xc5, 0x16, 0x75, 0xe6, 0x02, 0x51, 0xf3, 0xad, 0x46, 0xaa, 0xa9, 0x3e, 0x04,
-		0x76, 0x95, 0xb9, 0xdd, 0xa9, 0x39, 0x7f, 0xf6, 0x8b, 0xf8, 0x67, 0x8f, 0x0c, 0xba, 0x53, 0x0b,
-		0x30, 0xe7, 0x94, 0x3f, 0x53, 0xb1, 0x95, 0x3d, 0xc7, 0x19, 0x67, 0x1b, 0x4b, 0xaa, 0x14, 0xe3,
-		0x03, 0x69, 0xa9, 0xc0, 0x22, 0xd6, 0xc9, 0xde, 0xa7, 0xc7, 0xe8, 0xa1, 0x79, 0xc7, 0xce, 0x56,
-		0xf5, 0x30, 0x73, 0xa0, 0x48, 0x9e, 0xc0, 0x90, 0x02, 0x81, 0x20, 0x79, 0xd5, 0x75, 0xe1, 0x40,
-		0x8f, 0xc2, 0x1a, 0xb7, 0x58, 0x20, 0x87, 0x5e, 0xa9, 0x94, 0xb5, 0x4a, 0x61, 0xe5, 0xa9, 0x20,
-		0xc9, 0x5f, 0xb6, 0x33, 0xa7, 0x3c, 0x7a, 0xe9, 0x90, 0x1c, 0x72, 0xdb, 0x0b, 0x24, 0xe3, 0x03,
-		0xcb, 0x0e, 0xb8, 0x22, 0x8c, 0x53, 0x91, 0xa0, 0x91, 0x51, 0xec, 0x5d, 0x8a, 0xa8, 0xc7, 0x50,
-		0xc5, 0xbe, 0xe2, 0x0e, 0x51, 0xc4, 0x0a, 0xb8, 0x75, 0xa8, 0x86, 0x54, 0x70, 0xaa, 0xa6, 0x02,
-		0xf1, 0xb6, 0x2c, 0xeb, 0x72, 0x48, 0x25, 0xb5, 0x88, 0xa0, 0xc9, 0x20, 0x52, 0x11, 0xee, 0x10,
-		0xe1, 0x5c, 0xf1, 0x93, 0xc6, 0x7b, 0x2b, 0x7d, 0x6c, 0xa9, 0xee, 0xbd, 0x51, 0x74, 0x5f, 0x66,
-		0xfc, 0x59, 0xb8, 0xfa, 0x68, 0x0d, 0xab, 0x8f, 0x2e, 0x14, 0x29, 0x2f, 0xa4, 0xfa, 0xe8, 0x82,
-		0xe4, 0x43, 0x01, 0x52, 0x23, 0x1f, 0x89, 0x51, 0x6c, 0x8f, 0x32, 0xb7, 0xab, 0xee, 0xc3, 0xc4,
-		0x70, 0x66, 0xae, 0xea, 0x32, 0x4a, 0xe9, 0x4e, 0xad, 0xd1, 0x24, 0xce, 0x09, 0x19, 0xfc, 0x7b,
-		0xc1, 0x9b, 0xb7, 0x00, 0x19, 0x61, 0x6c, 0xeb, 0xfe, 0x7a, 0xbe, 0x4a, 0x09, 0xaf, 0xd5, 0x41,
-		0x2a, 0x4c, 0x15, 0xcb, 0x49, 0xa2, 0xae, 0xe3, 0xa9, 0x7e, 0x5a, 0x93, 0xc5, 0x62, 0x72, 0x04,
-		0x9b, 0xf2, 0xae, 0x80, 0x06, 0x72, 0x40, 0x3f, 0x19, 0xa0, 0xd5, 0xf8, 0x7f, 0x56, 0xe8, 0xe6,
-		0xcd, 0x89, 0x5c, 0x4e, 0xa3, 0xf6, 0x9d, 0x5e, 0xa3, 0xbc, 0xa8, 0xa1, 0x72, 0xfd, 0x96, 0xa1,
-		0x92, 0xcd, 0x2a, 0xd4, 0x63, 0x0d, 0xce, 0xb1, 0xe3, 0xe6, 0xb1, 0xfb, 0x7e, 0xbd, 0x03, 0x5e,
-		0x9f, 0x97, 0x5f, 0x48, 0x89, 0x8a, 0x3d, 0x01, 0x39, 0xbf, 0x9e, 0x8b, 0xf4, 0xc0, 0x8f, 0xbf,
-		0xff, 0xc6, 0x2c, 0xcf, 0x87, 0xb8, 0xe6, 0x46, 0x58, 0x59, 0x10, 0xd5, 0x53, 0xed, 0x34, 0xcf,
-		0xcc, 0x67, 0x94, 0x34, 0xb9, 0xf1, 0x51, 0x6e, 0xe1, 0x31, 0xab, 0x44, 0x2a, 0x86, 0xcd, 0xf9,
-		0x79, 0xad, 0x94, 0x27, 0x62, 0xb5, 0x9b, 0xd5, 0xae, 0xcf, 0x2e, 0x93, 0x33, 0xe6, 0x62, 0x94,
-		0x6e, 0x52, 0xcf, 0xb5, 0xe1, 0x56, 0xcf, 0xa0, 0x9e, 0x67, 0x43, 0x9a, 0x81, 0xcb, 0x99, 0xf3,
-		0x1c, 0xf2, 0x87, 0x47, 0x3c, 0x86, 0x3f, 0x2c, 0x0d, 0xe0, 0x17, 0xd4, 0xa5, 0x82, 0x72, 0x9b,
-		0x96, 0x89, 0xfa, 0xcf, 0x8f, 0xf6, 0xad, 0xc6, 0x4e, 0xa7, 0xd9, 0x8d, 0x0d, 0x77, 0x2b, 0x55,
-		0x54, 0xd2, 0xfa, 0x24, 0x82, 0x28, 0xb4, 0x4e, 0x8f, 0x3f, 0x5a, 0x55, 0x8b, 0xb9, 0x7b, 0x1e,
-		0x23, 0x72, 0xc1, 0x16, 0xc0, 0xe3, 0xfc, 0x2c, 0x93, 0x19, 0x90, 0x69, 0x02, 0xa1, 0xc1, 0x37,
-		0xc3, 0xe4, 0x7c, 0xe1, 0xde, 0x45, 0x73, 0xe0, 0xcb, 0x39, 0x20, 0x03, 0xe5, 0xf1, 0x61, 0x75,
-		0xb2, 0xab, 0xbc, 0xc9, 0x85, 0x73, 0xb3, 0xd3, 0x2e, 0x89, 0x3c, 0x95, 0xe9, 0x78, 0x57, 0xe2,
-		0x6d, 0x3a, 0xdf, 0xbe, 0xb9, 0x46, 0xad, 0x8b, 0x5a, 0xb7, 0x54, 0xad, 0xdb, 0x0f, 0x02, 0x8f,
-		0x66, 0x73, 0x3b, 0x4c, 0xd4, 0x6e, 0x1d, 0xd5, 0xee, 0x3c, 0x5a, 0xc3, 0xf1, 0x19, 0xbf, 0x50,
-		0x44, 0x45, 0xa8, 0x7c, 0x8b, 0x28, 0xdf, 0xa9, 0x69, 0x44, 0x15, 0xbc, 0x8c, 0x2a, 0xd8, 0x57,
-		0x51, 0x76, 0xf5, 0x1b, 0x5f, 0x84, 0x3a, 0x0f, 0x75, 0x5e, 0xa9, 0x3a, 0x2f, 0x62, 0x5c, 0xd5,
-		0x3b, 0x39, 0x54, 0x5e, 0x86, 0x9c, 0xf0, 0x9c, 0xb9, 0xdf, 0x39, 0xf4, 0x51, 0x91, 0x5c, 0xee,
-		0x34, 0x01, 0x38, 0x6f, 0x29, 0x0c, 0x5d, 0x59, 0xbe, 0xc5, 0xb3, 0x79, 0xf3, 0x78, 0x5e, 0x8a,
-		0xe4, 0x54, 0xa7, 0x53, 0xd7, 0x69, 0xb7, 0x9b, 0x6d, 0xf8, 0xd3, 0x87, 0x2a, 0xd2, 0xbc, 0x8a,
-		0x1c, 0x3b, 0x13, 0x32, 0xea, 0xc8, 0xe4, 0x2a, 0x54, 0x92, 0xa8, 0x24, 0x91, 0x8e, 0x85, 0x62,
-		0x17, 0x6e, 0x37, 0x1a, 0xcd, 0xae, 0xb5, 0x67, 0xfd, 0xbf, 0xbd, 0xcf, 0x9f, 0xac, 0x03, 0xa2,
-		0x88, 0x75, 0x1a, 0x38, 0xd4, 0x4b, 0xe2, 0xa9, 0x52, 0x13, 0xc7, 0x3a, 0x25, 0x9c, 0x0c, 0x92,
-		0x4c, 0x20, 0xb4, 0x0c, 0x5f, 0xb1, 0x0c, 0x33, 0x4f, 0x24, 0x2a, 0xbe, 0x65, 0x54, 0x7c, 0x2a,
-		0x8b, 0x18, 0x7a, 0x2c, 0x15, 0x37, 0x7f, 0x2f, 0x0c, 0x54, 0x7c, 0xa8, 0xf8, 0x9e, 0x41, 0x7b,
-		0xee, 0x10, 0x15, 0x88, 0xfb, 0x0c, 0xf1, 0xde, 0xf9, 0x95, 0x25, 0x73, 0x28, 0x57, 0x4c, 0xdd,
-		0x0b, 0xea, 0xe6, 0xd1, 0x98, 0x19, 0xcc, 0x87, 0xca, 0xf1, 0xf8, 0x56, 0x1f, 0x89, 0xa4, 0xf9,
-		0xa3, 0x8f, 0x1f, 0x83, 0x82, 0x73, 0x34, 0x9c, 0x19, 0x99, 0x3e, 0x32, 0x57, 0x7a, 0x66, 0xd1,
-		0x66, 0x53, 0x84, 0x93, 0x6a, 0xa1, 0x87, 0xd7, 0xf1, 0x12, 0xc5, 0x5e, 0x66, 0xe6, 0xa5, 0x5c,
-		0xc7, 0x61, 0x05, 0xea, 0x18, 0xbe, 0x5f, 0xf4, 0xf3, 0x87, 0x22, 0x08, 0xf7, 0x94, 0x0f, 0xf9,
-		0x15, 0x88, 0xb0, 0x39, 0x55, 0x90, 0xdf, 0x80, 0xc9, 0x60, 0x67, 0xa7, 0xd6, 0x68, 0xef, 0x9f,
-		0x1f, 0xf5, 0x8e, 0x39, 0xe8, 0x57, 0xe9, 0x0f, 0xc2, 0x30, 0xf0, 0x98, 0x7d, 0x4f, 0x6c, 0x3b,
-		0x88, 0xb8, 0x8a, 0xed, 0x10, 0xc0, 0xaf, 0xe3, 0x87, 0x9e, 0x84, 0xfc, 0xfc, 0x81, 0xe2, 0x67,
-		0x4e, 0x04, 0xfa, 0x74, 0x7b, 0xd1, 0x61, 0x18, 0xf0, 0xde, 0xf0, 0x5e, 0x32, 0x9b, 0x78, 0x7f,
-		0x72, 0xd0, 0xe2, 0x96, 0x8e, 0x93, 0x76, 0x9a, 0xa7, 0x7d, 0x06, 0x5b, 0x64, 0x8d, 0x82, 0xed,
-		0xeb, 0x8d, 0x55, 0x78, 0x89, 0x3a, 0xe8, 0x97, 0xe0, 0x2e, 0xe3, 0xac, 0x4f, 0xb8, 0x03, 0xfa,
-		0x98, 0x3b, 0xd2, 0x03, 0xbd, 0x97, 0xbc, 0xc0, 0x26, 0xde, 0x25, 0xf1, 0xbe, 0x83, 0x16, 0x4f,
-		0xa1, 0x27, 0x24, 0x6c, 0x2c, 0x48, 0x42, 0xd0, 0x78, 0xe3, 0x5e, 0x30, 0xe0, 0x60, 0x96, 0x78,
-		0xd1, 0xa7, 0x30, 0xe0, 0x67, 0x1c, 0x36, 0xec, 0x20, 0x5e, 0x1b, 0xf2, 0xf3, 0xdf, 0x04, 0xcc,
-		0xa6, 0x47, 0x7f, 0x9f, 0x81, 0x5e, 0x03, 0xe5, 0x5f, 0x44, 0xfd, 0x94, 0x2a, 0x86, 0xfc, 0x2a,
-		0x0e, 0x1b, 0x30, 0x45, 0xbc, 0x5e, 0x70, 0x4b, 0x85, 0xc7, 0x38, 0xe8, 0x77, 0x19, 0xb2, 0x30,
-		0x04, 0x0d, 0xc2, 0x5d, 0x22, 0x41, 0x4b, 0x58, 0x27, 0xb0, 0xe5, 0x3e, 0xe9, 0x7b, 0xf4, 0x94,
-		0xd8, 0x1e, 0xb9, 0xa7, 0x02, 0x3a, 0x01, 0xf5, 0x17, 0x13, 0xd4, 0xa3, 0x52, 0xf6, 0x1a, 0x3d,
-		0xd0, 0x10, 0x90, 0x84, 0x7d, 0xd0, 0xe0, 0x83, 0x05, 0x77, 0x0d, 0xd0, 0x5a, 0x8f, 0xbb, 0x44,
-		0xc2, 0xd7, 0xda, 0x9f, 0x0e, 0x0e, 0xf7, 0x3e, 0xef, 0x81, 0xa6, 0x9d, 0x42, 0xc5, 0x6c, 0xe2,
-		0xed, 0x0f, 0x09, 0xe7, 0xd4, 0x4b, 0xa2, 0x81, 0x21, 0xbf, 0x8e, 0xcd, 0xa4, 0x1d, 0x1c, 0x5f,
-		0x9c, 0x14, 0x6c, 0x5a, 0xb4, 0xf8, 0xf7, 0xb0, 0xd5, 0xa1, 0x1f, 0x79, 0xd0, 0x75, 0x77, 0x52,
-		0xf9, 0x06, 0xf2, 0x4b, 0x28, 0x51, 0xab, 0xed, 0x40, 0x57, 0xda, 0x07, 0x81, 0x2d, 0x27, 0x8a,
-		0xfb, 0xcf, 0x50, 0x2a, 0x41, 0x09, 0x68, 0x37, 0x52, 0x0c, 0xcb, 0x07, 0xd0, 0x25, 0xd5, 0x8d,
-		0x7f, 0x4b, 0x04, 0xfd, 0xc2, 0x84, 0x8a, 0x88, 0xf7, 0x99, 0xd9, 0xc0, 0xed, 0x0c, 0x2a, 0x3c,
-		0xc2, 0x1b, 0x2b, 0xe0, 0xbe, 0x00, 0xed, 0xd2, 0x93, 0x36, 0x74, 0x66, 0x19, 0xf2, 0xf3, 0x0f,
-		0xd8, 0x80, 0xf4, 0x99, 0x9a, 0x94, 0x7b, 0x5b, 0x09, 0xc3, 0x75, 0xa2, 0x2f, 0xc6, 0x00, 0x11,
-		0x34, 0xa6, 0xea, 0x7b, 0x5f, 0xa8, 0xad, 0xc8, 0x85, 0x22, 0xa0, 0x31, 0x89, 0x0c, 0x38, 0x55,
-		0x3d, 0xa2, 0x86, 0xc0, 0x71, 0x89, 0xa2, 0x01, 0xdf, 0xa9, 0x41, 0x77, 0xaf, 0x0e, 0x03, 0xa9,
-		0x7a, 0x04, 0xb4, 0x47, 0x8f, 0x81, 0x06, 0x52, 0x91, 0x04, 0x4d, 0xe5, 0xdc, 0xde, 0x12, 0xde,
-		0xeb, 0x81, 0x46, 0x4e, 0x43, 0x67, 0x58, 0xdf, 0x69, 0x34, 0xa0, 0x9b, 0x49, 0xa7, 0x91, 0xa7,
-		0x58, 0xe8, 0xd1, 0xbb, 0x00, 0xb4, 0x7a, 0x70, 0x6e, 0xfa, 0xe7, 0xb6, 0xbd, 0x0a, 0x76, 0x9e,
-		0xa0, 0xc4, 0x1e, 0x1e, 0x5c, 0x9c, 0x80, 0xc6, 0x84, 0x6e, 0x08, 0x7e, 0x33, 0xc9, 0x53, 0x62,
-		0x9f, 0xc0, 0xf7, 0x5e, 0x30, 0x9f, 0x88, 0xfb, 0xe3, 0x8b, 0x83, 0xcf, 0xe0, 0x79, 0xe7, 0xb3,
-		0x1b, 0x2a, 0x8e, 0x41, 0xef, 0x2b, 0x45, 0x4f, 0x18, 0x87, 0x1d, 0x80, 0x63, 0x07, 0xc0, 0x6d,
-		0xbc, 0x61, 0xe0, 0xd3, 0x90, 0x13, 0xd0, 0xa7, 0x01, 0x7a, 0x2c, 0x9a, 0xcb, 0xfa, 0x82, 0xae,
-		0x80, 0x6d, 0x3d, 0xd8, 0xae, 0x35, 0x89, 0xea, 0xb4, 0xbe, 0xc3, 0xc6, 0xb1, 0x1e, 0x68, 0xfe,
-		0xcc, 0x6b, 0xb2, 0xf0, 0x0e, 0xba, 0xe7, 0x6b, 0xa0, 0x40, 0x2b, 0xb6, 0xe1, 0x7d, 0x48, 0x85,
-		0x0d, 0xff, 0x40, 0x8f, 0x52, 0x75, 0x7a, 0x5e, 0x04, 0xda, 0x4b, 0x2f, 0x81, 0x33, 0xca, 0x52,
-		0x11, 0x71, 0x02, 0xfb, 0x3c, 0x33, 0xf7, 0x8b, 0xcb, 0x92, 0x9c, 0x4f, 0xc8, 0x60, 0x0f, 0xb6,
-		0x5f, 0x28, 0x31, 0x1a, 0x0e, 0x8e, 0x0f, 0x60, 0x93, 0x94, 0xb1, 0xd5, 0x03, 0x3c, 0x01, 0x72,
-		0xc4, 0xcd, 0xac, 0x82, 0x39, 0xed, 0x8a, 0xa3, 0x40, 0xdc, 0x12, 0xe1, 0xc0, 0x7e, 0x09, 0xe2,
-		0xd3, 0x73, 0xea, 0x91, 0x7b, 0xd0, 0x88, 0x09, 0xb8, 0xd7, 0x7a, 0x92, 0xdb, 0xd5, 0x6e, 0x81,
-		0x3e, 0xda, 0x12, 0x74, 0x6e, 0xda, 0x3f, 0x1e, 0x6c, 0xdb, 0x87, 0xd4, 0x1b, 0xa7, 0x61, 0x38,
-		0xea, 0x10, 0x09, 0x5c, 0x43, 0xec, 0x49, 0x76, 0x16, 0x29, 0xe0, 0x2f, 0x71, 0xe9, 0x80, 0x56,
-		0xd4, 0x14, 0xf4, 0x61, 0xe6, 0x12, 0xb6, 0x4b, 0x57, 0xfa, 0x8e, 0x3c, 0xb8, 0x63, 0xc0, 0x2d,
-		0x37, 0xfb, 0xfb, 0x65, 0x70, 0x11, 0xff, 0x03, 0x5f, 0x1e, 0x1d, 0xf3, 0x15, 0x88, 0xec, 0x3b,
-		0x09, 0x06, 0xcc, 0x26, 0x1e, 0x74, 0xc7, 0x83, 0xa0, 0x83, 0xc8, 0x23, 0x02, 0xba, 0xc7, 0x7d,
-		0x52, 0x49, 0xe5, 0x32, 0xf8, 0x4e, 0xf9, 0x39, 0xf0, 0xe2, 0x23, 0x12, 0xba, 0x2f, 0xe8, 0xae,
-		0x51, 0x6f, 0x02, 0x4f, 0xe8, 0x72, 0x81, 0x27, 0x31, 0xc3, 0x3e, 0xcd, 0x3e, 0xe8, 0xed, 0xef,
-		0x13, 0xfb, 0x82, 0xda, 0xfb, 0x01, 0x57, 0x22, 0xf0, 0x3c, 0xea, 0x1c, 0x1f, 0x81, 0xd6, 0xd9,
-		0x0e, 0xff, 0xbb, 0xb1, 0x0a, 0x39, 0xf1, 0x17, 0x2b, 0x15, 0x42, 0xbd, 0x1a, 0x4c, 0x59, 0x92,
-		0xe4, 0x6f, 0x07, 0x9c, 0x53, 0x1b, 0x76, 0xf1, 0x0b, 0xd7, 0x23, 0x9c, 0xc6, 0x08, 0x04, 0x34,
-		0xf0, 0x90, 0xdb, 0x17, 0x6c, 0x00, 0x1d, 0xd2, 0x3a, 0xb2, 0x7e, 0x74, 0x70, 0x02, 0x1b, 0xcd,
-		0xc2, 0xce, 0x46, 0xf3, 0x03, 0x9b, 0x7c, 0xa1, 0x42, 0xb2, 0x80, 0xd7, 0xe1, 0xc7, 0xa1, 0x34,
-		0xfc, 0xfe, 0x4a, 0x64, 0xce, 0x1e, 0xfd, 0x0d, 0xfa, 0x54, 0xc3, 0xf6, 0xd5, 0x85, 0x81, 0x5c,
-		0x81, 0xe2, 0x4e, 0x2b, 0x52, 0x8d, 0xf1, 0x06, 0x78, 0x20, 0x47, 0x52, 0x4c, 0xd9, 0xa3, 0xe4,
-		0x06, 0x74, 0x18, 0x84, 0x14, 0xe1, 0x0a, 0xd4, 0xba, 0xb8, 0x14, 0x84, 0xcb, 0x30, 0x10, 0xd0,
-		0x6b, 0xb7, 0x1d, 0x9e, 0x80, 0xae, 0xc1, 0x33, 0xd8, 0xdd, 0xdd, 0x69, 0xc2, 0x46, 0xad, 0xcd,
-		0xd5, 0x30, 0xe9, 0x2e, 0xa8, 0xb8, 0x61, 0xb0, 0xab, 0xb6, 0x25, 0x84, 0xc1, 0x21, 0xb7, 0x61,
-		0xd7, 0x94, 0x0c, 0x03, 0xde, 0x59, 0x09, 0x56, 0xbf, 0xb9, 0x2f, 0x7d, 0x62, 0x3b, 0xf0, 0x43,
-		0xb5, 0x0e, 0x82, 0x5b, 0x0e, 0x9f, 0xbd, 0xf1, 0x43, 0x4f, 0x5e, 0x46, 0xd0, 0x43, 0x92, 0xd3,
-		0xd4, 0x27, 0xe8, 0x5d, 0x10, 0x46, 0xee, 0x48, 0xe0, 0x65, 0x57, 0x47, 0xf5, 0x6b, 0x3e, 0x33,
-		0xfb, 0x12, 0xf8, 0xe1, 0xb8, 0x69, 0x76, 0xe0, 0x6b, 0xbe, 0x53, 0xd8, 0x21, 0xe2, 0xd5, 0x81,
-		0xe4, 0xb0, 0xb1, 0xd4, 0x81, 0x67, 0xb3, 0x43, 0xee, 0xf4, 0x40, 0x1b, 0x15, 0x37, 0xa3, 0x6a,
-		0x54, 0x97, 0x03, 0xf8, 0xbe, 0xbc, 0x3f, 0xb9, 0xbd, 0x22, 0xde, 0x3c, 0xaf, 0x01, 0x3d, 0xa5,
-		0xcb, 0xbf, 0xf1, 0x56, 0xa1, 0x13, 0x08, 0x7c, 0x4c, 0xcb, 0xa4, 0x03, 0x3b, 0x52, 0x7c, 0x15,
-		0x02, 0x7c, 0x65, 0xed, 0x63, 0xc4, 0x1d, 0x8f, 0x02, 0xaf, 0xf7, 0x7e, 0xec, 0xc3, 0xce, 0x1c,
-		0x6f, 0x6e, 0x43, 0xaf, 0x57, 0xb3, 0xcf, 0x61, 0x77, 0xbc, 0x1a, 0x65, 0x74, 0xed, 0x03, 0x4f,
-		0xba, 0x26, 0xca, 0xef, 0x07, 0xb0, 0x3b, 0xfa, 0x4c, 0x6a, 0x59, 0x9f, 0x5d, 0x80, 0xef, 0x4b,
-		0x14, 0x02, 0x4f, 0x58, 0xb6, 0x49, 0xdf, 0xa3, 0x8f, 0xa4, 0xd3, 0xb9, 0xdb, 0x03, 0xee, 0xa7,
-		0x90, 0x81, 0xab, 0x6e, 0x89, 0xa0, 0x27, 0x41, 0x10, 0xf6, 0x81, 0xc7, 0xc2, 0xa7, 0x04, 0xd4,
-		0xd1, 0x4a, 0xe4, 0x0d, 0x8e, 0x99, 0x83, 0xa3, 0x4f, 0x07, 0xd0, 0xdd, 0x48, 0xb0, 0x4b, 0xcf,
-		0x11, 0x41, 0x60, 0x87, 0x8e, 0xb7, 0x7d, 0x0f, 0xb4, 0xdb, 0x45, 0x10, 0x87, 0x05, 0xa7, 0x7b,
-		0xfb, 0xab, 0xd1, 0x69, 0x66, 0xdf, 0x57, 0x72, 0x35, 0xdc, 0x16, 0x77, 0x3b, 0x9d, 0x13, 0x12,
-		0x4a, 0xe0, 0x79, 0x09, 0xa0, 0xa1, 0x61, 0xe8, 0xf0, 0x24, 0x12, 0x2d, 0x56, 0xdf, 0x75, 0xd8,
-		0xc0, 0x2a, 0xbc, 0x25, 0xe1, 0x41, 0xa0, 0xea, 0xf5, 0x8f, 0x12, 0xf4, 0x96, 0x8a, 0x3c, 0x05,
-		0x5b, 0x61, 0x10, 0xd8, 0xee, 0xbb, 0xb1, 0x8b, 0xbb, 0x01, 0x3c, 0x61, 0x7e, 0xd8, 0x6c, 0x34,
-		0x3f, 0x11, 0x45, 0xbf, 0x53, 0x1a, 0xc2, 0x2e, 0x45, 0x22, 0x42, 0xd8, 0x2d, 0x66, 0xc0, 0x3b,
-		0xe6, 0x07, 0xa2, 0x59, 0x6b, 0x9e, 0x1f, 0x5c, 0x82, 0xcf, 0xe0, 0x8c, 0x6d, 0xbb, 0x21, 0x25,
-		0xce, 0x0a, 0x14, 0x64, 0xec, 0x13, 0xc9, 0x6c, 0xe8, 0xc5, 0x6e, 0x81, 0x97, 0x31, 0x18, 0x4a,
-		0xc9, 0x80, 0x2b, 0x6a, 0xe0, 0x55, 0x18, 0x26, 0x49, 0xe6, 0x47, 0xac, 0x0f, 0xbf, 0xf7, 0xe6,
-		0x74, 0x1b, 0xaf, 0x55, 0xe8, 0x27, 0x2a, 0x59, 0x08, 0x3b, 0xd2, 0x60, 0xb2, 0xbb, 0x0e, 0x94,
-		0x58, 0x01, 0x3f, 0x8c, 0x47, 0x6e, 0x41, 0x07, 0xf7, 0x43, 0x6f, 0x35, 0xc8, 0xfa, 0x7e, 0x73,
-		0xbb, 0x16, 0x12, 0x11, 0xa3, 0x0f, 0xd8, 0xb5, 0xdd, 0x85, 0x0f, 0xbd, 0x23, 0x0e, 0xf0, 0x74,
-		0xa3, 0xd8, 0xb8, 0xeb, 0x89, 0xe0, 0xee, 0x7e, 0x05, 0xc4, 0x6b, 0x2b, 0xa9, 0x10, 0xf3, 0x11,
-		0xb6, 0x5f, 0x6f, 0xfc, 0x2e, 0x9d, 0x53, 0xe0, 0x91, 0x5c, 0xd4, 0x61, 0xe4, 0x94, 0x30, 0x0f,
-		0x7e, 0x07, 0x0a, 0xc9, 0xee, 0x2e, 0x83, 0xa3, 0x20, 0x12, 0xab, 0x50, 0x3a, 0xb3, 0xf3, 0xd7,
-		0xe9, 0xde, 0x67, 0xd8, 0x21, 0xc0, 0xbd, 0x5b, 0xe8, 0x45, 0xa2, 0x59, 0xb8, 0x02, 0x25, 0x71,
-		0xed, 0xc0, 0x0f, 0x03, 0xc9, 0xe0, 0xf7, 0x67, 0x91, 0x2c, 0xbc, 0x60, 0x03, 0xf8, 0x9d, 0xe1,
-		0x60, 0x97, 0xdf, 0x07, 0x1e, 0xdb, 0x35, 0xa9, 0x11, 0x03, 0x3a, 0xd1, 0x53, 0xb8, 0x76, 0xbd,
-		0x05, 0x3b, 0xd5, 0x36, 0x0c, 0x78, 0xbd, 0x0d, 0xba, 0x50, 0x0f, 0xb1, 0x15, 0xf5, 0x98, 0x3c,
-		0xa5, 0x8a, 0x9c, 0x9c, 0x9d, 0xc1, 0x3e, 0xd5, 0x1e, 0x70, 0x5a, 0x96, 0x85, 0x21, 0x4b, 0xca,
-		0x58, 0xb9, 0xc4, 0x86, 0x1e, 0x86, 0x3d, 0x6e, 0x20, 0x0f, 0xda, 0xa8, 0x70, 0x57, 0xa1, 0x84,
-		0xd5, 0xf3, 0x6a, 0x6f, 0xf0, 0x43, 0x37, 0x85, 0x6c, 0x34, 0x1b, 0xb0, 0x8b, 0x5a, 0x39, 0x14,
-		0x76, 0x6a, 0x6a, 0x1d, 0x74, 0xcc, 0x0d, 0x95, 0x36, 0xec, 0xce, 0x14, 0xb1, 0x7c, 0x5d, 0x81,
-		0xb4, 0x4e, 0xcf, 0x87, 0x5d, 0x15, 0x03, 0x36, 0xdc, 0x60, 0xd2, 0xe1, 0xa0, 0xb9, 0x4b, 0x05,
-		0xdc, 0x8a, 0x1b, 0xc5, 0xb9, 0x7f, 0xbc, 0x80, 0xee, 0xff, 0xed, 0x05, 0x8c, 0xab, 0xcb, 0x20,
-		0xf9, 0xe7, 0x82, 0x0a, 0x06, 0x1b, 0xf5, 0x0d, 0xa1, 0xb7, 0x6e, 0xbd, 0x6b, 0xb4, 0x87, 0x11,
-		0x57, 0x9f, 0x44, 0x00, 0xbb, 0xf4, 0xe7, 0x98, 0x43, 0x6e, 0x12, 0xe7, 0x84, 0x80, 0xe6, 0xcb,
-		0x42, 0xd8, 0x62, 0x2a, 0x50, 0xfc, 0x4c, 0x45, 0xd0, 0x53, 0x71, 0x40, 0x03, 0xd6, 0x30, 0x0c,
-		0x81, 0x4b, 0xa4, 0x10, 0x76, 0x5e, 0xb9, 0x63, 0xc3, 0x76, 0x8f, 0x42, 0xef, 0x37, 0xeb, 0x0a,
-		0xb7, 0xde, 0x39, 0x75, 0x05, 0xfc, 0x0a, 0x05, 0xa1, 0x08, 0x14, 0x0d, 0x78, 0xbd, 0x76, 0xda,
-		0x67, 0x0a, 0x7a, 0x2f, 0xac, 0x63, 0x1b, 0xb6, 0x09, 0x64, 0x73, 0x01, 0x3c, 0x3f, 0x10, 0xf6,
-		0xb1, 0xf6, 0x43, 0xd0, 0xc8, 0xe8, 0x1f, 0xd8, 0x69, 0x8c, 0x44, 0xde, 0x73, 0x7b, 0x35, 0x2a,
-		0xc5, 0x9e, 0xf6, 0x8e, 0x81, 0xe3, 0xbb, 0xd3, 0xc8, 0x53, 0xcc, 0x63, 0xfc, 0x3b, 0x7c, 0x15,
-		0x37, 0xae, 0xd6, 0x76, 0x1c, 0xee, 0x39, 0x8e, 0xa0, 0xb0, 0x93, 0x1a, 0xed, 0xc0, 0x75, 0x29,
-		0xe8, 0xd5, 0xb0, 0xa9, 0x84, 0x4e, 0x30, 0x7d, 0xfc, 0x6b, 0x2f, 0x6c, 0x9c, 0xc2, 0x4e, 0xe5,
-		0x97, 0xa2, 0x0f, 0xbc, 0x3a, 0x18, 0x68, 0xaa, 0x38, 0x29, 0xad, 0xff, 0x07, 0x70, 0xc2, 0xd8,
-		0xf1, 0x24, 0xe8, 0x54, 0x08, 0xaf, 0xc9, 0x42, 0xe8, 0x35, 0x23, 0x99, 0x74, 0x60, 0xd7, 0x47,
-		0x76, 0xd8, 0x80, 0x29, 0xe2, 0xfd, 0x25, 0x48, 0x18, 0x52, 0xb1, 0x42, 0x99, 0xa5, 0xd3, 0x25,
-		0x21, 0x1a, 0xe0, 0x2b, 0xbc, 0xcb, 0x4b, 0x07, 0x76, 0x21, 0xc3, 0x24, 0x71, 0xf9, 0xcb, 0x25,
-		0x74, 0xec, 0xb1, 0x02, 0x71, 0x4c, 0x44, 0xf9, 0xe3, 0x16, 0xd3, 0x2b, 0x11, 0xc8, 0xb4, 0x1a,
-		0x35, 0x84, 0x88, 0xf2, 0xcf, 0x89, 0xc3, 0x02, 0xd0, 0xf6, 0xb7, 0xcd, 0x42, 0xf0, 0x5d, 0xcb,
-		0x5d, 0x7b, 0x67, 0x7b, 0xfb, 0x0e, 0x76, 0xb7, 0x4d, 0x7b, 0x05, 0xea, 0x42, 0x08, 0xe6, 0x0c,
-		0x28, 0xfc, 0x4a, 0x4e, 0x7f, 0xa9, 0x89, 0xca, 0x00, 0x7f, 0xbc, 0x89, 0xf2, 0x8f, 0x22, 0xd8,
-		0xed, 0xf9, 0xfa, 0x4c, 0x49, 0xe8, 0x31, 0x10, 0xf5, 0xe6, 0x6e, 0x6b, 0x95, 0x4a, 0x44, 0xac,
-		0x86, 0xfe, 0x4e, 0x6b, 0xae, 0x26, 0x98, 0x04, 0x76, 0xc5, 0x71, 0xd8, 0x9d, 0xa6, 0x87, 0xd0,
-		0x5b, 0x70, 0x02, 0x67, 0x44, 0xa6, 0x2a, 0x18, 0xf6, 0x44, 0xe0, 0x32, 0xd8, 0xa7, 0x61, 0xdc,
-		0x8b, 0x73, 0x05, 0xf8, 0x90, 0xa4, 0xfa, 0xd9, 0x31, 0xec, 0xea, 0x67, 0xff, 0x34, 0x76, 0x9b,
-		0x75, 0xd8, 0x1e, 0x6e, 0x3a, 0x58, 0x8d, 0xbe, 0xae, 0x37, 0xcc, 0x66, 0x67, 0xa3, 0xc3, 0x71,
-		0x58, 0xb0, 0xa4, 0x4d, 0xae, 0x2b, 0xaf, 0xdf, 0x95, 0x30, 0x69, 0xf9, 0x26, 0xeb, 0xd1, 0x20,
-		0x76, 0x9c, 0x1c, 0x70, 0xb9, 0xfc, 0xe7, 0x8c, 0x21, 0x59, 0xae, 0x66, 0x85, 0xe5, 0x3f, 0x2a,
-		0x11, 0x36, 0xa7, 0x0a, 0xc2, 0x93, 0x4e, 0xaa, 0x6e, 0xed, 0x9f, 0x1f, 0xf5, 0x8e, 0x39, 0x88,
-		0x47, 0xee, 0x0f, 0xc2, 0x30, 0xf0, 0x98, 0x7d, 0x4f, 0x6c, 0x3b, 0x88, 0xb8, 0x62, 0x7c, 0x00,
-		0xe1, 0xb1, 0xfd, 0x30, 0x4f, 0x83, 0x99, 0xf2, 0x9f, 0x33, 0x50, 0xfc, 0xcc, 0x89, 0x40, 0x9c,
-		0xb2, 0x51, 0xeb, 0xcf, 0x42, 0x4d, 0xf9, 0xcb, 0x7f, 0xea, 0x49, 0x03, 0xb4, 0x66, 0xbe, 0x58,
-		0xca, 0x05, 0x88, 0x88, 0x71, 0x59, 0x9c, 0x06, 0xa4, 0x87, 0xad, 0x83, 0x78, 0x58, 0xee, 0x32,
-		0xce, 0xfa, 0x24, 0x4f, 0xc3, 0xa3, 0x05, 0x1c, 0xb7, 0x7c, 0x39, 0x46, 0xe5, 0x3f, 0xa8, 0x17,
-		0xd8, 0xc4, 0xbb, 0x24, 0xde, 0x77, 0x10, 0xe2, 0x20, 0xf4, 0x84, 0x84, 0x81, 0x69, 0xf2, 0xb4,
-		0x83, 0x5f, 0x80, 0x9e, 0xbd, 0x17, 0x0c, 0x08, 0xf8, 0x22, 0x5e, 0xf4, 0x29, 0x6f, 0xef, 0xea,
-		0x05, 0x3c, 0x2d, 0xf1, 0xda, 0x10, 0x9e, 0x73, 0xd4, 0x80, 0xed, 0xef, 0x33, 0x10, 0x73, 0xaa,
-		0xfc, 0x8b, 0xa8, 0x5f, 0xa0, 0x7c, 0x4a, 0xf9, 0x8f, 0x3c, 0x0e, 0xc5, 0xe9, 0x05, 0xb7, 0x54,
-		0x78, 0x8c, 0x83, 0x78, 0xe6, 0xa4, 0x48, 0x0d, 0x84, 0x07, 0x75, 0x89, 0x04, 0x21, 0xb9, 0x1e,
-		0x1b, 0x24, 0xe5, 0x2e, 0x9d, 0xbd, 0x18, 0x02, 0x61, 0xe2, 0xcf, 0xe9, 0x35, 0x7a, 0x20, 0xa0,
-		0x0c, 0x09, 0xfb, 0x20, 0x94, 0x2e, 0x0b, 0x72, 0x45, 0x23, 0x94, 0xff, 0xa4, 0xdc, 0x25, 0x12,
-		0x8e, 0x16, 0xfb, 0x74, 0x70, 0xb8, 0xf7, 0x79, 0x0f, 0x04, 0x6d, 0xf0, 0xc4, 0x05, 0x91, 0x33,
-		0x8f, 0xbf, 0xfc, 0xc7, 0xb6, 0x99, 0xb4, 0x83, 0xe3, 0x8b, 0x93, 0x7c, 0x11, 0xbe, 0x0b, 0x78,
-		0x5e, 0x5b, 0x1d, 0xfa, 0x91, 0x07, 0x45, 0x97, 0xe5, 0x24, 0xdd, 0xcb, 0x7f, 0x58, 0x25, 0x6a,
-		0xb5, 0x1d, 0x28, 0x4a, 0x6c, 0x3a, 0x30, 0x61, 0x52, 0x21, 0x0d, 0x84, 0x36, 0x63, 0x9c, 0x0e,
-		0xa0, 0x48, 0x86, 0x1b, 0xff, 0x96, 0x08, 0x3a, 0x0e, 0x8b, 0xfa, 0xcc, 0x6c, 0x20, 0x38, 0x97,
-		0x0a, 0x8f, 0xf0, 0x06, 0x20, 0xfa, 0x16, 0x84, 0xcb, 0x41, 0xda, 0x50, 0x18, 0x3a, 0x08, 0xcf,
-		0x39, 0x60, 0x03, 0xd2, 0x67, 0x23, 0xe5, 0x00, 0x84, 0xaa, 0x99, 0xa9, 0x48, 0x99, 0x3b, 0xd6,
-		0x62, 0x01, 0x98, 0xa1, 0xef, 0x7d, 0xa1, 0xb6, 0x22, 0x17, 0x8a, 0x80, 0xd0, 0xc5, 0x49, 0x12,
-		0x46, 0x8f, 0xa8, 0x21, 0x10, 0x7d, 0xac, 0x68, 0xc0, 0x77, 0x6a, 0x50, 0xdc, 0x39, 0xc3, 0x40,
-		0xaa, 0x5c, 0x0d, 0x65, 0x16, 0xe0, 0x1f, 0x01, 0x01, 0x14, 0x22, 0x09, 0xc2, 0x44, 0x1f, 0x15,
-		0xc0, 0x07, 0x81, 0x0c, 0x86, 0xce, 0xb0, 0xbe, 0xd3, 0x68, 0x40, 0x81, 0xe3, 0x49, 0x15, 0x84,
-		0xd0, 0xa3, 0x77, 0x01, 0x08, 0xf1, 0x9a, 0x64, 0xea, 0xd9, 0x90, 0xec, 0x06, 0x41, 0x89, 0x3d,
-		0x3c, 0xb8, 0x38, 0x01, 0x81, 0x6d, 0xdc, 0x10, 0xcc, 0x26, 0x90, 0xa7, 0xc4, 0x3e, 0x81, 0xc3,
-		0xde, 0x32, 0x9f, 0x88, 0xfb, 0x7c, 0x5d, 0x58, 0x17, 0xc4, 0xdf, 0xe5, 0x6d, 0xec, 0xb4, 0x00,
-		0xfe, 0x23, 0x67, 0x8b, 0x9a, 0x05, 0x38, 0xcc, 0xed, 0x00, 0x88, 0xcd, 0x30, 0x0c, 0x7c, 0x1a,
-		0x72, 0x02, 0x62, 0xb7, 0x42, 0x89, 0xed, 0x70, 0x59, 0x5f, 0x50, 0x40, 0xb6, 0xd7, 0x60, 0xbb,
-		0xd6, 0x24, 0xaa, 0xd3, 0xfa, 0x0e, 0x03, 0x77, 0x79, 0x20, 0x78, 0x0e, 0xaf, 0xc9, 0xc2, 0x3b,
-		0x28, 0x8c, 0xfd, 0x40, 0x81, 0x50, 0x00, 0xc3, 0xfb, 0x90, 0x0a, 0x1b, 0xce, 0xc1, 0x1a, 0x85,
-		0x58, 0xf7, 0xbc, 0x08, 0x84, 0x37, 0x4f, 0x02, 0x61, 0xe6, 0xa4, 0x22, 0xe2, 0x04, 0xc6, 0xb9,
-		0x62, 0xee, 0x17, 0x97, 0xe5, 0xeb, 0x72, 0xb8, 0x00, 0xd0, 0x02, 0x83, 0xe7, 0x4e, 0x40, 0xeb,
-		0xc1, 0xf1, 0x01, 0x0c, 0x72, 0x28, 0x46, 0xd7, 0x40, 0x12, 0x42, 0x46, 0x36, 0x37, 0x24, 0x73,
-		0xcb, 0x15, 0xb9, 0xfb, 0x6f, 0x2e, 0xe2, 0x61, 0x27, 0x35, 0x35, 0x41, 0x20, 0x02, 0x20, 0x5e,
-		0xaf, 0x49, 0x2c, 0x7d, 0xbb, 0x05, 0xe2, 0x88, 0x49, 0x10, 0x31, 0xff, 0xff, 0x78, 0x30, 0x30,
-		0x36, 0xa9, 0x37, 0x4e, 0xc3, 0xf0, 0xe2, 0x96, 0x29, 0x7b, 0x08, 0x44, 0xc2, 0xee, 0x49, 0x76,
-		0x16, 0x29, 0x20, 0x0f, 0x7b, 0xe9, 0x80, 0x50, 0x5c, 0x14, 0xc4, 0xa1, 0xe2, 0x12, 0x86, 0xab,
-		0x48, 0xfa, 0x8e, 0x3c, 0xb8, 0x63, 0x40, 0x2c, 0x01, 0xfb, 0xfb, 0x65, 0x70, 0x11, 0xff, 0x03,
-		0xe7, 0xfc, 0x1f, 0x73, 0x40, 0x91, 0x2f, 0xe3, 0xfa, 0x76, 0x50, 0x88, 0x57, 0x41, 0x07, 0x91,
-		0x47, 0x04, 0x14, 0xcf, 0xdc, 0x24, 0xb3, 0xf9, 0x32, 0xf8, 0x4e, 0xf9, 0x39, 0x90, 0x24, 0x61,
-		0x09, 0x85, 0xdb, 0xbe, 0x6b, 0xd4, 0x9b, 0x40, 0x02, 0xe8, 0x5d, 0x20, 0xc9, 0x55, 0x30, 0x4e,
-		0x95, 0x0f, 0x62, 0x7b, 0xfa, 0xc4, 0xbe, 0xa0, 0xf6, 0x7e, 0xc0, 0x95, 0x08, 0x3c, 0x8f, 0x3a,
-		0xc7, 0x47, 0x20, 0x74, 0x98, 0xc3, 0xff, 0x6e, 0x40, 0xca, 0xb1, 0xbb, 0x00, 0x19, 0xba, 0x07,
-		0x8b, 0xd1, 0x48, 0x92, 0x03, 0xed, 0x80, 0x73, 0x6a, 0xc3, 0x48, 0x6a, 0x75, 0x3d, 0xc2, 0x69,
-		0xac, 0x79, 0x41, 0x28, 0x5c, 0xb9, 0x9d, 0xbb, 0x5f, 0xfa, 0x42, 0x38, 0x8e, 0xa3, 0x83, 0x13,
-		0x18, 0xe8, 0x0b, 0x46, 0x94, 0xbf, 0x1f, 0xd8, 0xe4, 0x0b, 0x15, 0x92, 0x05, 0xbc, 0x0e, 0xc7,
-		0x9f, 0xdc, 0xf0, 0xfb, 0xa0, 0x32, 0x80, 0x8e, 0xfe, 0x06, 0x71, 0xba, 0x60, 0xf8, 0x12, 0xc2,
-		0x40, 0x02, 0x2a, 0x6e, 0x00, 0xac, 0x3a, 0xcf, 0x0d, 0x10, 0x47, 0x2d, 0x8b, 0x51, 0x81, 0x47,
-		0xc9, 0x0d, 0x08, 0xf7, 0xa7, 0x14, 0x21, 0xa0, 0x1c, 0xd6, 0x02, 0xa5, 0x0f, 0x17, 0xc3, 0x25,
-		0x9d, 0x80, 0xc8, 0x65, 0x1f, 0xec, 0xee, 0xee, 0x34, 0x61, 0xa0, 0xac, 0x26, 0x2c, 0x13, 0xe1,
-		0x82, 0x8a, 0x1b, 0x06, 0xa3, 0x7a, 0x48, 0x62, 0x38, 0x1e, 0x72, 0x1b, 0x46, 0x2d, 0xa1, 0x30,
-		0xe0, 0x1d, 0x50, 0x6c, 0x67, 0x73, 0x5f, 0xfa, 0xc4, 0x76, 0xe0, 0x84, 0x44, 0x14, 0xa9, 0xab,
-		0xbe, 0x98, 0x1a, 0x8e, 0x97, 0x11, 0x94, 0x10, 0xb9, 0x34, 0x04, 0x1d, 0x4a, 0xd5, 0xd4, 0x91,
-		0x5b, 0x04, 0x48, 0x39, 0xac, 0x51, 0x7e, 0xf8, 0x67, 0x66, 0x5f, 0x02, 0xd9, 0xbc, 0x37, 0xcd,
-		0x0e, 0x1c, 0x0d, 0x71, 0x0a, 0x23, 0x04, 0xb1, 0x3a, 0x90, 0x1c, 0x06, 0x56, 0x38, 0xf0, 0x6c,
-		0x76, 0xc8, 0x9d, 0x1e, 0x08, 0x50, 0x3b, 0x6e, 0xc6, 0x7b, 0x39, 0x80, 0xe3, 0x6b, 0xf8, 0x93,
-		0xdb, 0xc0, 0xbc, 0x0d, 0x5e, 0x03, 0x4a, 0x08, 0xbd, 0x7f, 0xe3, 0x41, 0xaa, 0xec, 0x0b, 0x07,
-		0x83, 0x31, 0xe9, 0xc0, 0x88, 0x44, 0x84, 0x14, 0x88, 0x26, 0x6b, 0x79, 0xbb, 0xa1, 0x2f, 0xa4,
-		0xfe, 0xe4, 0xb1, 0x0f, 0x23, 0x43, 0xad, 0xb9, 0x0d, 0x25, 0x1f, 0x7c, 0x9f, 0xc3, 0xa8, 0xf4,
-		0x3e, 0x8a, 0xa0, 0xdf, 0x07, 0x92, 0xf4, 0x45, 0x94, 0xdf, 0x0f, 0x60, 0x54, 0xca, 0x9e, 0xd4,
-		0xee, 0x3b, 0xbb, 0x00, 0x53, 0xd7, 0x3b, 0x04, 0x92, 0x48, 0x65, 0x3f, 0x6d, 0xa6, 0x7a, 0xee,
-		0xf6, 0x80, 0xf0, 0xb4, 0x32, 0x70, 0x55, 0x6c, 0x30, 0x9e, 0x04, 0x41, 0xd8, 0x07, 0x12, 0x53,
-		0x99, 0x12, 0x08, 0x47, 0xa0, 0xf2, 0x2b, 0xc6, 0x16, 0xe4, 0xd1, 0xa7, 0x03, 0x28, 0xb4, 0x38,
-		0x8c, 0x52, 0x27, 0x44, 0x10, 0x18, 0xa1, 0x89, 0x6d, 0xdf, 0x03, 0x41, 0x2f, 0x0b, 0xe2, 0xb0,
-		0xe0, 0x74, 0x6f, 0x1f, 0x56, 0xc5, 0xe7, 0x7d, 0x5f, 0x49, 0x58, 0xb4, 0xed, 0xdd, 0x4e, 0xe7,
-		0x84, 0x84, 0x12, 0x48, 0xbc, 0x2a, 0x08, 0x88, 0x13, 0x3a, 0x3c, 0x89, 0xec, 0x88, 0xd5, 0x59,
-		0x1d, 0x06, 0x70, 0x48, 0x1b, 0x3e, 0x7e, 0x94, 0x20, 0xb6, 0x42, 0xe4, 0x29, 0x18, 0x02, 0x97,
-		0xc0, 0x70, 0x2f, 0x8c, 0x5d, 0x64, 0x0d, 0x20, 0x09, 0x78, 0xc3, 0x66, 0xa3, 0xf9, 0x89, 0x28,
-		0xfa, 0x9d, 0xd2, 0x10, 0x46, 0xca, 0xb0, 0x08, 0x61, 0x94, 0x7a, 0x06, 0xe3, 0xc0, 0x4b, 0x9a,
-		0xa1, 0x9e, 0xe7, 0x69, 0x86, 0xba, 0xa0, 0xcc, 0x95, 0xd8, 0x56, 0x18, 0x52, 0xe2, 0x00, 0x2a,
-		0xd0, 0xd3, 0x27, 0x92, 0xd9, 0x50, 0x8a, 0x8a, 0x01, 0x49, 0x73, 0x1c, 0x4a, 0xc9, 0x80, 0x28,
-		0x2e, 0x20, 0xd9, 0x98, 0x93, 0x64, 0xb6, 0x23, 0xd6, 0x87, 0xd3, 0x6b, 0x65, 0xba, 0x4c, 0x3d,
-		0xa4, 0x3e, 0x31, 0x92, 0x85, 0x30, 0x3c, 0x8f, 0x93, 0x5d, 0x71, 0xa0, 0x04, 0x20, 0xbe, 0xd9,
-		0x23, 0xb7, 0x30, 0xfa, 0xa0, 0x02, 0x69, 0x5d, 0xc1, 0xfa, 0x7e, 0x73, 0xbb, 0x16, 0x12, 0x11,
-		0x6b, 0x5d, 0x18, 0xb5, 0x26, 0x85, 0x0f, 0xa5, 0x02, 0x35, 0x90, 0x70, 0xf0, 0xd8, 0x58, 0xe8,
-		0x89, 0xe0, 0xee, 0x1e, 0x90, 0xd8, 0x6a, 0x25, 0x99, 0xd9, 0x1f, 0x61, 0xf8, 0x1d, 0xc6, 0xcf,
-		0xdc, 0x39, 0x05, 0x12, 0x31, 0x41, 0x1d, 0x46, 0x4e, 0x09, 0xf3, 0xe0, 0x54, 0xa0, 0x95, 0xec,
-		0xee, 0x32, 0x38, 0x0a, 0x22, 0x01, 0xa9, 0x34, 0x52, 0xe7, 0xaf, 0xd3, 0xbd, 0xcf, 0x30, 0x42,
-		0xd5, 0x7a, 0xb7, 0x50, 0x8a, 0xe5, 0xb1, 0x10, 0x50, 0xe9, 0x31, 0x3b, 0xf0, 0xc3, 0x40, 0x32,
-		0x38, 0xf5, 0x93, 0x25, 0x0b, 0x2f, 0xd8, 0x00, 0x4e, 0xc7, 0x02, 0x18, 0xe5, 0x3d, 0x81, 0xc4,
-		0x50, 0x4c, 0x72, 0xb3, 0x41, 0x24, 0xb8, 0x08, 0xd7, 0xae, 0xb7, 0x60, 0xa4, 0x0c, 0x85, 0x01,
-		0xaf, 0xb7, 0x41, 0x24, 0xbc, 0x13, 0x5b, 0x51, 0x8f, 0xc9, 0x53, 0xaa, 0xc8, 0xc9, 0xd9, 0x19,
-		0x8c, 0xd3, 0xe5, 0x01, 0xa1, 0xbd, 0x58, 0x18, 0x32, 0x50, 0xbd, 0x9c, 0x89, 0xf2, 0xc7, 0x0d,
-		0xee, 0x40, 0x80, 0x5a, 0x17, 0x52, 0xa9, 0x86, 0xe7, 0x55, 0x47, 0xe0, 0x84, 0x2a, 0x09, 0xd9,
-		0x68, 0x36, 0x60, 0x14, 0x6f, 0x70, 0x28, 0x8c, 0xd4, 0x9b, 0x3a, 0x08, 0x1f, 0x39, 0x95, 0x36,
-		0x8c, 0xca, 0xb4, 0xb1, 0xdc, 0x02, 0x94, 0xce, 0xe2, 0xf9, 0x30, 0xb2, 0x5d, 0x61, 0xa8, 0x59,
-		0x26, 0x1d, 0x0e, 0x82, 0x33, 0x52, 0x40, 0xac, 0x82, 0x51, 0xbc, 0xe4, 0xc7, 0x0b, 0x28, 0x7e,
-		0xa5, 0x5e, 0xc0, 0xb8, 0xba, 0x0c, 0x92, 0x7f, 0x2e, 0xa8, 0x60, 0x30, 0xd0, 0xcb, 0x10, 0x4a,
-		0x8b, 0x9d, 0xbb, 0x46, 0x7b, 0x18, 0x71, 0x05, 0xa6, 0x85, 0xfb, 0x98, 0x8b, 0x6b, 0x12, 0xe7,
-		0x84, 0x80, 0xe0, 0x35, 0x42, 0x18, 0x62, 0x21, 0x50, 0xfc, 0x4c, 0x45, 0x50, 0x42, 0xa8, 0x41,
-		0x00, 0xac, 0x30, 0x0c, 0x81, 0x48, 0x80, 0x10, 0x46, 0xfe, 0x9a, 0x63, 0xc3, 0x70, 0xc7, 0x40,
-		0xe9, 0xff, 0xe3, 0x0a, 0xb7, 0xde, 0x39, 0x75, 0x05, 0x9c, 0x0c, 0xc6, 0x71, 0xff, 0xe5, 0x3a,
-		0x98, 0xfe, 0xcb, 0xd2, 0x77, 0xe4, 0xb1, 0x0d, 0x03, 0x6a, 0xdb, 0x5c, 0x00, 0xc9, 0xa3, 0x80,
-		0x71, 0xbc, 0xfc, 0x10, 0x84, 0xe6, 0xff, 0x07, 0x46, 0x5a, 0x07, 0x91, 0xf7, 0xdc, 0x86, 0x55,
-		0xa9, 0xeb, 0xb4, 0x77, 0x0c, 0x04, 0xa7, 0x24, 0x4d, 0xad, 0x3d, 0xc6, 0xbf, 0xc3, 0x51, 0x05,
-		0xe3, 0xaa, 0x21, 0xc7, 0xe1, 0x9e, 0xe3, 0x08, 0x0a, 0x23, 0xc9, 0xc3, 0x0e, 0x5c, 0x97, 0x82,
-		0x98, 0x5d, 0x9b, 0x4a, 0x28, 0x04, 0xc1, 0xc7, 0xbf, 0xf6, 0xc2, 0xc6, 0x29, 0x8c, 0x14, 0x40,
-		0x29, 0xfa, 0x40, 0xaa, 0x5a, 0x80, 0xa0, 0xdc, 0x92, 0xd2, 0x9d, 0x7f, 0x00, 0x21, 0xde, 0x1c,
-		0x4f, 0xde, 0x42, 0xe9, 0xb7, 0x0b, 0xa5, 0x56, 0x10, 0x93, 0x0e, 0x8c, 0xfa, 0x71, 0x0e, 0x1b,
-		0x30, 0x45, 0xbc, 0xbf, 0x04, 0x09, 0x43, 0x2a, 0x00, 0x66, 0xce, 0x4c, 0xa7, 0x80, 0x36, 0xc0,
-		0x54, 0x9c, 0x94, 0x97, 0x0e, 0x8c, 0x82, 0x37, 0x49, 0x42, 0xd5, 0x97, 0x4b, 0x28, 0x3a, 0x17,
-		0x50, 0x1c, 0x01, 0x51, 0xfe, 0xb8, 0xf5, 0x16, 0xa8, 0x40, 0x02, 0x58, 0x39, 0xf7, 0x44, 0xf9,
-		0xe7, 0xc4, 0x61, 0x01, 0x08, 0xfb, 0xcc, 0x66, 0x21, 0x98, 0x2e, 0x6c, 0xae, 0xbd, 0xb3, 0xbd,
-		0x7d, 0x07, 0xa3, 0xbb, 0x0a, 0xa0, 0x7e, 0xf2, 0x7d, 0xc1, 0x9c, 0x01, 0x85, 0x53, 0xc9, 0xe0,
-		0x2f, 0x35, 0x11, 0xb9, 0x60, 0x8e, 0x19, 0x51, 0xfe, 0x51, 0x04, 0xa3, 0x0d, 0x44, 0x9f, 0x29,
-		0x09, 0xc5, 0xf7, 0x59, 0x6f, 0xee, 0xb6, 0x20, 0xa6, 0x84, 0xc2, 0xd2, 0x67, 0x69, 0x2d, 0xac,
-		0x44, 0x17, 0xc3, 0xa8, 0x98, 0x08, 0xa3, 0x03, 0xd7, 0x10, 0x4a, 0xcb, 0x15, 0x20, 0x16, 0xf0,
-		0x54, 0xa5, 0x9b, 0x9e, 0x08, 0x5c, 0x06, 0x63, 0xb7, 0x8e, 0x7b, 0xaf, 0x00, 0xb2, 0x7f, 0x93,
-		0xea, 0x1c, 0xc7, 0x30, 0xaa, 0x73, 0xfc, 0xd3, 0xd8, 0x6d, 0xd6, 0x61, 0x78, 0xc8, 0xe8, 0x00,
-		0x56, 0xff, 0x9d, 0x1b, 0x66, 0xb3, 0xb3, 0xd1, 0xe6, 0x3d, 0xcc, 0x99, 0x32, 0x9e, 0xe9, 0x8a,
-		0xeb, 0x77, 0x7a, 0xc7, 0x9d, 0x73, 0xca, 0x2a, 0xf4, 0x4e, 0x09, 0x52, 0x8d, 0xb8, 0x54, 0x89,
-		0x02, 0xec, 0x66, 0x98, 0xb8, 0x8a, 0xa0, 0x2e, 0x15, 0x94, 0xdb, 0xf1, 0x65, 0x5f, 0xdf, 0x99,
-		0x5d, 0x9d, 0x74, 0x65, 0xce, 0x8f, 0xf6, 0xad, 0xc6, 0x4e, 0xa7, 0xd9, 0xb5, 0x2e, 0x87, 0xd4,
-		0x4a, 0xf3, 0x32, 0xa4, 0x95, 0x44, 0xb6, 0x59, 0xa7, 0xc7, 0x1f, 0xad, 0xaa, 0xc5, 0xdc, 0x24,
-		0x2b, 0x32, 0xfb, 0xb6, 0xa9, 0x5c, 0x04, 0x91, 0xb0, 0xb3, 0xcd, 0xc3, 0x93, 0xeb, 0xff, 0x4b,
-		0xef, 0x6f, 0x03, 0xe1, 0x8c, 0x3a, 0x6b, 0x4f, 0xa6, 0xe7, 0x7d, 0xbe, 0xb1, 0xfe, 0x20, 0x72,
-		0x4f, 0x0c, 0x22, 0x9f, 0x72, 0x55, 0xe9, 0x5a, 0x4a, 0x44, 0x34, 0xe7, 0x40, 0x53, 0xa3, 0x64,
-		0x9a, 0x3f, 0xc3, 0x7b, 0x7e, 0xfe, 0x6f, 0x5f, 0x6b, 0xdd, 0xf3, 0x7b, 0x9c, 0x07, 0x8a, 0x28,
-		0x16, 0xf0, 0x6c, 0xfb, 0xfd, 0x7e, 0x10, 0xa8, 0x6a, 0x60, 0x57, 0xed, 0xc0, 0x0f, 0x05, 0x95,
-		0x92, 0x3a, 0x55, 0x8f, 0x12, 0x37, 0x1e, 0x64, 0xce, 0x43, 0xf9, 0xae, 0xd8, 0x37, 0xde, 0x78,
-		0xc1, 0xac, 0x2f, 0x56, 0x91, 0xf6, 0x90, 0xfa, 0x24, 0x24, 0x6a, 0x18, 0xef, 0x8c, 0x0f, 0x41,
-		0x48, 0xb9, 0x1d, 0x70, 0x97, 0x0d, 0xaa, 0x2c, 0xdd, 0x17, 0x1f, 0x5e, 0xfa, 0xf8, 0x61, 0xf4,
-		0xb5, 0x5f, 0x6f, 0x90, 0xd7, 0xdf, 0xe5, 0x17, 0xef, 0x91, 0x96, 0xdb, 0x7f, 0xf3, 0x0d, 0x66,
-		0x0a, 0xf4, 0xbf, 0x71, 0xcc, 0x2a, 0xff, 0x65, 0x3c, 0x3e, 0x97, 0xf5, 0x37, 0xbe, 0xb6, 0x3f,
-		0x7a, 0xb5, 0xae, 0x55, 0x7b, 0xe3, 0x8b, 0x3d, 0x41, 0x5d, 0x76, 0x37, 0xdf, 0x4c, 0xa7, 0x78,
-		0xc8, 0xae, 0x52, 0x35, 0x9c, 0x43, 0x20, 0x64, 0x15, 0x44, 0xd3, 0x82, 0x27, 0x1c, 0x3d, 0xd8,
-		0x9c, 0xa7, 0x21, 0xaf, 0x94, 0x79, 0x22, 0x55, 0xc6, 0xef, 0x65, 0x78, 0x7f, 0x1f, 0x30, 0x31,
-		0xdf, 0x74, 0xdb, 0x93, 0x35, 0x9c, 0x73, 0xf2, 0x1e, 0xfd, 0xf6, 0xc9, 0x75, 0x73, 0x4e, 0xc0,
-		0x7c, 0x1b, 0x2a, 0xf3, 0xc6, 0xca, 0xb3, 0xc1, 0xf2, 0x6f, 0xb4, 0xa2, 0x9a, 0x2f, 0xf7, 0xc6,
-		0xd3, 0xa6, 0xe6, 0x72, 0x6d, 0x44, 0x33, 0x68, 0x6a, 0xde, 0x0d, 0x9a, 0x5e, 0x40, 0x06, 0x03,
-		0x41, 0x07, 0x44, 0xd1, 0x2a, 0x73, 0xb2, 0x4f, 0x7d, 0x8a, 0x56, 0xa7, 0x47, 0xc9, 0x38, 0x79,
-		0xe3, 0x4d, 0x5c, 0xcb, 0x78, 0x59, 0xd6, 0xcd, 0x5c, 0x64, 0x53, 0xbf, 0xb4, 0xb9, 0x3d, 0x32,
-		0x58, 0x30, 0xbc, 0xcb, 0xb5, 0xd9, 0xcd, 0x62, 0xbb, 0xf1, 0xbc, 0x98, 0x86, 0x6d, 0x19, 0x57,
-		0x3c, 0x81, 0x92, 0x85, 0xd6, 0x3b, 0x46, 0x59, 0x82, 0xba, 0x79, 0x16, 0x7c, 0x22, 0xa3, 0xb7,
-		0x73, 0x5c, 0xdb, 0x4b, 0x81, 0x91, 0x5d, 0x65, 0x6e, 0x77, 0x0a, 0x08, 0x3d, 0xfb, 0xc5, 0xf8,
-		0x67, 0x1e, 0x3f, 0xae, 0xd1, 0xa9, 0xcc, 0x89, 0x5c, 0xf5, 0x21, 0xd8, 0xec, 0x3b, 0x26, 0xc3,
-		0x2b, 0x56, 0x48, 0xa4, 0x82, 0x2a, 0xa7, 0x83, 0x40, 0x31, 0xa2, 0x68, 0x01, 0x79, 0xf8, 0x74,
-		0x9c, 0x8c, 0x93, 0x7c, 0x40, 0x5d, 0x12, 0x79, 0x2a, 0xb3, 0x51, 0x9b, 0x5c, 0x1c, 0x9f, 0xe4,
-		0x6c, 0x7b, 0xe0, 0x7a, 0x3d, 0x04, 0x76, 0x36, 0x34, 0xb2, 0x3e, 0x02, 0x3b, 0x13, 0x5a, 0x81,
-		0x22, 0xb0, 0xfb, 0x41, 0xe0, 0x51, 0xc2, 0x8b, 0x08, 0xec, 0xba, 0xd1, 0x57, 0x2c, 0x40, 0x7b,
-		0x69, 0xa0, 0xbf, 0x8a, 0x91, 0x94, 0x4f, 0x66, 0xfa, 0xf8, 0xf0, 0xf0, 0xd0, 0xda, 0xa9, 0x35,
-		0xb6, 0x9a, 0xd5, 0x46, 0xad, 0xde, 0xb0, 0x9e, 0x88, 0x3e, 0x16, 0x70, 0x2b, 0x89, 0x9d, 0xf4,
-		0x99, 0x94, 0xf1, 0x0f, 0x21, 0x11, 0xc4, 0xa7, 0x8a, 0x0a, 0x99, 0xf3, 0x18, 0xe8, 0x38, 0x93,
-		0x26, 0xb8, 0x32, 0xed, 0xc7, 0xf4, 0xc5, 0xe3, 0x9a, 0x7b, 0xb2, 0x73, 0x3f, 0xc2, 0xc3, 0xbb,
-		0x72, 0xae, 0xba, 0x46, 0xd8, 0x92, 0x1f, 0xb6, 0x38, 0x51, 0xe8, 0xd1, 0xbb, 0xaa, 0x1f, 0x38,
-		0x05, 0x30, 0xcb, 0xf4, 0x20, 0x88, 0x08, 0x10, 0x11, 0xac, 0x18, 0x22, 0xa0, 0x3c, 0xf2, 0xa9,
-		0x18, 0xc9, 0x80, 0x02, 0xa8, 0xa0, 0x95, 0xe3, 0xda, 0x43, 0x1e, 0xf9, 0x39, 0x44, 0x05, 0xca,
-		0xb8, 0x27, 0x80, 0x89, 0xc7, 0x40, 0xa9, 0xea, 0x7a, 0xc1, 0x6d, 0x75, 0xdc, 0x12, 0x39, 0xbf,
-		0xac, 0x7b, 0x69, 0xb0, 0x32, 0x8d, 0x34, 0x97, 0x78, 0x12, 0xad, 0x34, 0x94, 0xc9, 0x68, 0xa5,
-		0xa1, 0x95, 0x56, 0xb2, 0x95, 0x76, 0x87, 0x86, 0x57, 0x21, 0xc3, 0xeb, 0x0e, 0x6d, 0xa9, 0x95,
-		0xc6, 0x19, 0x3e, 0xb1, 0xab, 0x64, 0x9c, 0xe8, 0x9d, 0x1b, 0x5f, 0x4c, 0x0f, 0x82, 0x7a, 0x1b,
-		0xf5, 0xf6, 0x8a, 0xe9, 0xed, 0xfc, 0xdb, 0xfb, 0x89, 0xee, 0xde, 0xc9, 0xe7, 0x12, 0x53, 0x54,
-		0xf0, 0xdc, 0xba, 0xb4, 0xf2, 0xb5, 0x56, 0xdd, 0x25, 0x55, 0x77, 0xaf, 0x7a, 0x74, 0xfd, 0xa3,
-		0xf1, 0xb0, 0xd1, 0x7d, 0xfa, 0xf3, 0xe6, 0x8f, 0xf6, 0x43, 0x05, 0x05, 0x6e, 0x99, 0x02, 0x37,
-		0x0c, 0x84, 0xaa, 0xca, 0x90, 0xd2, 0x02, 0xf1, 0x07, 0x53, 0x63, 0xa0, 0xb8, 0x45, 0x71, 0xbb,
-		0x62, 0xe2, 0x96, 0x39, 0x94, 0x2b, 0xa6, 0xee, 0x0b, 0x46, 0x20, 0xb4, 0x73, 0x5c, 0x7b, 0x3c,
-		0xbe, 0xf5, 0x47, 0x22, 0x0b, 0x6c, 0x9b, 0xc9, 0x8b, 0x1c, 0x5e, 0xfe, 0x71, 0x78, 0xfe, 0xf9,
-		0xf0, 0xf2, 0xdb, 0x45, 0xef, 0xf0, 0xf0, 0x20, 0xef, 0xde, 0xf9, 0x42, 0xbc, 0x88, 0xca, 0xdc,
-		0x1a, 0xa0, 0x98, 0x45, 0xf5, 0xe4, 0x7d, 0x92, 0xd7, 0xf8, 0xf6, 0xe7, 0xe7, 0xff, 0x7e, 0x3e,
-		0xfb, 0xeb, 0x73, 0x01, 0xbb, 0xe0, 0xfd, 0x72, 0xbc, 0x48, 0xbd, 0xf6, 0xe9, 0xe3, 0x2a, 0xbc,
-		0xc5, 0x8a, 0xbc, 0xc6, 0xe9, 0x2a, 0xbc, 0xc5, 0x2a, 0x2c, 0x45, 0xa3, 0xbd, 0x22, 0xe7, 0x62,
-		0x15, 0x76, 0x54, 0x6b, 0x25, 0x4e, 0x77, 0xbb, 0xd8, 0x5b, 0xe4, 0xba, 0xf2, 0x7a, 0xc9, 0x80,
-		0x11, 0x44, 0xbb, 0x46, 0x6f, 0x1a, 0x50, 0x34, 0x88, 0xa1, 0x6b, 0x62, 0xf8, 0xcc, 0x8f, 0x65,
-		0x72, 0x9a, 0x48, 0x73, 0xc5, 0x90, 0x52, 0x35, 0xec, 0x4e, 0xb2, 0x59, 0x26, 0x3f, 0x67, 0x4a,
-		0x49, 0x48, 0x6f, 0x7b, 0x40, 0xa5, 0x2d, 0x58, 0x38, 0x5e, 0xdd, 0xca, 0x9e, 0xe3, 0x48, 0xeb,
-		0x64, 0xef, 0x93, 0x25, 0xa9, 0x52, 0x8c, 0x0f, 0xa4, 0xa5, 0x02, 0x8b, 0x71, 0x87, 0xdd, 0x30,
-		0x27, 0x22, 0x9e, 0x75, 0x38, 0xbe, 0xe9, 0x15, 0x7f, 0x7c, 0xc0, 0x9c, 0xa6, 0x5c, 0x1d, 0x03,
-		0xc9, 0x57, 0xc2, 0x94, 0x5b, 0xc2, 0x40, 0xf2, 0xac, 0xd9, 0x15, 0xe9, 0x85, 0x85, 0xb2, 0x2c,
-		0x66, 0x36, 0x4d, 0x81, 0x6c, 0x8b, 0xd7, 0xce, 0xe7, 0x45, 0x48, 0x6d, 0xe6, 0xde, 0x5b, 0x6a,
-		0x48, 0x2d, 0x6f, 0x54, 0xc4, 0xca, 0x4a, 0x6f, 0x63, 0xa5, 0x67, 0x32, 0x3e, 0xb5, 0xb7, 0x43,
-		0x66, 0x0f, 0xaf, 0xb8, 0x1a, 0x32, 0x39, 0xf5, 0x1f, 0x7d, 0xea, 0x05, 0x7c, 0x90, 0x37, 0xaa,
-		0x2f, 0x27, 0x0d, 0x53, 0xf8, 0x0c, 0xeb, 0x38, 0xcb, 0xfa, 0xce, 0xb4, 0xae, 0xb3, 0xad, 0xfd,
-		0x8c, 0x6b, 0x3f, 0xeb, 0x5a, 0xcf, 0x7c, 0x31, 0x54, 0x94, 0x13, 0x11, 0xe6, 0xa7, 0x75, 0x66,
-		0xf6, 0x4b, 0xfe, 0xe4, 0x92, 0x19, 0xd5, 0xb7, 0x5d, 0x60, 0x0c, 0xf3, 0xc9, 0x26, 0xf9, 0x17,
-		0xea, 0x61, 0xe9, 0xbd, 0xfc, 0xb7, 0x43, 0xca, 0x17, 0xe9, 0xe0, 0x1f, 0xad, 0x89, 0xba, 0x0f,
-		0xa9, 0xf5, 0x3f, 0xd6, 0xbf, 0x99, 0xab, 0x52, 0x1c, 0xb7, 0x2f, 0x7d, 0x62, 0x3b, 0xff, 0x5e,
-		0x32, 0x79, 0x94, 0xcc, 0xd7, 0x92, 0x4b, 0xa3, 0x37, 0x26, 0x74, 0x11, 0x46, 0xe8, 0x33, 0xbd,
-		0xad, 0xc9, 0x26, 0x25, 0xb6, 0x62, 0x37, 0xd4, 0x8a, 0xd7, 0x24, 0x41, 0x00, 0x8f, 0x6a, 0x9d,
-		0xc9, 0x14, 0x9a, 0x17, 0x5d, 0x2d, 0x4d, 0x9b, 0xe8, 0xf9, 0x46, 0x72, 0xa6, 0x26, 0xe4, 0x7d,
-		0xf1, 0x91, 0x75, 0xef, 0xa9, 0x99, 0x7d, 0x35, 0xdf, 0x5c, 0x17, 0xba, 0xe7, 0xc3, 0xaa, 0x45,
-		0xa8, 0x68, 0xb6, 0xcf, 0xaf, 0x0d, 0x97, 0xe9, 0xc8, 0x5f, 0xd5, 0x22, 0xb5, 0xbd, 0xe7, 0x29,
-		0x6f, 0x31, 0xff, 0x4b, 0xcf, 0x21, 0x70, 0x2a, 0x52, 0x65, 0xc9, 0x78, 0x7c, 0x2c, 0xb3, 0xa6,
-		0xe6, 0x4f, 0x70, 0xcc, 0x5d, 0xaf, 0xa0, 0x81, 0xf5, 0x0a, 0x74, 0xca, 0x28, 0xac, 0x57, 0x80,
-		0xf5, 0x0a, 0x90, 0x66, 0xc2, 0x7a, 0x05, 0xba, 0x4d, 0xc9, 0x32, 0xea, 0x15, 0x60, 0xda, 0xff,
-		0xcb, 0x17, 0x63, 0xda, 0xbf, 0x16, 0xa5, 0xbe, 0x3e, 0x72, 0x0f, 0x13, 0x4a, 0x5e, 0x96, 0x7b,
-		0x98, 0x50, 0x32, 0xd7, 0x4c, 0x63, 0xda, 0x7f, 0x89, 0x5c, 0x14, 0xa6, 0xfd, 0x9b, 0xd4, 0xfe,
-		0x76, 0x10, 0xf1, 0x64, 0xb6, 0x72, 0xeb, 0xfd, 0x74, 0x84, 0xf5, 0xf0, 0x58, 0xa3, 0x4a, 0x05,
-		0xa3, 0x52, 0x73, 0x7b, 0xac, 0x19, 0xaf, 0xee, 0xd4, 0x1a, 0xf5, 0x7f, 0xaa, 0x49, 0x93, 0x51,
-		0x59, 0xdc, 0x69, 0xfd, 0x7c, 0x40, 0x74, 0x14, 0xe7, 0x3f, 0x4a, 0x26, 0x35, 0x22, 0x0c, 0x47,
-		0x71, 0xae, 0xa3, 0x56, 0x50, 0xb9, 0x2d, 0xdc, 0x51, 0x3c, 0x56, 0x33, 0x9d, 0x96, 0x06, 0x57,
-		0xf1, 0x4e, 0x81, 0x21, 0xce, 0x09, 0x1f, 0xd0, 0x42, 0xf1, 0xf7, 0x56, 0xe1, 0x78, 0xca, 0xe4,
-		0x41, 0x4e, 0x19, 0xd7, 0xe2, 0x4f, 0xb2, 0xd2, 0xb4, 0x82, 0xfc, 0x92, 0x61, 0x66, 0xbc, 0x23,
-		0x41, 0xec, 0x18, 0x9c, 0x1d, 0xb0, 0x01, 0x53, 0x52, 0xe3, 0xc0, 0x9f, 0xe9, 0x80, 0x28, 0x76,
-		0x13, 0x3f, 0x6b, 0x52, 0x47, 0xa2, 0xf0, 0xa8, 0x0f, 0x1a, 0x5c, 0x5c, 0xa7, 0xe4, 0x4e, 0xff,
-		0x52, 0xd4, 0x77, 0x5a, 0xad, 0xce, 0x76, 0xab, 0x55, 0xdb, 0x6e, 0x6e, 0xd7, 0x76, 0xdb, 0xed,
-		0x7a, 0x27, 0x4f, 0x12, 0x0b, 0xf4, 0xd5, 0x59, 0x90, 0x2f, 0xf0, 0xba, 0xac, 0xd8, 0x8e, 0xf7,
-		0xb9, 0xd0, 0x89, 0x2d, 0xec, 0x2a, 0x15, 0x22, 0x10, 0x7a, 0xb0, 0xc9, 0xd4, 0x70, 0x88, 0x4c,
-		0x10, 0x99, 0x20, 0x32, 0x41, 0x64, 0x82, 0xc8, 0x04, 0x91, 0x09, 0x22, 0x13, 0x44, 0x26, 0x59,
-		0x91, 0x89, 0x2b, 0x48, 0x92, 0xd7, 0xa3, 0x93, 0x3a, 0x79, 0x3e, 0x26, 0x62, 0x14, 0xc4, 0x28,
-		0x88, 0x51, 0x10, 0xa3, 0x20, 0x46, 0x41, 0x8c, 0x82, 0x18, 0x05, 0x31, 0x4a, 0x56, 0x8c, 0xf2,
-		0xff, 0x27, 0xfd, 0x3e, 0x15, 0x3a, 0x11, 0xca, 0xd3, 0x11, 0x11, 0x9f, 0x20, 0x3e, 0x41, 0x7c,
-		0x82, 0xf8, 0x04, 0xf1, 0x09, 0xe2, 0x13, 0xc4, 0x27, 0x88, 0x4f, 0xb2, 0xe2, 0x13, 0x9f, 0xd8,
-		0x93, 0xc2, 0xfc, 0x3a, 0x41, 0xca, 0x0b, 0xc3, 0x22, 0x52, 0x41, 0xa4, 0x82, 0x48, 0x05, 0x91,
-		0x0a, 0x22, 0x15, 0x44, 0x2a, 0x88, 0x54, 0x10, 0xa9, 0xe4, 0x41, 0x2a, 0x21, 0x89, 0x24, 0xd5,
-		0x8d, 0x53, 0x9e, 0x0c, 0x8a, 0x28, 0x05, 0x51, 0x0a, 0xa2, 0x14, 0x44, 0x29, 0x88, 0x52, 0x10,
-		0xa5, 0x20, 0x4a, 0x41, 0x94, 0x92, 0x15, 0xa5, 0x04, 0x37, 0x54, 0x48, 0xf6, 0x7f, 0x5a, 0x41,
-		0xca, 0xf3, 0x31, 0x11, 0xa3, 0x20, 0x46, 0x41, 0x8c, 0x82, 0x18, 0x05, 0x31, 0x0a, 0x62, 0x14,
-		0xc4, 0x28, 0x88, 0x51, 0x32, 0x4d, 0x72, 0x10, 0x29, 0xcd, 0x09, 0xc7, 0x33, 0x23, 0x22, 0x3e,
-		0x41, 0x7c, 0x82, 0xf8, 0x04, 0xf1, 0x09, 0xe2, 0x13, 0xc4, 0x27, 0x88, 0x4f, 0x10, 0x9f, 0x64,
-		0xc6, 0x27, 0x26, 0x82, 0x52, 0x5e, 0x19, 0x17, 0xb1, 0x0a, 0x62, 0x15, 0xc4, 0x2a, 0x88, 0x55,
-		0x10, 0xab, 0x20, 0x56, 0x41, 0xac, 0x82, 0x58, 0x25, 0x17, 0x56, 0xd1, 0x1b, 0x96, 0xf2, 0xe2,
-		0xa8, 0x88, 0x53, 0x10, 0xa7, 0x20, 0x4e, 0x41, 0x9c, 0x82, 0x38, 0x05, 0x71, 0x0a, 0xe2, 0x94,
-		0x75, 0xc6, 0x29, 0x4b, 0xdd, 0x2a, 0x5a, 0x43, 0x47, 0xa6, 0xa4, 0xd1, 0xd1, 0x87, 0x9c, 0x95,
-		0x9e, 0x47, 0x0f, 0xa1, 0x44, 0x64, 0x2b, 0x3e, 0x29, 0xa0, 0x3e, 0xb9, 0xc5, 0xb7, 0x49, 0xd3,
-		0xaf, 0x6f, 0xfb, 0x93, 0xc1, 0x97, 0xa0, 0x28, 0xb6, 0x13, 0x85, 0x1e, 0xbd, 0xab, 0xfa, 0x81,
-		0x53, 0xa0, 0x1f, 0xc6, 0xf4, 0x20, 0xd8, 0x6d, 0xc2, 0x1c, 0x02, 0xc4, 0xd2, 0xd8, 0x0b, 0xe9,
-		0x36, 0x41, 0x79, 0xe4, 0x53, 0x41, 0x72, 0xb6, 0x1e, 0x4c, 0xab, 0xbf, 0xb7, 0x72, 0x5c, 0x7b,
-		0xc8, 0x23, 0xdf, 0x68, 0xa3, 0xfb, 0x0c, 0xa2, 0x82, 0xba, 0x2e, 0x4d, 0x9a, 0x1a, 0x56, 0x65,
-		0x48, 0x69, 0x81, 0xae, 0x5c, 0xcf, 0x07, 0x42, 0x91, 0x81, 0x22, 0x63, 0xc5, 0x44, 0x46, 0xc4,
-		0xb8, 0x6a, 0x36, 0x0a, 0x48, 0x8b, 0x3c, 0x6d, 0xb9, 0x8a, 0x59, 0x7a, 0x05, 0x4c, 0x5e, 0x1d,
-		0x96, 0x9d, 0x2e, 0x8b, 0x4e, 0xbb, 0xad, 0xa0, 0xcf, 0x46, 0x28, 0xd2, 0x0f, 0x58, 0x87, 0xc5,
-		0x96, 0x4e, 0x71, 0xab, 0xb1, 0xdb, 0xda, 0xed, 0x6c, 0x37, 0x76, 0xdb, 0xab, 0x3b, 0xd7, 0x6b,
-		0xd4, 0xd8, 0x86, 0x72, 0xd2, 0xf7, 0x68, 0xd5, 0xf5, 0x82, 0xdb, 0x89, 0x7f, 0xb9, 0x80, 0x72,
-		0x7e, 0x61, 0xb0, 0x32, 0x1b, 0xdc, 0x25, 0xcb, 0x8e, 0x1d, 0xee, 0x10, 0x40, 0xac, 0x37, 0x80,
-		0xc0, 0x0e, 0x77, 0xa6, 0x41, 0xcb, 0x6c, 0x87, 0xbb, 0x3b, 0x6c, 0x5a, 0x97, 0xe3, 0x04, 0x4e,
-		0xcf, 0x1f, 0xaa, 0xeb, 0x39, 0x26, 0x6f, 0x78, 0x9b, 0xf8, 0x57, 0x89, 0xe3, 0x08, 0x2a, 0x0b,
-		0x74, 0xa3, 0x7b, 0x36, 0x0e, 0x2a, 0x41, 0x54, 0x82, 0x2b, 0xa6, 0x04, 0xf3, 0x6f, 0xef, 0x27,
-		0x8a, 0x70, 0x27, 0x5f, 0x8b, 0x6b, 0x45, 0x05, 0xcf, 0xad, 0x98, 0x2a, 0x5f, 0x6b, 0xd5, 0x5d,
-		0x52, 0x75, 0xf7, 0xaa, 0x47, 0xd7, 0x3f, 0x1a, 0x0f, 0x1b, 0xdd, 0xa7, 0x3f, 0x6f, 0xfe, 0x68,
-		0x3f, 0x54, 0x40, 0x4a, 0x2f, 0x2d, 0xa2, 0x0b, 0xe5, 0x16, 0xca, 0x2d, 0x94, 0x5b, 0x28, 0xb7,
-		0x4a, 0x94, 0x5b, 0x69, 0xbb, 0x7e, 0xa7, 0xaa, 0xc5, 0xe7, 0xf9, 0xca, 0x78, 0x28, 0xcd, 0x50,
-		0x9a, 0xad, 0x98, 0x34, 0x43, 0xf7, 0xe7, 0x73, 0x01, 0x12, 0x06, 0x42, 0x15, 0x75, 0x82, 0xbe,
-		0x3c, 0x1c, 0x8a, 0x0f, 0x14, 0x1f, 0x2b, 0x26, 0x3e, 0x98, 0x43, 0xb9, 0x62, 0xea, 0x5e, 0x50,
-		0xb7, 0x88, 0xf8, 0xc8, 0xe1, 0xaa, 0xaa, 0x1c, 0x8f, 0x6f, 0xfd, 0x91, 0x48, 0x5a, 0x3c, 0x30,
-		0xfe, 0xf0, 0xf2, 0x8f, 0xc3, 0xf3, 0xcf, 0x87, 0x97, 0xdf, 0x2e, 0x7a, 0x87, 0x87, 0x07, 0x79,
-		0xf7, 0x4e, 0xe2, 0x83, 0x93, 0x85, 0xe2, 0x71, 0x0b, 0x32, 0x8c, 0x93, 0xf7, 0x49, 0x5e, 0xe3,
-		0xdb, 0x9f, 0x9f, 0xff, 0xfb, 0xf9, 0xec, 0xaf, 0xcf, 0x95, 0x45, 0x78, 0x35, 0xb5, 0xbe, 0x48,
-		0xbd, 0xf6, 0xe9, 0xe3, 0x2a, 0xbc, 0xc5, 0x8a, 0xbc, 0xc6, 0xe9, 0x2a, 0xbc, 0xc5, 0x2a, 0x2c,
-		0x45, 0xa3, 0xbd, 0x22, 0xe7, 0x62, 0x15, 0x76, 0x54, 0x6b, 0x25, 0x4e, 0x77, 0xbb, 0xd8, 0x5b,
-		0xe4, 0xba, 0xf2, 0xda, 0x34, 0x30, 0x32, 0x62, 0x34, 0xe8, 0xb0, 0x14, 0xd0, 0x3c, 0x40, 0xf3,
-		0x00, 0xcd, 0x03, 0x34, 0x0f, 0xd0, 0x3c, 0x40, 0xf3, 0x00, 0xcd, 0x03, 0x34, 0x0f, 0xd0, 0x3c,
-		0x40, 0xf3, 0x00, 0xcd, 0x83, 0xe5, 0x35, 0x0f, 0xde, 0x69, 0x5c, 0xab, 0xca, 0x5e, 0x34, 0x88,
-		0x11, 0x60, 0x62, 0x3f, 0xcc, 0x0f, 0x09, 0x72, 0x5a, 0x1a, 0x1f, 0x02, 0xbb, 0xca, 0xdc, 0xee,
-		0x54, 0x82, 0xec, 0xb3, 0x5f, 0x7c, 0x18, 0xa1, 0xd0, 0x6e, 0x9a, 0x2d, 0x3b, 0xfe, 0x39, 0x49,
-		0x9a, 0xcd, 0x1e, 0x26, 0x2e, 0x6d, 0xc1, 0xc2, 0x71, 0xb6, 0x6f, 0x65, 0xcf, 0x71, 0xa4, 0x75,
-		0xb2, 0xf7, 0xc9, 0x92, 0x54, 0x29, 0xc6, 0x07, 0xd2, 0x52, 0x81, 0xc5, 0xb8, 0xc3, 0x6e, 0x98,
-		0x13, 0x11, 0xcf, 0x9a, 0xa4, 0xcf, 0x5e, 0xf1, 0xc7, 0xe7, 0xcb, 0x69, 0x10, 0xd5, 0x81, 0x19,
-		0x44, 0x1e, 0x19, 0xa0, 0x41, 0xf4, 0x82, 0x41, 0x14, 0xcf, 0xcb, 0x92, 0x19, 0x44, 0x07, 0x4c,
-		0xe4, 0x5b, 0x6e, 0x32, 0x18, 0x08, 0x3a, 0x20, 0x8a, 0x56, 0x99, 0x53, 0xdc, 0x18, 0x79, 0x32,
-		0x5a, 0xce, 0xc9, 0x7e, 0x76, 0x3e, 0x2f, 0x42, 0x6a, 0x33, 0xf7, 0xde, 0x52, 0x43, 0x6a, 0x79,
-		0xc1, 0x80, 0xd9, 0xc4, 0xb3, 0xd2, 0xdb, 0x58, 0xe9, 0x99, 0x8c, 0x4f, 0xed, 0xed, 0x90, 0xd9,
-		0xc3, 0x2b, 0xae, 0x86, 0x4c, 0x4e, 0xfd, 0x47, 0x9f, 0x7a, 0x01, 0x1f, 0x60, 0x2d, 0xa1, 0x22,
-		0x67, 0x5a, 0xd7, 0xd9, 0xd6, 0x7e, 0xc6, 0xb5, 0x9f, 0x75, 0xad, 0x67, 0xbe, 0x18, 0xb6, 0x58,
-		0x7c, 0x2d, 0x21, 0x8f, 0x12, 0x37, 0x1f, 0x41, 0x32, 0xa3, 0xfa, 0xb6, 0x0b, 0x8c, 0xd1, 0x4b,
-		0x0b, 0x6b, 0xcc, 0x81, 0x13, 0x98, 0xdb, 0x4d, 0x6a, 0x60, 0xac, 0x44, 0x61, 0x12, 0x1d, 0xd9,
-		0x2e, 0xb7, 0x43, 0xca, 0x17, 0x99, 0xe8, 0x32, 0x5a, 0x13, 0x75, 0x1f, 0x52, 0xeb, 0x7f, 0xac,
-		0x7f, 0x33, 0x57, 0xa5, 0x30, 0x6e, 0x5f, 0xfa, 0xc4, 0x76, 0xfe, 0xbd, 0x64, 0xf2, 0x28, 0x99,
-		0xaf, 0x25, 0x97, 0x46, 0x6f, 0x4c, 0xe8, 0x42, 0x52, 0x85, 0x9f, 0xea, 0x6d, 0x4d, 0x96, 0x1d,
-		0x49, 0x0a, 0x36, 0x58, 0xf1, 0x9a, 0x24, 0x08, 0xe0, 0x51, 0xad, 0x33, 0x99, 0x42, 0xf3, 0xa2,
-		0xab, 0xa5, 0x69, 0x13, 0x3d, 0xdf, 0x48, 0xce, 0xd4, 0x84, 0x68, 0x28, 0x9e, 0xa5, 0x7b, 0x4f,
-		0xcd, 0xec, 0xab, 0xf9, 0xe6, 0x7a, 0x41, 0x85, 0xa6, 0xc0, 0x67, 0x6a, 0xcd, 0xf5, 0xcd, 0xeb,
-		0x79, 0xcd, 0xf3, 0x7c, 0x05, 0xab, 0xb4, 0x15, 0xaa, 0x9a, 0x6f, 0x17, 0xbc, 0xfd, 0xce, 0x73,
-		0xc8, 0x9b, 0x8a, 0xbc, 0x65, 0xca, 0x1e, 0x52, 0xa7, 0x7a, 0xe3, 0x91, 0xf9, 0x5f, 0x37, 0x95,
-		0x20, 0x4f, 0x2f, 0x9f, 0x73, 0x7e, 0xb3, 0x19, 0xec, 0x99, 0x41, 0x7e, 0x1e, 0x50, 0x3f, 0xad,
-		0x4f, 0x33, 0xbc, 0x4a, 0x11, 0x01, 0x57, 0x18, 0xa5, 0x17, 0x96, 0x59, 0xcf, 0xf5, 0x5e, 0xf2,
-		0xe2, 0x0b, 0xa2, 0xc4, 0xb2, 0x9a, 0xd8, 0x15, 0x7b, 0xb2, 0x2b, 0x72, 0xd2, 0x62, 0xe3, 0xeb,
-		0xd7, 0x83, 0x6b, 0xca, 0xb8, 0xa5, 0xd7, 0x87, 0x6c, 0xca, 0xb6, 0xe5, 0x97, 0x9d, 0x6d, 0xb2,
-		0x6d, 0x2a, 0x65, 0x36, 0x49, 0xfe, 0x0b, 0x6c, 0xf8, 0x38, 0x18, 0x92, 0x3b, 0x05, 0x0e, 0x11,
-		0xb2, 0x3b, 0xf9, 0x0e, 0x19, 0x74, 0x7a, 0x27, 0xe2, 0xc5, 0x4c, 0x93, 0x54, 0xd7, 0xec, 0x16,
-		0x18, 0x63, 0xfc, 0x3a, 0x0b, 0x2f, 0x13, 0x3d, 0x99, 0x94, 0x78, 0x2b, 0xe4, 0x67, 0xaf, 0x5f,
-		0x9a, 0x9e, 0x8e, 0x86, 0xa1, 0xf4, 0x54, 0xd3, 0xd6, 0x37, 0x5d, 0xe9, 0x83, 0xe9, 0xac, 0xae,
-		0x9d, 0x0e, 0x9a, 0x96, 0x76, 0x7e, 0xaf, 0x77, 0x5c, 0x53, 0xf5, 0x9c, 0x1f, 0x37, 0x91, 0xee,
-		0xba, 0xce, 0x1a, 0x88, 0x99, 0xd9, 0x25, 0xd3, 0x58, 0x85, 0x7b, 0x66, 0xc9, 0x5a, 0xb5, 0xdd,
-		0x16, 0xae, 0x5a, 0x61, 0xba, 0x43, 0xef, 0x28, 0xd7, 0xef, 0x16, 0xb8, 0xf7, 0x34, 0xca, 0xe6,
-		0x7f, 0x18, 0xff, 0x47, 0xaf, 0x6c, 0xae, 0xef, 0x68, 0x18, 0xab, 0x68, 0xf2, 0xfb, 0xcc, 0x80,
-		0x1b, 0xad, 0xda, 0xee, 0xd7, 0x5a, 0xb5, 0x75, 0xfd, 0xb3, 0x55, 0xfb, 0x5a, 0xab, 0xee, 0x5c,
-		0x7f, 0xad, 0x55, 0x77, 0xaf, 0x7f, 0x7e, 0xad, 0x57, 0x9b, 0xa3, 0x8f, 0x3f, 0x9a, 0x0f, 0xf1,
-		0x4f, 0xbb, 0xe3, 0x9f, 0xea, 0xef, 0x1b, 0xe3, 0x9f, 0x37, 0xaf, 0xae, 0xb6, 0x36, 0x0a, 0x5c,
-		0xfe, 0xf3, 0xea, 0xea, 0xb7, 0xcd, 0xca, 0xa2, 0x37, 0x5c, 0xd9, 0xe5, 0xe8, 0x73, 0x22, 0x31,
-		0x0d, 0xde, 0x99, 0x74, 0xac, 0x42, 0x5e, 0x1a, 0x03, 0x47, 0x2d, 0x65, 0xfe, 0x92, 0xfa, 0x02,
-		0xd6, 0xff, 0x58, 0xff, 0xde, 0xdb, 0xdf, 0x3f, 0xbc, 0xb8, 0xf8, 0xb7, 0x8e, 0xc3, 0xa7, 0x91,
-		0x70, 0xb7, 0xf4, 0x7b, 0x6f, 0x8c, 0x59, 0x1d, 0x2f, 0x5a, 0x1f, 0xaf, 0x4f, 0xf4, 0x52, 0xb4,
-		0xec, 0xd0, 0xe9, 0xd5, 0x99, 0xd9, 0x64, 0x7b, 0x89, 0x05, 0x6f, 0x7d, 0x39, 0xd9, 0xfb, 0x6c,
-		0x11, 0x29, 0xd9, 0x80, 0x53, 0xc7, 0x52, 0xc1, 0x53, 0xef, 0x83, 0xd4, 0xb5, 0x9c, 0x9a, 0x77,
-		0x9d, 0x65, 0xd4, 0xdd, 0x63, 0x7c, 0x13, 0xce, 0x6c, 0xc4, 0x39, 0x17, 0x63, 0x49, 0x20, 0xcd,
-		0xc3, 0x5a, 0x68, 0x98, 0x82, 0x8d, 0x49, 0xd2, 0x71, 0xee, 0x07, 0x81, 0xaa, 0x06, 0x76, 0xd5,
-		0x0e, 0xfc, 0x50, 0x50, 0x29, 0xa9, 0x53, 0xf5, 0x28, 0x71, 0xb3, 0xd7, 0xb8, 0xc8, 0x3f, 0x81,
-		0x79, 0x3a, 0xcd, 0x3d, 0x95, 0x8d, 0xc5, 0x19, 0xc3, 0x67, 0xe3, 0x21, 0x69, 0x88, 0xa4, 0x21,
-		0x92, 0x86, 0x79, 0xf8, 0xb1, 0xf8, 0xf8, 0x54, 0x55, 0x3c, 0xa6, 0x06, 0xf6, 0xb0, 0x00, 0x5f,
-		0x90, 0xb3, 0x52, 0x0f, 0x8a, 0xe5, 0x42, 0x62, 0x99, 0x27, 0x6c, 0x89, 0x26, 0x2f, 0xce, 0xf4,
-		0x60, 0x28, 0x90, 0x51, 0x20, 0xa3, 0x40, 0xce, 0xb8, 0x63, 0xd0, 0x8b, 0xf3, 0x9a, 0x96, 0x42,
-		0x2f, 0xce, 0xbc, 0x0f, 0x86, 0x5e, 0x9c, 0x27, 0x9b, 0x08, 0xbd, 0x38, 0xe8, 0xc5, 0xd1, 0x49,
-		0x56, 0x58, 0xe8, 0xc5, 0x41, 0x2f, 0x0e, 0x7a, 0x71, 0x96, 0x93, 0x63, 0x5b, 0x2f, 0x2f, 0xce,
-		0xe5, 0xf9, 0x9f, 0x9f, 0xff, 0x8b, 0x4e, 0x1c, 0x0d, 0x03, 0xff, 0xda, 0x89, 0x33, 0x9e, 0xe7,
-		0xd5, 0xf7, 0xe1, 0x7c, 0x4e, 0x94, 0xee, 0xc8, 0x6d, 0xc0, 0xa4, 0x75, 0x43, 0x3c, 0xe6, 0x58,
-		0x6e, 0x20, 0xe2, 0xa9, 0xe6, 0xdf, 0xad, 0x64, 0x3e, 0xd0, 0x97, 0x63, 0x76, 0x2f, 0xce, 0xec,
-		0xc7, 0x8c, 0x8b, 0x82, 0x3e, 0x1d, 0x24, 0x0f, 0xcb, 0x22, 0x0f, 0x93, 0x1d, 0x98, 0x50, 0x35,
-		0xb2, 0x38, 0x79, 0x38, 0x3d, 0x18, 0x92, 0x87, 0x48, 0x1e, 0x22, 0x79, 0x88, 0xe4, 0x21, 0x92,
-		0x87, 0x48, 0x1e, 0x22, 0x79, 0x88, 0xe4, 0x21, 0x92, 0x87, 0xab, 0x48, 0x1e, 0x26, 0xb2, 0x59,
-		0x24, 0xa2, 0x10, 0xf9, 0xc3, 0x5f, 0xf1, 0x87, 0x57, 0x57, 0x5b, 0x05, 0x06, 0xa8, 0xe0, 0x86,
-		0x43, 0xb6, 0x7a, 0xad, 0xd8, 0xea, 0x65, 0xdb, 0x70, 0xfa, 0x85, 0xdc, 0xae, 0x86, 0xb1, 0xb4,
-		0x40, 0x75, 0x03, 0x18, 0x34, 0xad, 0xf6, 0xa1, 0x04, 0xe3, 0x03, 0x9d, 0x9c, 0x9d, 0xc6, 0x43,
-		0x6b, 0xec, 0xf0, 0x2e, 0x85, 0xca, 0x58, 0x0e, 0x29, 0xa0, 0x47, 0x1a, 0x68, 0xc6, 0xdc, 0xb8,
-		0xd1, 0xb5, 0x6f, 0xf4, 0xab, 0xab, 0xdf, 0x7e, 0x16, 0xd8, 0x6d, 0x9b, 0x85, 0xf7, 0xfa, 0xa2,
-		0xf1, 0x95, 0xe6, 0x8d, 0x8e, 0xce, 0xe2, 0xb9, 0xf6, 0xdd, 0x09, 0x93, 0x6a, 0x4f, 0x29, 0x51,
-		0x8c, 0x7d, 0x3b, 0x65, 0xfc, 0xd0, 0xa3, 0x3e, 0xe5, 0x45, 0x6d, 0xd9, 0xd8, 0x90, 0x9f, 0x1a,
-		0xa9, 0xbe, 0xd3, 0x6a, 0x75, 0xb6, 0x5b, 0xad, 0xda, 0x76, 0x73, 0xbb, 0xb6, 0xdb, 0x6e, 0xd7,
-		0x3b, 0x79, 0x1a, 0x94, 0xa4, 0x83, 0x9f, 0x09, 0x87, 0x0a, 0xea, 0x7c, 0xbc, 0xaf, 0x74, 0x2d,
-		0x1e, 0x79, 0x1e, 0xba, 0xe5, 0x35, 0x09, 0x6f, 0x74, 0xcb, 0xa3, 0x5b, 0xde, 0x74, 0x6a, 0xa5,
-		0xe7, 0x05, 0xb7, 0xd4, 0x49, 0x5c, 0xc0, 0xd2, 0xf2, 0xc9, 0xbd, 0xd5, 0xa7, 0x96, 0x4c, 0x6a,
-		0x69, 0x33, 0xfa, 0xdc, 0x17, 0x3c, 0x5d, 0xdb, 0x7e, 0x0b, 0x5d, 0xf4, 0xe5, 0xa4, 0x5b, 0xe6,
-		0x5f, 0x20, 0x74, 0xd7, 0x97, 0xa8, 0x87, 0x56, 0xc9, 0x5d, 0x6f, 0xb4, 0x28, 0x5c, 0xc1, 0x89,
-		0xd2, 0x52, 0x9b, 0x74, 0xba, 0xd6, 0xe7, 0x87, 0x71, 0xfd, 0xc4, 0x25, 0x68, 0xc7, 0x38, 0xaa,
-		0x99, 0x9a, 0xbb, 0x10, 0x64, 0xae, 0x36, 0x27, 0x45, 0xeb, 0x40, 0x36, 0xb0, 0x0e, 0xe4, 0x42,
-		0x15, 0x08, 0xd6, 0x81, 0x9c, 0x77, 0xd7, 0x60, 0x1d, 0x48, 0x0b, 0x83, 0x80, 0x34, 0x20, 0x32,
-		0x0c, 0x02, 0x2a, 0x4a, 0x1d, 0x62, 0x10, 0xd0, 0xaf, 0xa6, 0x07, 0x83, 0x80, 0xb2, 0x0e, 0x8a,
-		0x41, 0x40, 0x06, 0x78, 0x87, 0x69, 0xee, 0x10, 0x83, 0x80, 0xca, 0x59, 0x35, 0x0c, 0x02, 0xc2,
-		0x98, 0x8c, 0x1c, 0x03, 0x62, 0x06, 0x21, 0x08, 0x8a, 0x08, 0xeb, 0x40, 0x2e, 0xd6, 0xce, 0x78,
-		0xc9, 0xde, 0xc0, 0x3a, 0x90, 0x5a, 0x41, 0x03, 0xd6, 0x81, 0x9c, 0x7b, 0xe7, 0x61, 0x1d, 0xc8,
-		0x32, 0x21, 0x0d, 0x30, 0x27, 0x04, 0x96, 0x4d, 0x44, 0x8e, 0x0d, 0x39, 0x36, 0x2c, 0x9b, 0x08,
-		0xbb, 0x6c, 0x22, 0x56, 0x19, 0x44, 0xf9, 0x85, 0xf2, 0x6b, 0xad, 0xe4, 0x17, 0xfa, 0x08, 0x5e,
-		0x13, 0xea, 0xe8, 0x23, 0x98, 0xf7, 0xc1, 0xd0, 0x47, 0xf0, 0x64, 0x13, 0xa1, 0x8f, 0x00, 0x7d,
-		0x04, 0x3a, 0x4d, 0x61, 0x0b, 0x7d, 0x04, 0xe8, 0x23, 0x40, 0x1f, 0x81, 0x61, 0x06, 0x07, 0x7d,
-		0x04, 0x6f, 0xf0, 0x43, 0x98, 0xce, 0x80, 0xe9, 0x0c, 0x58, 0x65, 0x70, 0x9e, 0x0d, 0x88, 0x55,
-		0x06, 0x17, 0x01, 0x70, 0xd0, 0x63, 0x30, 0xbb, 0xaa, 0x58, 0x94, 0x0f, 0xb9, 0x36, 0xe4, 0xda,
-		0x90, 0x6b, 0x43, 0xae, 0x0d, 0xb9, 0x36, 0xe4, 0xda, 0x90, 0x6b, 0x43, 0xae, 0x0d, 0xb9, 0xb6,
-		0xac, 0xb2, 0x19, 0x8b, 0xf2, 0x61, 0x51, 0xbe, 0xb2, 0x36, 0x1c, 0x92, 0xbb, 0x58, 0x94, 0x6f,
-		0x11, 0x1b, 0x0e, 0x8b, 0xf2, 0xe5, 0x99, 0x3c, 0x2c, 0xca, 0x87, 0x45, 0xf9, 0x34, 0x48, 0x03,
-		0xcd, 0x98, 0x1b, 0x37, 0x3a, 0x16, 0xe5, 0xc3, 0xa2, 0x7c, 0x66, 0xee, 0x8f, 0x45, 0xf9, 0xb0,
-		0x28, 0xdf, 0x5b, 0x63, 0xa1, 0x17, 0xbb, 0x80, 0x5a, 0x40, 0x2f, 0xb6, 0x19, 0x60, 0x80, 0x45,
-		0xf9, 0x0a, 0x6d, 0x46, 0x2c, 0xca, 0xb7, 0x68, 0x8d, 0xbf, 0xf2, 0xde, 0xed, 0xf5, 0xaa, 0x61,
-		0x37, 0x2a, 0xfd, 0x66, 0xaa, 0x84, 0xdd, 0x3b, 0x8d, 0x73, 0x98, 0x77, 0xee, 0xb4, 0xcf, 0x59,
-		0x25, 0x53, 0x61, 0x3e, 0x11, 0xd9, 0x8a, 0x8f, 0xc5, 0xf3, 0xf1, 0x64, 0xe4, 0x6f, 0x87, 0xe3,
-		0x91, 0xbf, 0x5d, 0x8c, 0x47, 0xfe, 0x32, 0xb7, 0x23, 0xfa, 0xed, 0x49, 0xfd, 0xf5, 0x37, 0xde,
-		0x98, 0xee, 0xca, 0x5e, 0x34, 0x88, 0xa5, 0x15, 0x75, 0xe6, 0xc2, 0x4e, 0xf3, 0xad, 0x43, 0xaa,
-		0xa0, 0x3e, 0x04, 0x76, 0x95, 0xb9, 0xdd, 0xa9, 0xd9, 0x7e, 0xf6, 0x8b, 0xf8, 0x67, 0xaa, 0x86,
-		0xdd, 0xc9, 0xd4, 0xcf, 0x39, 0xd9, 0xcf, 0xd4, 0x6a, 0x65, 0xcf, 0x71, 0xc6, 0x19, 0xc6, 0x92,
-		0x2a, 0xc5, 0xf8, 0x40, 0x5a, 0x2a, 0xb0, 0x18, 0x77, 0xd8, 0x0d, 0x73, 0x22, 0xe2, 0x59, 0x93,
-		0x05, 0x98, 0x96, 0xb1, 0xf3, 0xde, 0x2b, 0x5b, 0xe5, 0xc3, 0xcc, 0xc1, 0x22, 0x79, 0x82, 0x43,
-		0x0a, 0x04, 0x83, 0xe4, 0x55, 0xd9, 0x85, 0x83, 0x3d, 0x0a, 0x6b, 0xdd, 0x62, 0xc1, 0x1c, 0x7a,
-		0x85, 0x53, 0xd6, 0x4a, 0x85, 0x95, 0xa7, 0x22, 0x25, 0x7f, 0xe9, 0xce, 0x9c, 0x92, 0xe9, 0xa5,
-		0x43, 0x73, 0xc8, 0x6d, 0x2f, 0x90, 0x8c, 0x0f, 0x2c, 0x3b, 0xe0, 0x8a, 0x30, 0x4e, 0x45, 0x82,
-		0x48, 0x46, 0xf1, 0x77, 0x29, 0xaa, 0x1e, 0xc3, 0x15, 0xfb, 0x8a, 0x3b, 0x44, 0x11, 0x2b, 0xe0,
-		0xe9, 0x61, 0x9a, 0x0a, 0xc6, 0xdb, 0xb2, 0xac, 0xcb, 0x21, 0x95, 0xd4, 0x22, 0x82, 0x26, 0x83,
-		0x48, 0x45, 0xb8, 0x43, 0x84, 0x73, 0xc5, 0x4f, 0x1a, 0xef, 0xad, 0xf4, 0xb1, 0xa5, 0xba, 0xf7,
-		0x46, 0x11, 0x7e, 0x99, 0x31, 0x68, 0xe1, 0x0a, 0xa4, 0x35, 0xac, 0x40, 0xba, 0x50, 0xb4, 0xbc,
-		0x90, 0x0a, 0xa4, 0x0b, 0x92, 0x0f, 0x05, 0x88, 0x8d, 0x7c, 0x44, 0x46, 0xb1, 0x3d, 0xca, 0xdc,
-		0xae, 0xba, 0x0f, 0x13, 0xe3, 0x99, 0xb9, 0x2a, 0xd5, 0xc6, 0xfb, 0xd2, 0x27, 0xb6, 0xf3, 0xef,
-		0x05, 0xef, 0xde, 0x02, 0x8c, 0x84, 0xb1, 0xbd, 0xfb, 0xc6, 0x84, 0x95, 0x12, 0x64, 0xab, 0x83,
-		0x5a, 0x98, 0x2a, 0x99, 0x93, 0xc4, 0x5e, 0xc7, 0x73, 0xfd, 0xb4, 0x32, 0x8b, 0xc5, 0x64, 0x2a,
-		0xf0, 0xf3, 0xae, 0x82, 0x06, 0x96, 0x40, 0x3f, 0x2b, 0xa0, 0x95, 0x05, 0x78, 0x56, 0xf1, 0x66,
-		0x9e, 0xb9, 0x5c, 0x4e, 0x03, 0xf7, 0x9d, 0x5e, 0x03, 0xbd, 0xa8, 0xf5, 0x72, 0xfd, 0x96, 0xf5,
-		0x92, 0xcd, 0x48, 0xd4, 0x60, 0x1c, 0xce, 0xb1, 0xe3, 0xde, 0x34, 0x03, 0x7f, 0xbd, 0xf6, 0xaf,
-		0xcf, 0xc8, 0x2f, 0xa4, 0x44, 0x65, 0x18, 0x78, 0x4e, 0x55, 0x31, 0xff, 0xed, 0x63, 0x96, 0x9e,
-		0xf9, 0xc7, 0x4b, 0xde, 0x98, 0xe5, 0xf9, 0x90, 0xd7, 0xdc, 0x48, 0x2b, 0x0b, 0xb2, 0x7a, 0xaa,
-		0xa5, 0xe6, 0x99, 0xfc, 0x8c, 0xc2, 0x26, 0x37, 0x4e, 0xca, 0x2d, 0x3f, 0x66, 0x75, 0x49, 0xc5,
-		0xb0, 0x8d, 0x3f, 0xaf, 0xb5, 0x52, 0xb1, 0x27, 0x2b, 0x98, 0xd1, 0xce, 0x1f, 0x5f, 0xb7, 0x1a,
-		0x06, 0xf5, 0x5c, 0xdb, 0x6c, 0xf5, 0xcc, 0xe9, 0x79, 0xb6, 0xe1, 0x92, 0x18, 0xd3, 0x4e, 0x70,
-		0x5b, 0xc0, 0x86, 0x4e, 0xae, 0xce, 0x6c, 0x3a, 0xbb, 0x24, 0xf2, 0x54, 0x2e, 0xff, 0x62, 0xa5,
-		0x96, 0x4d, 0xd7, 0x5f, 0xe7, 0xb3, 0x8b, 0x6b, 0xc0, 0xec, 0xe2, 0x4c, 0xc7, 0x6c, 0x7d, 0xac,
-		0xe2, 0x2c, 0xc7, 0x30, 0x27, 0xc6, 0xcb, 0xb8, 0xde, 0xb9, 0x13, 0x91, 0x1e, 0x13, 0x90, 0x18,
-		0x57, 0xcd, 0x46, 0x9e, 0xe5, 0x1e, 0xef, 0xed, 0xed, 0x1c, 0x97, 0x16, 0x4b, 0xa5, 0x29, 0x16,
-		0xf8, 0x51, 0x3c, 0xb5, 0x6e, 0x92, 0x67, 0x51, 0x30, 0x07, 0x42, 0x7b, 0x52, 0x85, 0xbe, 0x24,
-		0x8a, 0x87, 0x62, 0x11, 0x31, 0xfa, 0xa6, 0xb8, 0xd5, 0xd8, 0x6d, 0xed, 0x76, 0xb6, 0x1b, 0xbb,
-		0xed, 0xd5, 0x9d, 0xeb, 0x92, 0x8c, 0xcd, 0xeb, 0xa5, 0xf6, 0xa6, 0x6a, 0x6b, 0x99, 0x66, 0xa6,
-		0xab, 0x57, 0x14, 0xe6, 0xc7, 0x34, 0x51, 0x88, 0x88, 0x06, 0x11, 0x0d, 0x22, 0x1a, 0x44, 0x34,
-		0x88, 0x68, 0x10, 0xd1, 0x20, 0xa2, 0x41, 0x44, 0xf3, 0x80, 0x41, 0x5e, 0x2f, 0xf2, 0xf8, 0x29,
-		0xe9, 0x9d, 0xa9, 0x9f, 0xeb, 0x1c, 0x7e, 0x8c, 0xb9, 0xfc, 0x02, 0x59, 0xfa, 0xb6, 0xe6, 0xea,
-		0xd7, 0x9a, 0x9b, 0x5c, 0x6d, 0x20, 0xb9, 0x8a, 0xe4, 0x2a, 0x92, 0xab, 0x68, 0x8a, 0xa0, 0x29,
-		0x82, 0xa6, 0x08, 0x9a, 0x22, 0x68, 0x8a, 0xa0, 0x29, 0x02, 0xdb, 0x14, 0x41, 0x8e, 0x12, 0x81,
-		0x01, 0x02, 0x03, 0x04, 0x06, 0x08, 0x0c, 0x10, 0x18, 0x20, 0x30, 0x40, 0x60, 0xb0, 0xce, 0x54,
-		0x5f, 0x86, 0xb4, 0x57, 0xe3, 0xf9, 0x96, 0xa5, 0x45, 0x2c, 0xcf, 0x1b, 0xde, 0x6b, 0xfd, 0x2a,
-		0x64, 0xf9, 0x8f, 0xc0, 0x73, 0x2e, 0xe3, 0x31, 0x0c, 0x84, 0x2c, 0x8f, 0xef, 0x36, 0x67, 0xb4,
-		0x72, 0xf2, 0xed, 0xf9, 0x02, 0x95, 0x6b, 0x18, 0xa8, 0xac, 0x03, 0x34, 0x95, 0x1f, 0xa8, 0x3c,
-		0x37, 0xe8, 0x49, 0x67, 0xdb, 0xa3, 0xc4, 0x15, 0x74, 0xae, 0xf9, 0x9e, 0x30, 0xe3, 0x73, 0xc0,
-		0x9a, 0x4a, 0x6f, 0x7c, 0xde, 0xb6, 0xb6, 0xc6, 0x6e, 0x82, 0x0f, 0xc9, 0xf6, 0x33, 0x70, 0x08,
-		0x44, 0x10, 0xa9, 0x79, 0xd3, 0x42, 0xd3, 0xb7, 0x9e, 0xbe, 0x08, 0x46, 0xec, 0xfe, 0x9c, 0x59,
-		0x90, 0xf0, 0x0e, 0xc5, 0x7c, 0x59, 0x8c, 0x18, 0xbf, 0x8f, 0x09, 0xf1, 0xda, 0x8d, 0x59, 0xd0,
-		0x09, 0xf1, 0xc5, 0xf2, 0xe0, 0xf3, 0xa4, 0xbf, 0x03, 0x65, 0x6c, 0x30, 0x7f, 0x5c, 0xcb, 0x76,
-		0x07, 0xc3, 0xda, 0xe4, 0x6c, 0xd6, 0x51, 0xa4, 0x02, 0x70, 0xb1, 0x8a, 0xbf, 0x3a, 0x3a, 0x94,
-		0x30, 0xae, 0xea, 0x1d, 0x0d, 0x2d, 0x4a, 0x0a, 0xf4, 0xe0, 0xd0, 0xd4, 0x7b, 0x43, 0x43, 0x05,
-		0x41, 0x9d, 0xbd, 0x36, 0x74, 0x51, 0x5a, 0xc6, 0xe8, 0x16, 0xfd, 0xb4, 0x8b, 0x06, 0xaa, 0x4b,
-		0x2b, 0xe5, 0x35, 0xb3, 0x14, 0x9d, 0x76, 0xbb, 0xd9, 0x5e, 0xbf, 0xe5, 0x58, 0x87, 0xda, 0xb1,
-		0x1a, 0x64, 0x61, 0xe1, 0xa2, 0xcf, 0x8f, 0x45, 0x9e, 0x31, 0x46, 0x12, 0x63, 0x24, 0xf3, 0x30,
-		0x87, 0x53, 0xf4, 0xc2, 0x02, 0xa2, 0x24, 0x59, 0x78, 0xd3, 0xca, 0x6e, 0xc2, 0x26, 0x57, 0xad,
-		0x48, 0x02, 0x7a, 0xb8, 0x9e, 0x31, 0x92, 0x21, 0x18, 0xe3, 0x95, 0x38, 0x4e, 0x22, 0x4a, 0x64,
-		0x7e, 0x0b, 0xf6, 0x71, 0x88, 0xf5, 0x28, 0x83, 0xc6, 0x42, 0x34, 0x62, 0x0b, 0x6e, 0xfa, 0x72,
-		0x4c, 0xd8, 0xac, 0x87, 0xe1, 0xf9, 0xa1, 0x28, 0x5e, 0x8b, 0x6a, 0x32, 0x50, 0xb1, 0x66, 0xaf,
-		0xf5, 0x15, 0x69, 0xf6, 0x9a, 0xeb, 0xe0, 0xe8, 0x3a, 0x40, 0xda, 0x0f, 0x92, 0xf6, 0x03, 0xa5,
-		0xf3, 0x60, 0x15, 0x33, 0x36, 0xf2, 0x36, 0x41, 0xc8, 0x7b, 0xe0, 0xd2, 0x01, 0x32, 0xb2, 0xfe,
-		0x6f, 0x6e, 0xba, 0x4c, 0xde, 0x00, 0x43, 0xc7, 0x50, 0xdb, 0x71, 0xd4, 0x79, 0x2c, 0xb5, 0x1f,
-		0x4f, 0xdd, 0xc7, 0xd4, 0xd8, 0x71, 0x35, 0x76, 0x6c, 0x4d, 0x1c, 0x5f, 0x4d, 0x8c, 0x43, 0xc1,
-		0xfd, 0x56, 0xf4, 0x58, 0x4f, 0xd9, 0x43, 0xfa, 0x3b, 0x47, 0x68, 0xd9, 0xb5, 0x56, 0xf1, 0xd6,
-		0xea, 0xc6, 0x8e, 0xbb, 0x89, 0x63, 0x6f, 0xec, 0xf8, 0x9b, 0x12, 0x03, 0xc6, 0xc5, 0x81, 0x71,
-		0xb1, 0x60, 0x52, 0x3c, 0xe8, 0x11, 0x13, 0x9a, 0xc4, 0x45, 0xfa, 0xa2, 0x85, 0xdb, 0xbe, 0xff,
-		0x92, 0x21, 0xa9, 0x8e, 0xb1, 0x75, 0x95, 0x07, 0xd5, 0xff, 0x0b, 0x38, 0x5d, 0xdf, 0xde, 0x72,
-		0x1b, 0x69, 0x1f, 0xb7, 0x71, 0xe7, 0xb6, 0x9f, 0xf5, 0xe4, 0x9f, 0xd1, 0xe7, 0x46, 0xd2, 0xf2,
-		0x6d, 0xfc, 0xb9, 0xfd, 0xb5, 0x56, 0x6d, 0x27, 0xfd, 0xe0, 0x36, 0x7f, 0x34, 0x1f, 0xb2, 0x5f,
-		0xb8, 0xf1, 0xaf, 0xaf, 0x57, 0x57, 0xe1, 0x8f, 0xcf, 0x0f, 0xf1, 0xdf, 0x27, 0x0f, 0xd7, 0xff,
-		0xd9, 0xfc, 0x5d, 0xf7, 0xf9, 0x8b, 0x6f, 0x78, 0x75, 0xb5, 0x75, 0xfd, 0xdb, 0x8a, 0x36, 0x57,
-		0x2c, 0xca, 0x02, 0xbf, 0x3a, 0xae, 0x36, 0x76, 0xd8, 0x9c, 0x74, 0xd1, 0xe1, 0xc7, 0x1a, 0x49,
-		0xfd, 0xaa, 0x47, 0xf9, 0x20, 0xa1, 0x83, 0x35, 0xc3, 0x89, 0xa7, 0xc3, 0x23, 0xb2, 0x40, 0x64,
-		0x81, 0xc8, 0x62, 0x8d, 0x90, 0x45, 0xc4, 0xb8, 0xda, 0x31, 0x00, 0x25, 0xda, 0x1a, 0x87, 0xd4,
-		0x13, 0xdd, 0xf1, 0xfc, 0x8f, 0xde, 0xd3, 0x64, 0xe9, 0x8e, 0xfe, 0x98, 0x19, 0x5c, 0x73, 0x34,
-		0xc8, 0xcc, 0xf8, 0xa6, 0xc2, 0x11, 0x66, 0xb7, 0x9e, 0xee, 0xf0, 0x04, 0x43, 0xa7, 0xee, 0xe9,
-		0xd2, 0x92, 0x3b, 0xf3, 0x4b, 0xdb, 0x6c, 0xe0, 0xda, 0x96, 0x22, 0x97, 0xf5, 0x8f, 0x86, 0x90,
-		0x7b, 0x35, 0xba, 0x5e, 0x16, 0xf5, 0x64, 0xe8, 0x9d, 0x78, 0x4d, 0x81, 0x20, 0x2c, 0xbc, 0x69,
-		0x7d, 0x48, 0xbd, 0xd6, 0x93, 0x4f, 0x99, 0xe2, 0x43, 0xf4, 0x4f, 0x77, 0x91, 0x74, 0x56, 0x0d,
-		0xbc, 0xaa, 0x3e, 0x3e, 0x55, 0x93, 0xb5, 0x83, 0xee, 0x92, 0xa5, 0xb2, 0x62, 0xd0, 0x5d, 0x52,
-		0xbe, 0x75, 0x92, 0x23, 0x35, 0x70, 0xde, 0xc3, 0x59, 0xdf, 0xd6, 0x30, 0xd6, 0x54, 0x6a, 0x61,
-		0xb2, 0x70, 0xdd, 0x71, 0x82, 0xe1, 0xe8, 0x87, 0xa2, 0x0b, 0xb9, 0x18, 0x51, 0x9a, 0xad, 0xb0,
-		0xe1, 0x9b, 0x2b, 0x97, 0xa5, 0xe0, 0xe1, 0x9b, 0x6b, 0xa6, 0x4b, 0xa0, 0x36, 0x50, 0xa0, 0xa2,
-		0x40, 0x05, 0x24, 0x50, 0xd1, 0xff, 0xbc, 0x48, 0xfc, 0x64, 0xe2, 0xd8, 0x1b, 0x3b, 0xfe, 0xa6,
-		0xc4, 0x80, 0x71, 0x71, 0x60, 0x5c, 0x2c, 0x98, 0x14, 0x0f, 0x7a, 0xf9, 0x03, 0xf4, 0x3f, 0xa3,
-		0xff, 0x19, 0xfd, 0xcf, 0x26, 0xb8, 0x20, 0x0d, 0xf0, 0x2a, 0x10, 0x6c, 0xa0, 0x33, 0xa9, 0x33,
-		0x55, 0x00, 0xa3, 0x71, 0x51, 0x95, 0xa2, 0x2a, 0x45, 0x55, 0xba, 0x56, 0xaa, 0x34, 0x55, 0xa4,
-		0x5a, 0x45, 0xc0, 0x13, 0x3d, 0xda, 0xd2, 0x38, 0xe6, 0x21, 0x8f, 0x7c, 0x8c, 0xa9, 0xc9, 0xb4,
-		0xc6, 0x18, 0x53, 0x83, 0x22, 0x1e, 0x45, 0xfc, 0xda, 0x8a, 0x78, 0x8c, 0xa9, 0xd1, 0xb8, 0x1f,
-		0x31, 0xa6, 0x66, 0xae, 0xad, 0x87, 0x31, 0x35, 0xaf, 0x2c, 0x2d, 0xc6, 0xd4, 0x94, 0x24, 0x97,
-		0xf5, 0x8f, 0x76, 0x8d, 0x21, 0x25, 0xd0, 0x42, 0x4a, 0x32, 0x54, 0x6b, 0xd6, 0x3f, 0xdb, 0x45,
-		0xdc, 0xa0, 0x37, 0x42, 0x68, 0x8c, 0x29, 0x49, 0x46, 0xc3, 0x24, 0xdc, 0xd2, 0x70, 0x3d, 0x3a,
-		0x41, 0xd1, 0x09, 0xfa, 0xcb, 0xa3, 0x5d, 0x1d, 0x88, 0x20, 0x32, 0xe0, 0x0c, 0x9d, 0x1a, 0x5b,
-		0xaf, 0x99, 0x5f, 0x47, 0x33, 0x1f, 0xcd, 0x7c, 0x34, 0xf3, 0x8b, 0xbf, 0xa8, 0x2e, 0x31, 0x92,
-		0x0e, 0xa8, 0xa9, 0x64, 0xc7, 0xab, 0x87, 0x40, 0x4b, 0x09, 0x0f, 0xc3, 0x62, 0xc5, 0x98, 0x78,
-		0x31, 0x29, 0x66, 0x8c, 0x8b, 0x1b, 0xd3, 0x62, 0xa7, 0x34, 0xf1, 0x53, 0x9a, 0x18, 0x2a, 0x43,
-		0x1c, 0x19, 0xb2, 0x72, 0x35, 0xef, 0x77, 0xdd, 0x62, 0x2a, 0x1d, 0x98, 0xd8, 0x36, 0x0d, 0x55,
-		0xd5, 0x0f, 0x1c, 0x83, 0x1b, 0x32, 0x2d, 0xff, 0x35, 0x75, 0x33, 0x43, 0x3b, 0xa5, 0x48, 0x63,
-		0xc1, 0xb9, 0x6f, 0x92, 0xf0, 0x2c, 0x15, 0x23, 0xe3, 0x5f, 0x1b, 0x9a, 0x17, 0xbd, 0xee, 0xa1,
-		0xd2, 0x04, 0x7d, 0x19, 0x02, 0xbf, 0x34, 0xc1, 0x5f, 0x96, 0x02, 0x28, 0x5d, 0x11, 0x94, 0xae,
-		0x10, 0xca, 0x54, 0x0c, 0x66, 0x14, 0x84, 0x21, 0x45, 0x91, 0x4e, 0x8c, 0x76, 0xf7, 0xd5, 0xab,
-		0xa7, 0xa5, 0x1f, 0x04, 0x1e, 0x25, 0xdc, 0xe4, 0x79, 0x99, 0xa0, 0xd3, 0x3a, 0xa8, 0x25, 0x30,
-		0x94, 0x65, 0x39, 0x73, 0x1f, 0xe3, 0x59, 0x97, 0xe6, 0x4f, 0x82, 0x09, 0xf7, 0x11, 0x71, 0x6e,
-		0xa8, 0x50, 0x4c, 0xd2, 0x58, 0x3e, 0x8c, 0xc8, 0xeb, 0x1b, 0xe2, 0x95, 0x00, 0x72, 0x5e, 0xbe,
-		0x2f, 0x64, 0xbc, 0x53, 0xaf, 0xd5, 0x10, 0xed, 0x20, 0xda, 0x41, 0xb4, 0x83, 0x68, 0x67, 0xdd,
-		0xd1, 0x4e, 0xe1, 0x7e, 0x46, 0xf3, 0xca, 0xae, 0x8e, 0xc1, 0x5b, 0x98, 0x89, 0xee, 0x79, 0xfe,
-		0xc7, 0xec, 0x71, 0xb7, 0x4c, 0x47, 0xff, 0xcc, 0xdc, 0x6c, 0x12, 0x32, 0x52, 0x7f, 0x5f, 0xce,
-		0xfd, 0xca, 0x8a, 0x20, 0x99, 0xdd, 0xeb, 0xa6, 0x23, 0x4a, 0x4a, 0x12, 0x0b, 0x4f, 0xb7, 0x0a,
-		0xb9, 0x2b, 0x7f, 0xab, 0xb4, 0x6a, 0xbb, 0x6d, 0xdc, 0x2d, 0x20, 0x54, 0x93, 0xf9, 0xd1, 0xaf,
-		0xd1, 0x7a, 0x45, 0xeb, 0x75, 0xde, 0x69, 0x09, 0x05, 0xa5, 0x7e, 0xa8, 0xcc, 0x9b, 0xab, 0x93,
-		0x1b, 0x41, 0xb6, 0x4f, 0x63, 0xe8, 0x8d, 0x06, 0x2a, 0x1a, 0xa8, 0x68, 0xa0, 0xa2, 0x81, 0xba,
-		0xee, 0x06, 0x2a, 0xd2, 0xf1, 0x08, 0x68, 0x96, 0x18, 0xd0, 0x54, 0x1d, 0xea, 0x91, 0xfb, 0xd2,
-		0x60, 0xcd, 0xf8, 0x76, 0x90, 0xc1, 0x0d, 0x52, 0xef, 0x88, 0x6c, 0x10, 0xd9, 0x20, 0xb2, 0x59,
-		0x7b, 0x64, 0x83, 0xd4, 0xfb, 0xdc, 0x7f, 0x56, 0x95, 0x7a, 0xaf, 0x21, 0x99, 0x0a, 0x43, 0x2c,
-		0x3c, 0xdd, 0x2a, 0x8b, 0xa0, 0xde, 0x9b, 0x9d, 0x1a, 0xee, 0x16, 0x18, 0xaa, 0xc9, 0xfc, 0xe8,
-		0x48, 0xbd, 0xa3, 0xa5, 0x9a, 0xc1, 0x52, 0x65, 0x81, 0x60, 0xaa, 0x14, 0x23, 0x75, 0x7c, 0x27,
-		0x0c, 0x0e, 0x43, 0x0b, 0x15, 0x2d, 0x54, 0xb4, 0x50, 0xd1, 0x42, 0x05, 0x6f, 0xa1, 0xee, 0x94,
-		0x60, 0xa0, 0xb6, 0xd1, 0x40, 0x5d, 0x52, 0x03, 0x15, 0x63, 0xc3, 0xd0, 0x40, 0x9d, 0x73, 0xab,
-		0x34, 0xda, 0x2d, 0xdc, 0x2c, 0x68, 0x9f, 0xa2, 0x7d, 0x8a, 0xf6, 0x69, 0xb6, 0x69, 0xb9, 0x61,
-		0x42, 0x45, 0xc4, 0x9b, 0xd4, 0x09, 0x36, 0x6f, 0xa6, 0x3e, 0xbf, 0x21, 0xda, 0x65, 0x68, 0x97,
-		0xa1, 0x5d, 0x86, 0x76, 0x19, 0x18, 0xbb, 0xec, 0xb1, 0xa8, 0x7a, 0x19, 0x61, 0x51, 0xbb, 0x06,
-		0xef, 0x31, 0x9e, 0x33, 0xf0, 0xc6, 0xd9, 0x4b, 0x9d, 0x63, 0x2a, 0x25, 0x20, 0x7d, 0x03, 0x9d,
-		0x64, 0x5e, 0xd7, 0x07, 0x86, 0x3a, 0xcc, 0xbc, 0x7a, 0xc3, 0x45, 0x77, 0x9e, 0x31, 0xfe, 0x96,
-		0xd7, 0xef, 0x00, 0x5b, 0x9a, 0xe5, 0x9e, 0xaa, 0x0e, 0x9e, 0x2a, 0x6d, 0xa7, 0xaa, 0xfb, 0x33,
-		0xde, 0xfb, 0xa4, 0xea, 0xee, 0x55, 0x8f, 0xae, 0x7f, 0xd4, 0xde, 0xb7, 0x1e, 0x36, 0xbb, 0x9b,
-		0x1b, 0xcf, 0x7f, 0xd7, 0xdd, 0xfc, 0x51, 0x7b, 0xdf, 0x7e, 0xd8, 0xd8, 0x78, 0xe1, 0x7f, 0x7e,
-		0x7f, 0x69, 0x8c, 0xcd, 0x9f, 0x1b, 0x1b, 0x1b, 0xe3, 0xf3, 0xf4, 0xe4, 0x8c, 0x7d, 0xad, 0xd5,
-		0xaf, 0x7f, 0x4f, 0x3e, 0x8e, 0xfe, 0x4e, 0x4f, 0xe9, 0x5c, 0x5f, 0xde, 0x2c, 0xa1, 0x2b, 0xd4,
-		0xaf, 0x44, 0xd0, 0xff, 0x76, 0xaf, 0xff, 0xd3, 0xdd, 0xfc, 0xd1, 0x79, 0x98, 0x7c, 0x4e, 0xfe,
-		0xde, 0xfc, 0xb9, 0xb1, 0xf5, 0xdb, 0xd5, 0xd5, 0xd6, 0xd6, 0x6f, 0x9b, 0xa3, 0x17, 0x1f, 0x7f,
-		0xef, 0xb7, 0xd1, 0xff, 0xfe, 0xde, 0xed, 0xce, 0xfc, 0x6a, 0x73, 0xe3, 0x5f, 0x5b, 0xab, 0x20,
-		0x56, 0x90, 0x35, 0x48, 0xb6, 0xc6, 0x09, 0x93, 0x6a, 0x4f, 0x29, 0x61, 0x16, 0xf2, 0x9d, 0x32,
-		0x7e, 0xe8, 0x25, 0xa5, 0x37, 0x0c, 0x13, 0x5d, 0x95, 0x53, 0x72, 0x37, 0x75, 0xa7, 0xfa, 0x4e,
-		0xab, 0xd5, 0xd9, 0x6e, 0xb5, 0x6a, 0xdb, 0xcd, 0xed, 0xda, 0x6e, 0xbb, 0x5d, 0xef, 0xd4, 0x4d,
-		0xb2, 0xf4, 0x67, 0xc2, 0xa1, 0x82, 0x3a, 0x1f, 0xef, 0x2b, 0x5d, 0x8b, 0x47, 0x9e, 0x87, 0xfc,
-		0x11, 0xf2, 0x47, 0x59, 0xf9, 0xa3, 0xa4, 0x5e, 0xbb, 0xa8, 0x32, 0xa7, 0x3c, 0x06, 0xe9, 0xf1,
-		0x96, 0xc8, 0x21, 0x21, 0x87, 0x84, 0x1c, 0x12, 0x72, 0x48, 0x60, 0x38, 0x24, 0xf4, 0xed, 0x2f,
-		0x91, 0xa1, 0x8b, 0xbe, 0x7d, 0x23, 0x5b, 0x1d, 0x7d, 0xfb, 0x9a, 0xb6, 0x4a, 0xa3, 0x8d, 0x65,
-		0x5f, 0xe0, 0xd8, 0xd1, 0x68, 0xa5, 0xa3, 0x6d, 0xb6, 0xf0, 0x11, 0x75, 0x57, 0x8c, 0x37, 0xbc,
-		0x90, 0x66, 0xfb, 0x84, 0xdd, 0x08, 0x11, 0x7e, 0x78, 0x6c, 0x9e, 0xf3, 0x61, 0xdc, 0xf4, 0x62,
-		0x49, 0x1b, 0xd1, 0x69, 0x5c, 0xba, 0x4a, 0x3a, 0x4d, 0x55, 0x25, 0x88, 0xfd, 0x9d, 0x71, 0x83,
-		0xad, 0x44, 0x5e, 0xb8, 0x17, 0xb6, 0x15, 0xc1, 0xb6, 0x22, 0x8b, 0x36, 0xb3, 0xb1, 0xad, 0x48,
-		0x69, 0x4a, 0xc2, 0x58, 0x5b, 0x11, 0x43, 0x5d, 0x90, 0x66, 0x0e, 0x93, 0x91, 0x6e, 0x48, 0x86,
-		0xc5, 0x97, 0x71, 0x31, 0x56, 0x86, 0x38, 0x2b, 0x4d, 0xac, 0x95, 0x25, 0xde, 0x4a, 0x17, 0x73,
-		0xa5, 0x8b, 0xbb, 0x32, 0xc5, 0x9e, 0x59, 0xb3, 0xca, 0x94, 0xf1, 0x63, 0x4a, 0x1c, 0xa6, 0x37,
-		0x98, 0x24, 0x7c, 0x56, 0x1d, 0x6a, 0x0b, 0x3a, 0x5e, 0x03, 0xc3, 0xfb, 0xf9, 0x79, 0xb2, 0xe9,
-		0xd4, 0xbd, 0x0d, 0xef, 0xb3, 0x32, 0xd2, 0x4f, 0xd3, 0x9b, 0xd5, 0xcc, 0xc6, 0x09, 0x5c, 0x1b,
-		0x9e, 0x2b, 0xb3, 0x8e, 0xab, 0xd2, 0x54, 0x4e, 0x99, 0xaa, 0xa7, 0x74, 0x15, 0x54, 0xb6, 0x2a,
-		0x5a, 0x98, 0x4a, 0x5a, 0x98, 0x6a, 0x5a, 0x84, 0x8a, 0x32, 0xab, 0xaa, 0x0c, 0xab, 0xac, 0x74,
-		0xc2, 0x8c, 0x3b, 0xc2, 0x66, 0x4e, 0x9b, 0x69, 0x87, 0xd8, 0x73, 0xd1, 0x58, 0x02, 0xa3, 0x5e,
-		0x92, 0x83, 0x6c, 0xf2, 0xa7, 0x1c, 0xe9, 0x61, 0x95, 0xed, 0x30, 0x4b, 0x6f, 0x5a, 0x72, 0xd5,
-		0xa6, 0xf4, 0xbe, 0x8b, 0xf2, 0x89, 0x3c, 0x1e, 0x91, 0xb2, 0x7d, 0x23, 0x25, 0x49, 0x99, 0xa7,
-		0x5b, 0xaa, 0x44, 0xc7, 0xda, 0xcc, 0x96, 0x2a, 0x2d, 0x79, 0x16, 0x37, 0x95, 0x61, 0xc5, 0x58,
-		0xde, 0x5d, 0x40, 0x67, 0x0f, 0x94, 0xe5, 0x98, 0x4b, 0xef, 0x57, 0xba, 0x83, 0xce, 0xfc, 0x46,
-		0x30, 0xb8, 0x44, 0x95, 0xc4, 0x83, 0xf2, 0xe8, 0xfa, 0x2a, 0xcf, 0xac, 0x7f, 0x7e, 0x63, 0xb4,
-		0x53, 0xd1, 0x4e, 0x45, 0x3b, 0x15, 0xed, 0x54, 0xb4, 0x53, 0xc7, 0xa7, 0x2d, 0xd6, 0x5a, 0x82,
-		0xba, 0x65, 0xe6, 0xc1, 0x6d, 0x97, 0x93, 0x07, 0x37, 0x8e, 0xbc, 0xb0, 0xab, 0xcc, 0xed, 0x4e,
-		0x45, 0x5a, 0x3c, 0xfb, 0xc5, 0xf8, 0x67, 0x1e, 0x4f, 0x07, 0x22, 0xa0, 0x35, 0x47, 0x40, 0x18,
-		0x8b, 0xf6, 0xc2, 0x7d, 0x4a, 0x0d, 0x65, 0x9a, 0x0d, 0xba, 0x31, 0x12, 0xdd, 0x64, 0x6e, 0xc5,
-		0x4d, 0xa4, 0x24, 0x49, 0x45, 0x14, 0x35, 0x1f, 0x2d, 0x30, 0xba, 0x0d, 0xf0, 0x60, 0x81, 0x06,
-		0x06, 0x0b, 0x2c, 0x0d, 0xf2, 0xc5, 0x60, 0x81, 0xf5, 0xd5, 0x4e, 0x18, 0x2c, 0xa0, 0x77, 0x3a,
-		0x31, 0x58, 0x00, 0x49, 0x18, 0x24, 0x61, 0x90, 0x84, 0x41, 0x12, 0x06, 0x83, 0x05, 0x0a, 0x8b,
-		0x46, 0x0c, 0x16, 0x28, 0xf0, 0x62, 0x18, 0x2c, 0x50, 0xe6, 0x03, 0x60, 0xb0, 0x80, 0xe9, 0x2d,
-		0x85, 0xc1, 0x02, 0x18, 0x2c, 0x90, 0x19, 0xc2, 0xa3, 0x8f, 0x7b, 0x66, 0x53, 0xa1, 0x8f, 0x1b,
-		0xcd, 0x2b, 0x34, 0xaf, 0xd0, 0xbc, 0x42, 0xf3, 0x6a, 0xd9, 0xcc, 0x2b, 0xf4, 0x71, 0x97, 0xe5,
-		0xe3, 0x46, 0xf2, 0xdb, 0x5a, 0x27, 0xd7, 0xec, 0xc8, 0x63, 0x88, 0x05, 0x49, 0x96, 0x7f, 0xcb,
-		0x2c, 0x7a, 0xab, 0x54, 0x8c, 0xb8, 0xc5, 0x45, 0x64, 0x2b, 0x3e, 0x16, 0xf2, 0xc7, 0x93, 0x7b,
-		0x7e, 0x3b, 0x4f, 0x1e, 0xf8, 0x8b, 0x47, 0xf8, 0xb7, 0xe3, 0xf0, 0xa6, 0xf5, 0x6d, 0x6f, 0xf4,
-		0x94, 0xdf, 0xbe, 0x08, 0x11, 0x7e, 0x8a, 0x9f, 0xef, 0x5b, 0xfa, 0xdd, 0xcb, 0xc9, 0xe3, 0xad,
-		0x41, 0xed, 0x14, 0x33, 0x51, 0x04, 0x46, 0xa3, 0x07, 0x8c, 0x57, 0x48, 0x69, 0x60, 0x85, 0x94,
-		0xd2, 0x6c, 0x05, 0xac, 0x90, 0xb2, 0x7a, 0x5a, 0xcb, 0x58, 0x85, 0x14, 0x62, 0xdb, 0x34, 0x54,
-		0x55, 0x3f, 0x70, 0x4a, 0x08, 0x7c, 0x9a, 0xbe, 0x19, 0xe4, 0x5e, 0xd3, 0x09, 0x97, 0x89, 0xdd,
-		0xa6, 0x4b, 0x24, 0x91, 0x30, 0x2c, 0x0c, 0x02, 0x49, 0x84, 0x61, 0x61, 0x4b, 0x63, 0x19, 0x97,
-		0x57, 0x91, 0xba, 0x1f, 0x04, 0x1e, 0x25, 0xbc, 0x8c, 0x96, 0x66, 0xf5, 0x35, 0x8e, 0x4d, 0x26,
-		0xce, 0x0d, 0x15, 0x8a, 0xc9, 0x24, 0x0a, 0x6e, 0x64, 0x4b, 0xde, 0x10, 0xaf, 0x04, 0x9d, 0xfd,
-		0xf2, 0x7d, 0x21, 0xab, 0xef, 0x7a, 0xad, 0x86, 0xca, 0x1b, 0x95, 0x37, 0x2a, 0x6f, 0x54, 0xde,
-		0xeb, 0xae, 0xbc, 0x23, 0xc6, 0x55, 0xbd, 0x53, 0x82, 0xee, 0xee, 0x60, 0x3f, 0x89, 0xb7, 0x5f,
-		0x04, 0xfb, 0x49, 0x18, 0xd9, 0xeb, 0xd8, 0x4f, 0x42, 0xd3, 0x56, 0x69, 0xd5, 0x76, 0xb1, 0xa1,
-		0x04, 0x0c, 0xd5, 0x64, 0x7e, 0xf4, 0xeb, 0x35, 0x36, 0xc6, 0xec, 0x48, 0x88, 0xd8, 0x1c, 0x9a,
-		0xe4, 0x27, 0x95, 0x50, 0x61, 0xfa, 0xf9, 0x1d, 0xd1, 0xd4, 0x40, 0x53, 0x03, 0x4d, 0x0d, 0x34,
-		0x35, 0x40, 0x99, 0x1a, 0xd8, 0xb9, 0x6e, 0x9d, 0x2d, 0x8d, 0x1a, 0x62, 0x47, 0xb4, 0x34, 0xe6,
-		0xdb, 0x2a, 0xd8, 0xb9, 0x0e, 0x0d, 0x0d, 0x34, 0x34, 0x12, 0x38, 0x46, 0xfd, 0x50, 0x99, 0xb7,
-		0x2f, 0x26, 0x37, 0x82, 0xec, 0xd7, 0x89, 0x71, 0x24, 0x3a, 0x76, 0xd0, 0xda, 0x42, 0x6b, 0x0b,
-		0xad, 0xad, 0x75, 0xb7, 0xb6, 0x30, 0x2a, 0xa3, 0x4c, 0xfd, 0x5c, 0x75, 0xa8, 0x47, 0xee, 0x4b,
-		0xd3, 0xd2, 0xe3, 0xdb, 0x41, 0xd6, 0xd5, 0x18, 0x81, 0x81, 0x8a, 0x1a, 0x15, 0x35, 0x2a, 0xea,
-		0xb5, 0x57, 0xd4, 0x18, 0x81, 0x31, 0xf7, 0x1f, 0xe4, 0x45, 0x8b, 0xdd, 0x0f, 0x79, 0x51, 0xad,
-		0x5b, 0x65, 0x11, 0xbc, 0x68, 0xb3, 0x53, 0xc3, 0xdd, 0x02, 0x43, 0x35, 0x99, 0x1f, 0x7d, 0xbd,
-		0x89, 0xd1, 0xb2, 0x22, 0x2f, 0x4c, 0x47, 0x5c, 0x60, 0xc8, 0x3b, 0x1a, 0x5c, 0x68, 0x70, 0xa1,
-		0xc1, 0x85, 0x06, 0x17, 0xc6, 0xa1, 0xa0, 0xbd, 0x55, 0x06, 0x88, 0xc6, 0x88, 0x77, 0xb4, 0xb7,
-		0xe6, 0xdc, 0x2a, 0xa5, 0xd5, 0x6c, 0x45, 0x73, 0x0b, 0xcd, 0xad, 0x25, 0x36, 0xb7, 0x6e, 0x98,
-		0x50, 0x11, 0xf1, 0xaa, 0xe3, 0x42, 0x54, 0xe6, 0xad, 0xae, 0xe7, 0x37, 0x44, 0x33, 0x03, 0xcd,
-		0x0c, 0x34, 0x33, 0xd0, 0xcc, 0x00, 0x63, 0x66, 0xb0, 0xd0, 0xb0, 0xec, 0x9a, 0x96, 0x5f, 0xf5,
-		0x5d, 0x83, 0xf7, 0x18, 0xcf, 0x19, 0x78, 0x5b, 0xe3, 0x71, 0x65, 0x6e, 0x5a, 0x25, 0xac, 0xcd,
-		0xcc, 0x1a, 0xed, 0x94, 0x53, 0xaa, 0x56, 0x51, 0xc1, 0x4b, 0x6b, 0x07, 0x52, 0xd9, 0xd8, 0xf8,
-		0x5a, 0xab, 0xee, 0x5e, 0xff, 0xfc, 0x5a, 0xaf, 0xee, 0x5e, 0x8f, 0x3e, 0xd6, 0x93, 0x7f, 0x46,
-		0x9f, 0x1b, 0x5f, 0x6b, 0xd5, 0xd6, 0xe4, 0x73, 0xfb, 0x6b, 0xad, 0xda, 0xbe, 0xde, 0xbc, 0xba,
-		0xda, 0xda, 0xfc, 0xd1, 0x7c, 0xc8, 0x7e, 0xe1, 0xc6, 0xbf, 0xbe, 0x5e, 0x5d, 0x85, 0x3f, 0x3e,
-		0x3f, 0xc4, 0x7f, 0x9f, 0x3c, 0x5c, 0xff, 0x67, 0xf3, 0xf7, 0x0a, 0xb6, 0xeb, 0x5f, 0x9e, 0x53,
-		0xd5, 0xc1, 0x53, 0xa5, 0xed, 0x54, 0x75, 0x7f, 0xc6, 0x7b, 0x9f, 0x54, 0xdd, 0xbd, 0xea, 0xd1,
-		0xf5, 0x8f, 0xda, 0xfb, 0xd6, 0xc3, 0x66, 0x77, 0x73, 0xe3, 0xf9, 0xef, 0xba, 0x9b, 0x3f, 0x6a,
-		0xef, 0xdb, 0x0f, 0x1b, 0x1b, 0x2f, 0xfc, 0xcf, 0xef, 0x2f, 0x8d, 0xb1, 0xf9, 0x73, 0x63, 0x63,
-		0x63, 0x7c, 0x9e, 0x9e, 0x9c, 0xb1, 0xaf, 0xb5, 0xfa, 0xf5, 0xef, 0xc9, 0xc7, 0xd1, 0xdf, 0xe9,
-		0x29, 0x9d, 0xeb, 0xcb, 0x9b, 0x2f, 0x9e, 0xcd, 0xf7, 0xa5, 0x89, 0xa0, 0xff, 0xed, 0x5e, 0xff,
-		0xa7, 0xbb, 0xf9, 0xa3, 0xf3, 0x30, 0xf9, 0x9c, 0xfc, 0xbd, 0xf9, 0x73, 0x63, 0xeb, 0xb7, 0xab,
-		0xab, 0xad, 0xad, 0xdf, 0x36, 0x47, 0x2f, 0x3e, 0xfe, 0xde, 0x6f, 0xa3, 0xff, 0xfd, 0xbd, 0xdb,
-		0x9d, 0xf9, 0xd5, 0xe6, 0xc6, 0xbf, 0xb6, 0x56, 0x41, 0xac, 0x40, 0x33, 0x82, 0x0d, 0xc1, 0xbd,
-		0x13, 0x26, 0xd5, 0x9e, 0x52, 0x86, 0x3b, 0x64, 0x9e, 0x32, 0x7e, 0xe8, 0x25, 0xf5, 0xb1, 0x0c,
-		0xf3, 0x36, 0x95, 0x53, 0x72, 0x37, 0x75, 0xa7, 0xfa, 0x4e, 0xab, 0xd5, 0xd9, 0x6e, 0xb5, 0x6a,
-		0xdb, 0xcd, 0xed, 0xda, 0x6e, 0xbb, 0x5d, 0xef, 0xd4, 0x4d, 0x92, 0xce, 0x67, 0xc2, 0xa1, 0x82,
-		0x3a, 0x1f, 0xef, 0x2b, 0x5d, 0x8b, 0x47, 0x9e, 0x87, 0x74, 0x48, 0x35, 0x29, 0xd9, 0x2d, 0xaa,
-		0xcc, 0x29, 0x8f, 0x10, 0x79, 0xbc, 0x25, 0x52, 0x22, 0x48, 0x89, 0x20, 0x25, 0x82, 0x94, 0x08,
-		0x18, 0x4a, 0x04, 0x3d, 0xaf, 0x4b, 0x64, 0xb7, 0xa1, 0xe7, 0xd5, 0xc8, 0x56, 0x47, 0xcf, 0xab,
-		0xa6, 0xad, 0x82, 0x15, 0x00, 0x20, 0x99, 0x85, 0x16, 0x7a, 0x5e, 0xb1, 0xf3, 0x51, 0x99, 0x9d,
-		0x8f, 0x0c, 0x74, 0xc4, 0x5a, 0xce, 0x6e, 0x42, 0xe6, 0x4d, 0xcd, 0xd2, 0x4c, 0x4c, 0x43, 0xa6,
-		0xa5, 0x31, 0x93, 0x12, 0xbb, 0x0c, 0x2d, 0x83, 0xc9, 0x88, 0x5d, 0x86, 0x4a, 0xd3, 0x10, 0xc6,
-		0x4c, 0xc1, 0x12, 0x3a, 0x84, 0x9a, 0xec, 0x08, 0x9a, 0x76, 0x00, 0xdd, 0xda, 0xfa, 0x30, 0x52,
-		0x69, 0x1f, 0x66, 0x65, 0xe5, 0xb2, 0xea, 0xa2, 0x77, 0x4b, 0xb4, 0xd3, 0x62, 0xa1, 0x61, 0x52,
-		0xd3, 0x98, 0x21, 0xfc, 0xcd, 0x11, 0xfc, 0xa5, 0x12, 0xfa, 0x66, 0x08, 0x7c, 0x5d, 0x3b, 0xc3,
-		0x10, 0x3a, 0x2d, 0x15, 0x95, 0x56, 0xb4, 0x76, 0x91, 0xcc, 0xd9, 0x74, 0x53, 0x8f, 0x20, 0x2a,
-		0x2e, 0x36, 0x8a, 0x8d, 0x50, 0x70, 0x5b, 0x55, 0xe8, 0x9d, 0x12, 0xa4, 0x1a, 0x71, 0xa9, 0x48,
-		0xdf, 0xd3, 0xa3, 0xd4, 0x2a, 0x82, 0xba, 0x54, 0x50, 0x6e, 0xeb, 0xe3, 0xff, 0x34, 0xee, 0xf3,
-		0x89, 0x86, 0x3d, 0x3f, 0xda, 0xb7, 0xda, 0xdb, 0xbb, 0x3b, 0x56, 0xd5, 0xfa, 0x32, 0x92, 0xb2,
-		0x56, 0xb2, 0x65, 0x84, 0x75, 0x4e, 0x9d, 0x88, 0x3b, 0x84, 0xdb, 0xf7, 0x56, 0x4f, 0x04, 0x2a,
-		0xb0, 0x03, 0xef, 0x8a, 0x6f, 0x7c, 0x39, 0x3f, 0xef, 0x6d, 0x5a, 0x5f, 0xa8, 0x90, 0x2c, 0xe0,
-		0x56, 0xd3, 0x72, 0x03, 0x61, 0x1d, 0xf7, 0x6e, 0x5a, 0x16, 0xe1, 0x4e, 0xfc, 0x41, 0x67, 0x69,
-		0x02, 0x53, 0x98, 0x74, 0x1a, 0x8b, 0x3e, 0x2e, 0x92, 0x66, 0x70, 0x64, 0x1a, 0x86, 0x3e, 0x81,
-		0x9f, 0xfa, 0x57, 0x71, 0xd9, 0x00, 0xc5, 0xbb, 0xc5, 0x92, 0x42, 0x45, 0xe5, 0x8b, 0x66, 0x75,
-		0x65, 0x5e, 0x4d, 0x15, 0xdb, 0x00, 0xf9, 0x97, 0x2b, 0xdf, 0x95, 0x39, 0x97, 0x67, 0x82, 0x2f,
-		0x73, 0x5b, 0xd4, 0x7a, 0x00, 0xa4, 0x3e, 0xc0, 0x68, 0x14, 0x20, 0xea, 0x01, 0x84, 0x79, 0x97,
-		0x6a, 0x2f, 0x1a, 0xc4, 0xaf, 0x45, 0x9d, 0x42, 0xda, 0xb4, 0xd8, 0xd1, 0x4b, 0xb5, 0xe6, 0x87,
-		0xd8, 0xde, 0x77, 0xbb, 0x53, 0x07, 0xec, 0xd9, 0x2f, 0xe2, 0x9f, 0xe3, 0x23, 0xd6, 0x9d, 0x3e,
-		0x6e, 0x09, 0x49, 0xd0, 0x4d, 0x0e, 0xdd, 0xe8, 0xe3, 0xe3, 0xd1, 0x7b, 0xf2, 0x73, 0x41, 0x55,
-		0x54, 0x39, 0xa0, 0xd2, 0x16, 0x2c, 0x1c, 0x4b, 0x9b, 0xca, 0x9e, 0xe3, 0xb0, 0xf8, 0x33, 0xf1,
-		0xac, 0xe3, 0x9e, 0x15, 0xdf, 0xc3, 0x72, 0x89, 0xcf, 0xbc, 0x7b, 0x6b, 0x24, 0x32, 0x22, 0x91,
-		0x08, 0xa6, 0x58, 0x09, 0x5c, 0xf1, 0xc7, 0x57, 0x2a, 0xfa, 0x14, 0x7a, 0x7a, 0x84, 0x6b, 0x63,
-		0xeb, 0x74, 0xb2, 0x73, 0xda, 0xd9, 0x38, 0xdd, 0x48, 0xc7, 0x18, 0xdb, 0x66, 0x0c, 0xd6, 0x98,
-		0x60, 0xd3, 0x16, 0x6b, 0x52, 0xe8, 0xea, 0xc1, 0x5d, 0x49, 0xd4, 0xb1, 0xb6, 0x9d, 0x91, 0xb2,
-		0xf7, 0xf1, 0xa8, 0x9a, 0xd6, 0xee, 0x99, 0xc0, 0x39, 0xe4, 0xb6, 0x17, 0x48, 0xc6, 0x07, 0xb1,
-		0x80, 0x51, 0x84, 0x71, 0x2a, 0x12, 0x84, 0x19, 0xa3, 0x4e, 0x2b, 0xb1, 0x7c, 0xa5, 0x35, 0x24,
-		0xdc, 0xf1, 0xa8, 0x63, 0xf5, 0xef, 0x2d, 0x35, 0x64, 0xf2, 0x8a, 0x1f, 0xf7, 0xac, 0x54, 0xf6,
-		0xe8, 0x7a, 0x2e, 0x3d, 0x22, 0x48, 0xbb, 0x28, 0x32, 0x21, 0x92, 0x8c, 0x89, 0xa6, 0x32, 0x8d,
-		0x31, 0x23, 0x8e, 0x81, 0x72, 0x2d, 0x31, 0xcd, 0x8e, 0x80, 0xe5, 0xa2, 0x63, 0x0d, 0xb0, 0x24,
-		0x06, 0xd9, 0x12, 0xfd, 0xac, 0x09, 0x28, 0xf6, 0xc4, 0xf4, 0xc1, 0x2d, 0x93, 0x4d, 0x29, 0xed,
-		0x2c, 0x43, 0x63, 0x57, 0xf4, 0xca, 0x09, 0xfd, 0xa3, 0x5d, 0xaf, 0x06, 0xbb, 0x5c, 0x32, 0x9d,
-		0x71, 0x9d, 0xd7, 0x46, 0xd6, 0xc3, 0x32, 0x19, 0x65, 0x97, 0x0a, 0x48, 0x86, 0xac, 0x4e, 0x8e,
-		0x7c, 0xc7, 0x2c, 0xfb, 0x92, 0x65, 0xbb, 0x22, 0xa3, 0x32, 0x2e, 0xba, 0xa8, 0x46, 0x16, 0x33,
-		0xdb, 0xcc, 0xce, 0x3f, 0x3f, 0x19, 0xe6, 0xa6, 0x62, 0x4f, 0xd0, 0x78, 0xb6, 0x39, 0x79, 0x6c,
-		0x91, 0x39, 0xba, 0x3e, 0xe3, 0x6a, 0xe4, 0x33, 0x29, 0x72, 0x9b, 0x0e, 0x45, 0x4c, 0x84, 0xc2,
-		0xa6, 0x40, 0x51, 0xe4, 0xa0, 0x0d, 0xda, 0x6b, 0x53, 0xfb, 0x3a, 0xa0, 0xba, 0xd9, 0xd3, 0x9e,
-		0x97, 0x35, 0xa8, 0x50, 0x1e, 0x63, 0xf2, 0xfc, 0xe1, 0x7e, 0xe9, 0x6e, 0x99, 0x0c, 0x94, 0x73,
-		0x86, 0x75, 0xd4, 0x27, 0x2d, 0xd0, 0x9a, 0x29, 0xaf, 0xea, 0x2c, 0x16, 0x6b, 0x58, 0x98, 0x1a,
-		0xd0, 0x41, 0x05, 0x68, 0x33, 0xfd, 0x75, 0x59, 0x0c, 0xda, 0x4d, 0x7b, 0xed, 0xf0, 0x5f, 0xa7,
-		0xe9, 0x5e, 0xae, 0xe7, 0xaa, 0x70, 0xec, 0x9d, 0xc6, 0xd6, 0x3f, 0x05, 0x5b, 0xfb, 0x3c, 0x2c,
-		0x18, 0xed, 0xde, 0x0f, 0x02, 0x55, 0x0d, 0xec, 0xaa, 0x1d, 0xf8, 0x61, 0x02, 0x6f, 0x9c, 0xaa,
-		0x47, 0x89, 0x1b, 0x0f, 0xfa, 0x50, 0x16, 0x82, 0xcc, 0xa1, 0x9c, 0x7d, 0x15, 0x15, 0x17, 0xf6,
-		0xf1, 0x20, 0x28, 0x31, 0x51, 0x62, 0xa2, 0xc4, 0xcc, 0xb0, 0x5b, 0x0a, 0xf7, 0x60, 0xd1, 0xd0,
-		0x63, 0x45, 0x53, 0x66, 0xa9, 0x06, 0xf7, 0x94, 0xce, 0xcc, 0xd0, 0x34, 0x9d, 0xaf, 0xa3, 0xa9,
-		0x4e, 0x8f, 0xb1, 0x6c, 0x3d, 0xfd, 0xd9, 0x78, 0x1a, 0x48, 0x7a, 0xad, 0xa9, 0x97, 0x8f, 0x6b,
-		0xd1, 0x6e, 0x37, 0xdb, 0xeb, 0xb7, 0x1c, 0x0b, 0x62, 0x0c, 0xaf, 0x4b, 0x15, 0x8a, 0x1a, 0x3d,
-		0x3a, 0x1a, 0x3d, 0x38, 0x1a, 0x23, 0x34, 0xce, 0x8f, 0xf6, 0xad, 0xed, 0xdd, 0x7a, 0xd7, 0x4a,
-		0xd8, 0x42, 0x4e, 0x55, 0x4a, 0xd4, 0x2f, 0x79, 0xd8, 0x86, 0x6e, 0x57, 0x4a, 0x39, 0x91, 0x1b,
-		0xbf, 0x98, 0x6e, 0x3c, 0x91, 0x6b, 0x67, 0xd5, 0xac, 0x0b, 0x2f, 0x3e, 0xa6, 0x92, 0x97, 0x80,
-		0x14, 0xe7, 0x94, 0x0d, 0x86, 0xfd, 0x40, 0xc8, 0xfc, 0xbc, 0xf8, 0xe3, 0x10, 0x48, 0x8d, 0x1b,
-		0x93, 0xfc, 0x48, 0x8d, 0x97, 0x48, 0x8d, 0x4f, 0x76, 0x74, 0x71, 0xba, 0x24, 0x1d, 0xa9, 0x18,
-		0x67, 0x52, 0x47, 0xce, 0x04, 0x39, 0x13, 0x08, 0x9c, 0x49, 0xd1, 0x18, 0xd6, 0xbc, 0x4e, 0xda,
-		0x57, 0x37, 0x5d, 0x2e, 0xa7, 0xad, 0xe6, 0x63, 0xa8, 0xed, 0x38, 0xea, 0x3c, 0x96, 0xda, 0x8f,
-		0x67, 0x19, 0x36, 0x0e, 0x86, 0xa6, 0x2f, 0x84, 0x2a, 0xd2, 0x17, 0x9a, 0xce, 0x0c, 0x04, 0xa6,
-		0x6b, 0x8b, 0x5a, 0xd6, 0x5c, 0x3f, 0x06, 0xc3, 0xbf, 0x31, 0xfc, 0xbb, 0x2c, 0xf1, 0xa0, 0x47,
-		0x4c, 0x68, 0x12, 0x17, 0xe9, 0x8b, 0x6a, 0xaf, 0xf7, 0xf2, 0x62, 0x8f, 0x8d, 0x2a, 0x0f, 0xaa,
-		0xff, 0x17, 0x70, 0x9d, 0x21, 0xc4, 0x26, 0xaa, 0xff, 0x1b, 0xab, 0xf2, 0xbf, 0xf0, 0x1e, 0x19,
-		0x9a, 0x8f, 0x47, 0x7c, 0xc3, 0xab, 0xab, 0xad, 0xeb, 0xdf, 0x2a, 0xcb, 0x16, 0x55, 0xbc, 0xe4,
-		0xa5, 0x48, 0xb4, 0x33, 0x78, 0xfa, 0xa5, 0x8b, 0x0e, 0x9f, 0x95, 0xc7, 0xf8, 0xf7, 0xaa, 0x47,
-		0xee, 0xa9, 0xd0, 0xde, 0x2e, 0xee, 0xb1, 0x8e, 0xd4, 0xec, 0x3d, 0x10, 0x63, 0x20, 0xc6, 0x40,
-		0x8c, 0xb1, 0x74, 0x18, 0xe3, 0x94, 0x70, 0x87, 0xa8, 0x40, 0xdc, 0x6b, 0xcc, 0xdb, 0x34, 0x87,
-		0x5b, 0xc2, 0xe1, 0xbd, 0x34, 0xd0, 0xc5, 0x08, 0x18, 0x5e, 0x99, 0x6e, 0x1b, 0xd4, 0x78, 0xd8,
-		0xe8, 0x3e, 0xfd, 0x79, 0xf3, 0x37, 0x9d, 0x0d, 0x72, 0x50, 0xf5, 0x63, 0xbd, 0xae, 0xe5, 0xae,
-		0xa7, 0x93, 0xfa, 0xcf, 0xd2, 0x4f, 0xb9, 0xfc, 0x83, 0xfa, 0xe6, 0xbb, 0xc0, 0x5c, 0xeb, 0x20,
-		0x78, 0xf4, 0x11, 0x3b, 0x9a, 0xc0, 0x16, 0xf2, 0xb6, 0x4b, 0x05, 0xa2, 0x90, 0xb7, 0x2d, 0x1f,
-		0xc8, 0x18, 0x28, 0xb0, 0xab, 0xb3, 0xa0, 0xee, 0x74, 0x01, 0xdd, 0x51, 0x65, 0xa4, 0x71, 0x19,
-		0xdd, 0x49, 0x05, 0x25, 0x88, 0xa2, 0x74, 0x54, 0x82, 0x5e, 0x9b, 0x34, 0x1d, 0x0d, 0xb7, 0x64,
-		0x8e, 0xb0, 0x06, 0x0a, 0x54, 0x14, 0xa8, 0x80, 0x04, 0x2a, 0x3a, 0xc2, 0x90, 0xa4, 0x42, 0x92,
-		0x0a, 0x49, 0xaa, 0xa5, 0x21, 0x94, 0xd0, 0x11, 0x36, 0x4d, 0x2c, 0xa1, 0x23, 0xac, 0x1c, 0x36,
-		0x0c, 0xfd, 0x40, 0xa8, 0x62, 0x51, 0xc5, 0xa2, 0x8a, 0x5d, 0x22, 0x15, 0x8b, 0x7e, 0x20, 0xf4,
-		0x03, 0xad, 0x8b, 0xe6, 0x0b, 0x04, 0x1b, 0xe8, 0x4c, 0xa2, 0x4e, 0xe5, 0xf2, 0x68, 0x5c, 0xd4,
-		0x70, 0xa8, 0xe1, 0x50, 0xc3, 0xad, 0x91, 0x11, 0x39, 0xf1, 0x3b, 0x56, 0xb5, 0x0a, 0x80, 0x27,
-		0x0a, 0xa9, 0xa5, 0x71, 0xcc, 0x43, 0x1e, 0xf9, 0xe8, 0xd9, 0x4e, 0xf7, 0x3f, 0x28, 0xcf, 0xb6,
-		0x86, 0xbe, 0xba, 0xd8, 0x2b, 0x26, 0x1b, 0x30, 0xc6, 0x5e, 0x31, 0x6f, 0xde, 0x7c, 0x2d, 0xaa,
-		0x5a, 0xec, 0x34, 0x3a, 0x5d, 0x6b, 0x8f, 0x5b, 0x87, 0x6a, 0x38, 0xaa, 0xb4, 0x30, 0xae, 0x7a,
-		0x6b, 0x9d, 0x53, 0x19, 0x78, 0x51, 0xd2, 0xd2, 0x05, 0x6b, 0x5d, 0x18, 0xae, 0x75, 0x91, 0x69,
-		0x11, 0xb0, 0x02, 0x46, 0x99, 0x1a, 0xd0, 0xac, 0xe6, 0x2b, 0xa3, 0x8c, 0xf5, 0xe7, 0xc9, 0xbd,
-		0xb0, 0x5e, 0x87, 0xa1, 0xe5, 0x5c, 0x86, 0x92, 0x1d, 0xf9, 0xe2, 0x42, 0x0a, 0xc5, 0x81, 0x14,
-		0x2e, 0xd5, 0xd1, 0xc0, 0x52, 0x1d, 0x0b, 0xd4, 0x4a, 0x58, 0xc5, 0xfa, 0xed, 0xdd, 0x82, 0x55,
-		0xac, 0x17, 0xc0, 0xc9, 0x61, 0x7d, 0x91, 0x85, 0xa0, 0x53, 0xac, 0xc9, 0xba, 0x0c, 0x55, 0xac,
-		0xb1, 0xe8, 0x33, 0x0a, 0x18, 0x14, 0x30, 0x2b, 0x2b, 0x60, 0xb0, 0xe8, 0xf3, 0x33, 0x16, 0x12,
-		0x8b, 0x3e, 0x63, 0xd1, 0xe7, 0x95, 0x5b, 0x0e, 0x2c, 0xfa, 0x9c, 0x71, 0x2c, 0x2c, 0xfa, 0x5c,
-		0x78, 0x3c, 0x2c, 0xfa, 0xbc, 0x3a, 0x27, 0x12, 0x39, 0xd7, 0xd7, 0x38, 0xd7, 0x1c, 0x9e, 0x62,
-		0x33, 0x7c, 0x6b, 0xc4, 0x79, 0xe4, 0xf7, 0xa9, 0xc8, 0xc1, 0x32, 0x3d, 0x82, 0xc1, 0xc7, 0x31,
-		0xd6, 0xa4, 0x48, 0xb2, 0x8b, 0xcc, 0xeb, 0x4b, 0x86, 0x90, 0xbb, 0x32, 0xcc, 0x6b, 0xc1, 0x4a,
-		0xad, 0x7a, 0x2a, 0xb4, 0xae, 0x5c, 0x81, 0x64, 0x17, 0xf9, 0x85, 0x92, 0x8e, 0xd5, 0x62, 0xf8,
-		0x85, 0xc2, 0x05, 0x92, 0x8b, 0x3a, 0x3c, 0x66, 0x76, 0x5d, 0x31, 0xc7, 0xc7, 0xe3, 0x8b, 0x69,
-		0x70, 0x80, 0xa4, 0x83, 0x25, 0xc6, 0x59, 0x31, 0x2c, 0x78, 0x8d, 0xa5, 0x43, 0x8c, 0x0b, 0x9c,
-		0x32, 0x2c, 0x1c, 0xa0, 0x99, 0xee, 0x2e, 0x96, 0x0e, 0x79, 0xbe, 0xdb, 0x8a, 0x7b, 0x5c, 0x66,
-		0xb4, 0x7e, 0x7d, 0xa5, 0x22, 0x6f, 0x8d, 0x15, 0xf1, 0x82, 0x12, 0x31, 0xbb, 0x84, 0x81, 0x5c,
-		0x8f, 0x86, 0x5b, 0xa1, 0xa2, 0x5c, 0xe5, 0x38, 0xfb, 0xd2, 0xf7, 0xa8, 0x0a, 0xea, 0x16, 0x47,
-		0xe6, 0x4f, 0x87, 0x43, 0x80, 0x8e, 0x00, 0x1d, 0x01, 0xba, 0x71, 0xbb, 0x58, 0xaf, 0x7d, 0xac,
-		0xe9, 0x18, 0x22, 0x9c, 0x45, 0x38, 0x0b, 0x1b, 0xce, 0xea, 0x2b, 0xdc, 0x34, 0x51, 0x8a, 0x06,
-		0xea, 0x37, 0xa5, 0x43, 0x63, 0x06, 0xee, 0xd2, 0x08, 0x03, 0x53, 0x42, 0xc1, 0xb8, 0x70, 0x30,
-		0x2e, 0x24, 0x4c, 0x0a, 0x0b, 0x3d, 0x42, 0x43, 0x93, 0xf0, 0xd0, 0x6f, 0x13, 0xcf, 0xec, 0x56,
-		0x7d, 0x65, 0x35, 0x67, 0x34, 0xfe, 0xb6, 0xde, 0x52, 0x10, 0x63, 0x93, 0x2a, 0x5e, 0xe6, 0xee,
-		0x94, 0x09, 0xf5, 0xec, 0x17, 0xe3, 0x9f, 0x93, 0x94, 0x15, 0x2c, 0xc0, 0xbd, 0x1c, 0x27, 0x41,
-		0x47, 0xe8, 0x90, 0x8c, 0xfa, 0x06, 0x15, 0xe0, 0x93, 0xd1, 0x51, 0x07, 0xa2, 0x0e, 0x44, 0x1d,
-		0x88, 0x3a, 0x10, 0xac, 0x0e, 0xfc, 0xfa, 0xa8, 0x03, 0xff, 0xc7, 0x8e, 0x84, 0xa0, 0x5c, 0x6d,
-		0x6c, 0x7e, 0xd8, 0xda, 0x7a, 0xa4, 0x1b, 0xaf, 0xc7, 0x97, 0x4c, 0xcb, 0x3d, 0xf9, 0xc2, 0xef,
-		0xd2, 0x91, 0x1d, 0x7a, 0x87, 0xea, 0x14, 0xab, 0x7e, 0x2c, 0x77, 0xd5, 0x8f, 0x29, 0xca, 0xfc,
-		0x09, 0x83, 0x0c, 0xb8, 0xab, 0x05, 0x96, 0x62, 0x47, 0x46, 0x0f, 0x19, 0x3d, 0x64, 0xf4, 0x90,
-		0xd1, 0x43, 0x6b, 0x06, 0xad, 0x19, 0xb4, 0x66, 0x90, 0xd1, 0x43, 0x42, 0x0b, 0x09, 0x2d, 0x54,
-		0x01, 0xa8, 0x02, 0x50, 0x05, 0x20, 0xa1, 0xb5, 0x14, 0x84, 0x16, 0xf2, 0x39, 0xa5, 0xf3, 0x39,
-		0x6b, 0x54, 0xcb, 0x75, 0xb9, 0x23, 0x53, 0x75, 0x04, 0x6a, 0x5a, 0x59, 0x0a, 0x0d, 0xfe, 0x99,
-		0xde, 0xfb, 0x5b, 0xfa, 0xa5, 0x73, 0xea, 0x2e, 0x73, 0x6c, 0x6c, 0x31, 0x0a, 0x4f, 0x0b, 0x75,
-		0xa7, 0x2d, 0x16, 0xb6, 0x81, 0xb1, 0xb0, 0xe6, 0xa0, 0x16, 0xc6, 0xc2, 0x6a, 0xa3, 0xd8, 0x30,
-		0x59, 0x6d, 0xce, 0x3f, 0x98, 0xac, 0x56, 0x9e, 0xed, 0x87, 0xbe, 0x00, 0x4c, 0x56, 0x7b, 0x7b,
-		0xb7, 0x2d, 0x5f, 0xb2, 0x1a, 0x22, 0x65, 0x0d, 0x48, 0xb9, 0x80, 0xc9, 0x82, 0xa5, 0x5a, 0xe6,
-		0x98, 0xe0, 0x4a, 0x2e, 0x64, 0x9e, 0xd5, 0xe8, 0x30, 0x56, 0x15, 0xe6, 0x9d, 0xc6, 0x85, 0xca,
-		0xbb, 0x40, 0x1a, 0x17, 0xa6, 0x92, 0xa9, 0xaa, 0xf8, 0x5c, 0xab, 0x30, 0xdf, 0xd4, 0xbf, 0x3d,
-		0x91, 0x73, 0x4c, 0x62, 0x85, 0x85, 0x37, 0x9d, 0xb9, 0xa7, 0x6e, 0xba, 0x23, 0xee, 0xbc, 0x55,
-		0x17, 0x33, 0x1a, 0x64, 0x99, 0xf1, 0x51, 0x1e, 0x1c, 0x94, 0xbb, 0xda, 0x68, 0x5e, 0x5c, 0x53,
-		0x18, 0xbf, 0x14, 0xc6, 0x29, 0x45, 0xaa, 0x85, 0xea, 0x3d, 0xb2, 0x59, 0x0d, 0x9e, 0xca, 0xb8,
-		0x85, 0x23, 0x95, 0xf9, 0x4b, 0x43, 0x3d, 0x0e, 0xb1, 0x26, 0x95, 0xa1, 0xb0, 0x26, 0xbf, 0xb5,
-		0xda, 0x35, 0xf9, 0x8b, 0x76, 0x63, 0x7e, 0x7e, 0x34, 0x30, 0xf5, 0x1c, 0x6b, 0x4f, 0x97, 0x7b,
-		0xb0, 0x80, 0xd2, 0x6d, 0x98, 0x7a, 0xbe, 0x48, 0x72, 0x2a, 0x44, 0x72, 0xca, 0x04, 0x39, 0x15,
-		0x62, 0xa0, 0x6a, 0x6a, 0x0f, 0x19, 0x88, 0x50, 0x0d, 0x31, 0x2e, 0x69, 0x79, 0x8e, 0xbf, 0x29,
-		0x31, 0x60, 0x5c, 0x1c, 0x18, 0x17, 0x0b, 0x26, 0xc5, 0x83, 0x1e, 0x31, 0xa1, 0x49, 0x5c, 0xa4,
-		0x2f, 0x6a, 0x2e, 0x2e, 0x89, 0x85, 0x37, 0x9d, 0xea, 0x18, 0x5b, 0x57, 0x79, 0x50, 0xfd, 0xbf,
-		0x80, 0x53, 0x13, 0x41, 0x4a, 0x3b, 0x7a, 0x83, 0x94, 0x14, 0x15, 0x5c, 0x8b, 0xc7, 0xee, 0xc9,
-		0xc0, 0x1b, 0x1b, 0xdd, 0x9f, 0x5f, 0x6b, 0xd5, 0x5d, 0x52, 0x75, 0xf7, 0xaa, 0x47, 0xd7, 0x3f,
-		0x6a, 0xef, 0x5b, 0x0f, 0x9b, 0xdd, 0xcd, 0x8d, 0xe7, 0xbf, 0xeb, 0x6e, 0xfe, 0xa8, 0xbd, 0x6f,
-		0x3f, 0x6c, 0x6c, 0xbc, 0xf0, 0x3f, 0xbf, 0xbf, 0x34, 0xc6, 0xe6, 0xcf, 0x8d, 0x8d, 0x8d, 0x46,
-		0xfb, 0x6b, 0xad, 0xda, 0xbe, 0xfe, 0xd9, 0xf8, 0x5a, 0xab, 0xb6, 0xae, 0xe3, 0xef, 0x5c, 0xff,
-		0xfc, 0x5a, 0xab, 0x5f, 0xff, 0x9e, 0x7c, 0x1c, 0xfd, 0xbd, 0x79, 0x75, 0xb5, 0xb5, 0xf9, 0xa3,
-		0xf9, 0x30, 0xdf, 0x97, 0x37, 0x37, 0x37, 0xfe, 0xf5, 0xf5, 0xea, 0x2a, 0xfc, 0xf1, 0xf9, 0x21,
-		0xfe, 0xfb, 0xe4, 0xe1, 0xfa, 0x3f, 0x9b, 0xbf, 0xeb, 0x3e, 0xc5, 0x1b, 0x1b, 0x5f, 0xff, 0xb7,
-		0x7b, 0xfd, 0x9f, 0xee, 0xe6, 0x8f, 0xce, 0xc3, 0xe4, 0x73, 0xf2, 0xf7, 0xe6, 0xcf, 0x8d, 0xad,
-		0xdf, 0xae, 0xae, 0xb6, 0xb6, 0x7e, 0xdb, 0x1c, 0xbd, 0xe0, 0xf8, 0x7b, 0xbf, 0x8d, 0xfe, 0xf7,
-		0xf7, 0x6e, 0x77, 0xe6, 0x57, 0x9b, 0x1b, 0xff, 0xda, 0x32, 0xf0, 0x88, 0x8f, 0xf3, 0xdd, 0xbd,
-		0xba, 0xda, 0xba, 0xfe, 0x4d, 0x9f, 0x18, 0xb8, 0xc6, 0x0c, 0xcb, 0xe5, 0x90, 0xa6, 0x3a, 0xe2,
-		0xbb, 0x47, 0x5a, 0xae, 0xea, 0x51, 0x3e, 0x48, 0xf8, 0x74, 0xcd, 0xf0, 0xe9, 0xe9, 0xf0, 0x88,
-		0xa4, 0x10, 0x49, 0x21, 0x92, 0x5a, 0x3a, 0x24, 0x75, 0x4a, 0xb8, 0x43, 0x54, 0x20, 0xee, 0x8b,
-		0xb3, 0x10, 0x25, 0xa0, 0xb3, 0x88, 0x71, 0xb5, 0x63, 0x00, 0x8e, 0xb5, 0x35, 0x0e, 0xa9, 0xa7,
-		0xad, 0xd8, 0xf3, 0x3f, 0x7a, 0x4f, 0xa8, 0xa5, 0xbb, 0xed, 0xd8, 0xcc, 0xe0, 0x93, 0xd6, 0x57,
-		0xb5, 0xf7, 0x66, 0xc6, 0x37, 0xd5, 0x06, 0x6b, 0x76, 0xeb, 0xe9, 0x6e, 0x8b, 0x65, 0xe8, 0x24,
-		0x3f, 0x3b, 0xd5, 0x77, 0xe6, 0x97, 0xb6, 0xde, 0xd8, 0xc1, 0xc5, 0x2d, 0x45, 0xd8, 0xeb, 0x1f,
-		0x0d, 0x71, 0x3c, 0x66, 0xd6, 0x2c, 0x73, 0x66, 0x4d, 0xe7, 0x43, 0xea, 0xfa, 0x9f, 0x7c, 0x02,
-		0x5c, 0x22, 0x45, 0x03, 0x39, 0xad, 0x8f, 0x94, 0x5e, 0x9b, 0x80, 0x68, 0xf4, 0x39, 0x2d, 0xab,
-		0x29, 0xb4, 0x6a, 0x01, 0xd1, 0xfa, 0x92, 0x59, 0x75, 0x26, 0xb1, 0xa6, 0xc9, 0xab, 0x5b, 0x5b,
-		0x49, 0x4e, 0x69, 0xd8, 0x1d, 0x49, 0xd0, 0xf1, 0x0f, 0x45, 0x17, 0x12, 0xab, 0x4d, 0x3d, 0x5f,
-		0xb3, 0x55, 0xaf, 0x36, 0x85, 0x02, 0x15, 0x05, 0xea, 0x4b, 0x2f, 0x84, 0x4e, 0xfc, 0x45, 0xe2,
-		0x27, 0x13, 0xc7, 0xde, 0xd8, 0xf1, 0x37, 0x25, 0x06, 0x8c, 0x8b, 0x03, 0xe3, 0x62, 0xc1, 0xa4,
-		0x78, 0xd0, 0xcb, 0x1f, 0xa0, 0x13, 0x1f, 0x9d, 0xf8, 0xe8, 0xc4, 0x47, 0x27, 0xfe, 0x52, 0xf8,
-		0xb0, 0x03, 0xc1, 0x06, 0x1a, 0xfd, 0x18, 0x8f, 0x0a, 0x6f, 0x34, 0x2e, 0x42, 0x07, 0x84, 0x0e,
-		0x08, 0x1d, 0xd6, 0x0a, 0x3a, 0xa4, 0xc0, 0x41, 0xab, 0x08, 0x78, 0x82, 0x1b, 0x5a, 0x1a, 0xc7,
-		0x3c, 0xe4, 0x91, 0x8f, 0x81, 0x49, 0x99, 0xd6, 0x18, 0x03, 0x93, 0x50, 0xc4, 0xa3, 0x88, 0x5f,
-		0x72, 0x11, 0x8f, 0x81, 0x49, 0x18, 0x98, 0x64, 0xe0, 0x0f, 0x06, 0x26, 0x95, 0x7d, 0x92, 0x9f,
-		0x9d, 0x6a, 0x0c, 0x4c, 0x5a, 0x8e, 0xc5, 0x5d, 0xed, 0xc0, 0xa4, 0xe5, 0xa8, 0x9f, 0xae, 0x88,
-		0x8a, 0xa4, 0x81, 0xca, 0xe9, 0xa3, 0x71, 0x11, 0xb8, 0x22, 0x70, 0x45, 0xe0, 0xba, 0x46, 0xdc,
-		0x04, 0xe5, 0x91, 0x4f, 0xc5, 0x28, 0x5c, 0x6f, 0xbd, 0x58, 0x09, 0x4d, 0x4b, 0x43, 0xef, 0x94,
-		0x20, 0xd5, 0x88, 0x4b, 0x45, 0xfa, 0x9e, 0xe6, 0x45, 0x12, 0xd4, 0xa5, 0x82, 0x72, 0x1b, 0x04,
-		0xc2, 0x9e, 0xec, 0xa8, 0xf3, 0xa3, 0x7d, 0xab, 0xd5, 0xd8, 0x6d, 0x76, 0xad, 0x53, 0xc2, 0xc9,
-		0x80, 0xc6, 0x07, 0xd4, 0x3a, 0xe6, 0x6e, 0x20, 0xfc, 0x64, 0x9b, 0x59, 0x1f, 0x89, 0xa4, 0x96,
-		0x1b, 0x08, 0x4b, 0x0d, 0xe9, 0x15, 0x9f, 0x1a, 0x22, 0x29, 0x82, 0xc6, 0xa9, 0xb2, 0x7a, 0x22,
-		0x50, 0x81, 0x1d, 0x78, 0xd6, 0xc6, 0x71, 0x6f, 0xf3, 0xc9, 0x57, 0xaa, 0xd6, 0x71, 0xb8, 0x37,
-		0xe2, 0xd2, 0x2e, 0x12, 0x8d, 0x75, 0xb9, 0x7f, 0xc5, 0xad, 0xe4, 0x96, 0x3b, 0x9d, 0x46, 0xd7,
-		0x3a, 0xee, 0xdd, 0x74, 0xac, 0xf8, 0x7f, 0xa8, 0x47, 0xa5, 0xb4, 0xc6, 0x5f, 0xb5, 0xf6, 0xa2,
-		0x78, 0xbc, 0x58, 0x89, 0x44, 0xda, 0xf7, 0xba, 0x69, 0x39, 0xfd, 0x92, 0xbc, 0x7e, 0xdc, 0x18,
-		0x86, 0xb0, 0xa2, 0x69, 0xd1, 0xfd, 0xa2, 0x08, 0x87, 0xb1, 0x73, 0x10, 0x36, 0x97, 0x0d, 0x9b,
-		0x31, 0x9c, 0x7d, 0xde, 0x70, 0xf6, 0xc5, 0x76, 0x88, 0x28, 0x10, 0x82, 0x79, 0x23, 0x84, 0xc6,
-		0x78, 0xf6, 0x64, 0x34, 0xac, 0xa2, 0x54, 0x9a, 0xa9, 0x82, 0x01, 0x98, 0x18, 0x80, 0xf9, 0xcb,
-		0xa3, 0x5d, 0x1d, 0x88, 0x20, 0x32, 0x10, 0x88, 0x39, 0x35, 0xb6, 0x5e, 0xe6, 0xa2, 0x8e, 0xcc,
-		0x05, 0x32, 0x17, 0xc8, 0x5c, 0x14, 0x7f, 0x51, 0x5d, 0x62, 0x24, 0x1d, 0x50, 0x53, 0xcd, 0xc5,
-		0x57, 0x0f, 0x81, 0x96, 0x1a, 0x8c, 0x86, 0xc5, 0x8a, 0x31, 0xf1, 0x62, 0x52, 0xcc, 0x18, 0x17,
-		0x37, 0x8b, 0x30, 0xc4, 0x8d, 0x88, 0x9f, 0xc5, 0x5a, 0xe1, 0x9a, 0xc5, 0x91, 0x21, 0x2b, 0x57,
-		0xf3, 0x7e, 0xd7, 0x2d, 0xa6, 0xd2, 0x81, 0x89, 0x6d, 0xd3, 0x50, 0x55, 0xfd, 0xc0, 0x31, 0xb8,
-		0x21, 0xd3, 0xfa, 0xcd, 0x53, 0x37, 0x33, 0xb4, 0x53, 0x74, 0x76, 0x72, 0x7a, 0xf5, 0x26, 0x1a,
-		0x3a, 0x3c, 0xbd, 0x4a, 0x6a, 0x18, 0x9a, 0x17, 0xbd, 0x1e, 0xaf, 0xd2, 0x04, 0x7d, 0x19, 0x02,
-		0xbf, 0x34, 0xc1, 0x5f, 0x96, 0x02, 0x28, 0x5d, 0x11, 0x94, 0xae, 0x10, 0xca, 0x54, 0x0c, 0x66,
-		0x14, 0x84, 0x21, 0x45, 0x91, 0x4e, 0x8c, 0x76, 0x8f, 0xdc, 0xab, 0xa7, 0x45, 0x5f, 0x67, 0xac,
-		0x37, 0xd1, 0x69, 0x1d, 0xd4, 0x12, 0x18, 0xaa, 0xf0, 0x32, 0x73, 0x1f, 0xe3, 0x15, 0x5f, 0xcc,
-		0x9f, 0x04, 0x13, 0x61, 0x57, 0xc4, 0xb9, 0xa1, 0x42, 0x31, 0x99, 0xb8, 0x69, 0x46, 0xe4, 0xf5,
-		0x0d, 0xf1, 0x4a, 0x00, 0x39, 0x2f, 0xdf, 0x17, 0x32, 0xde, 0xa9, 0xd7, 0x6a, 0x88, 0x76, 0x10,
-		0xed, 0x20, 0xda, 0x41, 0xb4, 0xb3, 0xee, 0x68, 0x27, 0x62, 0x5c, 0xd5, 0x3b, 0x25, 0x80, 0x9d,
-		0x8e, 0xc1, 0x5b, 0x98, 0x89, 0x8a, 0x7f, 0xfe, 0xc7, 0xec, 0x71, 0xb7, 0x4c, 0x47, 0xcd, 0xcf,
-		0xdc, 0x2c, 0x0d, 0xb5, 0x7e, 0x5f, 0xce, 0xfd, 0xca, 0x0a, 0xbc, 0x9e, 0xdd, 0xeb, 0xa6, 0x03,
-		0xb1, 0x4b, 0x12, 0x0b, 0x4f, 0xb7, 0x0a, 0xb9, 0x2b, 0x7f, 0xab, 0xb4, 0x6a, 0xbb, 0x6d, 0xdc,
-		0x2d, 0x20, 0x54, 0x93, 0xf9, 0xd1, 0xaf, 0xd1, 0x7a, 0x45, 0xeb, 0x75, 0xde, 0x69, 0x09, 0x05,
-		0xa5, 0x7e, 0xa8, 0xcc, 0x9b, 0xab, 0x93, 0x1b, 0x41, 0xb6, 0x4f, 0x63, 0xe8, 0x8d, 0x06, 0x2a,
-		0x1a, 0xa8, 0x68, 0xa0, 0xa2, 0x81, 0xba, 0xee, 0x06, 0x2a, 0xd2, 0xf1, 0x08, 0x68, 0x96, 0x18,
-		0xd0, 0x54, 0x1d, 0xea, 0x91, 0xfb, 0xd2, 0x60, 0xcd, 0xf8, 0x76, 0x90, 0xc1, 0x0d, 0x52, 0xef,
-		0x88, 0x6c, 0x10, 0xd9, 0x20, 0xb2, 0x59, 0x7b, 0x64, 0x83, 0xd4, 0xfb, 0xdc, 0x7f, 0x56, 0x95,
-		0x7a, 0xaf, 0x21, 0x99, 0x0a, 0x43, 0x2c, 0x3c, 0xdd, 0x2a, 0x8b, 0xa0, 0xde, 0x9b, 0x9d, 0x1a,
-		0xee, 0x16, 0x18, 0xaa, 0xc9, 0xfc, 0xe8, 0x48, 0xbd, 0xa3, 0xa5, 0x9a, 0xc1, 0x52, 0x65, 0x81,
-		0x60, 0xaa, 0x14, 0x23, 0x75, 0x7c, 0x27, 0x0c, 0x0e, 0x43, 0x0b, 0x15, 0x2d, 0x54, 0xb4, 0x50,
-		0xd1, 0x42, 0x05, 0x6f, 0xa1, 0xee, 0x94, 0x60, 0xa0, 0xb6, 0xd1, 0x40, 0x5d, 0x52, 0x03, 0x15,
-		0x63, 0xc3, 0xd0, 0x40, 0x9d, 0x73, 0xab, 0x34, 0xda, 0x2d, 0xdc, 0x2c, 0x68, 0x9f, 0xa2, 0x7d,
-		0x8a, 0xf6, 0x69, 0xb6, 0x69, 0xb9, 0x61, 0x42, 0x45, 0xc4, 0x9b, 0xf4, 0xec, 0x30, 0x6f, 0xa6,
-		0x3e, 0xbf, 0x21, 0xda, 0x65, 0x68, 0x97, 0xa1, 0x5d, 0x86, 0x76, 0x19, 0x18, 0xbb, 0xec, 0xb1,
-		0xc1, 0x51, 0x19, 0x61, 0x51, 0xbb, 0x06, 0xef, 0x31, 0x9e, 0x33, 0xf0, 0xc6, 0xd9, 0x54, 0xd7,
-		0xca, 0x56, 0x09, 0x6b, 0x33, 0xb3, 0x46, 0x3b, 0x25, 0xdc, 0xcb, 0x54, 0x77, 0xcb, 0x57, 0x6f,
-		0x38, 0xea, 0x62, 0x79, 0xfd, 0xf3, 0x6b, 0xbd, 0xba, 0x3b, 0xee, 0x34, 0x59, 0x4f, 0xfe, 0x19,
-		0x7d, 0x9e, 0xee, 0x40, 0x39, 0xee, 0x4a, 0x99, 0x76, 0xa9, 0xcc, 0x7c, 0xe1, 0x4b, 0xfd, 0x2a,
-		0x8d, 0xbf, 0xe5, 0xf5, 0x3b, 0xc0, 0x96, 0x66, 0xb9, 0xa7, 0xaa, 0x83, 0xa7, 0x4a, 0xdb, 0xa9,
-		0xc2, 0x5e, 0xb2, 0x59, 0x44, 0x90, 0xf6, 0x1e, 0xb3, 0xd0, 0xc5, 0x0a, 0xb2, 0x06, 0xc9, 0xd6,
-		0x38, 0x61, 0x52, 0xed, 0x29, 0x25, 0xcc, 0x42, 0xbe, 0x53, 0xc6, 0x0f, 0xbd, 0xa4, 0xf4, 0x86,
-		0x61, 0xa2, 0xab, 0x72, 0x4a, 0xee, 0xa6, 0xee, 0x54, 0xdf, 0x69, 0xb5, 0x3a, 0xdb, 0xad, 0x56,
-		0x6d, 0xbb, 0xb9, 0x5d, 0xdb, 0x6d, 0xb7, 0xeb, 0x9d, 0xba, 0x49, 0x96, 0xfe, 0x4c, 0x38, 0x54,
-		0x50, 0xe7, 0xe3, 0x7d, 0xa5, 0x6b, 0xf1, 0xc8, 0xf3, 0x90, 0x3f, 0x42, 0xfe, 0x28, 0x2b, 0x7f,
-		0xe4, 0x31, 0xfe, 0xbd, 0xea, 0x05, 0x76, 0x19, 0x45, 0x71, 0x5e, 0xb8, 0x27, 0xb2, 0x48, 0xc8,
-		0x22, 0x21, 0x8b, 0x84, 0x2c, 0x12, 0xb2, 0x48, 0xc8, 0x22, 0x21, 0x8b, 0x84, 0x2c, 0x12, 0xb2,
-		0x48, 0xc8, 0x22, 0x21, 0x8b, 0x84, 0x2c, 0x12, 0xb2, 0x48, 0xc8, 0x22, 0x21, 0x77, 0xb0, 0xe4,
-		0xdc, 0x41, 0xd2, 0xeb, 0x4d, 0x54, 0x99, 0x53, 0x1e, 0x75, 0xf0, 0x78, 0x4b, 0x64, 0x0e, 0x90,
-		0x39, 0x40, 0xe6, 0x00, 0x99, 0x03, 0x30, 0xcc, 0x01, 0xe6, 0x05, 0x2c, 0x91, 0x79, 0x83, 0x79,
-		0x01, 0x46, 0xb6, 0x3a, 0xe6, 0x05, 0x68, 0xda, 0x2a, 0x8d, 0x36, 0x96, 0x8c, 0x85, 0x63, 0x3d,
-		0xa1, 0x6d, 0x86, 0xb6, 0xd9, 0xc2, 0x47, 0xd4, 0xdd, 0x6d, 0x6e, 0x2f, 0x1a, 0xc4, 0x88, 0x91,
-		0x3a, 0x46, 0xf4, 0xbd, 0x61, 0x7b, 0xf1, 0x43, 0x0c, 0x72, 0xdd, 0xee, 0x54, 0x8b, 0xf2, 0x67,
-		0xbf, 0x88, 0x7f, 0xbe, 0xf1, 0x08, 0xef, 0x4e, 0x37, 0x2c, 0x4f, 0x90, 0x71, 0x37, 0x69, 0x5b,
-		0x3e, 0xfa, 0xf8, 0xd8, 0xbc, 0xfc, 0xc9, 0xcf, 0x1f, 0x6e, 0x84, 0x08, 0x3f, 0x3c, 0xf6, 0xf5,
-		0xfd, 0x60, 0xa4, 0x1f, 0x67, 0xfa, 0x52, 0x07, 0x54, 0xda, 0x82, 0x85, 0xe3, 0x33, 0x55, 0xd9,
-		0x73, 0x1c, 0x16, 0x7f, 0x26, 0x9e, 0xf5, 0xe5, 0xfc, 0xbc, 0x67, 0x39, 0x44, 0x11, 0xcb, 0x0d,
-		0x84, 0x75, 0xdc, 0xbb, 0xe9, 0x58, 0x8f, 0xaf, 0x6c, 0xd8, 0x44, 0xae, 0xa3, 0x89, 0x8c, 0x26,
-		0x32, 0x9a, 0xc8, 0xab, 0x6f, 0x22, 0x9b, 0x6a, 0x3b, 0x9a, 0xde, 0xa0, 0xc4, 0x58, 0xa4, 0x99,
-		0x03, 0x5a, 0x5a, 0x4c, 0xd2, 0x6b, 0xd2, 0xfc, 0x28, 0x10, 0x23, 0x31, 0x1e, 0xf0, 0xe7, 0x02,
-		0xfc, 0xbd, 0x25, 0xa9, 0x92, 0x96, 0x1a, 0x52, 0x6b, 0xfc, 0x98, 0x56, 0xfc, 0x98, 0x56, 0xf2,
-		0x98, 0x57, 0xbc, 0x1c, 0xaf, 0x9c, 0x61, 0x46, 0xb4, 0x34, 0xb1, 0x5f, 0xa6, 0xf8, 0x2f, 0x5d,
-		0x0d, 0x94, 0xad, 0x0e, 0x16, 0xa6, 0x16, 0x16, 0xa6, 0x1e, 0x16, 0xa1, 0x26, 0x4a, 0x32, 0x5c,
-		0x0d, 0x9f, 0x37, 0xe3, 0x0c, 0xeb, 0xcc, 0x69, 0x2b, 0x25, 0x46, 0x6b, 0x06, 0x0e, 0xef, 0x96,
-		0x70, 0xaf, 0x52, 0x62, 0xb6, 0xcc, 0x9a, 0x68, 0x6f, 0xac, 0x5c, 0xa9, 0x31, 0x5c, 0x33, 0x6b,
-		0xb8, 0x53, 0xe2, 0x3d, 0xcb, 0x8e, 0x3e, 0x49, 0x6f, 0xbc, 0xf2, 0xb1, 0x5d, 0x66, 0x89, 0xb3,
-		0x92, 0xe5, 0xe7, 0x62, 0x4f, 0x63, 0x07, 0x4f, 0xa3, 0xf1, 0xd3, 0x88, 0x31, 0x61, 0x79, 0x44,
-		0x18, 0xbc, 0xd8, 0xb0, 0x92, 0xc5, 0x12, 0xc6, 0xba, 0x95, 0xcb, 0xbe, 0x2c, 0xb9, 0x7b, 0xe0,
-		0x5a, 0xb7, 0x7b, 0xc0, 0xac, 0x9f, 0xa7, 0x22, 0xed, 0x21, 0xf5, 0x49, 0x48, 0xd4, 0x70, 0xc4,
-		0xe7, 0x87, 0x94, 0x8f, 0x58, 0xf6, 0xea, 0x14, 0xa9, 0xff, 0xd2, 0xc7, 0x0f, 0xd3, 0x8c, 0x7e,
-		0xc2, 0xe5, 0x3f, 0xb2, 0xf8, 0x6f, 0xf0, 0xf7, 0xef, 0x96, 0x73, 0xf9, 0x34, 0x22, 0x8c, 0x4a,
-		0x3a, 0x4d, 0x55, 0x25, 0x88, 0xfd, 0x9d, 0xf1, 0x81, 0xf6, 0xe5, 0x7b, 0xc4, 0x0f, 0xb3, 0xf7,
-		0xd2, 0xbc, 0x09, 0xcd, 0xb8, 0x22, 0x8c, 0x71, 0x51, 0x26, 0xb9, 0x27, 0xe3, 0x5c, 0x93, 0x69,
-		0x6e, 0xa9, 0x34, 0x2e, 0xa9, 0x34, 0xee, 0xa8, 0x0c, 0xae, 0x68, 0xb9, 0x7d, 0xc8, 0xa6, 0x5c,
-		0x07, 0x15, 0x7b, 0x72, 0x42, 0x0d, 0xfb, 0x91, 0xcd, 0x3a, 0x76, 0xd1, 0x93, 0xba, 0x78, 0xb1,
-		0x56, 0x96, 0x78, 0x2b, 0x5d, 0xcc, 0x95, 0x2e, 0xee, 0xca, 0x14, 0x7b, 0x86, 0xb1, 0x3c, 0x54,
-		0x4f, 0xea, 0xa4, 0x97, 0x44, 0xd5, 0xa1, 0xb6, 0xa0, 0xe3, 0x35, 0x28, 0xc9, 0x93, 0xfa, 0xc2,
-		0xbd, 0x8d, 0x7b, 0x52, 0xcd, 0x77, 0xb6, 0x48, 0x6f, 0x56, 0x33, 0x4b, 0x10, 0x5c, 0xa3, 0x17,
-		0x77, 0xd9, 0x54, 0x4f, 0xe9, 0x2a, 0xa8, 0x6c, 0x55, 0xb4, 0x30, 0x95, 0xb4, 0x30, 0xd5, 0xb4,
-		0x08, 0x15, 0x65, 0x9e, 0xd0, 0xb2, 0x56, 0xd2, 0x8b, 0x6b, 0x3a, 0x5f, 0xe6, 0xb9, 0x68, 0x2c,
-		0x21, 0xe0, 0xbe, 0xa4, 0xfc, 0x99, 0xc9, 0x9f, 0x12, 0x5d, 0x46, 0x65, 0xe6, 0xd3, 0xa4, 0x37,
-		0x2d, 0xb9, 0x21, 0x64, 0x7a, 0xdf, 0x45, 0xa5, 0x4c, 0x3c, 0x1e, 0x91, 0xb2, 0x53, 0x27, 0x4a,
-		0x92, 0x32, 0x4f, 0xb7, 0x54, 0x89, 0x79, 0x37, 0x33, 0x5b, 0xaa, 0xb4, 0xbe, 0x1c, 0xb8, 0xa9,
-		0x4a, 0xf0, 0x54, 0x59, 0xab, 0xe0, 0x0f, 0x33, 0x6d, 0xb4, 0x97, 0x93, 0xb7, 0x93, 0xde, 0xaf,
-		0xf4, 0xfc, 0x1d, 0xf3, 0x1b, 0xc1, 0xe0, 0x12, 0x55, 0x12, 0x0f, 0xca, 0xa3, 0xeb, 0xab, 0x3c,
-		0xb3, 0xfe, 0xf9, 0x8d, 0xd1, 0x4e, 0x45, 0x3b, 0x15, 0xed, 0x54, 0xb4, 0x53, 0xd1, 0x4e, 0x1d,
-		0x9f, 0xb6, 0x58, 0x6b, 0x09, 0xea, 0x96, 0x19, 0x6a, 0xbc, 0x5d, 0x4e, 0x71, 0xb4, 0xe1, 0xdc,
-		0x99, 0x94, 0xcc, 0xed, 0xf2, 0x78, 0x3a, 0x10, 0x01, 0xad, 0x39, 0x02, 0xc2, 0x54, 0xf5, 0x17,
-		0xee, 0x53, 0x6a, 0x28, 0xd3, 0x6c, 0xd0, 0x8d, 0x91, 0xe8, 0x26, 0x73, 0x2b, 0x6e, 0xa2, 0x62,
-		0x99, 0x54, 0x44, 0x51, 0xf3, 0xd1, 0x02, 0xa3, 0xdb, 0x00, 0x0f, 0x16, 0x68, 0x60, 0xb0, 0xc0,
-		0xd2, 0x20, 0x5f, 0x0c, 0x16, 0x58, 0x5f, 0xed, 0x84, 0xc1, 0x02, 0x7a, 0xa7, 0x13, 0x83, 0x05,
-		0x90, 0x84, 0x41, 0x12, 0x06, 0x49, 0x18, 0x24, 0x61, 0x30, 0x58, 0xa0, 0xb0, 0x68, 0xc4, 0x60,
-		0x81, 0x02, 0x2f, 0x86, 0xc1, 0x02, 0x65, 0x3e, 0x00, 0x06, 0x0b, 0x98, 0xde, 0x52, 0x18, 0x2c,
-		0x80, 0xc1, 0x02, 0x99, 0x21, 0x3c, 0xfa, 0xb8, 0x67, 0x36, 0x15, 0xfa, 0xb8, 0xd1, 0xbc, 0x42,
-		0xf3, 0x0a, 0xcd, 0x2b, 0x34, 0xaf, 0x96, 0xcd, 0xbc, 0x42, 0x1f, 0x77, 0x59, 0x3e, 0x6e, 0x24,
-		0xbf, 0xad, 0x75, 0x72, 0xcd, 0x8e, 0x3c, 0x86, 0x58, 0xaf, 0x7c, 0xf9, 0xb7, 0xcc, 0xa2, 0xb7,
-		0x4a, 0xc5, 0x88, 0x5b, 0x5c, 0x44, 0xb6, 0xe2, 0x63, 0x21, 0x7f, 0x3c, 0xb9, 0xe7, 0xb7, 0xf3,
-		0xe4, 0x81, 0xbf, 0x78, 0x84, 0x7f, 0x3b, 0x0e, 0x6f, 0x3a, 0xdf, 0xf6, 0x46, 0x4f, 0xf9, 0xed,
-		0x8b, 0x10, 0xe1, 0xa7, 0xf8, 0xf9, 0xbe, 0xa5, 0xdf, 0xbd, 0x9c, 0x3c, 0xde, 0x1a, 0xd4, 0x4e,
-		0x31, 0x13, 0x45, 0x60, 0x34, 0x7a, 0xc0, 0x78, 0x85, 0x94, 0x06, 0x56, 0x48, 0x29, 0xcd, 0x56,
-		0xc0, 0x0a, 0x29, 0xab, 0xa7, 0xb5, 0x8c, 0x55, 0x48, 0x21, 0xb6, 0x4d, 0x43, 0x55, 0xf5, 0x03,
-		0xa7, 0x84, 0xc0, 0xa7, 0xe9, 0x9b, 0x19, 0x6b, 0x82, 0x61, 0xde, 0x7f, 0x5f, 0x49, 0xb8, 0x4c,
-		0x33, 0x70, 0xec, 0x1a, 0x1b, 0x56, 0x2e, 0x8a, 0x3c, 0xc2, 0xb0, 0x30, 0x70, 0xe4, 0x10, 0x86,
-		0x85, 0x2d, 0x8c, 0xfc, 0x49, 0x4f, 0x4b, 0x3f, 0x08, 0x3c, 0x4a, 0x78, 0x09, 0x2d, 0x2b, 0xeb,
-		0xf5, 0x35, 0x8e, 0x4d, 0x26, 0xce, 0x0d, 0x15, 0x8a, 0xc9, 0x24, 0x0a, 0x6e, 0x64, 0x4b, 0xde,
-		0x18, 0xec, 0x80, 0xf2, 0xa8, 0xb3, 0x5f, 0xbe, 0x2f, 0x64, 0xf5, 0x5d, 0xaf, 0xd5, 0x50, 0x79,
-		0xa3, 0xf2, 0x46, 0xe5, 0x8d, 0xca, 0x7b, 0xdd, 0x95, 0x77, 0xc4, 0xb8, 0xaa, 0x77, 0x4a, 0xd0,
-		0xdd, 0x1d, 0x6c, 0x37, 0xfd, 0xf6, 0x8b, 0x60, 0xbb, 0x69, 0x23, 0x7b, 0x1d, 0xdb, 0x4d, 0x6b,
-		0xda, 0x2a, 0xad, 0xda, 0x2e, 0xf6, 0x9b, 0x86, 0xa1, 0x9a, 0xcc, 0x8f, 0x7e, 0xbd, 0xc6, 0xc6,
-		0x98, 0x1d, 0x09, 0x11, 0x9b, 0x43, 0x93, 0xfc, 0xa4, 0x12, 0x2a, 0x4c, 0x3f, 0xbf, 0x23, 0x9a,
-		0x1a, 0x68, 0x6a, 0xa0, 0xa9, 0x81, 0xa6, 0x06, 0x28, 0x53, 0x63, 0xa7, 0x04, 0x4b, 0xa3, 0x8d,
-		0x96, 0xc6, 0x92, 0x5a, 0x1a, 0x35, 0xc4, 0x8e, 0x68, 0x69, 0xcc, 0xb7, 0x55, 0x1a, 0x6d, 0x34,
-		0x34, 0xd0, 0xd0, 0x40, 0x43, 0x23, 0x14, 0x94, 0xfa, 0xa1, 0x32, 0x6f, 0x5f, 0x4c, 0x6e, 0x04,
-		0xd9, 0xaf, 0x13, 0xe3, 0x48, 0x74, 0xec, 0xa0, 0xb5, 0x85, 0xd6, 0x16, 0x5a, 0x5b, 0xeb, 0x6e,
-		0x6d, 0x61, 0x54, 0x46, 0x99, 0xfa, 0xb9, 0xea, 0x50, 0x8f, 0xdc, 0x97, 0xa6, 0xa5, 0xc7, 0xb7,
-		0x83, 0xac, 0xab, 0x31, 0x02, 0x03, 0x15, 0x35, 0x2a, 0x6a, 0x54, 0xd4, 0x6b, 0xaf, 0xa8, 0x31,
-		0x02, 0x63, 0xee, 0x3f, 0xc8, 0x8b, 0x16, 0xbb, 0x1f, 0xf2, 0xa2, 0x5a, 0xb7, 0xca, 0x22, 0x78,
-		0xd1, 0x66, 0xa7, 0x86, 0xbb, 0x05, 0x86, 0x6a, 0x32, 0x3f, 0xfa, 0x7a, 0x13, 0xa3, 0x65, 0x45,
-		0x5e, 0x98, 0x8e, 0xb8, 0xc0, 0x90, 0x77, 0x34, 0xb8, 0xd0, 0xe0, 0x42, 0x83, 0x0b, 0x0d, 0x2e,
-		0x8c, 0x43, 0x41, 0x7b, 0xab, 0x0c, 0x10, 0x8d, 0x11, 0xef, 0x68, 0x6f, 0xcd, 0xb9, 0x55, 0x4a,
-		0xab, 0xd9, 0x8a, 0xe6, 0x16, 0x9a, 0x5b, 0x4b, 0x6c, 0x6e, 0xdd, 0x30, 0xa1, 0x22, 0xe2, 0x55,
-		0xc7, 0x85, 0xa8, 0xcc, 0x5b, 0x5d, 0xcf, 0x6f, 0x88, 0x66, 0x06, 0x9a, 0x19, 0x68, 0x66, 0xa0,
-		0x99, 0x01, 0xc6, 0xcc, 0x60, 0xa1, 0x61, 0xd9, 0x35, 0x2d, 0xbf, 0xea, 0xbb, 0x06, 0xef, 0x31,
-		0x9e, 0x33, 0xf0, 0xb6, 0xc6, 0xe3, 0xca, 0xdc, 0xb4, 0x4a, 0x58, 0x9b, 0x99, 0x35, 0xda, 0x29,
-		0xa7, 0x54, 0xad, 0xa2, 0x82, 0x97, 0xd6, 0x0e, 0xa4, 0xb2, 0xb1, 0xf1, 0xb5, 0x56, 0xdd, 0xbd,
-		0xfe, 0xf9, 0xb5, 0x5e, 0xdd, 0xbd, 0x1e, 0x7d, 0xac, 0x27, 0xff, 0x8c, 0x3e, 0x37, 0xbe, 0xd6,
-		0xaa, 0xad, 0xc9, 0xe7, 0xf6, 0xd7, 0x5a, 0xb5, 0x7d, 0xbd, 0x79, 0x75, 0xb5, 0xb5, 0xf9, 0xa3,
-		0xf9, 0x90, 0xfd, 0xc2, 0x8d, 0x7f, 0x7d, 0xbd, 0xba, 0x0a, 0x7f, 0x7c, 0x7e, 0x88, 0xff, 0x3e,
-		0x79, 0xb8, 0xfe, 0xcf, 0xe6, 0xef, 0x15, 0x6c, 0xd7, 0xbf, 0x3c, 0xa7, 0xaa, 0x83, 0xa7, 0x4a,
-		0xdb, 0xa9, 0xea, 0xfe, 0x8c, 0xf7, 0x3e, 0xa9, 0xba, 0x7b, 0xd5, 0xa3, 0xeb, 0x1f, 0xb5, 0xf7,
-		0xad, 0x87, 0xcd, 0xee, 0xe6, 0xc6, 0xf3, 0xdf, 0x75, 0x37, 0x7f, 0xd4, 0xde, 0xb7, 0x1f, 0x36,
-		0x36, 0x5e, 0xf8, 0x9f, 0xdf, 0x5f, 0x1a, 0x63, 0xf3, 0xe7, 0xc6, 0xc6, 0xc6, 0xf8, 0x3c, 0x3d,
-		0x39, 0x63, 0x5f, 0x6b, 0xf5, 0xeb, 0xdf, 0x93, 0x8f, 0xa3, 0xbf, 0xd3, 0x53, 0x3a, 0xd7, 0x97,
-		0x37, 0x5f, 0x3c, 0x9b, 0xef, 0x4b, 0x13, 0x41, 0xff, 0xdb, 0xbd, 0xfe, 0x4f, 0x77, 0xf3, 0x47,
-		0xe7, 0x61, 0xf2, 0x39, 0xf9, 0x7b, 0xf3, 0xe7, 0xc6, 0xd6, 0x6f, 0x57, 0x57, 0x5b, 0x5b, 0xbf,
-		0x6d, 0x8e, 0x5e, 0x7c, 0xfc, 0xbd, 0xdf, 0x46, 0xff, 0xfb, 0x7b, 0xb7, 0x3b, 0xf3, 0xab, 0xcd,
-		0x8d, 0x7f, 0x6d, 0xad, 0x82, 0x58, 0x81, 0x66, 0x04, 0x1b, 0x82, 0x7b, 0x27, 0x4c, 0xaa, 0x3d,
-		0xa5, 0x0c, 0x77, 0xc8, 0x3c, 0x65, 0xfc, 0xd0, 0x4b, 0xea, 0x63, 0x19, 0xe6, 0x6d, 0x2a, 0xa7,
-		0xe4, 0x6e, 0xea, 0x4e, 0xf5, 0x9d, 0x56, 0xab, 0xb3, 0xdd, 0x6a, 0xd5, 0xb6, 0x9b, 0xdb, 0xb5,
-		0xdd, 0x76, 0xbb, 0xde, 0xa9, 0x9b, 0x24, 0x9d, 0xcf, 0x84, 0x43, 0x05, 0x75, 0x3e, 0xde, 0x57,
-		0xba, 0x16, 0x8f, 0x3c, 0x0f, 0xe9, 0x90, 0xaa, 0xc7, 0xf8, 0xf7, 0xaa, 0x17, 0xd8, 0x65, 0x14,
-		0x62, 0x7b, 0xe1, 0x9e, 0x48, 0x8a, 0x20, 0x29, 0x82, 0xa4, 0x08, 0x92, 0x22, 0x48, 0x8a, 0x20,
-		0x29, 0x82, 0xa4, 0x08, 0x92, 0x22, 0x48, 0x8a, 0x20, 0x29, 0x82, 0xa4, 0x08, 0x92, 0x22, 0x48,
-		0x8a, 0xac, 0x04, 0x29, 0x02, 0xca, 0x14, 0x4e, 0xba, 0x57, 0x89, 0x2a, 0x73, 0xca, 0xb3, 0x84,
-		0x1f, 0x6f, 0x89, 0x86, 0x30, 0x1a, 0xc2, 0x68, 0x08, 0xa3, 0x21, 0x0c, 0xc6, 0x10, 0xc6, 0x20,
-		0xe4, 0x25, 0x42, 0xeb, 0x18, 0x84, 0x6c, 0x64, 0xab, 0x63, 0x10, 0xb2, 0xa6, 0xad, 0x82, 0xc5,
-		0xf0, 0x20, 0x19, 0x03, 0x68, 0x6a, 0x58, 0xcb, 0xdf, 0x04, 0x38, 0x1a, 0xc4, 0x00, 0x88, 0x3a,
-		0x46, 0xd4, 0x97, 0x61, 0xf3, 0x67, 0xae, 0x36, 0xe4, 0x37, 0x1e, 0xe1, 0xdd, 0xe9, 0x8e, 0xc2,
-		0x09, 0xd0, 0xeb, 0x26, 0x7d, 0x85, 0x47, 0x1f, 0x1f, 0xbb, 0x0b, 0x3f, 0xf9, 0x79, 0xa6, 0xc7,
-		0xb0, 0x89, 0x7e, 0xb3, 0xe9, 0x3b, 0x1d, 0x50, 0x69, 0x0b, 0x16, 0x8e, 0xdb, 0x31, 0x57, 0xf6,
-		0x1c, 0x87, 0xc5, 0x9f, 0x89, 0x67, 0x7d, 0x39, 0x3f, 0xef, 0x59, 0x0e, 0x51, 0xc4, 0x72, 0x03,
-		0x61, 0x1d, 0xf7, 0x6e, 0x3a, 0xd6, 0xe3, 0x1b, 0x1b, 0x36, 0xf8, 0xea, 0x68, 0xf0, 0xa1, 0xc1,
-		0x87, 0x06, 0xdf, 0xea, 0x1b, 0x7c, 0xa6, 0xda, 0xea, 0xce, 0xb0, 0x63, 0x25, 0x04, 0x8a, 0xbc,
-		0x4a, 0x93, 0x19, 0x0f, 0x18, 0x79, 0x4d, 0x9a, 0x1f, 0x05, 0x62, 0x24, 0xc6, 0x03, 0xfe, 0x5c,
-		0x80, 0xbf, 0xb7, 0x24, 0x55, 0xd2, 0x52, 0x43, 0x6a, 0x8d, 0x1f, 0xd3, 0x8a, 0x1f, 0xd3, 0x4a,
-		0x1e, 0xf3, 0x8a, 0x97, 0xe3, 0x32, 0x31, 0xcc, 0xef, 0x95, 0x26, 0xf6, 0xcb, 0x14, 0xff, 0xa5,
-		0xab, 0x81, 0xb2, 0xd5, 0xc1, 0xc2, 0xd4, 0xc2, 0xc2, 0xd4, 0xc3, 0x22, 0xd4, 0x44, 0x49, 0x66,
-		0x98, 0xe1, 0xf3, 0x66, 0x9c, 0x2f, 0x9c, 0x39, 0x6d, 0xa5, 0x04, 0xd0, 0xcc, 0xc0, 0xe1, 0xdd,
-		0x12, 0xee, 0x55, 0x4a, 0x40, 0x8d, 0x59, 0x0b, 0xed, 0x8d, 0x95, 0x2b, 0x35, 0xc0, 0x66, 0x66,
-		0x0d, 0x77, 0x4a, 0xbc, 0x67, 0xd9, 0xa1, 0x01, 0xe9, 0x8d, 0x57, 0x3e, 0xf0, 0xc6, 0x2c, 0x0d,
-		0x54, 0xb2, 0xfc, 0x5c, 0xec, 0x69, 0xec, 0xe0, 0x69, 0x34, 0x7e, 0x1a, 0x31, 0x60, 0x27, 0x8f,
-		0x08, 0x83, 0x17, 0xb8, 0x53, 0xb2, 0x58, 0xc2, 0x40, 0xa4, 0x72, 0xd9, 0x97, 0x25, 0xf7, 0x0e,
-		0x5c, 0xeb, 0xf6, 0x0e, 0x70, 0x1e, 0x28, 0x32, 0x66, 0x31, 0xf4, 0x2b, 0xa7, 0x8a, 0xb4, 0x87,
-		0xd4, 0x27, 0x21, 0x51, 0xc3, 0x11, 0x9d, 0x1f, 0x52, 0x6e, 0x27, 0x14, 0x41, 0x75, 0x8a, 0xd3,
-		0x7f, 0xe9, 0xe3, 0x87, 0x69, 0x42, 0x3f, 0xa1, 0xf2, 0x1f, 0x49, 0xfc, 0x5f, 0xd3, 0xf7, 0xef,
-		0x96, 0x73, 0xf5, 0x34, 0x02, 0x8c, 0x12, 0x02, 0xd1, 0x4a, 0x0b, 0x40, 0x33, 0x44, 0x4c, 0x19,
-		0x23, 0xa2, 0x4c, 0x12, 0x4f, 0xc6, 0x89, 0x26, 0xd3, 0xc4, 0x52, 0x69, 0x44, 0x52, 0x69, 0xc4,
-		0x51, 0x19, 0x44, 0xd1, 0x72, 0xfb, 0x8f, 0x8d, 0x11, 0x3f, 0xe9, 0x6e, 0xf7, 0x28, 0x71, 0x05,
-		0x75, 0x4d, 0xec, 0xf7, 0x89, 0x0d, 0xb2, 0x6d, 0x60, 0xec, 0xde, 0x58, 0xa9, 0x6d, 0x6d, 0x7d,
-		0x18, 0xa9, 0xb4, 0x0f, 0xb3, 0xb2, 0x72, 0x59, 0x75, 0xd1, 0xbb, 0x25, 0xda, 0x69, 0xb1, 0xd0,
-		0x30, 0xa9, 0x69, 0xcc, 0x64, 0xc6, 0x9b, 0xcb, 0x84, 0x2f, 0x35, 0xf3, 0xdd, 0x4c, 0xa6, 0xbb,
-		0xae, 0x9d, 0x61, 0x08, 0x9d, 0x96, 0x8a, 0x4a, 0x35, 0x8a, 0xb5, 0x8a, 0x54, 0x22, 0xb2, 0x15,
-		0x1f, 0xcb, 0xcd, 0xe3, 0xc9, 0x53, 0x7d, 0x3b, 0x4f, 0x9e, 0xea, 0x8b, 0x47, 0xf8, 0xb7, 0xe3,
-		0xf0, 0xa6, 0xf3, 0x6d, 0x6f, 0xf4, 0x28, 0xdf, 0xbe, 0x08, 0x11, 0x7e, 0x4a, 0x1e, 0xe2, 0xdd,
-		0x72, 0x88, 0x8d, 0x62, 0x23, 0x14, 0xdc, 0x56, 0x15, 0x7a, 0xa7, 0x04, 0xa9, 0x46, 0x5c, 0x2a,
-		0xd2, 0xf7, 0xf4, 0x28, 0xb5, 0x8a, 0xa0, 0x2e, 0x15, 0x94, 0xdb, 0xfa, 0x08, 0x7d, 0x8d, 0xfb,
-		0x7c, 0xa2, 0x61, 0xcf, 0x8f, 0xf6, 0xad, 0xf6, 0xf6, 0xee, 0x8e, 0x55, 0xb5, 0xbe, 0x8c, 0x5d,
-		0xd0, 0xc9, 0x96, 0x11, 0xd6, 0x39, 0x75, 0x22, 0xee, 0x10, 0x6e, 0xdf, 0x5b, 0x3d, 0x11, 0xa8,
-		0xc0, 0x0e, 0xbc, 0x2b, 0xbe, 0xf1, 0xe5, 0xfc, 0xbc, 0xb7, 0x69, 0x7d, 0xa1, 0x42, 0xb2, 0x80,
-		0x5b, 0xcd, 0x49, 0x58, 0x52, 0xcb, 0x22, 0xdc, 0x49, 0xdc, 0xdb, 0x3a, 0x37, 0xb5, 0x21, 0x4c,
-		0x3a, 0x8d, 0x45, 0x1f, 0x17, 0x49, 0x33, 0x38, 0x32, 0x0d, 0x43, 0x9f, 0xc0, 0x4f, 0xfd, 0xab,
-		0xb8, 0x6c, 0x80, 0xe2, 0xdd, 0x62, 0xc9, 0xac, 0xa2, 0xf2, 0x45, 0xb3, 0xba, 0x32, 0xaf, 0xa6,
-		0x8a, 0x6d, 0x80, 0xfc, 0xcb, 0x95, 0xef, 0xca, 0x9c, 0xcb, 0x33, 0xc1, 0x97, 0xb9, 0x2d, 0x6a,
-		0x3d, 0x00, 0x52, 0x1f, 0x60, 0x34, 0x0a, 0x10, 0xf5, 0x00, 0xc2, 0xbc, 0x4b, 0xa5, 0x27, 0x58,
-		0xb9, 0xd8, 0xd1, 0x2b, 0x29, 0xf8, 0xb8, 0xa0, 0x2a, 0xfa, 0x45, 0x38, 0xf1, 0x71, 0xcf, 0x8a,
-		0xef, 0x61, 0xb9, 0xc4, 0x67, 0xde, 0xbd, 0x35, 0x12, 0x19, 0x91, 0x48, 0x04, 0x53, 0xac, 0x04,
-		0xae, 0xb8, 0xb6, 0xe8, 0x62, 0x4d, 0x51, 0xc4, 0xda, 0xd8, 0x3a, 0x9d, 0xec, 0x9c, 0x76, 0x36,
-		0x4e, 0x37, 0xd2, 0x31, 0xc6, 0xb6, 0x19, 0x83, 0x35, 0x26, 0xd8, 0xb4, 0xc5, 0x9a, 0x14, 0xba,
-		0xa2, 0x6a, 0x2b, 0x89, 0x3a, 0xd6, 0xb6, 0x33, 0x52, 0xf6, 0x3e, 0x1e, 0x55, 0xd3, 0xda, 0x3d,
-		0x13, 0x38, 0x87, 0xdc, 0xf6, 0x02, 0xc9, 0xf8, 0x20, 0x16, 0x30, 0x8a, 0x30, 0x4e, 0x45, 0x82,
-		0x30, 0x93, 0x28, 0xd8, 0xc4, 0xf2, 0x95, 0xd6, 0x90, 0x70, 0xc7, 0xa3, 0x8e, 0xd5, 0xbf, 0xb7,
-		0xd4, 0x90, 0xc9, 0x2b, 0x7e, 0xdc, 0x7b, 0x0c, 0x8c, 0xd5, 0xf5, 0x5c, 0x7a, 0x13, 0x19, 0xb4,
-		0x3b, 0x0e, 0x4c, 0x38, 0x0c, 0x8c, 0x39, 0x0a, 0xca, 0x30, 0xc6, 0x8c, 0x38, 0x06, 0xca, 0xb5,
-		0xc4, 0x34, 0x3b, 0x02, 0x96, 0x8b, 0x8e, 0x35, 0xc0, 0x92, 0x18, 0x64, 0x4b, 0xf4, 0xb3, 0x26,
-		0xa0, 0xd8, 0x13, 0xd3, 0x07, 0xb7, 0x4c, 0x36, 0xa5, 0xb4, 0xb3, 0x0c, 0x8d, 0x5d, 0xd1, 0x2b,
-		0x27, 0xf4, 0x8f, 0x76, 0xbd, 0x1a, 0xec, 0x72, 0xc9, 0x74, 0xc6, 0x75, 0x5e, 0x1b, 0x59, 0x0f,
-		0xcb, 0x64, 0x94, 0x5d, 0x2a, 0x20, 0x19, 0xb2, 0x3a, 0x39, 0xf2, 0x1d, 0xb3, 0xec, 0x4b, 0x96,
-		0xed, 0x8a, 0x8c, 0xca, 0xb8, 0xe8, 0xa2, 0x1a, 0x59, 0xcc, 0x6c, 0x33, 0x3b, 0xff, 0xfc, 0x64,
-		0x98, 0x9b, 0x8a, 0x3d, 0x41, 0xe3, 0xd9, 0xe6, 0x24, 0xd5, 0xdd, 0xe3, 0xeb, 0x33, 0xae, 0x46,
-		0x3e, 0x93, 0x22, 0xb7, 0xe9, 0x50, 0xc4, 0x44, 0x28, 0x6c, 0x0a, 0x14, 0x45, 0x0e, 0xda, 0xa0,
-		0xbd, 0x36, 0xb5, 0xaf, 0x03, 0xaa, 0x9b, 0x3d, 0xed, 0x79, 0x59, 0x83, 0x8a, 0x13, 0x8d, 0x92,
-		0xa2, 0xaa, 0x0e, 0x55, 0xd4, 0x56, 0x55, 0x25, 0x08, 0x97, 0xfe, 0xa8, 0x7e, 0x49, 0xce, 0xe5,
-		0x9b, 0xec, 0x9f, 0xd7, 0x87, 0xce, 0xb9, 0x0a, 0x3a, 0x9a, 0x7d, 0x57, 0xea, 0x95, 0x52, 0x75,
-		0x6b, 0xb1, 0x60, 0xc4, 0xc2, 0xdc, 0x81, 0x0e, 0xae, 0x40, 0x1b, 0x37, 0xa0, 0xcb, 0xa4, 0xd0,
-		0x6e, 0xfb, 0x6b, 0xb7, 0x0f, 0x74, 0xda, 0xf6, 0xe5, 0xba, 0xb6, 0x0a, 0x07, 0xe7, 0x3d, 0xa9,
-		0xd2, 0xd6, 0x6c, 0x14, 0xd9, 0x2e, 0xe3, 0xb3, 0x53, 0x20, 0xd4, 0x4e, 0x53, 0x99, 0x35, 0x0d,
-		0x6c, 0xac, 0xce, 0x32, 0x69, 0x69, 0x6d, 0x2b, 0x5d, 0x84, 0xa2, 0xa9, 0xca, 0x55, 0xfa, 0x2b,
-		0x53, 0x69, 0xa0, 0xa4, 0xb4, 0x96, 0x21, 0x4b, 0x97, 0xa2, 0xd5, 0xd8, 0x6d, 0xed, 0x76, 0xb6,
-		0x1b, 0xbb, 0xed, 0xf5, 0x5b, 0x93, 0x05, 0x19, 0xc9, 0xd7, 0xa5, 0x0a, 0x46, 0x8d, 0x24, 0xa6,
-		0x46, 0xd2, 0x52, 0xa3, 0x53, 0xf2, 0xfc, 0x68, 0xdf, 0x6a, 0xed, 0x74, 0x1a, 0xdd, 0x51, 0xb9,
-		0x91, 0x0b, 0x45, 0x14, 0xf5, 0xa8, 0x94, 0xd6, 0xd8, 0x2c, 0xb6, 0xf6, 0x22, 0x15, 0x3c, 0x71,
-		0xfd, 0x2e, 0xb9, 0x07, 0x53, 0x37, 0xab, 0x58, 0x8e, 0x13, 0x33, 0xc7, 0x32, 0xe0, 0x09, 0x2e,
-		0x95, 0xe6, 0xba, 0x1f, 0x04, 0xaa, 0x1a, 0xd8, 0x55, 0x3b, 0xf0, 0xc3, 0x84, 0xd7, 0x70, 0xaa,
-		0x1e, 0x25, 0x6e, 0x3c, 0xe8, 0x43, 0x59, 0xd4, 0x51, 0x0e, 0xab, 0x9c, 0xf2, 0x58, 0x70, 0x39,
-		0xc5, 0xed, 0xba, 0xc9, 0x40, 0x8b, 0xb4, 0xe2, 0xe2, 0xc3, 0x87, 0x86, 0x1c, 0x1a, 0x72, 0x68,
-		0xc8, 0x99, 0x37, 0xe4, 0xfa, 0x41, 0xe0, 0x51, 0xc2, 0x35, 0x58, 0x72, 0xf5, 0x3a, 0x0a, 0xfc,
-		0xf2, 0x04, 0xbe, 0xaf, 0xa2, 0xe2, 0xc2, 0x3e, 0x1e, 0x04, 0x25, 0x26, 0x4a, 0x4c, 0x94, 0x98,
-		0x48, 0x7d, 0x2d, 0x13, 0xf5, 0x55, 0x6f, 0xec, 0x20, 0xfb, 0x85, 0xec, 0x17, 0xb2, 0x5f, 0xc8,
-		0x7e, 0x69, 0x63, 0xbf, 0x1a, 0xad, 0x4e, 0xad, 0x6b, 0x25, 0xf1, 0x21, 0x9c, 0xaa, 0x34, 0x34,
-		0xeb, 0x7d, 0x1a, 0x93, 0xd5, 0xb1, 0x36, 0x8e, 0x7b, 0x37, 0x9d, 0x4d, 0xeb, 0x22, 0xa4, 0x36,
-		0x73, 0x99, 0x9d, 0x80, 0xcb, 0x2b, 0x9e, 0x0e, 0x77, 0x41, 0x93, 0x5d, 0x6b, 0xb5, 0x91, 0x1a,
-		0x33, 0x44, 0x8d, 0xe9, 0x5c, 0x23, 0x3c, 0xfb, 0x6b, 0x67, 0x46, 0xad, 0x4b, 0xc8, 0xd5, 0x38,
-		0x4a, 0x69, 0x09, 0xe2, 0xad, 0x38, 0x65, 0x83, 0x61, 0x3f, 0x10, 0x32, 0x7f, 0xc8, 0xd5, 0xe3,
-		0x10, 0x18, 0x75, 0x65, 0x4c, 0x5d, 0x60, 0xd4, 0x55, 0x89, 0x51, 0x57, 0x93, 0x1d, 0x5d, 0x9c,
-		0x9f, 0x49, 0x47, 0x2a, 0x46, 0xd2, 0xd4, 0x91, 0xa4, 0x41, 0x92, 0x06, 0x02, 0x49, 0x53, 0x34,
-		0x3d, 0x32, 0x6f, 0xfc, 0xef, 0xab, 0x9b, 0x2e, 0x57, 0x3c, 0xb0, 0xe6, 0x63, 0xa8, 0xed, 0x38,
-		0xea, 0x3c, 0x96, 0xda, 0x8f, 0x67, 0x19, 0x86, 0x11, 0x66, 0x3d, 0x2f, 0x84, 0x99, 0xd2, 0x97,
-		0xf5, 0xcc, 0x0c, 0xe4, 0x3c, 0x6b, 0x4b, 0x88, 0xd5, 0x5c, 0x9a, 0x14, 0x33, 0x8b, 0x31, 0xb3,
-		0xb8, 0x2c, 0xf1, 0xa0, 0x47, 0x4c, 0x68, 0x12, 0x17, 0xe9, 0x8b, 0x6a, 0x2f, 0x25, 0xfa, 0x62,
-		0x8f, 0x83, 0x2a, 0x0f, 0xaa, 0xff, 0x17, 0x70, 0x9d, 0xd9, 0xa9, 0x26, 0x7a, 0x19, 0x18, 0xeb,
-		0x55, 0x80, 0xbd, 0x08, 0x5e, 0x9e, 0x14, 0xfd, 0xbd, 0x06, 0x34, 0x3f, 0xe2, 0xe3, 0x7c, 0x77,
-		0xaf, 0xae, 0xb6, 0xae, 0x7f, 0xab, 0x2c, 0x5b, 0x82, 0xee, 0x92, 0x57, 0xf5, 0xd4, 0xce, 0x58,
-		0xea, 0x97, 0xa6, 0x3a, 0x5c, 0x82, 0xa3, 0x1e, 0x87, 0xe4, 0x9e, 0x8a, 0xb4, 0xab, 0x8b, 0x76,
-		0x0c, 0xf5, 0xc2, 0x3d, 0x10, 0x53, 0x21, 0xa6, 0x42, 0x4c, 0xb5, 0x74, 0x98, 0xea, 0x94, 0x70,
-		0x87, 0xa8, 0x40, 0xdc, 0x6b, 0x2c, 0x81, 0x64, 0x0e, 0xa7, 0x85, 0xc3, 0x7b, 0x69, 0xa0, 0x17,
-		0x15, 0x30, 0x7c, 0x36, 0x0d, 0xac, 0x1a, 0x0f, 0x1b, 0xdd, 0xa7, 0x3f, 0x6f, 0xfe, 0xa6, 0xb3,
-		0x8d, 0x11, 0xaa, 0x7e, 0x2c, 0x7d, 0xbd, 0xdc, 0xa5, 0x69, 0x53, 0x7f, 0x61, 0xfa, 0x29, 0x97,
-		0x3f, 0x54, 0xdf, 0x7c, 0x17, 0x98, 0x6b, 0x1d, 0x84, 0x96, 0x3e, 0x22, 0x4b, 0x13, 0xd8, 0x42,
-		0x9e, 0x7a, 0xa9, 0x40, 0x14, 0xf2, 0xd4, 0xe5, 0x03, 0x19, 0x03, 0xbd, 0x6a, 0x74, 0xf6, 0xa6,
-		0x99, 0xee, 0x45, 0x33, 0x2a, 0x32, 0x3c, 0xee, 0x48, 0x33, 0x29, 0x46, 0x0c, 0x51, 0x94, 0x8e,
-		0xba, 0xb9, 0x69, 0x93, 0xa6, 0xa3, 0xe1, 0x96, 0xcc, 0xf1, 0xd7, 0x40, 0x81, 0x8a, 0x02, 0x15,
-		0x90, 0x40, 0x45, 0xc7, 0x1f, 0x92, 0x54, 0x48, 0x52, 0x21, 0x49, 0xb5, 0x34, 0x84, 0x12, 0x3a,
-		0xfe, 0xa6, 0x89, 0x25, 0x74, 0xfc, 0xbd, 0x34, 0x29, 0xe8, 0xf8, 0x5b, 0x3c, 0xf9, 0xa5, 0x01,
-		0x4e, 0x32, 0x39, 0x6e, 0x0d, 0x69, 0x00, 0x39, 0xa4, 0x43, 0x23, 0x80, 0x40, 0x00, 0x81, 0x00,
-		0x62, 0x8d, 0x00, 0x04, 0xf5, 0x43, 0x75, 0x6f, 0x02, 0x31, 0x34, 0x31, 0xe4, 0x20, 0x1b, 0xb3,
-		0x86, 0x21, 0x07, 0x28, 0x8c, 0x51, 0x18, 0x03, 0x10, 0xc6, 0x18, 0x72, 0x80, 0x21, 0x07, 0xeb,
-		0x62, 0x74, 0x4c, 0x5c, 0xd2, 0x55, 0x3d, 0xde, 0x88, 0x99, 0xdd, 0xf9, 0x6c, 0x7c, 0xd4, 0x78,
-		0xa8, 0xf1, 0x50, 0xe3, 0xad, 0x93, 0xf9, 0xc1, 0x23, 0x9f, 0x6a, 0xab, 0xcf, 0x3b, 0xa3, 0x9c,
-		0x5a, 0x1a, 0xc7, 0x3c, 0xe4, 0x91, 0xaf, 0x31, 0xa0, 0x0a, 0xbb, 0xd5, 0x69, 0xfd, 0xf3, 0xbc,
-		0x30, 0x74, 0xbd, 0x6b, 0x7d, 0x1e, 0xeb, 0x16, 0xeb, 0x80, 0x49, 0x3b, 0xb8, 0xa1, 0xe2, 0x7e,
-		0xdc, 0xa4, 0xcc, 0xba, 0x79, 0x56, 0x7d, 0x65, 0x54, 0x6f, 0x65, 0x52, 0x6a, 0x65, 0x7b, 0xab,
-		0xb9, 0xd5, 0xc0, 0x1e, 0x75, 0x8b, 0x15, 0xae, 0x2f, 0x0a, 0x59, 0x5d, 0x6b, 0x8b, 0x9d, 0xe9,
-		0x00, 0x42, 0xd1, 0x40, 0xb0, 0x81, 0xce, 0xda, 0x7c, 0x29, 0x60, 0x1a, 0x8d, 0x8b, 0xd0, 0x13,
-		0xa1, 0x27, 0x42, 0xcf, 0x35, 0x82, 0x9e, 0xa9, 0xe9, 0xa9, 0x55, 0x00, 0x40, 0x81, 0x9f, 0x18,
-		0xcf, 0xff, 0x64, 0x3c, 0xb3, 0xf1, 0xfc, 0x23, 0x72, 0x03, 0x54, 0x77, 0xd6, 0xbc, 0x15, 0x6f,
-		0xfe, 0x4b, 0xef, 0x0b, 0xc5, 0xa2, 0x55, 0x4e, 0x98, 0x54, 0x7b, 0x4a, 0x15, 0xac, 0x9b, 0x73,
-		0xca, 0xf8, 0xa1, 0x47, 0x63, 0x09, 0x59, 0xb0, 0x1c, 0x6b, 0xe5, 0x94, 0xdc, 0x4d, 0x8d, 0x54,
-		0xdf, 0x69, 0xb5, 0x3a, 0xdb, 0xad, 0x56, 0x6d, 0xbb, 0xb9, 0x5d, 0xdb, 0x6d, 0xb7, 0xeb, 0x9d,
-		0x7a, 0x81, 0x22, 0xb2, 0x95, 0x33, 0xe1, 0x50, 0x41, 0x9d, 0x8f, 0xf1, 0x9c, 0xf1, 0xc8, 0xf3,
-		0x40, 0x56, 0x4a, 0x34, 0x7a, 0x76, 0xca, 0xe8, 0xa4, 0x3b, 0x31, 0x26, 0xb0, 0x95, 0xae, 0xa9,
-		0xe5, 0x5c, 0x86, 0xd2, 0x8e, 0xf9, 0x18, 0xec, 0x42, 0xf1, 0xf3, 0x85, 0x4b, 0x3a, 0x36, 0xb0,
-		0xa4, 0xe3, 0x02, 0xd1, 0x37, 0x36, 0xd2, 0xcd, 0xb3, 0x7f, 0xb0, 0x91, 0x2e, 0x76, 0x13, 0x31,
-		0x62, 0xc6, 0x63, 0xa1, 0x4a, 0x73, 0x58, 0x10, 0xbb, 0x89, 0xbc, 0xf0, 0x07, 0x1b, 0xe9, 0xe6,
-		0xdd, 0x0b, 0xd8, 0x4a, 0x64, 0x0d, 0xd6, 0x04, 0x5b, 0x89, 0x64, 0x1c, 0x0b, 0x1b, 0xe9, 0xea,
-		0x86, 0x04, 0x2f, 0x41, 0x03, 0x6c, 0xa4, 0xbb, 0xa2, 0x27, 0x18, 0xfb, 0xce, 0x66, 0x1d, 0x04,
-		0xfb, 0xce, 0xa2, 0xdd, 0x83, 0x76, 0x4f, 0x39, 0x76, 0xcf, 0xe2, 0xfb, 0xce, 0x62, 0x9b, 0x56,
-		0x14, 0x30, 0x28, 0x60, 0x90, 0x58, 0x41, 0x62, 0x25, 0xaf, 0x35, 0x8f, 0x6d, 0x5a, 0x91, 0x5b,
-		0x41, 0x6e, 0x05, 0xb9, 0x15, 0x9d, 0xdc, 0x0a, 0xb6, 0x69, 0x5d, 0x7e, 0xe2, 0x05, 0xdb, 0xb4,
-		0x96, 0xc9, 0xca, 0xac, 0x4b, 0xf4, 0x4b, 0x8e, 0xa8, 0x3f, 0x33, 0x91, 0x2f, 0x11, 0xe7, 0x91,
-		0xdf, 0xa7, 0x22, 0x07, 0xad, 0xf5, 0x88, 0x3e, 0x1f, 0xc7, 0x58, 0x93, 0xb6, 0xa6, 0x2e, 0xc6,
-		0xc0, 0xbc, 0x64, 0x79, 0xb9, 0x2b, 0x13, 0x03, 0x53, 0xb0, 0xb7, 0xa2, 0x9e, 0x9e, 0x8a, 0x2b,
-		0xd7, 0xd2, 0xd4, 0x45, 0x42, 0xa3, 0xa4, 0x63, 0xb5, 0x18, 0x42, 0xa3, 0x70, 0x4b, 0xd3, 0xa2,
-		0x1e, 0x96, 0x99, 0x5d, 0x57, 0xcc, 0xd3, 0xf2, 0xf8, 0x62, 0x1a, 0x3c, 0x2e, 0xe9, 0x60, 0x89,
-		0x19, 0x58, 0x0c, 0x0b, 0x5e, 0x63, 0xf1, 0x7b, 0xe3, 0x02, 0xa7, 0x0c, 0xb3, 0x08, 0x68, 0xad,
-		0x66, 0x17, 0x8b, 0xdf, 0x3f, 0xdf, 0x6d, 0xc5, 0x5d, 0x3c, 0x33, 0x5a, 0xbf, 0xbe, 0x52, 0x59,
-		0x54, 0xc6, 0xda, 0xd0, 0x40, 0xc9, 0x7e, 0x5a, 0xc2, 0x94, 0x9a, 0x47, 0xc3, 0xad, 0x50, 0x5b,
-		0x99, 0x72, 0xbc, 0x8b, 0xe9, 0x7b, 0x54, 0x05, 0x75, 0x8b, 0x23, 0xf3, 0xa7, 0xc3, 0x21, 0x40,
-		0x47, 0x80, 0x8e, 0x00, 0xdd, 0xb8, 0x5d, 0xac, 0xd7, 0x3e, 0xd6, 0x74, 0x0c, 0x11, 0xce, 0x22,
-		0x9c, 0x85, 0x0d, 0x67, 0xf5, 0xb5, 0x1e, 0x99, 0x28, 0x45, 0x03, 0x75, 0xc4, 0xd3, 0xa1, 0xb1,
-		0x9a, 0xca, 0xd2, 0x08, 0x03, 0x53, 0x42, 0xc1, 0xb8, 0x70, 0x30, 0x2e, 0x24, 0x4c, 0x0a, 0x0b,
-		0x3d, 0x42, 0x43, 0x93, 0xf0, 0xd0, 0x6f, 0x13, 0xcf, 0xec, 0x56, 0x7d, 0x8d, 0xe1, 0x66, 0x34,
-		0xfe, 0xb6, 0xde, 0x0a, 0xb3, 0x63, 0x93, 0x2a, 0x5e, 0xe6, 0xee, 0x94, 0x09, 0xf5, 0xec, 0x17,
-		0xe3, 0x9f, 0x93, 0xe2, 0x01, 0xd8, 0x42, 0x76, 0x39, 0x4e, 0x82, 0x8e, 0x48, 0x25, 0x19, 0xf5,
-		0x0d, 0x2a, 0xc0, 0x27, 0xa3, 0xa3, 0x0e, 0x44, 0x1d, 0x88, 0x3a, 0x10, 0x75, 0x20, 0x58, 0x1d,
-		0xf8, 0xf5, 0x51, 0x07, 0xfe, 0x8f, 0x1d, 0x09, 0x41, 0xb9, 0xda, 0xd8, 0xfc, 0xb0, 0xb5, 0xf5,
-		0x48, 0x37, 0x5e, 0x8f, 0x2f, 0x99, 0x96, 0x7b, 0xf2, 0x85, 0xdf, 0xa5, 0x23, 0x3b, 0xf4, 0x0e,
-		0xd5, 0x29, 0x56, 0x70, 0x5b, 0xee, 0x0a, 0x6e, 0x53, 0x94, 0xf9, 0x13, 0x06, 0x19, 0x70, 0x5f,
-		0x76, 0x6c, 0x26, 0x8c, 0x8c, 0x1e, 0x32, 0x7a, 0xc8, 0xe8, 0x21, 0xa3, 0x87, 0xd6, 0x0c, 0x5a,
-		0x33, 0x68, 0xcd, 0x20, 0xa3, 0x87, 0x84, 0x16, 0x12, 0x5a, 0xa8, 0x02, 0x50, 0x05, 0xa0, 0x0a,
-		0x40, 0x42, 0x6b, 0x29, 0x08, 0x2d, 0xe4, 0x73, 0x4a, 0xe7, 0x73, 0xd6, 0xa8, 0x2e, 0xff, 0x72,
-		0x47, 0xa6, 0xea, 0x08, 0xd4, 0xb4, 0xb2, 0x94, 0x7c, 0xff, 0x33, 0xbd, 0xf7, 0xb7, 0xf4, 0x4b,
-		0xe7, 0xd4, 0x5d, 0xe6, 0xd8, 0xd8, 0x62, 0x14, 0x9e, 0x16, 0xea, 0x4e, 0x5b, 0x2c, 0x6c, 0x03,
-		0x63, 0x61, 0xcd, 0x41, 0x2d, 0x8c, 0x85, 0xd5, 0x46, 0xb1, 0x61, 0xb2, 0xda, 0x9c, 0x7f, 0x30,
-		0x59, 0xad, 0x3c, 0xdb, 0x0f, 0x7d, 0x01, 0x98, 0xac, 0xf6, 0xf6, 0x6e, 0x5b, 0xbe, 0x64, 0x35,
-		0x44, 0xca, 0x1a, 0x90, 0x72, 0x01, 0x93, 0x05, 0x4b, 0xb5, 0xcc, 0x31, 0xc1, 0x95, 0x5c, 0xc8,
-		0x3c, 0xab, 0xd1, 0x61, 0xac, 0x2a, 0xcc, 0x3b, 0x8d, 0x0b, 0x95, 0x77, 0x81, 0x34, 0x2e, 0x4c,
-		0x25, 0x53, 0x7f, 0xa7, 0xb9, 0x56, 0x61, 0xbe, 0xa9, 0x7f, 0x7b, 0x22, 0xe7, 0x98, 0xc4, 0x8c,
-		0x26, 0x5b, 0x2e, 0x13, 0x2d, 0xa3, 0x49, 0x96, 0xd9, 0x04, 0xcb, 0x83, 0x84, 0xa6, 0x11, 0x4f,
-		0xbc, 0x94, 0x59, 0x56, 0x31, 0x27, 0xb6, 0x29, 0x8c, 0x61, 0x0a, 0x63, 0x95, 0xe7, 0x98, 0x24,
-		0x79, 0xf1, 0x05, 0x1d, 0xdc, 0xac, 0x66, 0x4f, 0x25, 0x79, 0xd8, 0xdc, 0xb5, 0xa1, 0x32, 0xae,
-		0x71, 0x01, 0xc0, 0xbf, 0xf0, 0xaa, 0x50, 0x39, 0x5e, 0xd5, 0x5a, 0x8b, 0xba, 0x50, 0xd9, 0xb6,
-		0x7b, 0x39, 0x00, 0x23, 0x37, 0xa8, 0x9e, 0xaa, 0x7a, 0x96, 0xaf, 0xdf, 0x45, 0x2a, 0x95, 0x77,
-		0x73, 0x5c, 0x3b, 0x7e, 0xec, 0x7c, 0x86, 0xbd, 0xa6, 0x42, 0xc3, 0xf5, 0x8e, 0x86, 0x42, 0xc3,
-		0x1d, 0x2c, 0x34, 0xfc, 0x6c, 0x30, 0xec, 0xe0, 0x54, 0x68, 0x29, 0x4c, 0x54, 0x19, 0xee, 0xb4,
-		0xdb, 0x4d, 0x2c, 0x30, 0x5c, 0xd6, 0xd5, 0xa5, 0x16, 0x18, 0xd6, 0x20, 0x0b, 0xa5, 0x12, 0x8c,
-		0x0f, 0x74, 0x34, 0x75, 0xd8, 0x29, 0xc9, 0x66, 0xbf, 0x46, 0xd3, 0xf2, 0x4d, 0xd3, 0x32, 0x03,
-		0x89, 0x32, 0x87, 0x21, 0xf8, 0xae, 0xc0, 0x84, 0x54, 0xf6, 0xa2, 0x41, 0x8c, 0xa2, 0x12, 0x17,
-		0xc3, 0xdb, 0xba, 0x2e, 0xa3, 0x21, 0x39, 0x57, 0x4c, 0x5c, 0x3c, 0x23, 0xdd, 0xa9, 0xd9, 0x99,
-		0xd7, 0xf0, 0x3c, 0xa0, 0xd2, 0x16, 0x2c, 0x1c, 0xaf, 0x61, 0xe5, 0xb8, 0x77, 0xd3, 0xb2, 0x88,
-		0xe3, 0x08, 0xcb, 0x25, 0x3e, 0xf3, 0xee, 0xad, 0x27, 0xcd, 0xaa, 0x2c, 0x37, 0x10, 0x57, 0xfc,
-		0xf1, 0x41, 0x4c, 0x5b, 0xb7, 0xb5, 0x72, 0xac, 0xdb, 0x4c, 0xed, 0x3b, 0x56, 0xc7, 0xb6, 0xcd,
-		0xd2, 0x7e, 0x63, 0xc1, 0x96, 0x2d, 0x0b, 0x6f, 0x5a, 0xf9, 0x2d, 0xdb, 0xe4, 0xea, 0xac, 0xf5,
-		0x68, 0x9f, 0x9e, 0x8b, 0x1e, 0x11, 0xc4, 0xa7, 0x8a, 0x0a, 0x19, 0x9f, 0x01, 0x4b, 0x0d, 0xa9,
-		0x95, 0x1e, 0x15, 0x2a, 0xe5, 0xf8, 0xb4, 0x6c, 0xad, 0x4b, 0x55, 0x65, 0xec, 0x2c, 0x6e, 0x2d,
-		0xa6, 0xb3, 0xb8, 0xee, 0x03, 0xab, 0x83, 0x20, 0x5d, 0x6e, 0x75, 0xd6, 0x41, 0x75, 0x86, 0xea,
-		0x6c, 0xf9, 0xd4, 0x59, 0xa7, 0x90, 0x3a, 0xeb, 0x18, 0x51, 0x67, 0x1d, 0x54, 0x67, 0xa8, 0xce,
-		0x56, 0x5c, 0x9d, 0xfd, 0xf2, 0x1b, 0xd7, 0x6f, 0x99, 0x79, 0xd9, 0xec, 0x5d, 0x3d, 0x76, 0x6e,
-		0x65, 0x2e, 0x17, 0xe5, 0x5b, 0x5e, 0xd3, 0x5f, 0x2f, 0xe5, 0xeb, 0xd3, 0xf2, 0x0b, 0x19, 0x38,
-		0xa7, 0x63, 0x34, 0x93, 0x43, 0x74, 0x4e, 0x11, 0x32, 0xb7, 0x03, 0x34, 0x8b, 0x88, 0xc8, 0x1c,
-		0xe2, 0x95, 0x55, 0x04, 0xe4, 0x3e, 0xf2, 0xb9, 0x8f, 0x78, 0x9e, 0x10, 0xab, 0x62, 0x4c, 0xc8,
-		0xbc, 0xba, 0xb0, 0x42, 0x1c, 0x9f, 0xf1, 0x6a, 0xbc, 0x27, 0x22, 0x99, 0xdd, 0xb9, 0xfe, 0xe4,
-		0xea, 0x6c, 0xb0, 0xad, 0xb6, 0xac, 0xb0, 0xcd, 0x5d, 0x4f, 0xd8, 0xe6, 0x2e, 0x0a, 0xb6, 0x9d,
-		0x12, 0xee, 0x10, 0x15, 0x88, 0xfb, 0x0c, 0x58, 0x3e, 0xb3, 0x33, 0x72, 0x2a, 0x64, 0x38, 0xf2,
-		0x69, 0xe6, 0xd6, 0xfb, 0xa9, 0x3c, 0x6c, 0x65, 0xb8, 0xe6, 0x90, 0x47, 0xfe, 0xfc, 0x25, 0x6a,
-		0xe6, 0x9d, 0xae, 0x02, 0x7d, 0xfe, 0x0a, 0xf4, 0xf5, 0x2b, 0x80, 0xec, 0x92, 0x9e, 0x70, 0x3b,
-		0x9d, 0x66, 0xd7, 0xba, 0x8c, 0xf1, 0x6d, 0xaa, 0x66, 0xad, 0x4f, 0x22, 0x88, 0x42, 0xeb, 0xf4,
-		0xf8, 0xa3, 0x55, 0xb5, 0x98, 0xbb, 0x17, 0x8b, 0x92, 0x8b, 0x2c, 0x92, 0xc4, 0x14, 0x0c, 0x2c,
-		0xda, 0x7a, 0xcf, 0x0c, 0x12, 0xcc, 0x31, 0x8d, 0x4b, 0x03, 0x1b, 0xaf, 0x4b, 0x0c, 0x13, 0xb3,
-		0x83, 0x28, 0x9e, 0x9b, 0x1c, 0xca, 0x2c, 0xbd, 0x72, 0x45, 0xf8, 0x07, 0x54, 0x64, 0xcb, 0xce,
-		0x3f, 0xf0, 0x6a, 0x5f, 0x04, 0xc4, 0xb1, 0x89, 0x54, 0xd5, 0xf0, 0xbb, 0x92, 0x05, 0xc8, 0x88,
-		0x99, 0xa1, 0xd6, 0x23, 0x84, 0x0c, 0x1b, 0x0b, 0x16, 0x3e, 0x04, 0x39, 0x85, 0x7f, 0xe9, 0xe1,
-		0x63, 0x63, 0xf1, 0xdc, 0x69, 0x15, 0x08, 0x21, 0xdb, 0xc9, 0x71, 0x69, 0xb1, 0xe0, 0xa9, 0x02,
-		0x61, 0x13, 0x3a, 0x82, 0xa5, 0x74, 0x05, 0x49, 0x69, 0x8f, 0xc6, 0xd1, 0x17, 0x85, 0x53, 0xa4,
-		0x92, 0x9f, 0x8e, 0x20, 0xa8, 0xc7, 0x86, 0xf7, 0x3b, 0xad, 0x56, 0x67, 0xbb, 0xd5, 0xaa, 0x6d,
-		0x37, 0xb7, 0x6b, 0xbb, 0xed, 0x76, 0xbd, 0x53, 0x6f, 0xaf, 0xee, 0xac, 0x2f, 0x67, 0x04, 0x4e,
-		0x46, 0xa9, 0xa4, 0xa1, 0x69, 0xba, 0x86, 0x66, 0xe9, 0x1a, 0x42, 0xab, 0xe6, 0xb1, 0x0e, 0xa6,
-		0x3a, 0x6c, 0x5b, 0xcc, 0xfd, 0x63, 0xff, 0x98, 0x7f, 0x9c, 0x40, 0x86, 0x5e, 0x76, 0xc4, 0xa0,
-		0x53, 0xa1, 0x9a, 0xb0, 0xc2, 0xb4, 0xeb, 0xd8, 0xdc, 0x56, 0xd9, 0x5b, 0xf3, 0xbe, 0x6a, 0x67,
-		0xd0, 0x48, 0xdb, 0x6d, 0xc6, 0xab, 0x0e, 0x93, 0x36, 0x11, 0x4e, 0x31, 0x94, 0x9c, 0x0e, 0x82,
-		0xf8, 0x18, 0xf1, 0x31, 0xe2, 0x63, 0xc4, 0xc7, 0x88, 0x8f, 0x11, 0x1f, 0x23, 0x3e, 0x46, 0x7c,
-		0x9c, 0xb0, 0xe7, 0xc7, 0xfc, 0x20, 0x1f, 0x3e, 0x40, 0x34, 0x9c, 0x6f, 0x96, 0x11, 0xfb, 0xce,
-		0x87, 0x7d, 0xa9, 0x10, 0x81, 0x28, 0x86, 0x7c, 0xc7, 0x43, 0x20, 0xee, 0x45, 0xdc, 0x8b, 0xb8,
-		0x17, 0x71, 0x2f, 0xe2, 0x5e, 0xc4, 0xbd, 0x88, 0x7b, 0x11, 0xf7, 0x8e, 0x11, 0xd9, 0x61, 0x1e,
-		0x74, 0x80, 0xa8, 0x37, 0xcf, 0x1c, 0x23, 0xe6, 0x9d, 0x0f, 0xf3, 0xfa, 0x91, 0xa7, 0x98, 0x9e,
-		0xd8, 0x88, 0x67, 0x43, 0x21, 0x06, 0x46, 0x0c, 0x8c, 0x18, 0x18, 0x31, 0x30, 0x62, 0x60, 0xc4,
-		0xc0, 0x88, 0x81, 0x11, 0x03, 0xbf, 0xe4, 0xa3, 0x3f, 0x9d, 0x40, 0x06, 0x8c, 0x8d, 0x30, 0x88,
-		0x8b, 0xdf, 0x9a, 0x77, 0xc4, 0xca, 0xf3, 0x61, 0xe5, 0xc0, 0x56, 0xb4, 0x20, 0x46, 0x1e, 0x0f,
-		0x81, 0xd8, 0x18, 0xb1, 0x31, 0x62, 0x63, 0xc4, 0xc6, 0x88, 0x8d, 0x11, 0x1b, 0x23, 0x36, 0x46,
-		0x6c, 0x9c, 0x70, 0x97, 0x31, 0x30, 0x3b, 0xcb, 0x83, 0x0f, 0x10, 0x09, 0xe7, 0x9b, 0x65, 0xc4,
-		0xbd, 0xf3, 0xe1, 0xde, 0x88, 0x6b, 0x62, 0x88, 0x9f, 0x0c, 0x84, 0x18, 0x18, 0x31, 0x30, 0x62,
-		0x60, 0xc4, 0xc0, 0x88, 0x81, 0x11, 0x03, 0x23, 0x06, 0x46, 0x0c, 0x9c, 0xa2, 0xb3, 0x3f, 0x91,
-		0x10, 0x2e, 0x07, 0x06, 0xff, 0x89, 0x0c, 0x70, 0x66, 0x24, 0xfc, 0x9d, 0x07, 0xb7, 0xbc, 0x1a,
-		0x8a, 0x40, 0x05, 0x45, 0xb1, 0xf0, 0x93, 0xa1, 0x10, 0x0d, 0x23, 0x1a, 0x5e, 0x45, 0x34, 0xdc,
-		0x6c, 0x14, 0x40, 0xc3, 0xdb, 0x88, 0x86, 0x11, 0x0d, 0x9b, 0x42, 0xc3, 0xad, 0xc6, 0x6e, 0x6b,
-		0xb7, 0xb3, 0xdd, 0xd8, 0x45, 0x0c, 0x8c, 0x18, 0x78, 0x99, 0x30, 0xf0, 0x31, 0xff, 0x73, 0x84,
-		0x0d, 0x7a, 0x79, 0xa0, 0x01, 0xa2, 0xe0, 0x02, 0x53, 0x8d, 0x38, 0x78, 0x8e, 0x79, 0xf6, 0x88,
-		0x54, 0x55, 0xdb, 0xa3, 0x44, 0xe4, 0x07, 0xc0, 0x53, 0x63, 0x20, 0xf2, 0x45, 0xe4, 0xbb, 0x52,
-		0xc8, 0xd7, 0x21, 0x8a, 0x56, 0x09, 0x77, 0xaa, 0x8a, 0xf9, 0xb4, 0x48, 0x2b, 0xce, 0x3c, 0x64,
-		0x70, 0x8f, 0x28, 0x45, 0x05, 0xcf, 0xad, 0xe6, 0x2a, 0x57, 0x57, 0xce, 0x8f, 0xd6, 0x43, 0x35,
-		0xfe, 0xa7, 0x31, 0xf9, 0xe7, 0x72, 0xf4, 0x4f, 0xf7, 0xc9, 0x3f, 0x1b, 0x57, 0x57, 0x5b, 0x57,
-		0x57, 0xce, 0x7f, 0x36, 0x7f, 0xdf, 0xf8, 0xff, 0xfd, 0xfc, 0x7a, 0x75, 0xf5, 0x9f, 0xab, 0xab,
-		0xea, 0xf5, 0x93, 0x6f, 0x6c, 0x56, 0x40, 0xca, 0xb7, 0x20, 0x52, 0xda, 0x4a, 0x46, 0xbe, 0x30,
-		0x16, 0xca, 0x3b, 0x94, 0x77, 0xab, 0x68, 0xe9, 0xa3, 0xdf, 0x0b, 0x2d, 0xfd, 0xe5, 0xb4, 0xf4,
-		0xd1, 0xef, 0x85, 0x36, 0x3f, 0xa4, 0xbc, 0x88, 0xb3, 0x48, 0x61, 0xd1, 0xc8, 0x32, 0x18, 0x80,
-		0x37, 0x27, 0x1e, 0xf9, 0x80, 0x39, 0xf1, 0x72, 0xf1, 0xb2, 0x91, 0x4f, 0x46, 0x41, 0x8c, 0x8c,
-		0x18, 0x19, 0x31, 0x32, 0x62, 0x64, 0xc4, 0xc8, 0x88, 0x91, 0x11, 0x23, 0x23, 0x46, 0x4e, 0x9c,
-		0x35, 0x67, 0x91, 0xc2, 0xc2, 0x91, 0x86, 0x11, 0xf1, 0xb3, 0x69, 0x46, 0xfc, 0x3b, 0x27, 0xfe,
-		0x2d, 0x5a, 0x3a, 0x72, 0x6a, 0x0c, 0xc4, 0xbe, 0x88, 0x7d, 0x11, 0xfb, 0x22, 0xf6, 0x45, 0xec,
-		0x8b, 0xd8, 0x17, 0xb1, 0x2f, 0x62, 0xdf, 0x09, 0x28, 0xc3, 0xe2, 0x91, 0x25, 0x20, 0x5f, 0xac,
-		0x1e, 0x99, 0x0d, 0xf7, 0xea, 0x2a, 0x1f, 0xf9, 0xc2, 0x58, 0x88, 0x83, 0x11, 0x07, 0x23, 0x0e,
-		0x46, 0x1c, 0x8c, 0x38, 0x18, 0x71, 0x30, 0xe2, 0x60, 0xc4, 0xc1, 0x2f, 0xba, 0xeb, 0xb1, 0x80,
-		0x64, 0x19, 0xd8, 0xf8, 0xcd, 0x89, 0x47, 0xbc, 0x3c, 0x27, 0x5e, 0x2e, 0x5a, 0x42, 0x72, 0x6a,
-		0x0c, 0xc4, 0xc7, 0x88, 0x8f, 0x11, 0x1f, 0x23, 0x3e, 0x46, 0x7c, 0x8c, 0xf8, 0x18, 0xf1, 0x31,
-		0xe2, 0xe3, 0x71, 0x59, 0x97, 0xb3, 0x48, 0x61, 0x11, 0x49, 0xa3, 0x68, 0xf8, 0xd9, 0x34, 0x23,
-		0xf6, 0x9d, 0x13, 0xfb, 0xea, 0x29, 0x23, 0x39, 0x33, 0x12, 0xe2, 0x60, 0xc4, 0xc1, 0x88, 0x83,
-		0x11, 0x07, 0x23, 0x0e, 0x46, 0x1c, 0x8c, 0x38, 0x18, 0x71, 0xf0, 0x23, 0x40, 0xc3, 0x42, 0x92,
-		0x65, 0x41, 0x61, 0xac, 0x24, 0x39, 0xe7, 0x37, 0xe7, 0x3c, 0xb7, 0x45, 0xce, 0x6b, 0x81, 0x73,
-		0x5a, 0x00, 0xb4, 0xc4, 0xbb, 0x65, 0xbb, 0xd1, 0x68, 0x5a, 0x55, 0x6b, 0xcf, 0xfa, 0x7f, 0x7b,
-		0x9f, 0x3f, 0x59, 0x07, 0x44, 0x11, 0xeb, 0x34, 0x70, 0xa8, 0x67, 0xb9, 0x81, 0x78, 0xdc, 0x40,
-		0x57, 0xfc, 0x94, 0x70, 0x32, 0xa0, 0xc9, 0x3e, 0x5b, 0x2c, 0xa6, 0x2d, 0x7a, 0x10, 0xcd, 0xc0,
-		0xda, 0x9c, 0x53, 0x69, 0x1a, 0x07, 0xbf, 0xd3, 0x7b, 0xc2, 0xe6, 0x3d, 0x09, 0x7b, 0x9c, 0x07,
-		0x8a, 0xc4, 0xd8, 0x20, 0xdb, 0x29, 0x90, 0xf6, 0x90, 0xfa, 0x24, 0x24, 0x6a, 0x18, 0xcf, 0xe9,
-		0x87, 0x20, 0xa4, 0xdc, 0x4e, 0x6c, 0xb8, 0x2a, 0x4b, 0x45, 0xd9, 0x87, 0x97, 0x3e, 0x7e, 0x90,
-		0x8a, 0x28, 0xfa, 0x61, 0x8c, 0xc2, 0xb3, 0xe8, 0x8e, 0x8a, 0x54, 0x22, 0xb2, 0x15, 0x1f, 0x1f,
-		0x89, 0x74, 0x99, 0xbe, 0xed, 0x4f, 0xc6, 0x7a, 0xa7, 0x67, 0xa6, 0xe7, 0x98, 0xbb, 0x8a, 0x43,
-		0xa5, 0x2d, 0x58, 0x98, 0x69, 0xe2, 0x1e, 0x4b, 0x18, 0x4d, 0x5d, 0x3c, 0xe7, 0x3a, 0x65, 0xb3,
-		0xab, 0x33, 0xdb, 0xd3, 0x79, 0xec, 0xe8, 0xdc, 0xf6, 0x73, 0x5e, 0x19, 0x53, 0xd8, 0x5e, 0x2e,
-		0x2c, 0x50, 0x8a, 0xd8, 0xc7, 0x7a, 0x35, 0x58, 0x66, 0x3b, 0x38, 0x5d, 0x2d, 0xa9, 0x04, 0xe3,
-		0x83, 0x2c, 0xcb, 0x95, 0x16, 0xcc, 0x42, 0x1d, 0x3c, 0x07, 0x62, 0xdb, 0xf3, 0x18, 0x91, 0xa8,
-		0x79, 0xf3, 0x43, 0xde, 0xd1, 0x04, 0x42, 0xd3, 0xb7, 0x5a, 0xb4, 0x0a, 0xe5, 0xf1, 0x59, 0x70,
-		0xb2, 0x6b, 0x94, 0xc9, 0x85, 0x73, 0x9e, 0xbd, 0x03, 0xea, 0x92, 0xc8, 0x53, 0x99, 0x4e, 0x4f,
-		0x25, 0xde, 0x05, 0xf3, 0x2d, 0xcb, 0x35, 0x2a, 0x35, 0x54, 0x6a, 0xa5, 0x2a, 0xb5, 0x7e, 0x10,
-		0x78, 0x94, 0xf0, 0x3c, 0x5a, 0xad, 0x8e, 0x5a, 0x6d, 0x1e, 0xa1, 0xec, 0xf8, 0x8c, 0x5f, 0x28,
-		0xa2, 0x22, 0xd4, 0x6d, 0x45, 0x74, 0xdb, 0xd4, 0x34, 0xae, 0xa5, 0x86, 0x63, 0x2e, 0xe3, 0x0e,
-		0xbd, 0xcb, 0xae, 0xe1, 0x26, 0x17, 0xa2, 0x6a, 0x41, 0xd5, 0x52, 0xaa, 0x6a, 0x89, 0x18, 0x57,
-		0x99, 0xda, 0x6c, 0xe4, 0x68, 0xaf, 0x91, 0xd3, 0x39, 0x98, 0x43, 0xec, 0x17, 0x71, 0x06, 0x16,
-		0x75, 0x02, 0x6a, 0x73, 0x43, 0x15, 0x77, 0x3f, 0xe5, 0x70, 0xf6, 0x15, 0x72, 0xf2, 0x69, 0x6b,
-		0x8b, 0xb1, 0x4c, 0x73, 0x08, 0x82, 0xe0, 0x04, 0x0b, 0xc8, 0xac, 0xea, 0xab, 0x50, 0x02, 0x11,
-		0xd8, 0x2f, 0x10, 0xd8, 0xaf, 0xe6, 0x6d, 0x2d, 0x21, 0xd7, 0xa8, 0x19, 0xc4, 0x70, 0xac, 0x61,
-		0x32, 0xc2, 0xae, 0xe9, 0x8b, 0x11, 0x7a, 0x21, 0xf4, 0x2a, 0x15, 0x7a, 0x29, 0xe6, 0x53, 0xc5,
-		0xec, 0xef, 0x12, 0xd1, 0x17, 0xa2, 0x2f, 0x44, 0x5f, 0x88, 0xbe, 0x96, 0x86, 0x0e, 0x3b, 0x21,
-		0x52, 0xed, 0x67, 0xd1, 0x8a, 0xc8, 0x86, 0xfd, 0x7a, 0x16, 0xd7, 0x12, 0x99, 0xf9, 0x2a, 0xca,
-		0x8e, 0xc8, 0xe2, 0x8b, 0x10, 0x89, 0x21, 0x12, 0x2b, 0x9d, 0x04, 0xab, 0x77, 0x72, 0xc0, 0xb0,
-		0x0e, 0xc2, 0x30, 0x84, 0x61, 0xcf, 0xa7, 0xae, 0xd3, 0x6e, 0x37, 0x11, 0x81, 0x2d, 0x83, 0x06,
-		0x1a, 0x07, 0xd3, 0x65, 0x54, 0x41, 0xc9, 0x55, 0xa8, 0x83, 0x50, 0x07, 0x95, 0xaa, 0x83, 0x30,
-		0x70, 0xad, 0x80, 0x4d, 0xb3, 0xdd, 0x68, 0x34, 0xbb, 0x6f, 0x05, 0x3c, 0x5b, 0x18, 0x3a, 0xfe,
-		0xa6, 0x59, 0x93, 0x79, 0x22, 0xd7, 0x52, 0xaf, 0x04, 0x21, 0x15, 0x55, 0x39, 0x8a, 0x73, 0xc8,
-		0xac, 0x5e, 0xa6, 0x2f, 0x46, 0x2d, 0x83, 0x5a, 0x26, 0x87, 0x8c, 0x3e, 0x25, 0xdc, 0x21, 0x2a,
-		0x10, 0xf7, 0xb1, 0xf8, 0x37, 0xae, 0x99, 0x28, 0x8f, 0x7c, 0x2a, 0x48, 0x86, 0x78, 0xfe, 0x27,
-		0xea, 0xa9, 0x95, 0xe1, 0x9a, 0x43, 0x1e, 0xf9, 0xf1, 0x43, 0x62, 0x3e, 0xd4, 0x5c, 0x25, 0x87,
-		0x43, 0x2a, 0x30, 0x68, 0xad, 0x28, 0x4d, 0x37, 0x35, 0x8b, 0x6b, 0xa9, 0xcc, 0x54, 0x16, 0xc1,
-		0xf0, 0xe8, 0xbc, 0x8a, 0xaf, 0x42, 0xf5, 0x85, 0xea, 0x6b, 0xe9, 0xd5, 0x17, 0x73, 0x28, 0x57,
-		0x4c, 0xdd, 0x0b, 0xea, 0xe6, 0x51, 0x5f, 0x19, 0x98, 0x9c, 0xca, 0xf1, 0xf8, 0x56, 0x1f, 0x89,
-		0xa4, 0xf9, 0x8b, 0xd4, 0xa4, 0xc9, 0x84, 0xd5, 0x0c, 0x87, 0xec, 0x29, 0x0b, 0x25, 0x73, 0x65,
-		0xd9, 0xe7, 0x54, 0x04, 0xe9, 0x73, 0x13, 0x4e, 0xaa, 0x85, 0x1e, 0x5e, 0xc7, 0x4b, 0x14, 0x7b,
-		0x99, 0x99, 0x97, 0x72, 0x1d, 0x87, 0x55, 0x16, 0x51, 0x75, 0x43, 0xd3, 0xf3, 0x87, 0x22, 0x08,
-		0xf7, 0x94, 0x0f, 0xf9, 0x15, 0x88, 0xb0, 0x39, 0x55, 0x90, 0xdf, 0x80, 0xc9, 0x60, 0x67, 0xa7,
-		0xd6, 0x68, 0xef, 0x9f, 0x1f, 0xf5, 0x8e, 0x39, 0xe8, 0x57, 0xe9, 0x0f, 0xc2, 0x30, 0xf0, 0x98,
-		0x7d, 0x4f, 0xec, 0x24, 0xc9, 0x99, 0xf1, 0x01, 0xe4, 0xd7, 0xf1, 0x43, 0x4f, 0x42, 0x7e, 0xfe,
-		0x40, 0xf1, 0x33, 0x27, 0x02, 0x7d, 0xba, 0xbd, 0xe8, 0x30, 0x0c, 0x78, 0x6f, 0x78, 0x2f, 0x99,
-		0x4d, 0xbc, 0x3f, 0x39, 0x68, 0x71, 0x4b, 0xd5, 0x90, 0x0a, 0x4e, 0x55, 0xf3, 0xb4, 0xcf, 0x60,
-		0x8b, 0x2c, 0x4a, 0xe9, 0x4e, 0xad, 0x51, 0x6f, 0xac, 0xc2, 0x4b, 0xd4, 0x41, 0xbf, 0x04, 0x77,
-		0x19, 0x67, 0x7d, 0xc2, 0x1d, 0xd0, 0xc7, 0xdc, 0x91, 0x1e, 0xe8, 0xbd, 0xe4, 0x05, 0x36, 0xf1,
-		0x2e, 0x89, 0xf7, 0x1d, 0xb4, 0x78, 0x0a, 0x3d, 0x21, 0x61, 0x63, 0x41, 0x12, 0x82, 0xc6, 0x1b,
-		0xf7, 0x82, 0x01, 0x07, 0xb3, 0xc4, 0x8b, 0x3e, 0x85, 0x01, 0x3f, 0xe3, 0xb0, 0x61, 0x07, 0xf1,
-		0xda, 0x90, 0x9f, 0xff, 0x26, 0x60, 0x36, 0x3d, 0xfa, 0xfb, 0x0c, 0xf4, 0x1a, 0x28, 0xff, 0x22,
-		0xea, 0xa7, 0xc4, 0x2c, 0xe4, 0x57, 0x71, 0xd8, 0x80, 0x29, 0xe2, 0xf5, 0x82, 0x5b, 0x2a, 0x3c,
-		0xc6, 0x41, 0xbf, 0xcb, 0x90, 0x85, 0x21, 0x68, 0x10, 0xee, 0x12, 0x09, 0x5a, 0xc2, 0x3a, 0x81,
-		0x2d, 0xf7, 0x49, 0xdf, 0xa3, 0xa7, 0xc4, 0xf6, 0xc8, 0x3d, 0x15, 0xd0, 0x09, 0xa8, 0xbf, 0x98,
-		0xa0, 0x1e, 0x95, 0xb2, 0xd7, 0xe8, 0x81, 0x86, 0x80, 0x24, 0xec, 0x83, 0x06, 0x1f, 0x2c, 0xb8,
-		0x6b, 0x80, 0xd6, 0x7a, 0xdc, 0x25, 0x12, 0xbe, 0xd6, 0xfe, 0x74, 0x70, 0xb8, 0xf7, 0x79, 0x0f,
-		0x34, 0xed, 0x14, 0x2a, 0x66, 0x13, 0x6f, 0x7f, 0x48, 0x38, 0xa7, 0x5e, 0xe2, 0x4f, 0x85, 0xfc,
-		0x3a, 0x36, 0x93, 0x76, 0x70, 0x7c, 0x71, 0x72, 0xe3, 0x11, 0x0e, 0xfa, 0x3d, 0x6c, 0x75, 0xe8,
-		0x47, 0x1e, 0x74, 0xdd, 0x7d, 0xa8, 0x86, 0xb0, 0x75, 0x9e, 0x12, 0xb5, 0xda, 0x0e, 0x74, 0xa5,
-		0x7d, 0x10, 0xd8, 0x72, 0xa2, 0xb8, 0xff, 0x0c, 0xa5, 0x12, 0x94, 0x80, 0x76, 0x23, 0xc5, 0xb0,
-		0x7c, 0x00, 0x5d, 0x52, 0xdd, 0xf8, 0xb7, 0x44, 0xd0, 0x2f, 0x4c, 0xa8, 0x88, 0x78, 0x9f, 0x99,
-		0x0d, 0xdc, 0xce, 0xa0, 0xc2, 0x23, 0xbc, 0xb1, 0x02, 0xee, 0x0b, 0xd0, 0x2e, 0x3d, 0x69, 0x43,
-		0x67, 0x96, 0x21, 0x3f, 0xff, 0x80, 0x0d, 0x48, 0x9f, 0x8d, 0x94, 0x1e, 0x70, 0x6a, 0x30, 0x35,
-		0x5c, 0x27, 0xfa, 0x62, 0x0c, 0x10, 0x41, 0x63, 0xaa, 0xbe, 0xf7, 0x85, 0xda, 0x8a, 0x5c, 0x28,
-		0x02, 0x1a, 0x93, 0xc8, 0x80, 0x53, 0xd5, 0x23, 0x6a, 0x08, 0x1c, 0x97, 0x28, 0x1a, 0xf0, 0x9d,
-		0x1a, 0x74, 0xf7, 0xea, 0x30, 0x90, 0xaa, 0x47, 0x40, 0x7b, 0xf4, 0x18, 0x68, 0x20, 0x15, 0x49,
-		0xd0, 0x54, 0xce, 0xed, 0x2d, 0xe1, 0xbd, 0x1e, 0x68, 0xe4, 0x34, 0x74, 0x86, 0xf5, 0x9d, 0x46,
-		0x03, 0xba, 0x99, 0x94, 0xf4, 0x32, 0x0f, 0x3d, 0x7a, 0x17, 0x80, 0x56, 0x0f, 0xce, 0x4d, 0xff,
-		0xdc, 0xb6, 0x57, 0xc1, 0xce, 0x13, 0x94, 0xd8, 0xc3, 0x83, 0x8b, 0x13, 0xd0, 0x98, 0xd0, 0x0d,
-		0xc1, 0x6f, 0x26, 0x79, 0x4a, 0xec, 0x13, 0xf8, 0xde, 0x0b, 0xe6, 0x13, 0x71, 0x7f, 0x7c, 0x71,
-		0xf0, 0x19, 0x3c, 0xef, 0x7c, 0x76, 0x43, 0xc5, 0x31, 0xe8, 0x7d, 0xa5, 0xe8, 0x09, 0xe3, 0xb0,
-		0x03, 0x70, 0xec, 0x00, 0xb8, 0x8d, 0x37, 0x0c, 0x7c, 0x1a, 0x72, 0x02, 0xfa, 0x34, 0x40, 0x8f,
-		0x45, 0x73, 0x59, 0x5f, 0xd0, 0x15, 0xb0, 0xad, 0x07, 0xdb, 0xb5, 0x26, 0x51, 0x9d, 0xd6, 0x77,
-		0xd8, 0x38, 0xd6, 0x03, 0xcd, 0x9f, 0x79, 0x4d, 0x16, 0xde, 0x41, 0xf7, 0x7c, 0x0d, 0x14, 0x68,
-		0xc5, 0x36, 0xbc, 0x0f, 0xa9, 0xb0, 0xe1, 0x1f, 0xe8, 0x51, 0xaa, 0x4e, 0xcf, 0x8b, 0x40, 0x7b,
-		0xe9, 0x25, 0x70, 0x46, 0x59, 0x2a, 0x22, 0x4e, 0x60, 0x9f, 0x67, 0xe6, 0x7e, 0x71, 0x59, 0x92,
-		0xf3, 0x09, 0x19, 0xec, 0xc1, 0xf6, 0x0b, 0x25, 0x46, 0xc3, 0xc1, 0xf1, 0x01, 0x6c, 0x92, 0x32,
-		0xb6, 0x7a, 0x80, 0x27, 0x40, 0x8e, 0xb8, 0x99, 0x55, 0x30, 0xa7, 0x5d, 0x71, 0x14, 0x88, 0x5b,
-		0x22, 0x1c, 0xd8, 0x2f, 0x41, 0x7c, 0x7a, 0x4e, 0x3d, 0x72, 0x0f, 0x1a, 0x31, 0x01, 0xf7, 0x5a,
-		0x4f, 0x72, 0xbb, 0xda, 0x2d, 0xd0, 0x47, 0x5b, 0x82, 0xce, 0x4d, 0xfb, 0xc7, 0x83, 0x6d, 0xfb,
-		0x90, 0x7a, 0xe3, 0x34, 0x0c, 0x2f, 0x6e, 0x99, 0xb2, 0x87, 0xc0, 0x35, 0xc4, 0x9e, 0x64, 0x67,
-		0x91, 0x02, 0xfe, 0x12, 0x97, 0x0e, 0x68, 0x45, 0x4d, 0x41, 0x1f, 0x66, 0x2e, 0x61, 0xbb, 0x74,
-		0xa5, 0xef, 0xc8, 0x83, 0x3b, 0x06, 0xdc, 0x72, 0xb3, 0xbf, 0x5f, 0x06, 0x17, 0xf1, 0x3f, 0xf0,
-		0xe5, 0xd1, 0x31, 0x5f, 0x81, 0xc8, 0xbe, 0x93, 0x60, 0xc0, 0x6c, 0xe2, 0x41, 0x77, 0x3c, 0x08,
-		0x3a, 0x88, 0x3c, 0x22, 0xa0, 0x7b, 0xdc, 0x27, 0x95, 0x54, 0x2e, 0x83, 0xef, 0x94, 0x9f, 0x03,
-		0x2f, 0x3e, 0x22, 0xa1, 0xfb, 0x82, 0xee, 0x1a, 0xf5, 0x26, 0xf0, 0x84, 0x2e, 0x17, 0x78, 0x12,
-		0x33, 0xec, 0xd3, 0xec, 0x83, 0xde, 0xfe, 0x3e, 0xb1, 0x2f, 0xa8, 0xbd, 0x1f, 0x70, 0x25, 0x02,
-		0xcf, 0xa3, 0xce, 0xf1, 0x11, 0x68, 0x9d, 0xed, 0xf0, 0xbf, 0x1b, 0xab, 0x90, 0x13, 0x7f, 0xb1,
-		0x52, 0x21, 0xd4, 0xab, 0xc1, 0x94, 0x25, 0x49, 0xfe, 0x76, 0xc0, 0x39, 0xb5, 0x61, 0x17, 0xbf,
-		0x70, 0x3d, 0xc2, 0x69, 0x8c, 0x40, 0x40, 0x03, 0x0f, 0xb9, 0x7d, 0xc1, 0x06, 0xd0, 0x21, 0xad,
-		0x23, 0xeb, 0x47, 0x07, 0x27, 0xb0, 0xd1, 0x2c, 0xec, 0x6c, 0x34, 0x3f, 0xb0, 0xc9, 0x17, 0x2a,
-		0x24, 0x0b, 0x78, 0x1d, 0x7e, 0x1c, 0x4a, 0xc3, 0xef, 0xaf, 0x44, 0xe6, 0xec, 0xd1, 0xdf, 0xa0,
-		0x4f, 0x35, 0x6c, 0x5f, 0x5d, 0x18, 0xc8, 0x15, 0x28, 0xee, 0xb4, 0x22, 0xd5, 0x18, 0x6f, 0x80,
-		0x07, 0x72, 0x24, 0xc5, 0x94, 0x3d, 0x4a, 0x6e, 0x40, 0x87, 0x41, 0x48, 0x11, 0xae, 0x40, 0xad,
-		0x8b, 0x4b, 0x41, 0xb8, 0x0c, 0x03, 0x01, 0xbd, 0x76, 0xdb, 0xe1, 0x09, 0xe8, 0x1a, 0x3c, 0x83,
-		0xdd, 0xdd, 0x9d, 0x26, 0x6c, 0xd4, 0xda, 0x5c, 0x0d, 0x93, 0xee, 0x82, 0x8a, 0x1b, 0x06, 0xbb,
-		0x6a, 0x5b, 0x42, 0x18, 0x1c, 0x72, 0x1b, 0x76, 0x4d, 0xc9, 0x30, 0xe0, 0x9d, 0x95, 0x60, 0xf5,
-		0x9b, 0xfb, 0xd2, 0x27, 0xb6, 0x03, 0x3f, 0x54, 0xeb, 0x20, 0xb8, 0xe5, 0xf0, 0xd9, 0x1b, 0x3f,
-		0xf4, 0xe4, 0x65, 0x04, 0x3d, 0x24, 0x39, 0x4d, 0x7d, 0x82, 0xde, 0x05, 0x61, 0xe4, 0x8e, 0x04,
-		0x5e, 0x76, 0x75, 0x54, 0xbf, 0xe6, 0x33, 0xb3, 0x2f, 0x81, 0x1f, 0x8e, 0x9b, 0x66, 0x07, 0xbe,
-		0xe6, 0x3b, 0x85, 0x1d, 0x22, 0x5e, 0x1d, 0x48, 0x0e, 0x1b, 0x4b, 0x1d, 0x78, 0x36, 0x3b, 0xe4,
-		0x4e, 0x0f, 0xb4, 0x51, 0x71, 0x33, 0xaa, 0x46, 0x75, 0x39, 0x80, 0xef, 0xcb, 0xfb, 0x93, 0xdb,
-		0x2b, 0xe2, 0xcd, 0xf3, 0x1a, 0xd0, 0x53, 0xba, 0xfc, 0x1b, 0x6f, 0x15, 0x3a, 0x81, 0xc0, 0xc7,
-		0xb4, 0x4c, 0x3a, 0xb0, 0x23, 0xc5, 0x57, 0x21, 0xc0, 0x57, 0xd6, 0x3e, 0x46, 0xdc, 0xf1, 0x28,
-		0xf0, 0x7a, 0xef, 0xc7, 0x3e, 0xec, 0xcc, 0xf1, 0xe6, 0x36, 0xf4, 0x7a, 0x35, 0xfb, 0x1c, 0x76,
-		0xc7, 0xab, 0x51, 0x46, 0xd7, 0x3e, 0xf0, 0xa4, 0x6b, 0xa2, 0xfc, 0x7e, 0x00, 0xbb, 0xa3, 0xcf,
-		0xa4, 0x96, 0xf5, 0xd9, 0x05, 0xf8, 0xbe, 0x44, 0x21, 0xf0, 0x84, 0x65, 0x9b, 0xf4, 0x3d, 0xfa,
-		0x48, 0x3a, 0x9d, 0xbb, 0x3d, 0xe0, 0x7e, 0x0a, 0x19, 0xb8, 0xea, 0x96, 0x08, 0x7a, 0x12, 0x04,
-		0x61, 0x1f, 0x78, 0x2c, 0x7c, 0x4a, 0x40, 0x1d, 0xad, 0x44, 0xde, 0xe0, 0x98, 0x39, 0x38, 0xfa,
-		0x74, 0x00, 0xdd, 0x8d, 0x04, 0xbb, 0xf4, 0x1c, 0x11, 0x04, 0x76, 0xe8, 0x78, 0xdb, 0xf7, 0x40,
-		0xbb, 0x5d, 0x04, 0x71, 0x58, 0x70, 0xba, 0xb7, 0xbf, 0x1a, 0x9d, 0x66, 0xf6, 0x7d, 0x25, 0x57,
-		0xc3, 0x6d, 0x71, 0xb7, 0xd3, 0x39, 0x21, 0xa1, 0x04, 0x9e, 0x97, 0x00, 0x1a, 0x1a, 0x86, 0x0e,
-		0x4f, 0x22, 0xd1, 0x62, 0xf5, 0x5d, 0x87, 0x0d, 0xac, 0xc2, 0x5b, 0x12, 0x1e, 0x04, 0xaa, 0x5e,
-		0xff, 0x28, 0x41, 0x6f, 0xa9, 0xc8, 0x53, 0xb0, 0x15, 0x06, 0x81, 0xed, 0xbe, 0x1b, 0xbb, 0xb8,
-		0x1b, 0xc0, 0x13, 0xe6, 0x87, 0xcd, 0x46, 0xf3, 0x13, 0x51, 0xf4, 0x3b, 0xa5, 0x21, 0xec, 0x52,
-		0x24, 0x22, 0x84, 0xdd, 0x62, 0x06, 0xbc, 0x63, 0x7e, 0x20, 0x9a, 0xb5, 0xe6, 0xf9, 0xc1, 0x25,
-		0xf8, 0x0c, 0xce, 0xd8, 0xb6, 0x1b, 0x52, 0xe2, 0xac, 0x40, 0x41, 0xc6, 0x3e, 0x91, 0xcc, 0x86,
-		0x5e, 0xec, 0x16, 0x78, 0x19, 0x83, 0xa1, 0x94, 0x0c, 0xb8, 0xa2, 0x06, 0x5e, 0x85, 0x61, 0x92,
-		0x64, 0x7e, 0xc4, 0xfa, 0xf0, 0x7b, 0x6f, 0x4e, 0xb7, 0xf1, 0x5a, 0x85, 0x7e, 0xa2, 0x92, 0x85,
-		0xb0, 0x23, 0x0d, 0x26, 0xbb, 0xeb, 0x40, 0x89, 0x15, 0xf0, 0xc3, 0x78, 0xe4, 0x16, 0x74, 0x70,
-		0x3f, 0xf4, 0x56, 0x83, 0xac, 0xef, 0x37, 0xb7, 0x6b, 0x21, 0x11, 0x31, 0xfa, 0x80, 0x5d, 0xdb,
-		0x5d, 0xf8, 0xd0, 0x3b, 0xe2, 0x00, 0x4f, 0x37, 0x8a, 0x8d, 0xbb, 0x9e, 0x08, 0xee, 0xee, 0x57,
-		0x40, 0xbc, 0xb6, 0x92, 0x0a, 0x31, 0x1f, 0x61, 0xfb, 0xf5, 0xc6, 0xef, 0xd2, 0x39, 0x05, 0x1e,
-		0xc9, 0x45, 0x1d, 0x46, 0x4e, 0x09, 0xf3, 0xe0, 0x77, 0xa0, 0x90, 0xec, 0xee, 0x32, 0x38, 0x0a,
-		0x22, 0xb1, 0x0a, 0xa5, 0x33, 0x3b, 0x7f, 0x9d, 0xee, 0x7d, 0x86, 0x1d, 0x02, 0xdc, 0xbb, 0x85,
-		0x5e, 0x24, 0x9a, 0x85, 0x2b, 0x50, 0x12, 0xd7, 0x0e, 0xfc, 0x30, 0x90, 0x0c, 0x7e, 0x7f, 0x16,
-		0xc9, 0xc2, 0x0b, 0x36, 0x80, 0xdf, 0x19, 0x0e, 0x76, 0xf9, 0x7d, 0xe0, 0xb1, 0x5d, 0x93, 0x1a,
-		0x31, 0xa0, 0x13, 0x3d, 0x85, 0x6b, 0xd7, 0x5b, 0xb0, 0x53, 0x6d, 0xc3, 0x80, 0xd7, 0xdb, 0xa0,
-		0x0b, 0xf5, 0x10, 0x5b, 0x51, 0x8f, 0xc9, 0x53, 0xaa, 0xc8, 0xc9, 0xd9, 0x19, 0xec, 0x53, 0xed,
-		0x01, 0xa7, 0x65, 0x59, 0x18, 0xb2, 0xa4, 0x8c, 0x95, 0x4b, 0x6c, 0xe8, 0x61, 0xd8, 0xe3, 0x06,
-		0xf2, 0xa0, 0x8d, 0x0a, 0x77, 0x15, 0x4a, 0x58, 0x3d, 0xaf, 0xf6, 0x06, 0x3f, 0x74, 0x53, 0xc8,
-		0x46, 0xb3, 0x01, 0xbb, 0xa8, 0x95, 0x43, 0x61, 0xa7, 0xa6, 0xd6, 0x41, 0xc7, 0xdc, 0x50, 0x69,
-		0xc3, 0xee, 0x4c, 0x11, 0xcb, 0xd7, 0x15, 0x48, 0xeb, 0xf4, 0x7c, 0xd8, 0x55, 0x31, 0x60, 0xc3,
-		0x0d, 0x26, 0x1d, 0x0e, 0x9a, 0xbb, 0x54, 0xc0, 0xad, 0xb8, 0x51, 0x9c, 0xfb, 0xc7, 0x0b, 0xe8,
-		0xfe, 0xdf, 0x5e, 0xc0, 0xb8, 0xba, 0x0c, 0x92, 0x7f, 0x2e, 0xa8, 0x60, 0xb0, 0x51, 0xdf, 0x10,
-		0x7a, 0xeb, 0xd6, 0xbb, 0x46, 0x7b, 0x18, 0x71, 0xf5, 0x49, 0x04, 0xb0, 0x4b, 0x7f, 0x8e, 0x39,
-		0xe4, 0x26, 0x71, 0x4e, 0x08, 0x68, 0xbe, 0x2c, 0x84, 0x2d, 0xa6, 0x02, 0xc5, 0xcf, 0x54, 0x04,
-		0x3d, 0x15, 0x07, 0x34, 0x60, 0x0d, 0xc3, 0x10, 0xb8, 0x44, 0x0a, 0x61, 0xe7, 0x95, 0x3b, 0x36,
-		0x6c, 0xf7, 0x28, 0xf4, 0x7e, 0xb3, 0xae, 0x70, 0xeb, 0x9d, 0x53, 0x57, 0xc0, 0xaf, 0x50, 0x10,
-		0x8a, 0x40, 0xd1, 0x80, 0xd7, 0x6b, 0xa7, 0x7d, 0xa6, 0xa0, 0xf7, 0xc2, 0x3a, 0xb6, 0x61, 0x9b,
-		0x40, 0x36, 0x17, 0xc0, 0xf3, 0x03, 0x61, 0x1f, 0x6b, 0x3f, 0x04, 0x8d, 0x8c, 0xfe, 0x81, 0x9d,
-		0xc6, 0x48, 0xe4, 0x3d, 0xb7, 0x57, 0xa3, 0x52, 0xec, 0x69, 0xef, 0x18, 0x38, 0xbe, 0x3b, 0x8d,
-		0x3c, 0xc5, 0x3c, 0xc6, 0xbf, 0xc3, 0x57, 0x71, 0xe3, 0x6a, 0x6d, 0xc7, 0xe1, 0x9e, 0xe3, 0x08,
-		0x0a, 0x3b, 0xa9, 0xd1, 0x0e, 0x5c, 0x97, 0x82, 0x5e, 0x0d, 0x9b, 0x4a, 0xe8, 0x04, 0xd3, 0xc7,
-		0xbf, 0xf6, 0xc2, 0xc6, 0x29, 0xec, 0x54, 0x7e, 0x29, 0xfa, 0xc0, 0xab, 0x83, 0x81, 0xa6, 0x8a,
-		0x93, 0xd2, 0xfa, 0x7f, 0x00, 0x27, 0x8c, 0x1d, 0x4f, 0x82, 0x4e, 0x85, 0xf0, 0x9a, 0x2c, 0x84,
-		0x5e, 0x33, 0x92, 0x49, 0x07, 0x76, 0x7d, 0x64, 0x87, 0x0d, 0x98, 0x22, 0xde, 0x5f, 0x82, 0x84,
-		0x21, 0x15, 0x2b, 0x94, 0x59, 0x3a, 0x5d, 0x12, 0xa2, 0x01, 0xbe, 0xc2, 0xbb, 0xbc, 0x74, 0x60,
-		0x17, 0x32, 0x4c, 0x12, 0x97, 0xbf, 0x5c, 0x42, 0xc7, 0x1e, 0x2b, 0x10, 0xc7, 0x44, 0x94, 0x3f,
-		0x6e, 0x31, 0xbd, 0x12, 0x81, 0x4c, 0xab, 0x51, 0x43, 0x88, 0x28, 0xff, 0x9c, 0x38, 0x2c, 0x00,
-		0x6d, 0x7f, 0xdb, 0x2c, 0x04, 0xdf, 0xb5, 0xdc, 0xb5, 0x77, 0xb6, 0xb7, 0xef, 0x60, 0x77, 0xdb,
-		0xb4, 0x57, 0xa0, 0x2e, 0x84, 0x60, 0xce, 0x80, 0xc2, 0xaf, 0xe4, 0xf4, 0x97, 0x9a, 0xa8, 0x0c,
-		0xf0, 0xc7, 0x9b, 0x28, 0xff, 0x28, 0x82, 0xdd, 0x9e, 0xaf, 0xcf, 0x94, 0x84, 0x1e, 0x03, 0x51,
-		0x6f, 0xee, 0xb6, 0x56, 0xa9, 0x44, 0xc4, 0x6a, 0xe8, 0xef, 0xb4, 0xe6, 0x6a, 0x82, 0x49, 0x60,
-		0x57, 0x1c, 0x87, 0xdd, 0x69, 0x7a, 0x08, 0xbd, 0x05, 0x27, 0x70, 0x46, 0x64, 0xaa, 0x82, 0x61,
-		0x4f, 0x04, 0x2e, 0x83, 0x7d, 0x1a, 0xc6, 0xbd, 0x38, 0x57, 0x80, 0x0f, 0x49, 0xaa, 0x9f, 0x1d,
-		0xc3, 0xae, 0x7e, 0xf6, 0x4f, 0x63, 0xb7, 0x59, 0x87, 0xed, 0xe1, 0xa6, 0x83, 0xd5, 0xe8, 0xeb,
-		0x7a, 0xc3, 0x6c, 0x76, 0x36, 0x3a, 0x1c, 0x87, 0x05, 0x4b, 0xda, 0xe4, 0xba, 0xf2, 0xfa, 0x5d,
-		0x09, 0x93, 0x96, 0x6f, 0xb2, 0x1e, 0x0d, 0x62, 0xc7, 0xc9, 0x01, 0x97, 0xcb, 0x7f, 0xce, 0x18,
-		0x92, 0xe5, 0x6a, 0x56, 0x58, 0xfe, 0xa3, 0x12, 0x61, 0x73, 0xaa, 0x20, 0x3c, 0xe9, 0xa4, 0xea,
-		0xd6, 0xfe, 0xf9, 0x51, 0xef, 0x98, 0x83, 0x78, 0xe4, 0xfe, 0x20, 0x0c, 0x03, 0x8f, 0xd9, 0xf7,
-		0xc4, 0xb6, 0x83, 0x88, 0x2b, 0xc6, 0x07, 0x10, 0x1e, 0xdb, 0x0f, 0xf3, 0x34, 0x98, 0x29, 0xff,
-		0x39, 0x03, 0xc5, 0xcf, 0x9c, 0x08, 0xc4, 0x29, 0x1b, 0xb5, 0xfe, 0x2c, 0xd4, 0x94, 0xbf, 0xfc,
-		0xa7, 0x9e, 0x34, 0x40, 0x6b, 0xe6, 0x8b, 0xa5, 0x5c, 0x80, 0x88, 0x18, 0x97, 0xc5, 0x69, 0x40,
-		0x7a, 0xd8, 0x3a, 0x88, 0x87, 0xe5, 0x2e, 0xe3, 0xac, 0x4f, 0xf2, 0x34, 0x3c, 0x5a, 0xc0, 0x71,
-		0xcb, 0x97, 0x63, 0x54, 0xfe, 0x83, 0x7a, 0x81, 0x4d, 0xbc, 0x4b, 0xe2, 0x7d, 0x07, 0x21, 0x0e,
-		0x42, 0x4f, 0x48, 0x18, 0x98, 0x26, 0x4f, 0x3b, 0xf8, 0x05, 0xe8, 0xd9, 0x7b, 0xc1, 0x80, 0x80,
-		0x2f, 0xe2, 0x45, 0x9f, 0xf2, 0xf6, 0xae, 0x5e, 0xc0, 0xd3, 0x12, 0xaf, 0x0d, 0xe1, 0x39, 0x47,
-		0x0d, 0xd8, 0xfe, 0x3e, 0x03, 0x31, 0xa7, 0xca, 0xbf, 0x88, 0xfa, 0x05, 0xca, 0xa7, 0x94, 0xff,
-		0xc8, 0xe3, 0x50, 0x9c, 0x5e, 0x70, 0x4b, 0x85, 0xc7, 0x38, 0x88, 0x67, 0x4e, 0x8a, 0xd4, 0x40,
-		0x78, 0x50, 0x97, 0x48, 0x10, 0x92, 0xeb, 0xb1, 0x41, 0x52, 0xee, 0xd2, 0xd9, 0x8b, 0x21, 0x10,
-		0x26, 0xfe, 0x9c, 0x5e, 0xa3, 0x07, 0x02, 0xca, 0x90, 0xb0, 0x0f, 0x42, 0xe9, 0xb2, 0x20, 0x57,
-		0x34, 0x42, 0xf9, 0x4f, 0xca, 0x5d, 0x22, 0xe1, 0x68, 0xb1, 0x4f, 0x07, 0x87, 0x7b, 0x9f, 0xf7,
-		0x40, 0xd0, 0x06, 0x4f, 0x5c, 0x10, 0x39, 0xf3, 0xf8, 0xcb, 0x7f, 0x6c, 0x9b, 0x49, 0x3b, 0x38,
-		0xbe, 0x38, 0xc9, 0x17, 0xe1, 0xbb, 0x80, 0xe7, 0xb5, 0xd5, 0xa1, 0x1f, 0x79, 0x50, 0x74, 0x59,
-		0x4e, 0xd2, 0xbd, 0xfc, 0x87, 0x55, 0xa2, 0x56, 0xdb, 0x81, 0xa2, 0xc4, 0xa6, 0x03, 0x13, 0x26,
-		0x15, 0xd2, 0x40, 0x68, 0x33, 0xc6, 0xe9, 0x00, 0x8a, 0x64, 0xb8, 0xf1, 0x6f, 0x89, 0xa0, 0xe3,
-		0xb0, 0xa8, 0xcf, 0xcc, 0x06, 0x82, 0x73, 0xa9, 0xf0, 0x08, 0x6f, 0x00, 0xa2, 0x6f, 0x41, 0xb8,
-		0x1c, 0xa4, 0x0d, 0x85, 0xa1, 0x83, 0xf0, 0x9c, 0x03, 0x36, 0x20, 0x7d, 0x36, 0x52, 0x0e, 0x40,
-		0xa8, 0x9a, 0x99, 0x8a, 0x94, 0xb9, 0x63, 0x2d, 0x16, 0x80, 0x19, 0xfa, 0xde, 0x17, 0x6a, 0x2b,
-		0x72, 0xa1, 0x08, 0x08, 0x5d, 0x9c, 0x24, 0x61, 0xf4, 0x88, 0x1a, 0x02, 0xd1, 0xc7, 0x8a, 0x06,
-		0x7c, 0xa7, 0x06, 0xc5, 0x9d, 0x33, 0x0c, 0xa4, 0xca, 0xd5, 0x50, 0x66, 0x01, 0xfe, 0x11, 0x10,
-		0x40, 0x21, 0x92, 0x20, 0x4c, 0xf4, 0x51, 0x01, 0x7c, 0x10, 0xc8, 0x60, 0xe8, 0x0c, 0xeb, 0x3b,
-		0x8d, 0x06, 0x14, 0x38, 0x9e, 0x54, 0x41, 0x08, 0x3d, 0x7a, 0x17, 0x80, 0x10, 0xaf, 0x49, 0xa6,
-		0x9e, 0x0d, 0xc9, 0x6e, 0x10, 0x94, 0xd8, 0xc3, 0x83, 0x8b, 0x13, 0x10, 0xd8, 0xc6, 0x0d, 0xc1,
-		0x6c, 0x02, 0x79, 0x4a, 0xec, 0x13, 0x38, 0xec, 0x2d, 0xf3, 0x89, 0xb8, 0xcf, 0xd7, 0x85, 0x75,
-		0x41, 0xfc, 0x5d, 0xde, 0xc6, 0x4e, 0x0b, 0xe0, 0x3f, 0x72, 0xb6, 0xa8, 0x59, 0x80, 0xc3, 0xdc,
-		0x0e, 0x80, 0xd8, 0x0c, 0xc3, 0xc0, 0xa7, 0x21, 0x27, 0x20, 0x76, 0x2b, 0x94, 0xd8, 0x0e, 0x97,
-		0xf5, 0x05, 0x05, 0x64, 0x7b, 0x0d, 0xb6, 0x6b, 0x4d, 0xa2, 0x3a, 0xad, 0xef, 0x30, 0x70, 0x97,
-		0x07, 0x82, 0xe7, 0xf0, 0x9a, 0x2c, 0xbc, 0x83, 0xc2, 0xd8, 0x0f, 0x14, 0x08, 0x05, 0x30, 0xbc,
-		0x0f, 0xa9, 0xb0, 0xe1, 0x1c, 0xac, 0x51, 0x88, 0x75, 0xcf, 0x8b, 0x40, 0x78, 0xf3, 0x24, 0x10,
-		0x66, 0x4e, 0x2a, 0x22, 0x4e, 0x60, 0x9c, 0x2b, 0xe6, 0x7e, 0x71, 0x59, 0xbe, 0x2e, 0x87, 0x0b,
-		0x00, 0x2d, 0x30, 0x78, 0xee, 0x04, 0xb4, 0x1e, 0x1c, 0x1f, 0xc0, 0x20, 0x87, 0x62, 0x74, 0x0d,
-		0x24, 0x21, 0x64, 0x64, 0x73, 0x43, 0x32, 0xb7, 0x5c, 0x91, 0xbb, 0xff, 0xe6, 0x22, 0x1e, 0x76,
-		0x52, 0x53, 0x13, 0x04, 0x22, 0x00, 0xe2, 0xf5, 0x9a, 0xc4, 0xd2, 0xb7, 0x5b, 0x20, 0x8e, 0x98,
-		0x04, 0x11, 0xf3, 0xff, 0x8f, 0x07, 0x03, 0x63, 0x93, 0x7a, 0xe3, 0x34, 0x0c, 0x2f, 0x6e, 0x99,
-		0xb2, 0x87, 0x40, 0x24, 0xec, 0x9e, 0x64, 0x67, 0x91, 0x02, 0xf2, 0xb0, 0x97, 0x0e, 0x08, 0xc5,
-		0x45, 0x41, 0x1c, 0x2a, 0x2e, 0x61, 0xb8, 0x8a, 0xa4, 0xef, 0xc8, 0x83, 0x3b, 0x06, 0xc4, 0x12,
-		0xb0, 0xbf, 0x5f, 0x06, 0x17, 0xf1, 0x3f, 0x70, 0xce, 0xff, 0x31, 0x07, 0x14, 0xf9, 0x32, 0xae,
-		0x6f, 0x07, 0x85, 0x78, 0x15, 0x74, 0x10, 0x79, 0x44, 0x40, 0xf1, 0xcc, 0x4d, 0x32, 0x9b, 0x2f,
-		0x83, 0xef, 0x94, 0x9f, 0x03, 0x49, 0x12, 0x96, 0x50, 0xb8, 0xed, 0xbb, 0x46, 0xbd, 0x09, 0x24,
-		0x80, 0xde, 0x05, 0x92, 0x5c, 0x05, 0xe3, 0x54, 0xf9, 0x20, 0xb6, 0xa7, 0x4f, 0xec, 0x0b, 0x6a,
-		0xef, 0x07, 0x5c, 0x89, 0xc0, 0xf3, 0xa8, 0x73, 0x7c, 0x04, 0x42, 0x87, 0x39, 0xfc, 0xef, 0x06,
-		0xa4, 0x1c, 0xbb, 0x0b, 0x90, 0xa1, 0x7b, 0xb0, 0x18, 0x8d, 0x24, 0x39, 0xd0, 0x0e, 0x38, 0xa7,
-		0x36, 0x8c, 0xa4, 0x56, 0xd7, 0x23, 0x9c, 0xc6, 0x9a, 0x17, 0x84, 0xc2, 0x95, 0xdb, 0xb9, 0xfb,
-		0xa5, 0x2f, 0x84, 0xe3, 0x38, 0x3a, 0x38, 0x81, 0x81, 0xbe, 0x60, 0x44, 0xf9, 0xfb, 0x81, 0x4d,
-		0xbe, 0x50, 0x21, 0x59, 0xc0, 0xeb, 0x70, 0xfc, 0xc9, 0x0d, 0xbf, 0x0f, 0x2a, 0x03, 0xe8, 0xe8,
-		0x6f, 0x10, 0xa7, 0x0b, 0x86, 0x2f, 0x21, 0x0c, 0x24, 0xa0, 0xe2, 0x06, 0xc0, 0xaa, 0xf3, 0xdc,
-		0x00, 0x71, 0xd4, 0xb2, 0x18, 0x15, 0x78, 0x94, 0xdc, 0x80, 0x70, 0x7f, 0x4a, 0x11, 0x02, 0xca,
-		0x61, 0x2d, 0x50, 0xfa, 0x70, 0x31, 0x5c, 0xd2, 0x09, 0x88, 0x5c, 0xf6, 0xc1, 0xee, 0xee, 0x4e,
-		0x13, 0x06, 0xca, 0x6a, 0xc2, 0x32, 0x11, 0x2e, 0xa8, 0xb8, 0x61, 0x30, 0xaa, 0x87, 0x24, 0x86,
-		0xe3, 0x21, 0xb7, 0x61, 0xd4, 0x12, 0x0a, 0x03, 0xde, 0x01, 0xc5, 0x76, 0x36, 0xf7, 0xa5, 0x4f,
-		0x6c, 0x07, 0x4e, 0x48, 0x44, 0x91, 0xba, 0xea, 0x8b, 0xa9, 0xe1, 0x78, 0x19, 0x41, 0x09, 0x91,
-		0x4b, 0x43, 0xd0, 0xa1, 0x54, 0x4d, 0x1d, 0xb9, 0x45, 0x80, 0x94, 0xc3, 0x1a, 0xe5, 0x87, 0x7f,
-		0x66, 0xf6, 0x25, 0x90, 0xcd, 0x7b, 0xd3, 0xec, 0xc0, 0xd1, 0x10, 0xa7, 0x30, 0x42, 0x10, 0xab,
-		0x03, 0xc9, 0x61, 0x60, 0x85, 0x03, 0xcf, 0x66, 0x87, 0xdc, 0xe9, 0x81, 0x00, 0xb5, 0xe3, 0x66,
-		0xbc, 0x97, 0x03, 0x38, 0xbe, 0x86, 0x3f, 0xb9, 0x0d, 0xcc, 0xdb, 0xe0, 0x35, 0xa0, 0x84, 0xd0,
-		0xfb, 0x37, 0x1e, 0xa4, 0xca, 0xbe, 0x70, 0x30, 0x18, 0x93, 0x0e, 0x8c, 0x48, 0x44, 0x48, 0x81,
-		0x68, 0xb2, 0x96, 0xb7, 0x1b, 0xfa, 0x42, 0xea, 0x4f, 0x1e, 0xfb, 0x30, 0x32, 0xd4, 0x9a, 0xdb,
-		0x50, 0xf2, 0xc1, 0xf7, 0x39, 0x8c, 0x4a, 0xef, 0xa3, 0x08, 0xfa, 0x7d, 0x20, 0x49, 0x5f, 0x44,
-		0xf9, 0xfd, 0x00, 0x46, 0xa5, 0xec, 0x49, 0xed, 0xbe, 0xb3, 0x0b, 0x30, 0x75, 0xbd, 0x43, 0x20,
-		0x89, 0x54, 0xf6, 0xd3, 0x66, 0xaa, 0xe7, 0x6e, 0x0f, 0x08, 0x4f, 0x2b, 0x03, 0x57, 0xc5, 0x06,
-		0xe3, 0x49, 0x10, 0x84, 0x7d, 0x20, 0x31, 0x95, 0x29, 0x81, 0x70, 0x04, 0x2a, 0xbf, 0x62, 0x6c,
-		0x41, 0x1e, 0x7d, 0x3a, 0x80, 0x42, 0x8b, 0xc3, 0x28, 0x75, 0x42, 0x04, 0x81, 0x11, 0x9a, 0xd8,
-		0xf6, 0x3d, 0x10, 0xf4, 0xb2, 0x20, 0x0e, 0x0b, 0x4e, 0xf7, 0xf6, 0x61, 0x55, 0x7c, 0xde, 0xf7,
-		0x95, 0x84, 0x45, 0xdb, 0xde, 0xed, 0x74, 0x4e, 0x48, 0x28, 0x81, 0xc4, 0xab, 0x82, 0x80, 0x38,
-		0xa1, 0xc3, 0x93, 0xc8, 0x8e, 0x58, 0x9d, 0xd5, 0x61, 0x00, 0x87, 0xb4, 0xe1, 0xe3, 0x47, 0x09,
-		0x62, 0x2b, 0x44, 0x9e, 0x82, 0x21, 0x70, 0x09, 0x0c, 0xf7, 0xc2, 0xd8, 0x45, 0xd6, 0x00, 0x92,
-		0x80, 0x37, 0x6c, 0x36, 0x9a, 0x9f, 0x88, 0xa2, 0xdf, 0x29, 0x0d, 0x61, 0xa4, 0x0c, 0x8b, 0x10,
-		0x46, 0xa9, 0x67, 0x30, 0x0e, 0xbc, 0xa4, 0x19, 0xea, 0x79, 0x9e, 0x66, 0xa8, 0x0b, 0xca, 0x5c,
-		0x89, 0x6d, 0x85, 0x21, 0x25, 0x0e, 0xa0, 0x02, 0x3d, 0x7d, 0x22, 0x99, 0x0d, 0xa5, 0xa8, 0x18,
-		0x90, 0x34, 0xc7, 0xa1, 0x94, 0x0c, 0x88, 0xe2, 0x02, 0x92, 0x8d, 0x39, 0x49, 0x66, 0x3b, 0x62,
-		0x7d, 0x38, 0xbd, 0x56, 0xa6, 0xcb, 0xd4, 0x43, 0xea, 0x13, 0x23, 0x59, 0x08, 0xc3, 0xf3, 0x38,
-		0xd9, 0x15, 0x07, 0x4a, 0x00, 0xe2, 0x9b, 0x3d, 0x72, 0x0b, 0xa3, 0x0f, 0x2a, 0x90, 0xd6, 0x15,
-		0xac, 0xef, 0x37, 0xb7, 0x6b, 0x21, 0x11, 0xb1, 0xd6, 0x85, 0x51, 0x6b, 0x52, 0xf8, 0x50, 0x2a,
-		0x50, 0x03, 0x09, 0x07, 0x8f, 0x8d, 0x85, 0x9e, 0x08, 0xee, 0xee, 0x01, 0x89, 0xad, 0x56, 0x92,
-		0x99, 0xfd, 0x11, 0x86, 0xdf, 0x61, 0xfc, 0xcc, 0x9d, 0x53, 0x20, 0x11, 0x13, 0xd4, 0x61, 0xe4,
-		0x94, 0x30, 0x0f, 0x4e, 0x05, 0x5a, 0xc9, 0xee, 0x2e, 0x83, 0xa3, 0x20, 0x12, 0x90, 0x4a, 0x23,
-		0x75, 0xfe, 0x3a, 0xdd, 0xfb, 0x0c, 0x23, 0x54, 0xad, 0x77, 0x0b, 0xa5, 0x58, 0x1e, 0x0b, 0x01,
-		0x95, 0x1e, 0xb3, 0x03, 0x3f, 0x0c, 0x24, 0x83, 0x53, 0x3f, 0x59, 0xb2, 0xf0, 0x82, 0x0d, 0xe0,
-		0x74, 0x2c, 0x80, 0x51, 0xde, 0x13, 0x48, 0x0c, 0xc5, 0x24, 0x37, 0x1b, 0x44, 0x82, 0x8b, 0x70,
-		0xed, 0x7a, 0x0b, 0x46, 0xca, 0x50, 0x18, 0xf0, 0x7a, 0x1b, 0x44, 0xc2, 0x3b, 0xb1, 0x15, 0xf5,
-		0x98, 0x3c, 0xa5, 0x8a, 0x9c, 0x9c, 0x9d, 0xc1, 0x38, 0x5d, 0x1e, 0x10, 0xda, 0x8b, 0x85, 0x21,
-		0x03, 0xd5, 0xcb, 0x99, 0x28, 0x7f, 0xdc, 0xe0, 0x0e, 0x04, 0xa8, 0x75, 0x21, 0x95, 0x6a, 0x78,
-		0x5e, 0x75, 0x04, 0x4e, 0xa8, 0x92, 0x90, 0x8d, 0x66, 0x03, 0x46, 0xf1, 0x06, 0x87, 0xc2, 0x48,
-		0xbd, 0xa9, 0x83, 0xf0, 0x91, 0x53, 0x69, 0xc3, 0xa8, 0x4c, 0x1b, 0xcb, 0x2d, 0x40, 0xe9, 0x2c,
-		0x9e, 0x0f, 0x23, 0xdb, 0x15, 0x86, 0x9a, 0x65, 0xd2, 0xe1, 0x20, 0x38, 0x23, 0x05, 0xc4, 0x2a,
-		0x18, 0xc5, 0x4b, 0x7e, 0xbc, 0x80, 0xe2, 0x57, 0xea, 0x05, 0x8c, 0xab, 0xcb, 0x20, 0xf9, 0xe7,
-		0x82, 0x0a, 0x06, 0x03, 0xbd, 0x0c, 0xa1, 0xb4, 0xd8, 0xb9, 0x6b, 0xb4, 0x87, 0x11, 0x57, 0x60,
-		0x5a, 0xb8, 0x8f, 0xb9, 0xb8, 0x26, 0x71, 0x4e, 0x08, 0x08, 0x5e, 0x23, 0x84, 0x21, 0x16, 0x02,
-		0xc5, 0xcf, 0x54, 0x04, 0x25, 0x84, 0x1a, 0x04, 0xc0, 0x0a, 0xc3, 0x10, 0x88, 0x04, 0x08, 0x61,
-		0xe4, 0xaf, 0x39, 0x36, 0x0c, 0x77, 0x0c, 0x94, 0xfe, 0x3f, 0xae, 0x70, 0xeb, 0x9d, 0x53, 0x57,
-		0xc0, 0xc9, 0x60, 0x1c, 0xf7, 0x5f, 0xae, 0x83, 0xe9, 0xbf, 0x2c, 0x7d, 0x47, 0x1e, 0xdb, 0x30,
-		0xa0, 0xb6, 0xcd, 0x05, 0x90, 0x3c, 0x0a, 0x18, 0xc7, 0xcb, 0x0f, 0x41, 0x68, 0xfe, 0x7f, 0x60,
-		0xa4, 0x75, 0x10, 0x79, 0xcf, 0x6d, 0x58, 0x95, 0xba, 0x4e, 0x7b, 0xc7, 0x40, 0x70, 0x4a, 0xd2,
-		0xd4, 0xda, 0x63, 0xfc, 0x3b, 0x1c, 0x55, 0x30, 0xae, 0x1a, 0x72, 0x1c, 0xee, 0x39, 0x8e, 0xa0,
-		0x30, 0x92, 0x3c, 0xec, 0xc0, 0x75, 0x29, 0x88, 0xd9, 0xb5, 0xa9, 0x84, 0x42, 0x10, 0x7c, 0xfc,
-		0x6b, 0x2f, 0x6c, 0x9c, 0xc2, 0x48, 0x01, 0x94, 0xa2, 0x0f, 0xa4, 0xaa, 0x05, 0x08, 0xca, 0x2d,
-		0x29, 0xdd, 0xf9, 0x07, 0x10, 0xe2, 0xcd, 0xf1, 0xe4, 0x2d, 0x94, 0x7e, 0xbb, 0x50, 0x6a, 0x05,
-		0x31, 0xe9, 0xc0, 0xa8, 0x1f, 0xe7, 0xb0, 0x01, 0x53, 0xc4, 0xfb, 0x4b, 0x90, 0x30, 0xa4, 0x02,
-		0x60, 0xe6, 0xcc, 0x74, 0x0a, 0x68, 0x03, 0x4c, 0xc5, 0x49, 0x79, 0xe9, 0xc0, 0x28, 0x78, 0x93,
-		0x24, 0x54, 0x7d, 0xb9, 0x84, 0xa2, 0x73, 0x01, 0xc5, 0x11, 0x10, 0xe5, 0x8f, 0x5b, 0x6f, 0x81,
-		0x0a, 0x24, 0x80, 0x95, 0x73, 0x4f, 0x94, 0x7f, 0x4e, 0x1c, 0x16, 0x80, 0xb0, 0xcf, 0x6c, 0x16,
-		0x82, 0xe9, 0xc2, 0xe6, 0xda, 0x3b, 0xdb, 0xdb, 0x77, 0x30, 0xba, 0xab, 0x00, 0xea, 0x27, 0xdf,
-		0x17, 0xcc, 0x19, 0x50, 0x38, 0x95, 0x0c, 0xfe, 0x52, 0x13, 0x91, 0x0b, 0xe6, 0x98, 0x11, 0xe5,
-		0x1f, 0x45, 0x30, 0xda, 0x40, 0xf4, 0x99, 0x92, 0x50, 0x7c, 0x9f, 0xf5, 0xe6, 0x6e, 0x0b, 0x62,
-		0x4a, 0x28, 0x2c, 0x7d, 0x96, 0xd6, 0xc2, 0x4a, 0x74, 0x31, 0x8c, 0x8a, 0x89, 0x30, 0x3a, 0x70,
-		0x0d, 0xa1, 0xb4, 0x5c, 0x01, 0x62, 0x01, 0x4f, 0x55, 0xba, 0xe9, 0x89, 0xc0, 0x65, 0x30, 0x76,
-		0xeb, 0xb8, 0xf7, 0x0a, 0x20, 0xfb, 0x37, 0xa9, 0xce, 0x71, 0x0c, 0xa3, 0x3a, 0xc7, 0x3f, 0x8d,
-		0xdd, 0x66, 0x1d, 0x86, 0x87, 0x8c, 0x0e, 0x60, 0xf5, 0xdf, 0xb9, 0x61, 0x36, 0x3b, 0x1b, 0x6d,
-		0xde, 0xc3, 0x9c, 0x29, 0xe3, 0x99, 0xae, 0xb8, 0x7e, 0xa7, 0x77, 0xdc, 0x39, 0xa7, 0xac, 0x42,
-		0xef, 0x94, 0x20, 0xd5, 0x88, 0x4b, 0x95, 0x28, 0xc0, 0x6e, 0x86, 0x89, 0xab, 0x08, 0xea, 0x52,
-		0x41, 0xb9, 0x1d, 0x5f, 0xf6, 0xf5, 0x9d, 0xd9, 0xd5, 0x49, 0x57, 0xe6, 0xfc, 0x68, 0xdf, 0x6a,
-		0xec, 0x74, 0x9a, 0x5d, 0xeb, 0x72, 0x48, 0xad, 0x34, 0x2f, 0x43, 0x5a, 0x49, 0x64, 0x9b, 0x75,
-		0x7a, 0xfc, 0xd1, 0xaa, 0x5a, 0xcc, 0x4d, 0xb2, 0x22, 0xb3, 0x6f, 0x9b, 0xca, 0x45, 0x10, 0x09,
-		0x3b, 0xdb, 0x3c, 0x3c, 0xb9, 0xfe, 0xbf, 0xf4, 0xfe, 0x36, 0x10, 0xce, 0xa8, 0xb3, 0xf6, 0x64,
-		0x7a, 0xde, 0xe7, 0x1b, 0xeb, 0x0f, 0x22, 0xf7, 0xc4, 0x20, 0xf2, 0x29, 0x57, 0x95, 0xae, 0xa5,
-		0x44, 0x44, 0x73, 0x0e, 0x34, 0x35, 0x4a, 0xa6, 0xf9, 0x33, 0xbc, 0xe7, 0xe7, 0xff, 0xf6, 0x7c,
-		0xa7, 0xe3, 0xed, 0xf1, 0x7e, 0xfd, 0x8d, 0x37, 0xce, 0x4c, 0x65, 0x8f, 0xf3, 0x40, 0x11, 0xc5,
-		0x02, 0x3e, 0xd7, 0xfe, 0xa8, 0x48, 0x7b, 0x48, 0x7d, 0x12, 0x12, 0x35, 0x8c, 0x27, 0xfe, 0x43,
-		0x10, 0x52, 0x6e, 0x07, 0xdc, 0x65, 0x83, 0x2a, 0x4b, 0xa7, 0xfd, 0xc3, 0x4b, 0x1f, 0x3f, 0x48,
-		0x45, 0xd4, 0x1b, 0xd3, 0xff, 0xfa, 0xab, 0xfc, 0xe2, 0x35, 0x2a, 0x32, 0xea, 0x3f, 0xde, 0xf0,
-		0xcd, 0xb7, 0x78, 0x44, 0x73, 0x4f, 0x2e, 0x7b, 0x63, 0x9a, 0xfe, 0xcb, 0x78, 0xbc, 0xff, 0xeb,
-		0x6f, 0x7c, 0x6d, 0x3f, 0x99, 0x8a, 0x4a, 0xd7, 0xaa, 0xbd, 0xf1, 0xc5, 0x9e, 0xa0, 0x2e, 0xbb,
-		0x9b, 0x6f, 0xca, 0x53, 0xdc, 0x61, 0x57, 0x99, 0x3b, 0xc7, 0xb9, 0xcb, 0x7a, 0xde, 0xa7, 0xcf,
-		0x77, 0x38, 0x7a, 0xae, 0x39, 0x05, 0x6d, 0xde, 0xc3, 0xfc, 0xe4, 0xf0, 0x8e, 0x5e, 0xcb, 0xf0,
-		0x36, 0x3f, 0x60, 0x62, 0xce, 0xfd, 0x3d, 0xb5, 0x2b, 0xe6, 0x9f, 0xc1, 0x97, 0xf6, 0xd4, 0xbc,
-		0x93, 0xf8, 0xff, 0xb1, 0xf7, 0xb6, 0xbd, 0x89, 0x63, 0x4b, 0xbb, 0xf0, 0xf7, 0xfe, 0x15, 0x16,
-		0xd2, 0xe8, 0x86, 0x9e, 0x98, 0x00, 0x21, 0x24, 0x41, 0xda, 0x6a, 0x65, 0xa6, 0x7b, 0xe6, 0x89,
-		0x9e, 0xce, 0x4c, 0x94, 0x9e, 0xdd, 0x3a, 0xfb, 0x04, 0x76, 0xcb, 0x8d, 0x17, 0x89, 0x35, 0x8e,
-		0xcd, 0x6d, 0x9b, 0xec, 0xce, 0xee, 0xce, 0x7f, 0x3f, 0xb2, 0x31, 0xe6, 0x35, 0x09, 0xb6, 0xab,
-		0xca, 0x06, 0xae, 0x7c, 0x48, 0x80, 0xc0, 0x5a, 0xc6, 0x6b, 0xd5, 0xcb, 0x75, 0x55, 0xad, 0xaa,
-		0xcd, 0xb6, 0x56, 0xea, 0x2d, 0x96, 0x65, 0xab, 0x65, 0xde, 0x72, 0x79, 0x4d, 0x4d, 0xe6, 0x2d,
-		0x48, 0x66, 0x57, 0xb2, 0x6c, 0x49, 0x1e, 0xef, 0x65, 0xd3, 0xad, 0x9a, 0x7c, 0x60, 0x30, 0xdd,
-		0x11, 0x29, 0xef, 0xf9, 0x2c, 0xb1, 0x23, 0xfa, 0x7c, 0xca, 0xfb, 0x95, 0x6e, 0xdb, 0x66, 0xde,
-		0xbe, 0x79, 0xb6, 0x71, 0xee, 0xed, 0xcc, 0xe1, 0x41, 0x65, 0xda, 0xde, 0xbc, 0xee, 0x53, 0xca,
-		0xed, 0x9e, 0xd1, 0x31, 0x4a, 0xb9, 0xde, 0x69, 0xc5, 0x20, 0xf9, 0xa0, 0xa9, 0xfc, 0x81, 0x67,
-		0x8d, 0x36, 0x76, 0x69, 0x5e, 0xdc, 0x31, 0xf3, 0x83, 0x65, 0xbc, 0xd3, 0xb1, 0xa0, 0x34, 0x32,
-		0x7e, 0x3c, 0xab, 0xc0, 0x50, 0x08, 0x0e, 0x99, 0x00, 0x51, 0x09, 0x12, 0xb9, 0x40, 0x91, 0x0b,
-		0x16, 0xa5, 0x80, 0x65, 0x13, 0xb4, 0x1c, 0x04, 0x43, 0x74, 0xe1, 0x11, 0x4a, 0x22, 0xd9, 0x2d,
-		0x7e, 0xe0, 0x59, 0xce, 0x6d, 0x9e, 0xed, 0x32, 0x35, 0x32, 0xa7, 0xa2, 0x77, 0x20, 0x07, 0x7f,
-		0x40, 0xc8, 0x27, 0xd0, 0xb0, 0x3f, 0x79, 0xf8, 0x86, 0x73, 0xdb, 0x32, 0xfc, 0x9c, 0x82, 0x45,
-		0x29, 0xf5, 0x1c, 0x84, 0x04, 0xbb, 0x22, 0xc8, 0x43, 0x58, 0x4c, 0x16, 0x20, 0xf7, 0xf4, 0x4f,
-		0x6f, 0x8a, 0xf9, 0x74, 0x5f, 0x54, 0x6a, 0x53, 0x32, 0x19, 0xcf, 0x8e, 0xf3, 0x78, 0xeb, 0x06,
-		0xba, 0x3b, 0xd0, 0x07, 0xee, 0xfd, 0xc8, 0x53, 0xbe, 0xaf, 0x4c, 0xdd, 0x56, 0xc6, 0x30, 0x1c,
-		0x34, 0xa3, 0x22, 0x96, 0xe0, 0x86, 0x2b, 0xca, 0x09, 0x95, 0x95, 0x99, 0xdf, 0xe5, 0x99, 0x0e,
-		0x94, 0x71, 0x19, 0xde, 0xab, 0xa1, 0x31, 0xb6, 0x83, 0x5c, 0xea, 0xae, 0x12, 0x8a, 0x5d, 0xb6,
-		0x7d, 0xdf, 0x87, 0x97, 0x06, 0x2f, 0x0d, 0x5e, 0x5a, 0x8a, 0xdd, 0xf2, 0xd5, 0x75, 0x6d, 0x65,
-		0x38, 0x14, 0x6e, 0x5a, 0x13, 0x6e, 0x5a, 0x11, 0x6e, 0x9a, 0x79, 0x6f, 0x39, 0x9f, 0x02, 0x23,
-		0x18, 0xc3, 0x59, 0x2b, 0xd2, 0x59, 0x9b, 0x5b, 0x06, 0xb8, 0x6c, 0x70, 0xd9, 0x5e, 0xbf, 0x6c,
-		0xcb, 0x31, 0xd5, 0xb7, 0xfc, 0x0e, 0xdb, 0x64, 0x98, 0x22, 0xdd, 0xb5, 0x06, 0x7c, 0x35, 0xf8,
-		0x6a, 0xf0, 0xd5, 0xf8, 0x7d, 0xb5, 0xb1, 0xe5, 0x04, 0x47, 0x2d, 0x02, 0x57, 0xed, 0x24, 0xc7,
-		0x10, 0xd7, 0x86, 0x73, 0x5b, 0x0a, 0x3f, 0xe9, 0xd2, 0x72, 0xe8, 0xdc, 0x94, 0xcf, 0x86, 0x3d,
-		0x56, 0xd9, 0x95, 0xc2, 0xca, 0x78, 0xbf, 0x79, 0xc6, 0x20, 0xb4, 0x6c, 0xef, 0xad, 0x5b, 0x2b,
-		0xf0, 0x09, 0x07, 0xfe, 0x43, 0xdd, 0x1a, 0x81, 0xf5, 0x10, 0x5e, 0xeb, 0xd0, 0xb0, 0x7d, 0x95,
-		0xdf, 0xd7, 0x20, 0x70, 0x1a, 0x2f, 0x8d, 0x6f, 0xf4, 0x4b, 0xd1, 0x6e, 0x9d, 0xb5, 0xcf, 0x3a,
-		0x27, 0xad, 0xb3, 0xe3, 0xfd, 0x5b, 0x13, 0xf8, 0x7f, 0xbb, 0xef, 0xff, 0x39, 0x13, 0xad, 0x9e,
-		0xd3, 0xfd, 0x8b, 0x46, 0x81, 0x27, 0x05, 0x4f, 0x0a, 0x9e, 0x54, 0x8a, 0xdd, 0x82, 0xd8, 0x64,
-		0x89, 0x49, 0xaf, 0x93, 0x56, 0xeb, 0xa8, 0xab, 0x9d, 0x6b, 0xff, 0x3a, 0xff, 0xe3, 0x77, 0xed,
-		0xbd, 0x11, 0x18, 0xda, 0xa5, 0x6b, 0x2a, 0x5b, 0x1b, 0xba, 0xde, 0x8c, 0x7f, 0xd1, 0x2e, 0x0d,
-		0xc7, 0xb8, 0x55, 0xd1, 0x3e, 0x06, 0xed, 0xc5, 0x43, 0x7b, 0xa5, 0x5e, 0x08, 0x38, 0x3e, 0x7b,
-		0xe7, 0xf8, 0xb0, 0x66, 0x81, 0xe5, 0xbc, 0x51, 0x79, 0xd2, 0xd5, 0xe7, 0xd3, 0xc3, 0x17, 0x9e,
-		0x1d, 0xc6, 0x09, 0x93, 0x4c, 0xe7, 0x02, 0x52, 0xdc, 0xa2, 0x8c, 0x0c, 0x62, 0x2e, 0xe6, 0x30,
-		0xa3, 0xcf, 0x88, 0xc4, 0x4f, 0x24, 0x7e, 0x32, 0xfb, 0x78, 0xb3, 0x22, 0x50, 0xca, 0x18, 0x7a,
-		0x2a, 0xd3, 0x7a, 0x4f, 0x9d, 0xba, 0x0c, 0xfc, 0x58, 0xe5, 0x2a, 0xd6, 0x32, 0xf5, 0x7a, 0xac,
-		0x21, 0x0e, 0x27, 0xf2, 0x55, 0x06, 0x3d, 0x31, 0x7a, 0x68, 0xe7, 0x50, 0x13, 0xe1, 0xa7, 0xf7,
-		0x24, 0x3d, 0x7c, 0x04, 0x2d, 0xb1, 0x4e, 0x4b, 0x8c, 0x76, 0x26, 0x3d, 0xdc, 0x98, 0x54, 0xdc,
-		0xdc, 0xe0, 0xa4, 0xd8, 0xab, 0xfb, 0x65, 0x36, 0x54, 0x3e, 0xfa, 0xa5, 0xb9, 0x2b, 0xf4, 0xcb,
-		0x08, 0xf4, 0x8b, 0x90, 0x70, 0x15, 0x43, 0xbf, 0x64, 0x15, 0xba, 0x65, 0xe1, 0xcb, 0xbf, 0xc8,
-		0x4b, 0x22, 0x98, 0x77, 0x89, 0xf3, 0x09, 0x22, 0x99, 0x40, 0x52, 0x0a, 0x26, 0xb9, 0x80, 0x4a,
-		0x30, 0x25, 0x24, 0x02, 0x2b, 0x4b, 0x93, 0xe4, 0x14, 0x60, 0x22, 0xde, 0x23, 0xe7, 0x7e, 0xcb,
-		0x2b, 0xd8, 0xc9, 0x40, 0x19, 0xcf, 0x22, 0xbe, 0xba, 0x79, 0x33, 0x9d, 0x51, 0x64, 0x16, 0x77,
-		0x72, 0xb1, 0xe7, 0x10, 0x7f, 0x36, 0x35, 0xc0, 0xa5, 0x0e, 0xd8, 0xd5, 0x02, 0xbb, 0x7a, 0xe0,
-		0x54, 0x13, 0x34, 0xea, 0x82, 0x48, 0x6d, 0x90, 0xab, 0x8f, 0x39, 0xbc, 0x4a, 0xbf, 0x9f, 0x66,
-		0x68, 0x96, 0x7a, 0x23, 0xe5, 0x8b, 0xa2, 0x8a, 0xa9, 0x15, 0x4e, 0xf5, 0xc2, 0xae, 0x66, 0xb8,
-		0xd5, 0x8d, 0x98, 0xda, 0x11, 0x53, 0x3f, 0x12, 0x6a, 0x88, 0x56, 0x1d, 0x11, 0xab, 0xa5, 0xfc,
-		0x8c, 0x62, 0x2a, 0x86, 0x4c, 0x8f, 0xb1, 0x88, 0xee, 0xb8, 0xfa, 0x7f, 0x5d, 0x47, 0x71, 0x6c,
-		0xfe, 0x24, 0xe6, 0xcc, 0x30, 0xf6, 0x95, 0x11, 0x04, 0xca, 0x73, 0x72, 0xc7, 0x82, 0x9f, 0x9d,
-		0xa0, 0x5a, 0xbd, 0x69, 0xe8, 0x67, 0xfd, 0x1f, 0x37, 0x4d, 0xfd, 0xac, 0x3f, 0x79, 0xd8, 0x8c,
-		0xfe, 0x4c, 0x1e, 0xb7, 0x6e, 0x1a, 0x7a, 0x7b, 0xfa, 0xf8, 0xf8, 0xa6, 0xa1, 0x1f, 0xf7, 0x6b,
-		0xbd, 0x5e, 0xbd, 0xf6, 0xfd, 0xe8, 0x29, 0xfd, 0x07, 0xab, 0x3f, 0xdd, 0xf4, 0x7a, 0xa3, 0xef,
-		0x7f, 0x3c, 0x85, 0xbf, 0x3f, 0x3e, 0xf5, 0x7f, 0xae, 0xbd, 0xe3, 0x92, 0xe3, 0x70, 0xe2, 0x5e,
-		0xaf, 0xde, 0x7f, 0x4b, 0x2f, 0x56, 0xfd, 0x52, 0x8b, 0x15, 0x51, 0x10, 0xf3, 0xd9, 0xf1, 0xc9,
-		0x83, 0x9b, 0xfc, 0xda, 0x8b, 0xf0, 0x16, 0xc7, 0xd6, 0x47, 0xb7, 0x95, 0x73, 0x1b, 0xc5, 0x15,
-		0x98, 0xdc, 0xa1, 0xc5, 0x69, 0xe0, 0x19, 0xc1, 0x33, 0x82, 0x67, 0x04, 0xcf, 0x88, 0x6c, 0xb7,
-		0x8f, 0x2d, 0x27, 0x38, 0x65, 0x74, 0x85, 0x8e, 0x19, 0x86, 0xa6, 0x39, 0xe0, 0xf0, 0xdc, 0x0f,
-		0x8f, 0x74, 0x6a, 0xd4, 0x07, 0x22, 0x9e, 0x9d, 0x84, 0xf8, 0xa0, 0xc4, 0xb3, 0xf3, 0x70, 0x25,
-		0xeb, 0x3f, 0xbf, 0x65, 0xa9, 0x93, 0xf8, 0x85, 0xa4, 0x78, 0x71, 0x0b, 0x18, 0xdf, 0xe4, 0xb6,
-		0xc0, 0x51, 0x0b, 0x7b, 0xa0, 0x14, 0x76, 0x81, 0x6f, 0x54, 0x40, 0x90, 0x6d, 0x83, 0x20, 0xa5,
-		0x62, 0x86, 0x99, 0x16, 0x88, 0x27, 0xaf, 0xd3, 0x1a, 0x3d, 0xb4, 0x0f, 0x93, 0xa4, 0x8e, 0xe9,
-		0xa3, 0x4c, 0xe9, 0x9e, 0x7c, 0xab, 0x43, 0x71, 0x5a, 0x8f, 0x90, 0x5e, 0xa7, 0xa7, 0xd5, 0x89,
-		0x41, 0x23, 0xa2, 0x73, 0x88, 0xce, 0x49, 0x83, 0xbf, 0x72, 0xe9, 0x60, 0x72, 0x90, 0x47, 0x90,
-		0x68, 0xfb, 0x9a, 0xf0, 0x37, 0x4f, 0x08, 0xc7, 0x9c, 0x4b, 0xcc, 0x8d, 0x16, 0xba, 0x1b, 0xa7,
-		0xe7, 0x4e, 0x9e, 0x50, 0x2d, 0x7c, 0x39, 0x54, 0xfb, 0xa4, 0xca, 0x3e, 0xb9, 0x76, 0x9f, 0x0c,
-		0x5b, 0xf2, 0xf4, 0x8b, 0x16, 0x14, 0x3c, 0x14, 0xfc, 0x1e, 0x2a, 0x78, 0xa4, 0x5f, 0x20, 0xc8,
-		0xc0, 0xac, 0x66, 0xb8, 0xd5, 0x8d, 0x98, 0xda, 0x11, 0x53, 0x3f, 0x12, 0x6a, 0x88, 0x87, 0xf6,
-		0x41, 0xfa, 0xc5, 0x6b, 0x8e, 0x0b, 0xd2, 0x2f, 0x90, 0x7e, 0x51, 0x24, 0xc5, 0x47, 0xe8, 0x3e,
-		0xba, 0x9e, 0x75, 0xcb, 0x10, 0x35, 0x9a, 0x19, 0xa2, 0xc9, 0xf8, 0x30, 0xf9, 0x30, 0xf9, 0x30,
-		0xf9, 0x30, 0xf9, 0x84, 0x26, 0x3f, 0x31, 0xf8, 0x2c, 0x2a, 0x66, 0xc1, 0xde, 0xb7, 0x19, 0xc6,
-		0xfe, 0xe0, 0x8c, 0xef, 0x91, 0xca, 0x46, 0xba, 0x27, 0x90, 0xca, 0x06, 0x93, 0x03, 0x93, 0x03,
-		0x93, 0xc3, 0xb4, 0xdb, 0x91, 0xca, 0xb6, 0xfc, 0x83, 0x54, 0xb6, 0xcd, 0xe6, 0x41, 0x2a, 0x5b,
-		0xa6, 0x2d, 0x80, 0x54, 0xb6, 0xed, 0xd8, 0x03, 0xfb, 0x99, 0xca, 0x86, 0x8c, 0xad, 0x54, 0xe3,
-		0x4a, 0x66, 0x6c, 0x6d, 0xd0, 0x6a, 0x5e, 0x6e, 0x71, 0x28, 0xa2, 0xfa, 0x0f, 0x9e, 0xc7, 0x90,
-		0xb2, 0x15, 0x8d, 0x8a, 0x92, 0x0a, 0xa5, 0x83, 0x41, 0x88, 0xe9, 0x17, 0x01, 0x73, 0x76, 0x3c,
-		0xa6, 0x1f, 0x0a, 0xbb, 0x7e, 0xeb, 0xb9, 0x63, 0xc6, 0xd8, 0xfe, 0xdc, 0x1c, 0x3c, 0xec, 0x4b,
-		0x13, 0xec, 0x0b, 0xd8, 0x17, 0xb0, 0x2f, 0xe5, 0x63, 0x5f, 0xa8, 0xd5, 0x55, 0x32, 0x30, 0x71,
-		0x41, 0xa9, 0x67, 0x85, 0x89, 0xb4, 0xc0, 0x94, 0x90, 0xfa, 0x62, 0x57, 0x63, 0x12, 0xea, 0x4c,
-		0x4c, 0xad, 0x49, 0xa9, 0x37, 0x71, 0x35, 0x27, 0xae, 0xee, 0x24, 0xd5, 0x1e, 0x33, 0xc9, 0xc0,
-		0x24, 0x2f, 0x5c, 0xea, 0x30, 0x99, 0xc0, 0x18, 0x0c, 0xd4, 0x28, 0xd0, 0xef, 0x5d, 0x53, 0x60,
-		0x23, 0x27, 0xc5, 0x36, 0xe7, 0x26, 0x65, 0xde, 0x59, 0x14, 0x6d, 0x29, 0x37, 0x9e, 0x2c, 0xa2,
-		0xc7, 0x2a, 0xac, 0xf3, 0xf4, 0x99, 0xef, 0x17, 0x4f, 0x74, 0x52, 0xdc, 0xd0, 0x48, 0x1a, 0x1c,
-		0x71, 0xc3, 0x23, 0x6d, 0x80, 0x0a, 0x33, 0x44, 0x85, 0x19, 0xa4, 0x22, 0x0c, 0x13, 0xaf, 0x81,
-		0x62, 0x36, 0x54, 0xc9, 0x0d, 0x63, 0x8b, 0x9e, 0x3e, 0x2b, 0x6d, 0xf9, 0xdb, 0xce, 0xa7, 0xf6,
-		0xbe, 0x9b, 0x5b, 0xbd, 0x44, 0xcc, 0x67, 0xdd, 0x57, 0xe6, 0x13, 0x3b, 0xfb, 0x2e, 0x27, 0x49,
-		0x9c, 0xd1, 0x4a, 0xc3, 0x7c, 0x50, 0x5e, 0x60, 0xf9, 0x51, 0x1f, 0xac, 0x49, 0x4c, 0xe3, 0xc1,
-		0xb0, 0x05, 0x9d, 0xb3, 0xf5, 0xf3, 0xef, 0x92, 0x9f, 0xd6, 0x6c, 0x34, 0xe0, 0xa5, 0xc1, 0x4b,
-		0x83, 0x97, 0x06, 0x2f, 0x0d, 0x5e, 0x9a, 0x8c, 0xb4, 0x8d, 0x2d, 0x27, 0x68, 0x76, 0x04, 0x9d,
-		0xb4, 0x8e, 0xc0, 0x54, 0xbc, 0x49, 0x71, 0xcb, 0x3f, 0x32, 0xea, 0x43, 0x93, 0x4a, 0x9a, 0x5b,
-		0x99, 0x74, 0x9a, 0x41, 0xd5, 0x3c, 0x90, 0x9d, 0x57, 0x3a, 0xa1, 0x6a, 0x55, 0x46, 0xa4, 0x12,
-		0xac, 0x84, 0xd5, 0xcc, 0xe2, 0x96, 0x32, 0xbe, 0x15, 0xb7, 0xa5, 0xda, 0x8d, 0xb3, 0x63, 0xec,
-		0x2a, 0xa9, 0x5d, 0xf5, 0x66, 0x37, 0x66, 0xe9, 0x03, 0xe5, 0x03, 0xe5, 0x73, 0xdd, 0xae, 0x91,
-		0xa7, 0xd4, 0xfd, 0x28, 0x90, 0x83, 0xf5, 0xd3, 0x09, 0x77, 0x09, 0xc7, 0x87, 0x10, 0x03, 0x40,
-		0x1e, 0x40, 0x1e, 0x40, 0x1e, 0x40, 0x1e, 0x40, 0x5e, 0x46, 0xda, 0x10, 0x6e, 0x81, 0x23, 0xb6,
-		0x83, 0x8e, 0x98, 0x6e, 0x2a, 0xdb, 0x78, 0x14, 0x77, 0xc7, 0xe2, 0x69, 0x77, 0xc9, 0x29, 0x43,
-		0x68, 0x05, 0x1e, 0x19, 0x3c, 0x32, 0x78, 0x64, 0xf0, 0xc8, 0x84, 0xa4, 0x0d, 0xa1, 0x95, 0xdc,
-		0x3f, 0xfb, 0x12, 0x5a, 0x69, 0x80, 0x04, 0x17, 0xfa, 0xd9, 0x9b, 0xd0, 0xca, 0x51, 0xa7, 0x81,
-		0x5d, 0x25, 0xb6, 0xab, 0x10, 0x5a, 0x01, 0xa2, 0x07, 0xa2, 0x7f, 0x0d, 0xd1, 0x5b, 0xae, 0x67,
-		0x05, 0xa2, 0x60, 0x3e, 0x9e, 0x11, 0x49, 0x92, 0x40, 0xf2, 0x40, 0xf2, 0x40, 0xf2, 0x40, 0xf2,
-		0x40, 0xf2, 0x19, 0x91, 0xfc, 0xa9, 0x20, 0x90, 0x3f, 0x06, 0x90, 0xdf, 0x52, 0x20, 0x8f, 0x1c,
-		0x49, 0x00, 0x79, 0xe2, 0x2d, 0xd5, 0x3a, 0x6e, 0x63, 0x53, 0x01, 0xc7, 0x03, 0xc7, 0x03, 0xc7,
-		0x97, 0x03, 0xc7, 0x3f, 0x58, 0x5e, 0x30, 0x36, 0xec, 0x69, 0x79, 0x7e, 0x39, 0x38, 0xbf, 0x3c,
-		0x31, 0x70, 0x2a, 0x70, 0x2a, 0x70, 0x2a, 0x70, 0x2a, 0x70, 0xea, 0x4a, 0xcf, 0x14, 0xc9, 0x34,
-		0xc0, 0x33, 0x81, 0xb9, 0xe2, 0x7b, 0xb9, 0x73, 0x60, 0x75, 0x5d, 0x83, 0xbb, 0x8a, 0x20, 0xb2,
-		0x61, 0x6c, 0x78, 0xf7, 0xbc, 0x1d, 0x62, 0x6e, 0x84, 0xf7, 0xec, 0xc4, 0x45, 0x37, 0xc8, 0x13,
-		0xfb, 0xb6, 0xfd, 0x37, 0x3b, 0x84, 0xc0, 0x8b, 0x91, 0xc6, 0x0e, 0xa4, 0x91, 0x5d, 0x1a, 0xbb,
-		0x3f, 0x42, 0x99, 0x31, 0xf4, 0xe1, 0xb9, 0xfe, 0x5b, 0xff, 0x7b, 0xe3, 0xa0, 0xfd, 0x54, 0xeb,
-		0xd6, 0xaa, 0xcb, 0xaf, 0x75, 0x6b, 0xdf, 0x1b, 0x07, 0xc7, 0x4f, 0xd5, 0xea, 0x9a, 0xff, 0xbc,
-		0x5b, 0x37, 0x46, 0xed, 0x47, 0xb5, 0x5a, 0x8d, 0xe5, 0x70, 0x41, 0x36, 0x6f, 0x1a, 0xcd, 0xfe,
-		0xbb, 0xe8, 0xe1, 0xe4, 0x77, 0x22, 0xdd, 0x1b, 0xbd, 0xb9, 0x26, 0xd8, 0xf4, 0xf2, 0x25, 0x15,
-		0xf6, 0xef, 0x6e, 0xff, 0xe7, 0x6e, 0xed, 0x7b, 0xe7, 0x69, 0xfa, 0x38, 0xfa, 0x5d, 0xfb, 0x51,
-		0xad, 0xbf, 0xed, 0xf5, 0xea, 0xf5, 0xb7, 0xb5, 0xc9, 0x0d, 0x88, 0xdf, 0xf7, 0x76, 0xf2, 0xdf,
-		0x77, 0xdd, 0xee, 0xca, 0x4b, 0xb5, 0xea, 0x4f, 0xf5, 0x5d, 0x54, 0x4b, 0x60, 0x57, 0x5e, 0xdc,
-		0x42, 0x1f, 0x2d, 0x3f, 0x38, 0x0f, 0x02, 0x4f, 0xc6, 0x35, 0xbd, 0xb4, 0x9c, 0x0f, 0x76, 0x54,
-		0xb2, 0x48, 0x88, 0x48, 0xac, 0x5c, 0x1a, 0xdf, 0xe6, 0x66, 0x6c, 0x9e, 0xb6, 0xdb, 0x9d, 0x93,
-		0x76, 0xbb, 0x71, 0x72, 0x74, 0xd2, 0x38, 0x3b, 0x3e, 0x6e, 0x76, 0x9a, 0x12, 0xd1, 0x95, 0x3f,
-		0x3d, 0x53, 0x79, 0xca, 0xfc, 0xe5, 0xb1, 0xd2, 0xd5, 0x9c, 0xb1, 0x6d, 0x83, 0x8f, 0x03, 0x1f,
-		0xc7, 0xcd, 0xc7, 0x79, 0xee, 0x38, 0x50, 0x9e, 0x6e, 0x99, 0xf2, 0x8c, 0xdc, 0x6c, 0x6a, 0x70,
-		0x72, 0xe0, 0xe4, 0xc0, 0xc9, 0x81, 0x93, 0x03, 0x27, 0x87, 0xdc, 0x91, 0xed, 0x23, 0x00, 0x90,
-		0x3b, 0x22, 0x79, 0x01, 0xc8, 0x1d, 0xe1, 0xde, 0x52, 0xad, 0x63, 0x94, 0xd7, 0x12, 0xdb, 0x54,
-		0x60, 0x37, 0x80, 0x55, 0x77, 0x02, 0xab, 0x6e, 0x55, 0x27, 0x16, 0xa1, 0x05, 0x17, 0x6d, 0x6f,
-		0xfa, 0xe0, 0x79, 0xa3, 0xc3, 0x59, 0xaf, 0xbd, 0xc3, 0xb8, 0x77, 0xd5, 0x96, 0xb4, 0xe1, 0x65,
-		0x58, 0xe9, 0x4a, 0x72, 0xcb, 0xf4, 0xc0, 0x33, 0x06, 0x7f, 0x5b, 0x8e, 0x40, 0xc7, 0xb0, 0x35,
-		0x73, 0xa2, 0x7b, 0x58, 0x51, 0x2c, 0x06, 0xba, 0x87, 0x6d, 0x1d, 0x4b, 0x81, 0xee, 0x61, 0xcf,
-		0xdd, 0x18, 0xf6, 0xee, 0x61, 0xcc, 0x4d, 0x15, 0x57, 0x84, 0x92, 0xb5, 0xb9, 0xa2, 0x90, 0x9a,
-		0x14, 0x53, 0x97, 0x92, 0x6a, 0x53, 0x5c, 0x7d, 0x4a, 0xab, 0xd1, 0xc2, 0xd4, 0x69, 0x61, 0x6a,
-		0xb5, 0x08, 0xf5, 0x2a, 0x83, 0x3e, 0xb9, 0xb1, 0x21, 0xb7, 0xda, 0x4d, 0x26, 0x9a, 0x9e, 0x3b,
-		0xd7, 0x4d, 0x35, 0xf0, 0x54, 0xbc, 0x46, 0x42, 0x72, 0xb0, 0x7c, 0xf6, 0x7d, 0xee, 0x1a, 0x84,
-		0xf6, 0xa5, 0xe4, 0x69, 0xf8, 0x64, 0xd2, 0x86, 0x4c, 0x1a, 0x4b, 0x5f, 0xe8, 0x1e, 0xca, 0xc4,
-		0x39, 0xc5, 0x4d, 0x5e, 0x11, 0xa6, 0xaf, 0x30, 0x13, 0x58, 0x94, 0x29, 0x2c, 0xdc, 0x24, 0x16,
-		0x6e, 0x1a, 0x8b, 0x34, 0x91, 0x32, 0xa6, 0x52, 0xc8, 0x64, 0x26, 0x37, 0x52, 0x2c, 0x6e, 0xba,
-		0x22, 0xad, 0x52, 0xf1, 0xd3, 0x65, 0xd5, 0x2b, 0x18, 0x38, 0x11, 0x8e, 0xa7, 0x4e, 0x7f, 0x64,
-		0xb5, 0x91, 0x56, 0x54, 0x7c, 0x35, 0x99, 0xbc, 0xa0, 0x62, 0x7b, 0xc9, 0xfc, 0x45, 0x87, 0xc6,
-		0x66, 0xa2, 0x55, 0x54, 0x88, 0x4c, 0x58, 0x6b, 0x2d, 0x6e, 0xbd, 0x02, 0xe2, 0xb0, 0x2b, 0x5b,
-		0x4f, 0xfc, 0x2c, 0x3f, 0x36, 0x5f, 0x41, 0x86, 0x59, 0x7e, 0xb6, 0x9d, 0x3a, 0xf4, 0x23, 0x1d,
-		0xc7, 0x4d, 0xe6, 0x2d, 0x2c, 0x9e, 0x2b, 0xb7, 0x61, 0x04, 0x96, 0xb0, 0x12, 0x45, 0xcc, 0x66,
-		0x91, 0x51, 0x79, 0xfa, 0x63, 0xf9, 0x02, 0x80, 0xdb, 0x81, 0xdb, 0x81, 0xdb, 0x81, 0xdb, 0x81,
-		0xdb, 0x85, 0xa4, 0x35, 0xb4, 0x9a, 0x9e, 0x1a, 0x16, 0x71, 0x7c, 0xf6, 0x44, 0xf6, 0xf8, 0x6c,
-		0x9c, 0x10, 0x34, 0xd0, 0xad, 0x61, 0x77, 0x2e, 0xd1, 0x67, 0xe9, 0x85, 0xf8, 0xb9, 0x13, 0xde,
-		0x1e, 0x78, 0x6a, 0xf0, 0xd4, 0x8a, 0x9f, 0x61, 0xd7, 0x52, 0x31, 0x8b, 0xcc, 0xd0, 0x5b, 0xcd,
-		0x15, 0x63, 0x4d, 0xda, 0xe3, 0xdf, 0x20, 0x9c, 0x07, 0x16, 0xfd, 0xc0, 0x08, 0x94, 0x5c, 0x72,
-		0xca, 0x64, 0xba, 0x1d, 0xcb, 0x4d, 0x69, 0x21, 0x37, 0x65, 0x6b, 0x1c, 0x7b, 0xe4, 0xa6, 0x20,
-		0x37, 0xe5, 0xb5, 0x1b, 0x86, 0xdc, 0x14, 0x91, 0x2b, 0x40, 0x6e, 0x0a, 0x38, 0x2e, 0x70, 0x5c,
-		0xe0, 0xb8, 0xc0, 0x71, 0x81, 0xe3, 0xca, 0x2f, 0xad, 0xc8, 0x4d, 0xe1, 0xfa, 0x41, 0x6e, 0x8a,
-		0xec, 0xfc, 0x48, 0x0f, 0x10, 0xd6, 0x5a, 0x8b, 0x5b, 0x0f, 0xb9, 0x29, 0xd8, 0x7c, 0x1a, 0x72,
-		0x53, 0x4a, 0xff, 0x7d, 0x90, 0x3a, 0x01, 0x58, 0x09, 0x58, 0x09, 0x58, 0x09, 0x58, 0x09, 0x58,
-		0xc9, 0x27, 0xad, 0x48, 0x9d, 0x28, 0x3a, 0x75, 0x02, 0xc1, 0x8a, 0x97, 0x75, 0xcc, 0x7e, 0x47,
-		0xf6, 0x27, 0x01, 0x65, 0x54, 0x77, 0xe2, 0xdf, 0x71, 0x7b, 0x51, 0xdd, 0x49, 0xac, 0xce, 0xd0,
-		0xe4, 0x9b, 0x06, 0xde, 0x78, 0x10, 0x38, 0xb1, 0xa9, 0xb9, 0x98, 0xce, 0xfd, 0xe5, 0xd3, 0xdc,
-		0x95, 0x7f, 0xb9, 0x18, 0x3d, 0xb4, 0xbf, 0x9c, 0x4f, 0xae, 0xf7, 0xcb, 0x67, 0xcf, 0x1b, 0xfd,
-		0x1e, 0x5e, 0xe9, 0x97, 0xe4, 0xdd, 0x7f, 0x4d, 0x2f, 0x74, 0x8f, 0x4b, 0x52, 0xf1, 0x66, 0xb1,
-		0x88, 0x64, 0xaf, 0x88, 0x15, 0x9e, 0x6a, 0xa1, 0xf0, 0x54, 0x69, 0x30, 0x13, 0x0a, 0x4f, 0xed,
-		0xaf, 0x39, 0x65, 0x2f, 0x3c, 0x65, 0x0c, 0x06, 0x6a, 0x14, 0xe8, 0xf7, 0xae, 0x29, 0x98, 0xe0,
-		0x37, 0x3f, 0x29, 0x77, 0x9a, 0x8e, 0x60, 0xfe, 0x48, 0x25, 0xe2, 0xa0, 0x79, 0xfd, 0xcc, 0x3e,
-		0xfa, 0x35, 0x94, 0x95, 0xc4, 0x43, 0x5a, 0xe4, 0xce, 0x91, 0x74, 0x48, 0x8b, 0x2c, 0x2d, 0x09,
-		0x97, 0x48, 0xdb, 0x57, 0xd7, 0xb5, 0x95, 0xe1, 0x48, 0x36, 0x50, 0x6d, 0xe2, 0x8c, 0xc0, 0xaa,
-		0x23, 0x61, 0x3e, 0x28, 0x2f, 0xb0, 0xfc, 0x28, 0x3b, 0x74, 0x02, 0xc2, 0x1f, 0x0c, 0x5b, 0xd0,
-		0xa7, 0x58, 0x3f, 0xff, 0x2e, 0xb9, 0x17, 0xcd, 0x46, 0x03, 0xce, 0x05, 0x9c, 0x0b, 0x38, 0x17,
-		0x70, 0x2e, 0xe0, 0x5c, 0xc8, 0x48, 0xdb, 0xd8, 0x72, 0x82, 0x66, 0x47, 0xd0, 0xb7, 0xe8, 0xa0,
-		0x1b, 0x54, 0xf6, 0x2f, 0x86, 0x6e, 0x50, 0x92, 0x17, 0x80, 0x6e, 0x50, 0xdc, 0x5b, 0xaa, 0xdd,
-		0x38, 0x43, 0x3b, 0x28, 0xb1, 0x5d, 0x85, 0x76, 0x50, 0x3b, 0x0b, 0x4e, 0x07, 0x63, 0xcf, 0x0b,
-		0x61, 0xe1, 0xf4, 0x1c, 0xa3, 0x60, 0xa3, 0x85, 0xe5, 0x99, 0x01, 0xb1, 0x00, 0xb1, 0x00, 0xb1,
-		0x00, 0xb1, 0x00, 0xb1, 0xd0, 0x6f, 0x17, 0x08, 0x2b, 0x95, 0x3b, 0xdc, 0x80, 0x2f, 0x0c, 0x84,
-		0x45, 0xbb, 0xa5, 0xd0, 0x6f, 0x17, 0x00, 0x0b, 0x00, 0x2b, 0xff, 0xa6, 0x1a, 0x79, 0x4a, 0xdd,
-		0x8f, 0x02, 0x39, 0x5c, 0x35, 0x9d, 0x70, 0x97, 0xe2, 0x7b, 0xa1, 0x5f, 0x8c, 0x00, 0x1f, 0xd0,
-		0x27, 0xd0, 0x27, 0xd0, 0x27, 0xd0, 0xa7, 0x8c, 0xb4, 0x21, 0x7b, 0xa8, 0x4c, 0xfe, 0x83, 0x6e,
-		0x2a, 0xdb, 0x78, 0x14, 0xf7, 0x22, 0xe2, 0x69, 0x77, 0xc9, 0x97, 0x40, 0xa6, 0x10, 0x1c, 0x09,
-		0x38, 0x12, 0x70, 0x24, 0xe0, 0x48, 0x08, 0x49, 0x1b, 0x32, 0x85, 0x72, 0xff, 0x80, 0xc7, 0xe6,
-		0x99, 0x17, 0x3c, 0xb6, 0xc8, 0x96, 0x2a, 0x92, 0xc7, 0x3e, 0xea, 0x34, 0xb0, 0xab, 0xc4, 0x76,
-		0x15, 0x88, 0xec, 0x1d, 0x06, 0xa2, 0xd2, 0x19, 0x42, 0x52, 0x99, 0x41, 0x38, 0xaa, 0x02, 0x00,
-		0x0a, 0x00, 0x0a, 0x00, 0x0a, 0x00, 0xaa, 0x21, 0x8f, 0x8a, 0x40, 0x35, 0x22, 0x8f, 0x6a, 0x5b,
-		0xf1, 0x27, 0x4e, 0xaa, 0x00, 0x7f, 0x12, 0x6f, 0x29, 0xf1, 0x5a, 0xe4, 0x80, 0x9f, 0x80, 0x9f,
-		0x3b, 0x08, 0x3f, 0x1f, 0x2c, 0x2f, 0x18, 0x1b, 0xb6, 0x1e, 0x97, 0x1e, 0x94, 0x43, 0xa1, 0xcb,
-		0x13, 0x03, 0x5e, 0x01, 0x5e, 0x01, 0x5e, 0x01, 0x5e, 0x01, 0x5e, 0xc5, 0xd2, 0x66, 0x8d, 0x84,
-		0x74, 0xe3, 0xbc, 0x7e, 0x6c, 0x9e, 0x09, 0xcc, 0x15, 0xdf, 0xcb, 0x9d, 0xc3, 0x58, 0xb3, 0x95,
-		0x7b, 0x68, 0x0b, 0xae, 0xdd, 0xca, 0x1a, 0x9e, 0xca, 0x96, 0x68, 0x0f, 0x94, 0xe7, 0x88, 0xb7,
-		0xff, 0xaa, 0x54, 0xab, 0x37, 0x0d, 0xfd, 0xac, 0xff, 0xe3, 0xa6, 0xa9, 0x9f, 0xf5, 0x27, 0x0f,
-		0x9b, 0xd1, 0x9f, 0xc9, 0xe3, 0xd6, 0x4d, 0x43, 0x6f, 0x4f, 0x1f, 0x1f, 0xdf, 0x34, 0xf4, 0xe3,
-		0x7e, 0xad, 0xd7, 0xab, 0xd7, 0xbe, 0x1f, 0x3d, 0xa5, 0xff, 0x60, 0xf5, 0xa7, 0x9b, 0x5e, 0x6f,
-		0xf4, 0xfd, 0x8f, 0xa7, 0xf0, 0xf7, 0xc7, 0xa7, 0xfe, 0xcf, 0xb5, 0x77, 0x95, 0x5d, 0xeb, 0x8c,
-		0x73, 0xb0, 0xc3, 0xd2, 0xd8, 0x81, 0x34, 0xb2, 0x4b, 0x63, 0xf7, 0x47, 0x28, 0x33, 0x86, 0x3e,
-		0x3c, 0xd7, 0x7f, 0xeb, 0x7f, 0x6f, 0x1c, 0xb4, 0x9f, 0x6a, 0xdd, 0x5a, 0x75, 0xf9, 0xb5, 0x6e,
-		0xed, 0x7b, 0xe3, 0xe0, 0xf8, 0xa9, 0x5a, 0x5d, 0xf3, 0x9f, 0x77, 0xeb, 0xc6, 0xa8, 0xfd, 0xa8,
-		0x56, 0xab, 0xb1, 0x1c, 0x2e, 0xc8, 0xe6, 0x4d, 0xa3, 0xd9, 0x7f, 0x17, 0x3d, 0x9c, 0xfc, 0x4e,
-		0xa4, 0x7b, 0xa3, 0x37, 0xd7, 0xd6, 0xca, 0xf4, 0x81, 0xb8, 0x0a, 0xfb, 0x77, 0xb7, 0xff, 0x73,
-		0xb7, 0xf6, 0xbd, 0xf3, 0x34, 0x7d, 0x1c, 0xfd, 0xae, 0xfd, 0xa8, 0xd6, 0xdf, 0xf6, 0x7a, 0xf5,
-		0xfa, 0xdb, 0xda, 0xe4, 0x06, 0xc4, 0xef, 0x7b, 0x3b, 0xf9, 0xef, 0xbb, 0x6e, 0x77, 0xe5, 0xa5,
-		0x5a, 0xf5, 0xa7, 0xfa, 0x2e, 0xaa, 0xa5, 0x6d, 0x27, 0x05, 0x98, 0xdd, 0xd2, 0x8f, 0x96, 0x1f,
-		0x9c, 0x07, 0x81, 0x50, 0x67, 0xf0, 0x4b, 0xcb, 0xf9, 0x60, 0x47, 0xf5, 0x0e, 0x85, 0xf8, 0xaf,
-		0xca, 0xa5, 0xf1, 0x6d, 0x6e, 0xc6, 0xe6, 0x69, 0xbb, 0xdd, 0x39, 0x69, 0xb7, 0x1b, 0x27, 0x47,
-		0x27, 0x8d, 0xb3, 0xe3, 0xe3, 0x66, 0xa7, 0x29, 0x11, 0x14, 0xf8, 0xd3, 0x33, 0x95, 0xa7, 0xcc,
-		0x5f, 0x1e, 0x2b, 0x5d, 0xcd, 0x19, 0xdb, 0x36, 0x68, 0xa4, 0x67, 0x69, 0x24, 0xcf, 0x1d, 0x07,
-		0xca, 0xd3, 0x2d, 0x53, 0x9e, 0x48, 0x9a, 0x4d, 0x0d, 0x2a, 0x09, 0x54, 0x12, 0xa8, 0x24, 0x50,
-		0x49, 0xa0, 0x92, 0x10, 0xa9, 0xdf, 0x3e, 0xdc, 0x8a, 0x48, 0xbd, 0xe4, 0x05, 0x20, 0x52, 0xcf,
-		0xbd, 0xa5, 0x50, 0xf1, 0x04, 0x91, 0xfa, 0x92, 0x81, 0x72, 0xf4, 0x7a, 0xd2, 0xf6, 0xa5, 0x75,
-		0x22, 0x63, 0x27, 0xce, 0xed, 0x68, 0x42, 0x28, 0x87, 0xcc, 0xc5, 0x11, 0x39, 0x33, 0x12, 0x67,
-		0x47, 0xe0, 0x68, 0x4e, 0xb8, 0x0d, 0x08, 0x1b, 0xcd, 0x09, 0x4b, 0x63, 0xb0, 0xd8, 0x91, 0xb3,
-		0x60, 0x83, 0x75, 0x89, 0x86, 0xea, 0x49, 0x03, 0xf5, 0x7a, 0xfd, 0x70, 0x62, 0x71, 0x0f, 0x57,
-		0x75, 0xf3, 0xb6, 0xd8, 0xc6, 0x37, 0x25, 0xde, 0xa1, 0xa1, 0x52, 0x92, 0xb0, 0x7c, 0xbc, 0xf1,
-		0x1e, 0xfe, 0xf8, 0x4e, 0x21, 0xf1, 0x1c, 0xde, 0xf8, 0x0d, 0xf5, 0x4e, 0x62, 0x76, 0xca, 0x8b,
-		0x74, 0xc6, 0x2b, 0x2c, 0x3d, 0xb6, 0x33, 0x37, 0x2b, 0xa7, 0xd5, 0x7c, 0x74, 0xfa, 0x89, 0x66,
-		0x24, 0xa2, 0x7d, 0x59, 0x51, 0xdf, 0x02, 0xcf, 0xd0, 0xc7, 0x8e, 0x1f, 0x18, 0x5f, 0x6d, 0x5a,
-		0xeb, 0x5b, 0xf1, 0xd4, 0x50, 0x79, 0xca, 0x19, 0xd0, 0xf3, 0xb8, 0x0c, 0x82, 0x33, 0x75, 0x0d,
-		0xae, 0x7f, 0xfb, 0x55, 0x3b, 0x3e, 0x39, 0x3b, 0xd5, 0x74, 0xed, 0xf3, 0x44, 0xdd, 0x6b, 0xd7,
-		0x91, 0xba, 0xd7, 0xae, 0x95, 0x39, 0x76, 0x4c, 0xc3, 0x19, 0x3c, 0x6a, 0x57, 0x9e, 0x1b, 0xb8,
-		0x03, 0xd7, 0xee, 0x39, 0xd5, 0xcf, 0xd7, 0xd7, 0x57, 0x35, 0xed, 0xb3, 0xf2, 0x7c, 0xcb, 0x75,
-		0xb4, 0x23, 0x6d, 0xe8, 0x7a, 0xda, 0xc5, 0xd5, 0x43, 0x5b, 0x33, 0x1c, 0x33, 0x7c, 0xc0, 0x51,
-		0xc2, 0x85, 0xdb, 0x19, 0x9f, 0x77, 0xc2, 0x67, 0x8b, 0xc8, 0xe4, 0xf5, 0x49, 0xf9, 0xdf, 0x0b,
-		0x7e, 0x37, 0xfd, 0x2a, 0x97, 0xdd, 0x23, 0x7a, 0x53, 0x2e, 0xf2, 0x8e, 0x4a, 0x7f, 0x31, 0xd9,
-		0x53, 0x71, 0x3b, 0x4a, 0xb3, 0x7f, 0xf2, 0xaf, 0x72, 0xbe, 0x11, 0x72, 0xae, 0xea, 0xd4, 0xcf,
-		0xce, 0xcd, 0x64, 0xd0, 0x3a, 0xd2, 0xf4, 0x8e, 0xb3, 0x88, 0xa3, 0x4c, 0xeb, 0x18, 0xe7, 0x5d,
-		0xda, 0xf3, 0xf1, 0x6d, 0xf8, 0x75, 0x95, 0x49, 0xe2, 0x0c, 0xd0, 0x48, 0x7a, 0x62, 0xf4, 0x0f,
-		0xdd, 0x81, 0x6e, 0x0d, 0xbb, 0x73, 0x72, 0xbb, 0xf4, 0x42, 0xfc, 0x7c, 0x51, 0xb6, 0x57, 0x5f,
-		0x8b, 0x5e, 0x1a, 0x75, 0x23, 0x39, 0x9f, 0x3c, 0x9c, 0x49, 0xfb, 0xc2, 0x73, 0x22, 0x63, 0x5a,
-		0x79, 0xaf, 0xfc, 0x81, 0x67, 0x8d, 0x62, 0xfd, 0x57, 0x39, 0x37, 0x4d, 0x2b, 0x7c, 0x6c, 0xd8,
-		0xda, 0xc5, 0x95, 0x16, 0xce, 0xa5, 0x0d, 0x8d, 0x7b, 0xcb, 0x7e, 0xd4, 0x26, 0xca, 0x6b, 0xec,
-		0x45, 0xaa, 0x32, 0x34, 0x5f, 0x3d, 0x67, 0xf6, 0x4d, 0xa8, 0xae, 0x66, 0xca, 0x79, 0x10, 0x0d,
-		0x47, 0x4d, 0xd0, 0x72, 0x10, 0xb2, 0x6c, 0x04, 0x2c, 0x97, 0x8f, 0xc7, 0x4e, 0xb0, 0xb2, 0x3b,
-		0x74, 0x9c, 0x04, 0x6a, 0xb9, 0xc0, 0xd9, 0x7b, 0x8b, 0x96, 0x09, 0xaa, 0x44, 0x9e, 0x06, 0xf9,
-		0x8e, 0x4a, 0x02, 0x43, 0xe1, 0xe8, 0xc4, 0x6b, 0xbd, 0xa4, 0xe0, 0x3e, 0x38, 0x03, 0xdb, 0xf5,
-		0x2d, 0xe7, 0x36, 0x54, 0x68, 0x81, 0x61, 0x39, 0xca, 0x8b, 0x7c, 0xf1, 0xd0, 0x3f, 0xd7, 0x22,
-		0x16, 0xc2, 0xd7, 0xee, 0x0c, 0xc7, 0xb4, 0x95, 0xa9, 0x7d, 0x7d, 0xd4, 0x82, 0x3b, 0xcb, 0xef,
-		0x39, 0x17, 0x57, 0x5a, 0xa2, 0xeb, 0xa8, 0xaf, 0x8f, 0x56, 0xe5, 0xb1, 0xa9, 0x3e, 0x4e, 0x15,
-		0xc8, 0xae, 0x0a, 0x8b, 0x80, 0xbd, 0xac, 0xb1, 0xa7, 0x62, 0x30, 0x2f, 0x53, 0xac, 0xa9, 0xdc,
-		0xcc, 0x3d, 0x23, 0xbf, 0x25, 0xc0, 0x73, 0xf1, 0xf1, 0x5d, 0x5b, 0xc9, 0x7b, 0x49, 0x29, 0x82,
-		0x22, 0x78, 0x30, 0x71, 0xdd, 0xb0, 0xad, 0xbc, 0x18, 0x8f, 0xde, 0xe1, 0x1b, 0xb5, 0xbf, 0xdb,
-		0x71, 0x88, 0x82, 0x19, 0xa7, 0x7e, 0x5e, 0x5a, 0x82, 0x96, 0x3f, 0x94, 0xe4, 0x0d, 0x09, 0x14,
-		0x51, 0xfa, 0xf8, 0x5a, 0x3e, 0x79, 0xce, 0xbe, 0xd6, 0xd9, 0x3e, 0x99, 0xd1, 0x9b, 0xa0, 0xda,
-		0x15, 0x12, 0xbb, 0x21, 0xdb, 0x82, 0xa4, 0xbf, 0x9d, 0x19, 0x6e, 0x65, 0x65, 0x30, 0x45, 0x31,
-		0xd9, 0x6e, 0xe1, 0xac, 0xe9, 0xf9, 0x64, 0x9c, 0x8c, 0x8b, 0x99, 0x0f, 0xa2, 0xe5, 0x86, 0x62,
-		0x14, 0x90, 0x8b, 0x0c, 0x5a, 0x51, 0x79, 0x4e, 0xe4, 0x50, 0x89, 0xdc, 0xed, 0xa1, 0x84, 0x3e,
-		0xb2, 0xca, 0x27, 0x2f, 0xeb, 0x53, 0x51, 0x4e, 0x88, 0x75, 0xf2, 0x67, 0x02, 0x27, 0xbb, 0x6e,
-		0x3a, 0x60, 0xce, 0x15, 0xa1, 0xac, 0x18, 0x4e, 0xd0, 0xdc, 0x32, 0xaf, 0xeb, 0x40, 0x93, 0x9e,
-		0x4c, 0x46, 0xf5, 0x50, 0x52, 0x3b, 0xe4, 0x54, 0x0e, 0x35, 0x62, 0x63, 0xa3, 0x6a, 0xd8, 0xe0,
-		0x17, 0x07, 0x15, 0x53, 0x6c, 0xb0, 0x96, 0x2c, 0x5d, 0x97, 0xa1, 0x79, 0x22, 0x51, 0x73, 0xc4,
-		0xa7, 0x92, 0xa1, 0x8b, 0xc7, 0x5b, 0x37, 0xd0, 0xdd, 0x81, 0x3e, 0x70, 0xef, 0x47, 0x91, 0xfb,
-		0x67, 0xea, 0xb6, 0x32, 0x86, 0xe1, 0xe0, 0x4f, 0x45, 0x39, 0xe6, 0x39, 0x9c, 0x91, 0xfb, 0x60,
-		0x4c, 0x67, 0xa4, 0xc2, 0xc1, 0xa0, 0xd1, 0xa1, 0xd1, 0xa1, 0xd1, 0x4b, 0xa4, 0xd1, 0xc9, 0xba,
-		0xd8, 0x11, 0x76, 0xa9, 0x23, 0xae, 0x2d, 0x40, 0x18, 0x9e, 0xe5, 0xa8, 0x0d, 0x90, 0x1c, 0xd4,
-		0xee, 0x10, 0x57, 0xaa, 0x63, 0x3f, 0x87, 0xcd, 0x77, 0xce, 0x9a, 0x30, 0xc8, 0xc4, 0x72, 0xf8,
-		0x7e, 0xb6, 0x66, 0xc7, 0xc7, 0x47, 0xc7, 0x58, 0x36, 0x12, 0xe5, 0x48, 0x37, 0x4a, 0xbf, 0x50,
-		0x25, 0xcd, 0x10, 0xd1, 0x64, 0x88, 0x60, 0x32, 0x64, 0x58, 0x5d, 0xff, 0xf6, 0xab, 0x76, 0x72,
-		0xd6, 0xec, 0x6a, 0x11, 0x4d, 0xed, 0xa8, 0x20, 0x09, 0x44, 0x6d, 0x59, 0xda, 0x15, 0x57, 0x28,
-		0x51, 0x36, 0xf3, 0xea, 0x85, 0xe5, 0x80, 0xc4, 0x03, 0x45, 0x4a, 0x7c, 0x72, 0x77, 0xc3, 0x3b,
-		0x71, 0xc4, 0xa3, 0xc4, 0xb1, 0x1d, 0x47, 0x59, 0xb7, 0x77, 0x5f, 0x5d, 0xcf, 0xcf, 0x1f, 0xde,
-		0x99, 0x0d, 0x85, 0x08, 0x0f, 0x22, 0x3c, 0x85, 0xe0, 0xef, 0x2d, 0x8b, 0xf0, 0x4c, 0x25, 0x86,
-		0x8e, 0x3d, 0x4b, 0x46, 0xa4, 0xa1, 0xd0, 0x9a, 0xa0, 0xd0, 0x40, 0xa1, 0xed, 0x23, 0x85, 0x46,
-		0x95, 0xb2, 0x9f, 0x37, 0x77, 0xe2, 0xd9, 0xcd, 0x9b, 0x2b, 0x97, 0x82, 0x49, 0xdc, 0xc9, 0xc5,
-		0x9e, 0x43, 0xfc, 0xd9, 0xd4, 0x80, 0x24, 0xf4, 0xc4, 0x89, 0x1f, 0x26, 0x88, 0x59, 0xd6, 0x13,
-		0x3f, 0x16, 0xe3, 0x79, 0x1f, 0xf2, 0x43, 0x1e, 0x4c, 0x15, 0xdf, 0x70, 0x9a, 0x46, 0x52, 0xdd,
-		0x88, 0xa9, 0x1d, 0x31, 0xf5, 0x23, 0xa1, 0x86, 0x68, 0xd5, 0x11, 0xb1, 0x5a, 0x4a, 0x6e, 0x00,
-		0x5b, 0x85, 0xb6, 0xb5, 0xcd, 0xd5, 0x74, 0xc7, 0xd5, 0xff, 0xeb, 0x3a, 0x1c, 0x27, 0x2e, 0x38,
-		0xdb, 0x37, 0xb1, 0xb7, 0x69, 0x2a, 0xbc, 0x39, 0x1a, 0x93, 0x78, 0x85, 0x13, 0xf7, 0x7a, 0xf5,
-		0xfe, 0xdb, 0x4a, 0xd9, 0x0f, 0x61, 0x6c, 0x59, 0x51, 0x30, 0x36, 0xc2, 0x97, 0x4f, 0x7b, 0x51,
-		0x86, 0x68, 0x6d, 0xcb, 0xf9, 0x5b, 0xb7, 0x8d, 0x47, 0xe5, 0xb1, 0xf5, 0x41, 0x9e, 0x55, 0x96,
-		0x5c, 0x9d, 0x0b, 0x3e, 0x12, 0x7c, 0x24, 0xf8, 0x48, 0x7b, 0xe3, 0x23, 0x5d, 0x1a, 0x8e, 0x69,
-		0x04, 0xae, 0xf7, 0xc8, 0x50, 0x16, 0x80, 0xdf, 0xff, 0x1a, 0xdd, 0x3d, 0xfa, 0x8c, 0xed, 0x34,
-		0xb7, 0xdc, 0xef, 0x9a, 0xef, 0x5f, 0xd9, 0x7a, 0xaa, 0x76, 0x17, 0x9f, 0xd7, 0xde, 0x72, 0x74,
-		0x68, 0x84, 0xeb, 0xb2, 0x6d, 0xae, 0x0b, 0x0a, 0xe4, 0xe5, 0x8a, 0x7d, 0x27, 0xe1, 0xe0, 0xe4,
-		0x51, 0xae, 0x70, 0x38, 0xfd, 0xf2, 0x10, 0x2c, 0x0d, 0x25, 0x2f, 0x47, 0xcf, 0xc7, 0x11, 0xfb,
-		0x98, 0xa0, 0xf5, 0x41, 0xeb, 0x4b, 0xfb, 0x8a, 0xe5, 0x52, 0xc2, 0xe4, 0x7e, 0x1b, 0x63, 0x27,
-		0x03, 0x8e, 0xce, 0x05, 0xf3, 0x9d, 0x0a, 0x26, 0x75, 0x10, 0xe3, 0x7e, 0x05, 0xd3, 0x7a, 0x89,
-		0xbb, 0xa4, 0xda, 0x27, 0x3d, 0x89, 0xc8, 0xb5, 0xfb, 0x64, 0xd8, 0x92, 0xc7, 0x6d, 0x5b, 0x50,
-		0xf0, 0x50, 0xf0, 0x7b, 0xa8, 0xe0, 0x11, 0xb7, 0x05, 0x27, 0xc9, 0xac, 0x66, 0xb8, 0xd5, 0x8d,
-		0x98, 0xda, 0x11, 0x53, 0x3f, 0x12, 0x6a, 0x88, 0x9e, 0x3e, 0xd0, 0x10, 0xb7, 0xdd, 0xc0, 0x71,
-		0x41, 0xdc, 0x16, 0x71, 0xdb, 0x22, 0x39, 0x3e, 0x84, 0x27, 0xe1, 0x0a, 0xc0, 0x15, 0x80, 0x2b,
-		0xb0, 0x75, 0xae, 0x00, 0xc2, 0x93, 0xbb, 0xeb, 0x5e, 0xec, 0x40, 0x78, 0xb2, 0x94, 0x16, 0xda,
-		0xf5, 0xac, 0x5b, 0x8e, 0xd2, 0x1c, 0x89, 0x7d, 0x98, 0x8c, 0x0f, 0x4b, 0x0c, 0x4b, 0x0c, 0x4b,
-		0x0c, 0x50, 0x4e, 0xb6, 0xdb, 0xa7, 0xf1, 0x73, 0x9d, 0x45, 0xc1, 0x2c, 0x18, 0xcc, 0x36, 0xc3,
-		0xd8, 0x1f, 0x9c, 0xf1, 0x3d, 0x12, 0x3d, 0x36, 0x96, 0xa7, 0x5d, 0x48, 0xf4, 0x98, 0x04, 0xb9,
-		0xd0, 0x0b, 0x11, 0xbd, 0x10, 0x77, 0xb9, 0x17, 0xe2, 0x5e, 0x97, 0x70, 0x3a, 0x6d, 0x75, 0xba,
-		0xda, 0xb9, 0xa3, 0x7d, 0x08, 0xee, 0x26, 0x65, 0x83, 0xe2, 0xce, 0x02, 0xda, 0xb5, 0xf2, 0x5d,
-		0x7b, 0x1c, 0xf5, 0x27, 0x44, 0x61, 0x27, 0x59, 0xa7, 0x6c, 0xa5, 0xb0, 0x53, 0xaa, 0x45, 0x42,
-		0xb9, 0x27, 0x52, 0xed, 0xb0, 0x3d, 0x2d, 0x49, 0x56, 0x0d, 0xb8, 0x6c, 0x4f, 0x92, 0x3f, 0xa6,
-		0xb3, 0xa2, 0x6a, 0x55, 0xb9, 0xf6, 0x43, 0x99, 0x0b, 0x57, 0xe5, 0x4b, 0xcf, 0x22, 0x49, 0xc7,
-		0x22, 0x2b, 0x58, 0xd5, 0x42, 0xc1, 0x2a, 0x3e, 0x8a, 0x04, 0x05, 0xab, 0x92, 0x0b, 0x47, 0x4b,
-		0x92, 0x4d, 0x06, 0x43, 0x4b, 0x12, 0x06, 0x3d, 0x43, 0xae, 0x6f, 0xb8, 0x00, 0x01, 0xaa, 0x6f,
-		0x69, 0x68, 0x49, 0xb2, 0x89, 0xd1, 0x6f, 0xa2, 0x53, 0x07, 0x3a, 0x75, 0x40, 0xd1, 0x41, 0xd1,
-		0x95, 0x4a, 0xd1, 0xa1, 0x53, 0x47, 0x9a, 0x0b, 0x43, 0xa7, 0x8e, 0xc5, 0x4d, 0x84, 0x4e, 0x1d,
-		0xe8, 0xd4, 0x41, 0xa6, 0x1c, 0xe9, 0x46, 0x41, 0xa7, 0x0e, 0x51, 0xad, 0x88, 0x4e, 0x1d, 0x45,
-		0x7b, 0x3a, 0x6b, 0x3d, 0x1e, 0x74, 0xea, 0x10, 0x00, 0x57, 0x08, 0x05, 0x2c, 0x84, 0x02, 0x72,
-		0x24, 0x72, 0xc8, 0x84, 0x01, 0xc6, 0x8e, 0x33, 0xbe, 0xff, 0xaa, 0xbc, 0x1c, 0xa4, 0xe4, 0xcc,
-		0x77, 0x9e, 0x8d, 0x85, 0x0e, 0x16, 0x11, 0xc0, 0x1a, 0x22, 0x20, 0x90, 0x05, 0x97, 0x0e, 0xf7,
-		0x26, 0x20, 0x40, 0x54, 0xde, 0x9e, 0xb6, 0xac, 0xfd, 0xde, 0x74, 0xaf, 0x18, 0x82, 0x56, 0xe2,
-		0xa0, 0x95, 0x86, 0xe8, 0x5e, 0x31, 0x85, 0x3e, 0x44, 0xf1, 0xbe, 0x95, 0xdd, 0x4b, 0x13, 0xf7,
-		0x9b, 0x7d, 0x61, 0xc2, 0xf8, 0x5f, 0x32, 0x68, 0x84, 0xa5, 0x69, 0x5c, 0xeb, 0x3e, 0x0a, 0x7a,
-		0x95, 0x46, 0xe1, 0x49, 0x02, 0xcf, 0x1d, 0xa9, 0xf7, 0x32, 0x44, 0x41, 0xaf, 0xb4, 0xbb, 0x95,
-		0x2e, 0xe0, 0xb8, 0xe2, 0xd5, 0x34, 0xf7, 0xe2, 0xbc, 0x02, 0x7b, 0xc5, 0xd0, 0x6d, 0x3f, 0x58,
-		0xb0, 0x3d, 0xf9, 0xa5, 0x33, 0x5c, 0x4d, 0x52, 0x01, 0xb4, 0x98, 0xd0, 0x79, 0xf2, 0x9d, 0x74,
-		0x4f, 0x0d, 0xe9, 0x00, 0xcf, 0xe2, 0xb0, 0xc0, 0x3d, 0xc0, 0x3d, 0xc0, 0x3d, 0xc5, 0xe3, 0x1e,
-		0x74, 0xed, 0x03, 0x1a, 0x00, 0x1a, 0xd8, 0x37, 0x34, 0x40, 0x5f, 0xfd, 0x71, 0x6a, 0xdc, 0x19,
-		0x8b, 0x40, 0x26, 0x53, 0xa0, 0xec, 0x84, 0x48, 0xd9, 0x89, 0x21, 0xca, 0x4e, 0x14, 0xa8, 0x8c,
-		0x24, 0x94, 0x12, 0xad, 0x72, 0x22, 0x56, 0x52, 0x7c, 0xd4, 0xc5, 0xca, 0x6e, 0xa7, 0xaf, 0x49,
-		0xbe, 0xe2, 0xb9, 0x9c, 0xf0, 0xd4, 0x67, 0x8a, 0x11, 0x6c, 0xb8, 0x2d, 0xba, 0x73, 0xc8, 0x74,
-		0xe9, 0x85, 0xf8, 0x79, 0x74, 0x42, 0x11, 0xcd, 0x59, 0xf6, 0xb7, 0xaf, 0xdc, 0x3c, 0x61, 0xc1,
-		0x67, 0xa8, 0x17, 0x66, 0x81, 0xad, 0x86, 0xad, 0x86, 0xad, 0x86, 0xad, 0x86, 0xad, 0xde, 0xd0,
-		0x56, 0xdf, 0xcc, 0x6c, 0xf5, 0x3f, 0x06, 0x63, 0xcf, 0x53, 0x4e, 0x50, 0xad, 0x1d, 0xd6, 0xeb,
-		0x33, 0x16, 0xba, 0x1f, 0x7f, 0x64, 0x91, 0x8c, 0x5e, 0x7d, 0x2d, 0x19, 0xd9, 0x54, 0xdf, 0x60,
-		0xf6, 0xd1, 0x93, 0xad, 0x7c, 0x0b, 0x24, 0x13, 0x89, 0x59, 0x08, 0x3c, 0xec, 0x60, 0x67, 0x36,
-		0xb4, 0xef, 0x29, 0xa5, 0x9f, 0x06, 0x02, 0x57, 0xdc, 0x0f, 0x03, 0x81, 0x9b, 0x79, 0x40, 0x10,
-		0xb8, 0x00, 0x85, 0x00, 0x85, 0x00, 0x85, 0x00, 0x85, 0x65, 0x04, 0x85, 0x7c, 0x04, 0x2e, 0x78,
-		0x4a, 0x98, 0x24, 0x98, 0x24, 0x98, 0x24, 0x98, 0x24, 0x98, 0xa4, 0xdd, 0xe3, 0x29, 0x41, 0xc7,
-		0x95, 0x9d, 0x8e, 0x43, 0xfd, 0x7c, 0xae, 0x55, 0x2d, 0x60, 0x35, 0x65, 0x8b, 0x29, 0xff, 0x33,
-		0xb9, 0x8a, 0x2f, 0xc9, 0xdb, 0xae, 0xd5, 0x70, 0x1b, 0x53, 0xee, 0x69, 0x28, 0x5c, 0x52, 0xea,
-		0x96, 0x3c, 0xc5, 0xbe, 0x85, 0x14, 0xfb, 0xe2, 0x5d, 0x4e, 0xa4, 0xd8, 0x6f, 0xfc, 0x85, 0x70,
-		0xb4, 0x98, 0x62, 0x50, 0x1c, 0x2d, 0x2e, 0x03, 0x16, 0x47, 0x2c, 0x4a, 0x1c, 0x6b, 0xe3, 0x68,
-		0x71, 0xfe, 0xdd, 0x5a, 0xfe, 0xa3, 0xc5, 0x80, 0x1c, 0xf2, 0x90, 0x83, 0x00, 0x32, 0xa2, 0x6c,
-		0x1a, 0xfd, 0xba, 0x54, 0x72, 0xe1, 0x9f, 0xf4, 0xa0, 0x4f, 0xac, 0x56, 0xdb, 0x1b, 0xc6, 0x95,
-		0xce, 0xbb, 0xc2, 0x7c, 0x2b, 0x5b, 0xc9, 0xd4, 0xa9, 0x66, 0xc3, 0x65, 0x4c, 0xb7, 0x76, 0x9b,
-		0xaf, 0x40, 0x8a, 0xbb, 0x5f, 0xb1, 0x46, 0x0f, 0x9d, 0xd4, 0xf7, 0x7c, 0x96, 0xcc, 0x10, 0x7e,
-		0x3a, 0xe5, 0x5a, 0x67, 0x43, 0xd4, 0x99, 0x1d, 0xcc, 0x3c, 0x8e, 0x64, 0xee, 0x9a, 0xee, 0x79,
-		0x1d, 0x43, 0x32, 0x07, 0x90, 0xcc, 0xd1, 0xa3, 0xa8, 0xc9, 0xce, 0xab, 0x4b, 0xb2, 0x22, 0xd6,
-		0x4a, 0xdc, 0x9f, 0x5e, 0xf9, 0xf9, 0x2b, 0x4b, 0xce, 0x86, 0x42, 0x61, 0x49, 0x74, 0x9a, 0x12,
-		0x15, 0xae, 0x62, 0x5c, 0xb4, 0xdc, 0x85, 0x25, 0x63, 0x89, 0xa1, 0x63, 0x7f, 0xa7, 0x03, 0xa2,
-		0xc4, 0x0a, 0xbb, 0x80, 0x72, 0xd1, 0x20, 0xe8, 0x58, 0xa2, 0xa1, 0xc4, 0xca, 0x6b, 0x9b, 0x17,
-		0x25, 0x56, 0xca, 0xa6, 0x06, 0xc0, 0x8a, 0x16, 0xa1, 0x26, 0xca, 0xc9, 0x8a, 0xd2, 0x67, 0xe8,
-		0x8f, 0x18, 0x53, 0xf3, 0x47, 0x48, 0x80, 0x94, 0x49, 0x80, 0x1c, 0x21, 0x01, 0xb2, 0x40, 0xf5,
-		0x23, 0xa1, 0x86, 0x68, 0xd5, 0x11, 0xb1, 0x5a, 0x4a, 0x6e, 0x00, 0x7f, 0x02, 0xa4, 0x35, 0x7a,
-		0xe8, 0xe8, 0x31, 0x16, 0xd1, 0x1d, 0x57, 0xff, 0xaf, 0xeb, 0x28, 0xce, 0x6c, 0xc8, 0x53, 0x9e,
-		0x6c, 0xc8, 0x40, 0x79, 0x0e, 0x69, 0x48, 0x7c, 0x61, 0x82, 0x6a, 0xb5, 0xfb, 0xe3, 0xa6, 0xa1,
-		0x9f, 0x19, 0xfa, 0xf0, 0x5c, 0xff, 0xad, 0xff, 0xbd, 0x71, 0xd0, 0x7e, 0xaa, 0x75, 0x6b, 0xd5,
-		0xe5, 0xd7, 0xba, 0xb5, 0xef, 0x8d, 0x83, 0xe3, 0xa7, 0x6a, 0x75, 0xcd, 0x7f, 0xde, 0xad, 0x1b,
-		0xa3, 0xf6, 0xa3, 0x5a, 0xad, 0xb6, 0x8e, 0x6f, 0x1a, 0xfa, 0x71, 0xff, 0x47, 0xeb, 0xa6, 0xa1,
-		0xb7, 0xfb, 0xe1, 0x7b, 0xfa, 0x3f, 0x6e, 0x1a, 0xcd, 0xfe, 0xbb, 0xe8, 0xe1, 0xe4, 0x77, 0xad,
-		0xd7, 0xab, 0xd7, 0xbe, 0x1f, 0x3d, 0x6d, 0xf6, 0xe6, 0x5a, 0xad, 0xfa, 0xd3, 0x4d, 0xaf, 0x37,
-		0xfa, 0xfe, 0xc7, 0x53, 0xf8, 0xfb, 0xe3, 0x53, 0xff, 0xe7, 0xda, 0x3b, 0x2e, 0x6d, 0x50, 0xad,
-		0xde, 0xfc, 0xbb, 0xdb, 0xff, 0xb9, 0x5b, 0xfb, 0xde, 0x79, 0x9a, 0x3e, 0x8e, 0x7e, 0xd7, 0x7e,
-		0x54, 0xeb, 0x6f, 0x7b, 0xbd, 0x7a, 0xfd, 0x6d, 0x6d, 0xf2, 0x45, 0xe3, 0xf7, 0xbd, 0x9d, 0xfc,
-		0xf7, 0x5d, 0xb7, 0xbb, 0xf2, 0x52, 0xad, 0xfa, 0x53, 0x9d, 0xf1, 0x52, 0x67, 0xf7, 0xbf, 0xdb,
-		0xeb, 0xd5, 0xfb, 0x6f, 0xe9, 0xd5, 0x49, 0x1f, 0x27, 0xf8, 0xf7, 0xb7, 0x70, 0xcf, 0xc4, 0xea,
-		0xea, 0xb6, 0x72, 0x6e, 0xa3, 0x40, 0x0f, 0x93, 0x1b, 0xb8, 0x38, 0x0d, 0x3c, 0x42, 0x78, 0x84,
-		0xf0, 0x08, 0xf7, 0xc6, 0x23, 0xbc, 0x34, 0x1c, 0xd3, 0x08, 0x5c, 0xef, 0x91, 0x8e, 0x05, 0x12,
-		0xf4, 0x36, 0xc7, 0x96, 0x13, 0x9c, 0x32, 0xba, 0x97, 0xc7, 0x0c, 0x43, 0xd3, 0x36, 0x0b, 0x5e,
-		0xfe, 0xe1, 0x91, 0x7c, 0x8d, 0xab, 0x99, 0xf0, 0xca, 0x24, 0xd3, 0x46, 0xb5, 0x8d, 0x03, 0xde,
-		0x79, 0xb8, 0x9b, 0xd6, 0xae, 0x6e, 0x59, 0xae, 0x26, 0xb6, 0xcc, 0x1a, 0x62, 0x49, 0x5b, 0x7c,
-		0x93, 0xdb, 0x02, 0xcd, 0xd6, 0x29, 0x36, 0x41, 0x29, 0x8c, 0x0e, 0xdf, 0xa8, 0xc0, 0x37, 0xa8,
-		0x50, 0x56, 0xbe, 0x05, 0x62, 0xcb, 0xaf, 0xeb, 0x1c, 0x26, 0x99, 0x32, 0xd3, 0x47, 0x3b, 0x58,
-		0x9a, 0x8c, 0x30, 0x66, 0x41, 0x1f, 0xab, 0xd8, 0xfb, 0x83, 0x20, 0x08, 0x79, 0x22, 0xe4, 0xa9,
-		0x6d, 0xc5, 0x41, 0x10, 0xfa, 0x62, 0x0a, 0x1c, 0x45, 0x14, 0x92, 0xe2, 0x09, 0xf5, 0x7a, 0x54,
-		0xd3, 0x60, 0xd4, 0x9d, 0x68, 0xf4, 0xf8, 0x09, 0xd5, 0xc2, 0xa3, 0xea, 0x64, 0xda, 0x35, 0xde,
-		0xd7, 0xaa, 0x93, 0x50, 0xf0, 0x50, 0xf0, 0x1a, 0x72, 0x5a, 0x88, 0x4d, 0x06, 0x22, 0x18, 0x88,
-		0x60, 0x94, 0x48, 0xfd, 0x48, 0xa8, 0x21, 0x1e, 0xda, 0x07, 0x39, 0x2d, 0xaf, 0x39, 0x2e, 0xc8,
-		0x69, 0x41, 0x4e, 0x0b, 0x72, 0x5a, 0xe4, 0xa9, 0x4d, 0x42, 0xb7, 0xd9, 0xf5, 0xac, 0x5b, 0x86,
-		0x70, 0xd9, 0xcc, 0x00, 0x4f, 0xc6, 0x87, 0xab, 0x03, 0x57, 0x07, 0xae, 0x0e, 0x5c, 0x1d, 0x42,
-		0x57, 0x27, 0x71, 0x74, 0x58, 0x54, 0xcc, 0x82, 0x9f, 0xd3, 0x66, 0x18, 0xfb, 0x83, 0x33, 0xbe,
-		0x47, 0x7e, 0x20, 0xe9, 0x9e, 0x40, 0x7e, 0x20, 0x4c, 0x0e, 0x4c, 0x0e, 0xf2, 0x03, 0x91, 0x1f,
-		0xb8, 0xa2, 0xb6, 0x90, 0x1f, 0x38, 0xbf, 0x55, 0x90, 0x1f, 0x98, 0x67, 0xcb, 0x22, 0x3f, 0x30,
-		0xe5, 0x16, 0x40, 0x7e, 0x60, 0x59, 0x8c, 0x0e, 0xdf, 0xa8, 0xfb, 0xc0, 0x15, 0xf9, 0x81, 0x11,
-		0x8c, 0x7d, 0xc6, 0x8e, 0x37, 0x93, 0xf1, 0xe1, 0xb8, 0xc3, 0x71, 0x87, 0xe3, 0x0e, 0xae, 0x88,
-		0x6c, 0xb7, 0x2b, 0x67, 0x7c, 0xaf, 0xbc, 0x49, 0xf6, 0x2d, 0x58, 0x22, 0x86, 0x25, 0x54, 0xdf,
-		0x02, 0xcf, 0xd0, 0xc7, 0x8e, 0x1f, 0x18, 0x5f, 0x6d, 0xa6, 0xc5, 0xf4, 0xd4, 0x50, 0x79, 0xca,
-		0x19, 0x6c, 0x25, 0xe2, 0x98, 0xee, 0xc4, 0xeb, 0xdf, 0x7e, 0xd5, 0xda, 0xad, 0xb3, 0xa3, 0xae,
-		0x76, 0x69, 0x38, 0xc6, 0xad, 0x0a, 0x05, 0x5f, 0xbb, 0x70, 0x86, 0xae, 0x77, 0x1f, 0x6d, 0x4f,
-		0xed, 0x17, 0xc3, 0x57, 0xda, 0xd0, 0xf5, 0xb4, 0xe0, 0x4e, 0xf5, 0x9c, 0xb9, 0x21, 0xa2, 0x6a,
-		0xa5, 0x8e, 0x0a, 0xb4, 0x2b, 0xcf, 0x0d, 0xdc, 0x81, 0x6b, 0x6b, 0xd5, 0x8b, 0xab, 0xda, 0xc2,
-		0x5b, 0x74, 0xed, 0x62, 0x74, 0x3e, 0xe1, 0x44, 0x3f, 0x45, 0x96, 0xf4, 0xaf, 0x5f, 0x7b, 0x8e,
-		0x16, 0x4d, 0x79, 0xda, 0x69, 0x75, 0xb5, 0x8b, 0xab, 0x87, 0x8e, 0x16, 0xfe, 0x47, 0xd9, 0xca,
-		0xf7, 0xb5, 0xf8, 0xad, 0xda, 0xf9, 0x38, 0x1c, 0x2f, 0x34, 0x66, 0x63, 0x36, 0x19, 0x91, 0xb2,
-		0x0f, 0xeb, 0xec, 0xc4, 0x6c, 0xe3, 0x30, 0xfb, 0xc4, 0x52, 0x26, 0x63, 0xad, 0xe9, 0xd8, 0x8e,
-		0x9d, 0x05, 0x98, 0x80, 0xd3, 0x32, 0x9b, 0x6c, 0xec, 0x1d, 0x38, 0x2d, 0x53, 0xae, 0xce, 0x61,
-		0x04, 0x19, 0xd5, 0x0f, 0x9e, 0xc7, 0x70, 0x5c, 0x26, 0x1a, 0x15, 0x35, 0x02, 0x4b, 0x87, 0xe8,
-		0x90, 0x4f, 0x5d, 0x04, 0x62, 0xdb, 0xf1, 0x7c, 0xea, 0x50, 0xd8, 0xf5, 0x5b, 0xcf, 0x1d, 0x33,
-		0xe6, 0x55, 0xcf, 0xcd, 0xc1, 0x43, 0x24, 0x35, 0x41, 0x24, 0x81, 0x48, 0x02, 0x91, 0x54, 0x3e,
-		0x16, 0x82, 0x5a, 0x5d, 0x25, 0x03, 0x13, 0x57, 0x48, 0x7e, 0x56, 0x98, 0x48, 0x2b, 0x26, 0x0b,
-		0xa9, 0x2f, 0x76, 0x35, 0x26, 0xa1, 0xce, 0xc4, 0xd4, 0x5a, 0x91, 0x3c, 0x08, 0xab, 0x9a, 0x2b,
-		0x07, 0x09, 0xc2, 0xa4, 0xf6, 0x98, 0x49, 0x06, 0x26, 0x79, 0xe1, 0x52, 0x87, 0xc9, 0x04, 0xc6,
-		0x60, 0xa0, 0x46, 0x81, 0x7e, 0xef, 0x9a, 0x02, 0x1b, 0x39, 0xe9, 0x1e, 0x31, 0x37, 0x29, 0xf3,
-		0xce, 0xe2, 0x68, 0x38, 0xfa, 0xec, 0x64, 0x84, 0x8d, 0x48, 0x9f, 0xe5, 0x9e, 0x98, 0xef, 0x17,
-		0x4f, 0xa0, 0x55, 0xdc, 0xd0, 0x48, 0x1a, 0x1c, 0x71, 0xc3, 0x23, 0x6d, 0x80, 0x0a, 0x33, 0x44,
-		0x85, 0x19, 0xa4, 0x22, 0x0c, 0x13, 0xaf, 0x81, 0x62, 0x36, 0x54, 0xc9, 0x0d, 0x63, 0x0b, 0x04,
-		0x3f, 0x2b, 0x6d, 0xf4, 0x0d, 0x5c, 0x5f, 0xf5, 0xbe, 0x9b, 0x5b, 0xbd, 0x44, 0xcc, 0x75, 0xc6,
-		0x56, 0xe6, 0x13, 0xab, 0x3b, 0x26, 0x27, 0x49, 0x9c, 0x59, 0x8d, 0x86, 0xf9, 0xa0, 0xbc, 0xc0,
-		0xf2, 0xa3, 0xe8, 0xdf, 0x24, 0xa6, 0xf1, 0x60, 0xd8, 0x82, 0xce, 0xd9, 0xfa, 0xf9, 0x77, 0xc9,
-		0x4f, 0x6b, 0x36, 0x1a, 0xf0, 0xd2, 0xe0, 0xa5, 0xc1, 0x4b, 0x83, 0x97, 0x06, 0x2f, 0x4d, 0x46,
-		0xda, 0xc6, 0x96, 0x13, 0x34, 0x3b, 0x82, 0x4e, 0x5a, 0x47, 0x60, 0x2a, 0xde, 0xc3, 0x33, 0xcb,
-		0x3f, 0x32, 0xea, 0x43, 0x93, 0x3a, 0x5c, 0xb3, 0x32, 0x69, 0x72, 0xd2, 0xe2, 0x40, 0x76, 0x5e,
-		0xe9, 0x73, 0x17, 0xab, 0x32, 0x22, 0x75, 0x0e, 0x43, 0x58, 0xcd, 0x2c, 0x6e, 0x29, 0xe3, 0x5b,
-		0x71, 0x5b, 0xaa, 0xdd, 0x38, 0x3b, 0xc6, 0xae, 0x92, 0xda, 0x55, 0x6f, 0x76, 0x63, 0x96, 0x3e,
-		0x50, 0x3e, 0x50, 0x3e, 0xd7, 0xed, 0x1a, 0x79, 0x4a, 0xdd, 0x8f, 0x02, 0x39, 0x58, 0x3f, 0x9d,
-		0x70, 0x97, 0x70, 0x7c, 0x08, 0x31, 0x00, 0xe4, 0x01, 0xe4, 0x01, 0xe4, 0x01, 0xe4, 0x01, 0xe4,
-		0x65, 0xa4, 0x0d, 0xe1, 0x16, 0x38, 0x62, 0x3b, 0xe8, 0x88, 0xe9, 0xa6, 0xb2, 0x8d, 0x47, 0x71,
-		0x77, 0x2c, 0x9e, 0x76, 0x97, 0x9c, 0x32, 0x84, 0x56, 0xe0, 0x91, 0xc1, 0x23, 0x83, 0x47, 0x06,
-		0x8f, 0x4c, 0x48, 0xda, 0x10, 0x5a, 0xc9, 0xfd, 0xb3, 0x2f, 0xa1, 0x95, 0x06, 0x48, 0x70, 0xa1,
-		0x9f, 0xbd, 0x09, 0xad, 0x1c, 0x75, 0x1a, 0xd8, 0x55, 0x62, 0xbb, 0x0a, 0xa1, 0x15, 0x20, 0x7a,
-		0x20, 0xfa, 0xd7, 0x10, 0xbd, 0xe5, 0x7a, 0x56, 0x20, 0x0a, 0xe6, 0xe3, 0x19, 0x91, 0x24, 0x09,
-		0x24, 0x0f, 0x24, 0x0f, 0x24, 0x0f, 0x24, 0x0f, 0x24, 0x9f, 0x11, 0xc9, 0x9f, 0x0a, 0x02, 0xf9,
-		0x63, 0x00, 0xf9, 0x2d, 0x05, 0xf2, 0xc8, 0x91, 0x04, 0x90, 0x27, 0xde, 0x52, 0xad, 0xe3, 0x36,
-		0x36, 0x15, 0x70, 0x3c, 0x70, 0x3c, 0x70, 0x7c, 0x39, 0x70, 0xfc, 0x83, 0xe5, 0x05, 0x63, 0xc3,
-		0x9e, 0xb6, 0x08, 0x93, 0x83, 0xf3, 0xcb, 0x13, 0x03, 0xa7, 0x02, 0xa7, 0x02, 0xa7, 0x02, 0xa7,
-		0x02, 0xa7, 0xae, 0xf4, 0x6d, 0x94, 0x4c, 0x03, 0x3c, 0x13, 0x98, 0x2b, 0xbe, 0x97, 0x3b, 0x07,
-		0x56, 0xe7, 0x9a, 0x8b, 0xb7, 0x05, 0xd7, 0x6e, 0x65, 0x0d, 0x4f, 0x05, 0xe7, 0xe4, 0x6e, 0x42,
-		0xfe, 0xec, 0xc4, 0x93, 0x66, 0xe3, 0xfd, 0x1f, 0x37, 0x4d, 0xfd, 0x2c, 0x6e, 0x08, 0xde, 0x8c,
-		0xfe, 0x4c, 0x1e, 0xcf, 0x37, 0x0a, 0x8f, 0x9b, 0x87, 0x27, 0xcd, 0xc4, 0x53, 0x7f, 0x70, 0x5d,
-		0x5b, 0x71, 0xb1, 0x6f, 0xdb, 0x7f, 0xb3, 0x43, 0x08, 0xbc, 0x18, 0x69, 0xec, 0x40, 0x1a, 0xd9,
-		0xa5, 0x71, 0x4d, 0x9b, 0xff, 0x6e, 0xad, 0xba, 0xfc, 0x5a, 0xb7, 0xf6, 0xbd, 0x71, 0x70, 0xfc,
-		0x54, 0xad, 0xae, 0xf9, 0xcf, 0xbb, 0x75, 0x63, 0x44, 0x1d, 0xf4, 0x37, 0xea, 0xfe, 0x3f, 0x95,
-		0xee, 0x8d, 0xde, 0x5c, 0x5b, 0x2b, 0xd3, 0x07, 0xe2, 0x2a, 0xec, 0xdf, 0xdd, 0xfe, 0xcf, 0xdd,
-		0xda, 0xf7, 0xce, 0xd3, 0xf4, 0x71, 0xf4, 0xbb, 0xf6, 0xa3, 0x5a, 0x7f, 0xdb, 0xeb, 0xd5, 0xeb,
-		0x6f, 0x6b, 0x93, 0x1b, 0x10, 0xbf, 0xef, 0xed, 0xe4, 0xbf, 0xef, 0xba, 0xdd, 0x95, 0x97, 0x6a,
-		0xd5, 0x9f, 0xea, 0xbb, 0xa8, 0x96, 0xc0, 0xae, 0xbc, 0xb8, 0x85, 0x3e, 0x5a, 0x7e, 0x70, 0x1e,
-		0x04, 0x9e, 0x8c, 0x6b, 0x7a, 0x69, 0x39, 0x1f, 0xec, 0xa8, 0x64, 0x91, 0x10, 0x91, 0x58, 0xb9,
-		0x34, 0xbe, 0xcd, 0xcd, 0xd8, 0x3c, 0x6d, 0xb7, 0x3b, 0x27, 0xed, 0x76, 0xe3, 0xe4, 0xe8, 0xa4,
-		0x71, 0x76, 0x7c, 0xdc, 0xec, 0x34, 0x25, 0xa2, 0x2b, 0x7f, 0x7a, 0xa6, 0xf2, 0x94, 0xf9, 0xcb,
-		0x63, 0xa5, 0xab, 0x39, 0x63, 0xdb, 0x06, 0x1f, 0x07, 0x3e, 0x8e, 0x9b, 0x8f, 0xb3, 0x2d, 0xe7,
-		0x6f, 0xdd, 0x76, 0x07, 0x92, 0x45, 0xc9, 0xd6, 0xcc, 0x0d, 0x56, 0x0e, 0xac, 0x1c, 0x58, 0x39,
-		0xb0, 0x72, 0x60, 0xe5, 0xc0, 0xca, 0x81, 0x95, 0x03, 0x0f, 0x00, 0x56, 0x0e, 0xac, 0x1c, 0x58,
-		0x39, 0xb0, 0x72, 0x60, 0xe5, 0xc0, 0xca, 0x81, 0x95, 0x03, 0xc7, 0xb2, 0xd5, 0x1c, 0x8b, 0xe7,
-		0x8e, 0x03, 0xe5, 0xe9, 0x96, 0x29, 0x4f, 0xb1, 0xcc, 0xa6, 0x06, 0xc3, 0x02, 0x86, 0x05, 0x0c,
-		0x0b, 0x18, 0x16, 0x30, 0x2c, 0x38, 0x9f, 0xb3, 0x7d, 0x70, 0x0e, 0xe7, 0x73, 0x24, 0x2f, 0x00,
-		0xe7, 0x73, 0xb8, 0xb7, 0x54, 0xeb, 0x18, 0x25, 0xcc, 0xc5, 0x36, 0x15, 0xb0, 0x2a, 0xb0, 0xea,
-		0x4e, 0x60, 0xd5, 0xad, 0xea, 0x76, 0x7b, 0x3e, 0xbe, 0x0d, 0x3d, 0x60, 0x65, 0xb2, 0xfa, 0x23,
-		0x42, 0x78, 0xfa, 0x30, 0x74, 0xe2, 0x87, 0xdd, 0xa8, 0x81, 0xda, 0xd0, 0x18, 0x28, 0x7f, 0xf9,
-		0x85, 0xf8, 0xb9, 0x3f, 0xfe, 0xba, 0xf2, 0x9e, 0xf9, 0xd7, 0xa2, 0x97, 0x46, 0x5d, 0x6b, 0xf4,
-		0xd0, 0x89, 0x1f, 0xc6, 0xdc, 0x74, 0xfc, 0xee, 0xe4, 0xf9, 0xe1, 0x83, 0xe7, 0x8d, 0xa2, 0x5f,
-		0xfa, 0xad, 0xe7, 0x8e, 0x47, 0x87, 0xac, 0xad, 0xc8, 0x93, 0xef, 0xfb, 0x5e, 0xf9, 0x03, 0xcf,
-		0x1a, 0xc5, 0x62, 0x5a, 0x39, 0x37, 0x4d, 0x2b, 0x7c, 0x6c, 0xd8, 0xda, 0xe7, 0xeb, 0xeb, 0x2b,
-		0xcd, 0x34, 0x02, 0x43, 0x1b, 0xba, 0x9e, 0x76, 0x71, 0xf5, 0xd0, 0xd1, 0x66, 0xdf, 0x54, 0x88,
-		0x5d, 0x68, 0x82, 0x5d, 0x00, 0xbb, 0x00, 0x76, 0x01, 0xec, 0x42, 0x6a, 0xb5, 0x66, 0x09, 0x65,
-		0xae, 0x16, 0x90, 0x56, 0xb7, 0x22, 0xe8, 0xe2, 0xe9, 0x75, 0xcf, 0x59, 0x8f, 0xdf, 0x5c, 0x6f,
-		0x62, 0x36, 0x5c, 0x67, 0xd9, 0x60, 0x1c, 0x68, 0xbe, 0x0a, 0x7c, 0x2d, 0xb8, 0x53, 0x5a, 0x7c,
-		0xb9, 0x5a, 0x78, 0xb9, 0x5a, 0x74, 0xb9, 0x3d, 0x47, 0x36, 0x60, 0x2b, 0x44, 0x5e, 0x8b, 0x9b,
-		0x99, 0x22, 0xcc, 0x4d, 0x61, 0x66, 0xa7, 0x28, 0xf3, 0x53, 0xb8, 0x19, 0x2a, 0xdc, 0x1c, 0x15,
-		0x69, 0x96, 0x84, 0x31, 0xbe, 0x90, 0xbc, 0x8a, 0x91, 0xe1, 0x2b, 0xd2, 0x2a, 0x9a, 0x76, 0xb8,
-		0xe2, 0xde, 0x9f, 0x09, 0xce, 0x29, 0x9a, 0x86, 0x28, 0x83, 0x56, 0x5f, 0x59, 0xd9, 0x42, 0xd2,
-		0x12, 0x57, 0xd6, 0xf8, 0xb4, 0x80, 0xb9, 0x8b, 0x4a, 0x8c, 0x4a, 0x2e, 0x60, 0x6f, 0xd2, 0x15,
-		0xa7, 0x3f, 0x7d, 0xd1, 0x19, 0x9f, 0x0e, 0xf6, 0x48, 0x8a, 0x3b, 0x90, 0xe2, 0xc2, 0xa4, 0x18,
-		0x69, 0x8e, 0x79, 0x54, 0xe0, 0xf6, 0xa6, 0x3b, 0x16, 0xa4, 0xd6, 0x90, 0xce, 0x59, 0x2e, 0x96,
-		0x6a, 0xcb, 0x22, 0x3b, 0x7d, 0xae, 0xc8, 0x8e, 0x4c, 0x28, 0xaf, 0xe2, 0x0f, 0xee, 0xd4, 0xbd,
-		0x31, 0x32, 0x82, 0xbb, 0x49, 0x08, 0x66, 0xa4, 0x9c, 0x49, 0x14, 0x44, 0x9f, 0x8b, 0xb1, 0xac,
-		0x7b, 0x78, 0xb8, 0x18, 0x86, 0x59, 0x08, 0xc0, 0x44, 0xa1, 0x97, 0x59, 0xd0, 0xe5, 0x95, 0x70,
-		0xcb, 0x9b, 0xed, 0x58, 0x6d, 0x06, 0x07, 0xa8, 0x92, 0xdc, 0x32, 0x3d, 0xf0, 0x8c, 0xc1, 0xdf,
-		0x96, 0x73, 0xcb, 0xb6, 0xda, 0x33, 0xf7, 0x66, 0x75, 0x4e, 0xa6, 0x3d, 0xcc, 0x1b, 0x51, 0x62,
-		0xa7, 0xf8, 0x24, 0x28, 0x3d, 0x31, 0x0a, 0x4f, 0x8a, 0xb2, 0x13, 0xa7, 0xe8, 0xc4, 0x29, 0x39,
-		0x49, 0x0a, 0x6e, 0xbb, 0xb2, 0x11, 0xb8, 0x23, 0x40, 0x95, 0xc1, 0x54, 0xe2, 0x85, 0x32, 0x12,
-		0x64, 0xf2, 0x01, 0x10, 0x78, 0x2f, 0xbf, 0xfa, 0x94, 0x56, 0xa3, 0x85, 0xa9, 0xd3, 0xc2, 0xd4,
-		0x6a, 0x11, 0xea, 0x55, 0x08, 0xd2, 0xec, 0x4a, 0xe0, 0x7d, 0xda, 0xb5, 0x49, 0x37, 0xd5, 0xc0,
-		0x53, 0xf1, 0x1a, 0x09, 0x07, 0xde, 0xd7, 0x5c, 0x83, 0x58, 0xe0, 0x5d, 0xae, 0x97, 0x54, 0x32,
-		0x69, 0x43, 0x86, 0x7f, 0xe9, 0x23, 0x09, 0x60, 0xdb, 0x4c, 0x5f, 0x61, 0x26, 0xb0, 0x28, 0x53,
-		0x58, 0xb8, 0x49, 0x2c, 0xdc, 0x34, 0x16, 0x69, 0x22, 0x65, 0x4c, 0xa5, 0x90, 0xc9, 0x4c, 0x6e,
-		0x64, 0x71, 0x49, 0x00, 0x52, 0x27, 0xe3, 0x96, 0x55, 0xaf, 0xe0, 0x91, 0x18, 0xe1, 0x93, 0x72,
-		0xd3, 0x9f, 0x02, 0x22, 0x87, 0x45, 0x9c, 0x9c, 0x4b, 0x26, 0x2f, 0xa8, 0x55, 0x75, 0x32, 0x7f,
-		0xd1, 0x87, 0x9e, 0x66, 0xa2, 0x55, 0xd4, 0xe1, 0x27, 0x61, 0xad, 0xb5, 0xb8, 0xf5, 0x0a, 0x38,
-		0x61, 0xb7, 0xb2, 0xf5, 0xc4, 0x3b, 0x61, 0x61, 0xf3, 0x15, 0x64, 0x98, 0xe5, 0x67, 0xdb, 0xa9,
-		0xe2, 0x5c, 0xd2, 0x27, 0xf4, 0x92, 0x79, 0x0b, 0x3b, 0xa9, 0x27, 0xb7, 0x61, 0x04, 0x96, 0xb0,
-		0x12, 0x45, 0xcc, 0x66, 0x91, 0x51, 0x79, 0xfa, 0x63, 0xf9, 0x02, 0x80, 0xdb, 0x81, 0xdb, 0x81,
-		0xdb, 0x81, 0xdb, 0x81, 0xdb, 0x85, 0xa4, 0x35, 0xb4, 0x9a, 0x9e, 0x1a, 0x16, 0x91, 0xb9, 0x7f,
-		0x22, 0x5b, 0xe6, 0xf2, 0x2e, 0xcd, 0x99, 0x6c, 0x27, 0xbc, 0x3d, 0xf0, 0xd4, 0xe0, 0xa9, 0x15,
-		0x3f, 0xc3, 0xae, 0x15, 0xd9, 0x28, 0x32, 0x43, 0x6f, 0x35, 0x57, 0x8c, 0x35, 0x69, 0x8f, 0x7f,
-		0x83, 0x70, 0x96, 0xa2, 0xf4, 0x03, 0x23, 0x50, 0x72, 0xc9, 0x29, 0x93, 0xe9, 0x76, 0x2c, 0x37,
-		0xa5, 0x85, 0xdc, 0x94, 0xad, 0x71, 0xec, 0x91, 0x9b, 0x82, 0xdc, 0x94, 0xd7, 0x6e, 0x18, 0x72,
-		0x53, 0x44, 0xae, 0x00, 0xb9, 0x29, 0xe0, 0xb8, 0xc0, 0x71, 0x81, 0xe3, 0x02, 0xc7, 0x05, 0x8e,
-		0x2b, 0xbf, 0xb4, 0x22, 0x37, 0x85, 0xeb, 0x07, 0xb9, 0x29, 0xb2, 0xf3, 0x23, 0x3d, 0x40, 0x58,
-		0x6b, 0x2d, 0x6e, 0x3d, 0xe4, 0xa6, 0x60, 0xf3, 0x69, 0xc8, 0x4d, 0x29, 0xfd, 0xf7, 0x41, 0xea,
-		0x04, 0x60, 0x25, 0x60, 0x25, 0x60, 0x25, 0x60, 0x25, 0x60, 0x25, 0x9f, 0xb4, 0x22, 0x75, 0xa2,
-		0xe8, 0xd4, 0x09, 0x04, 0x2b, 0x5e, 0xd6, 0x31, 0xfb, 0x1d, 0xd9, 0x9f, 0x04, 0x94, 0x51, 0xdd,
-		0x89, 0x7f, 0xc7, 0xed, 0x45, 0x75, 0x27, 0xb1, 0x3a, 0x43, 0x93, 0x6f, 0x1a, 0x78, 0xe3, 0x41,
-		0xe0, 0xc4, 0xa6, 0xe6, 0x62, 0x3a, 0xf7, 0x97, 0x4f, 0x73, 0x57, 0xfe, 0xe5, 0x62, 0xf4, 0xd0,
-		0xf9, 0x72, 0x3e, 0xb9, 0xde, 0x2f, 0x9f, 0x3d, 0x6f, 0xf4, 0x7b, 0x78, 0xa5, 0x5f, 0x92, 0x77,
-		0xff, 0x35, 0xbd, 0xd0, 0x3d, 0x2e, 0x49, 0xc5, 0x9b, 0xc5, 0x22, 0x92, 0xbd, 0x22, 0x56, 0x78,
-		0xaa, 0x85, 0xc2, 0x53, 0xa5, 0xc1, 0x4c, 0x28, 0x3c, 0xb5, 0xbf, 0xe6, 0x94, 0xbd, 0xf0, 0x94,
-		0x31, 0x18, 0xa8, 0x51, 0xa0, 0xdf, 0xbb, 0xa6, 0x60, 0x82, 0xdf, 0xfc, 0xa4, 0xec, 0x2d, 0xa9,
-		0xe4, 0xf2, 0x47, 0x2a, 0x11, 0x07, 0xcd, 0xeb, 0x67, 0xf6, 0xd1, 0x89, 0xbb, 0xac, 0x24, 0x1e,
-		0xd2, 0x22, 0x77, 0x8e, 0xa4, 0x43, 0x5a, 0x64, 0x69, 0x49, 0xb8, 0x44, 0xda, 0xbe, 0xba, 0xae,
-		0xad, 0x0c, 0x47, 0xb0, 0x17, 0x77, 0xb3, 0x89, 0x33, 0x02, 0xab, 0x8e, 0x84, 0xf9, 0xa0, 0xbc,
-		0xc0, 0xf2, 0xa3, 0xec, 0xd0, 0x09, 0x08, 0x7f, 0x10, 0xe8, 0x5f, 0x36, 0xf3, 0x29, 0xd6, 0xcf,
-		0xbf, 0x4b, 0xee, 0x45, 0xb3, 0xd1, 0x80, 0x73, 0x01, 0xe7, 0x02, 0xce, 0x05, 0x9c, 0x0b, 0x38,
-		0x17, 0x32, 0xd2, 0x36, 0xb6, 0x9c, 0xa0, 0xd9, 0x11, 0xf4, 0x2d, 0x3a, 0x02, 0x53, 0xc9, 0x66,
-		0x88, 0x0a, 0xc6, 0x63, 0x8b, 0xc8, 0x08, 0x4d, 0xd2, 0xf1, 0x9a, 0xc2, 0x79, 0x03, 0x45, 0x27,
-		0xe1, 0x15, 0x97, 0x7c, 0x27, 0x98, 0xf1, 0x59, 0x48, 0xa6, 0x67, 0xb2, 0xa5, 0xda, 0x8d, 0xb3,
-		0x63, 0xec, 0x2a, 0xa9, 0x5d, 0xb5, 0x23, 0x99, 0x0a, 0x7d, 0x80, 0xd3, 0x95, 0x4d, 0x35, 0x18,
-		0x7b, 0x5e, 0x08, 0x0b, 0xa7, 0xe7, 0x18, 0x05, 0x1b, 0x2d, 0x2c, 0xcf, 0x0c, 0x88, 0x05, 0x88,
-		0x05, 0x88, 0x05, 0x88, 0x05, 0x88, 0x25, 0x7c, 0x26, 0x4f, 0xf0, 0x2c, 0x1e, 0x10, 0x16, 0x8b,
-		0x3b, 0xdc, 0x80, 0x2f, 0x0c, 0x84, 0x45, 0xbb, 0xa5, 0x5a, 0xc7, 0x00, 0x58, 0x00, 0x58, 0x00,
-		0x58, 0x79, 0x37, 0xd5, 0xc8, 0x53, 0xea, 0x7e, 0x14, 0xc8, 0xe1, 0xaa, 0xe9, 0x84, 0xbb, 0x14,
-		0xdf, 0x0b, 0xfd, 0x62, 0x04, 0xf8, 0x80, 0x3e, 0x81, 0x3e, 0x81, 0x3e, 0x81, 0x3e, 0x65, 0xa4,
-		0x0d, 0xd9, 0x43, 0x65, 0xf2, 0x1f, 0x74, 0x53, 0xd9, 0xc6, 0xa3, 0xb8, 0x17, 0x11, 0x4f, 0xbb,
-		0x4b, 0xbe, 0x04, 0x32, 0x85, 0xe0, 0x48, 0xc0, 0x91, 0x80, 0x23, 0x01, 0x47, 0x42, 0x48, 0xda,
-		0x90, 0x29, 0x94, 0xfb, 0x07, 0x3c, 0x36, 0xcf, 0xbc, 0xe0, 0xb1, 0x45, 0xb6, 0x54, 0x91, 0x3c,
-		0xf6, 0x51, 0xa7, 0x81, 0x5d, 0x25, 0xb6, 0xab, 0x40, 0x64, 0xef, 0x30, 0x10, 0x95, 0xce, 0x10,
-		0x92, 0xca, 0x0c, 0xc2, 0x51, 0x15, 0x00, 0x50, 0x00, 0x50, 0x00, 0x50, 0x00, 0x50, 0x0d, 0x79,
-		0x54, 0x04, 0xaa, 0x11, 0x79, 0x54, 0xdb, 0x8a, 0x3f, 0x71, 0x52, 0x05, 0xf8, 0x93, 0x78, 0x4b,
-		0x89, 0xd7, 0x22, 0x07, 0xfc, 0x04, 0xfc, 0xdc, 0x41, 0xf8, 0xf9, 0x60, 0x79, 0xc1, 0xd8, 0xb0,
-		0xf5, 0xb8, 0xf4, 0xa0, 0x1c, 0x0a, 0x5d, 0x9e, 0x18, 0xf0, 0x0a, 0xf0, 0x0a, 0xf0, 0x0a, 0xf0,
-		0x0a, 0xf0, 0x2a, 0x96, 0x36, 0x6b, 0x24, 0xa4, 0x1b, 0xe7, 0xf5, 0x63, 0xf3, 0x4c, 0x60, 0xae,
-		0xf8, 0x5e, 0xee, 0x1c, 0xc6, 0x9a, 0xad, 0xdc, 0x43, 0x5b, 0x70, 0xed, 0x56, 0xd6, 0xf0, 0x54,
-		0xb6, 0x44, 0x7b, 0xa0, 0x3c, 0x47, 0xbc, 0xfd, 0x57, 0xa5, 0x5a, 0xbd, 0x69, 0xe8, 0x67, 0xfd,
-		0x1f, 0x37, 0x4d, 0xfd, 0xac, 0x3f, 0x79, 0xd8, 0x8c, 0xfe, 0x4c, 0x1e, 0xb7, 0x6e, 0x1a, 0x7a,
-		0x7b, 0xfa, 0xf8, 0xf8, 0xa6, 0xa1, 0x1f, 0xf7, 0x6b, 0xbd, 0x5e, 0xbd, 0xf6, 0xfd, 0xe8, 0x29,
-		0xfd, 0x07, 0xab, 0x3f, 0xdd, 0xf4, 0x7a, 0xa3, 0xef, 0x7f, 0x3c, 0x85, 0xbf, 0x3f, 0x3e, 0xf5,
-		0x7f, 0xae, 0xbd, 0xab, 0xec, 0x5a, 0x67, 0x9c, 0x83, 0x1d, 0x96, 0xc6, 0x0e, 0xa4, 0x91, 0x5d,
-		0x1a, 0xbb, 0x3f, 0x42, 0x99, 0x31, 0xf4, 0xe1, 0xb9, 0xfe, 0x5b, 0xff, 0x7b, 0xe3, 0xa0, 0xfd,
-		0x54, 0xeb, 0xd6, 0xaa, 0xcb, 0xaf, 0x75, 0x6b, 0xdf, 0x1b, 0x07, 0xc7, 0x4f, 0xd5, 0xea, 0x9a,
-		0xff, 0xbc, 0x5b, 0x37, 0x46, 0xed, 0x47, 0xb5, 0x5a, 0x8d, 0xe5, 0x70, 0x41, 0x36, 0x6f, 0x1a,
-		0xcd, 0xfe, 0xbb, 0xe8, 0xe1, 0xe4, 0x77, 0x22, 0xdd, 0x1b, 0xbd, 0xb9, 0xb6, 0x56, 0xa6, 0x0f,
-		0xc4, 0x55, 0xd8, 0xbf, 0xbb, 0xfd, 0x9f, 0xbb, 0xb5, 0xef, 0x9d, 0xa7, 0xe9, 0xe3, 0xe8, 0x77,
-		0xed, 0x47, 0xb5, 0xfe, 0xb6, 0xd7, 0xab, 0xd7, 0xdf, 0xd6, 0x26, 0x37, 0x20, 0x7e, 0xdf, 0xdb,
-		0xc9, 0x7f, 0xdf, 0x75, 0xbb, 0x2b, 0x2f, 0xd5, 0xaa, 0x3f, 0xd5, 0x77, 0x51, 0x2d, 0x6d, 0x3b,
-		0x29, 0xc0, 0xec, 0x96, 0x7e, 0xb4, 0xfc, 0xe0, 0x3c, 0x08, 0x84, 0x3a, 0x83, 0x5f, 0x5a, 0xce,
-		0x07, 0x3b, 0xaa, 0x77, 0x28, 0xc4, 0x7f, 0x55, 0x2e, 0x8d, 0x6f, 0x73, 0x33, 0x36, 0x4f, 0xdb,
-		0xed, 0xce, 0x49, 0xbb, 0xdd, 0x38, 0x39, 0x3a, 0x69, 0x9c, 0x1d, 0x1f, 0x37, 0x3b, 0x4d, 0x89,
-		0xa0, 0xc0, 0x9f, 0x9e, 0xa9, 0x3c, 0x65, 0xfe, 0xf2, 0x58, 0xe9, 0x6a, 0xce, 0xd8, 0xb6, 0x41,
-		0x23, 0x3d, 0x4b, 0x23, 0xd9, 0x96, 0xf3, 0xb7, 0x6e, 0xbb, 0x03, 0xc9, 0x42, 0x9c, 0x6b, 0xe6,
-		0x06, 0x99, 0x04, 0x32, 0x09, 0x64, 0x12, 0xc8, 0x24, 0x90, 0x49, 0x20, 0x93, 0x40, 0x26, 0x01,
-		0xbe, 0x82, 0x4c, 0x02, 0x99, 0x04, 0x32, 0x09, 0x64, 0x12, 0xc8, 0x24, 0x90, 0x49, 0x5b, 0x4c,
-		0x26, 0x6d, 0x35, 0x35, 0xe0, 0xb9, 0xe3, 0x40, 0x79, 0xba, 0x65, 0xca, 0x33, 0x03, 0xb3, 0xa9,
-		0x41, 0x0c, 0x80, 0x18, 0x00, 0x31, 0x00, 0x62, 0x00, 0xc4, 0x00, 0x92, 0xf8, 0xb7, 0x0f, 0x85,
-		0x20, 0x89, 0x5f, 0xf2, 0x02, 0x90, 0xc4, 0xcf, 0xbd, 0xa5, 0x50, 0x0c, 0x15, 0x49, 0xfc, 0x7b,
-		0x03, 0xb1, 0xb6, 0xaa, 0x0d, 0xf4, 0xf9, 0xf8, 0x36, 0x74, 0xdc, 0x94, 0xc9, 0x6a, 0x46, 0x85,
-		0x60, 0xe0, 0x61, 0xe8, 0x7b, 0x0e, 0xbb, 0x51, 0x8b, 0xc6, 0xa1, 0x31, 0x50, 0xfe, 0xf2, 0x0b,
-		0xf1, 0x73, 0x7f, 0xfc, 0x75, 0xe5, 0x3d, 0xf3, 0xaf, 0x45, 0x2f, 0x8d, 0xba, 0xd6, 0xe8, 0xa1,
-		0x13, 0x3f, 0x8c, 0x99, 0xc0, 0xf8, 0xdd, 0xc9, 0xf3, 0xc3, 0x07, 0xcf, 0x1b, 0x45, 0xbf, 0xf4,
-		0x5b, 0xcf, 0x1d, 0x8f, 0x0e, 0x39, 0x5b, 0xf4, 0x27, 0x5f, 0xf7, 0xbd, 0xf2, 0x07, 0x9e, 0x35,
-		0x0a, 0xd5, 0x5b, 0xf8, 0xad, 0xcf, 0x4d, 0xd3, 0x0a, 0x1f, 0x1b, 0xb6, 0xf6, 0xf9, 0xfa, 0xfa,
-		0x4a, 0x33, 0x8d, 0xc0, 0xd0, 0x86, 0xae, 0xa7, 0x5d, 0x5c, 0x3d, 0x74, 0xb4, 0xd9, 0x17, 0x15,
-		0xc2, 0xc4, 0x4d, 0x60, 0x62, 0x60, 0x62, 0x60, 0x62, 0x60, 0xe2, 0xd4, 0x6a, 0xcd, 0x12, 0xca,
-		0x6e, 0x2b, 0x20, 0x87, 0x69, 0x45, 0xd0, 0xc5, 0x73, 0x99, 0x9e, 0xb3, 0x1e, 0xbf, 0xb9, 0xde,
-		0xc4, 0x6c, 0xb8, 0xce, 0xb2, 0xc1, 0x38, 0xd0, 0x7c, 0x15, 0xf8, 0x5a, 0x70, 0xa7, 0xb4, 0xf8,
-		0x72, 0xb5, 0xf0, 0x72, 0xb5, 0xe8, 0x72, 0x7b, 0x8e, 0x6c, 0x74, 0x4c, 0x88, 0x72, 0x15, 0x37,
-		0x33, 0x45, 0x98, 0x9b, 0xc2, 0xcc, 0x4e, 0x51, 0xe6, 0xa7, 0x70, 0x33, 0x54, 0xb8, 0x39, 0x2a,
-		0xd2, 0x2c, 0x09, 0x23, 0x53, 0x21, 0x79, 0x15, 0xa3, 0x70, 0x57, 0xa4, 0x55, 0x34, 0xc7, 0x6b,
-		0xc5, 0xbd, 0x3f, 0x13, 0x9c, 0x53, 0x34, 0xe7, 0x4b, 0x06, 0xac, 0xbe, 0xb2, 0xb2, 0x85, 0xe4,
-		0x80, 0xad, 0xac, 0xf1, 0x69, 0x01, 0x73, 0x17, 0x95, 0x85, 0x92, 0x5c, 0xc0, 0xde, 0xe4, 0x86,
-		0xc9, 0x30, 0x6c, 0x05, 0xe9, 0xe5, 0x72, 0x48, 0x71, 0x07, 0x52, 0x5c, 0x98, 0x14, 0x23, 0xa7,
-		0x2c, 0x8f, 0x0a, 0xdc, 0xde, 0xdc, 0xb2, 0x82, 0xd4, 0x1a, 0x72, 0xe7, 0xca, 0xc5, 0x52, 0x6d,
-		0x59, 0x60, 0xa7, 0xcf, 0x15, 0xd8, 0x71, 0x1c, 0x37, 0x30, 0x62, 0x96, 0x87, 0xcf, 0x18, 0x56,
-		0xfc, 0xc1, 0x9d, 0xba, 0x37, 0x46, 0x46, 0x70, 0x37, 0x89, 0xc0, 0x8c, 0x94, 0x33, 0x88, 0xa8,
-		0x13, 0x7d, 0x2e, 0xc4, 0xb2, 0xee, 0xe1, 0xe1, 0x62, 0x14, 0x66, 0x21, 0xfe, 0x12, 0x45, 0x5e,
-		0x66, 0x31, 0x97, 0x97, 0xa3, 0x2d, 0x6f, 0xb6, 0x63, 0xb1, 0x19, 0xfc, 0x1f, 0xc1, 0x94, 0x4b,
-		0xf1, 0x54, 0x4b, 0x66, 0xbe, 0x8f, 0x9d, 0xdf, 0x93, 0xe0, 0xf3, 0xc4, 0xf8, 0x3b, 0x29, 0xbe,
-		0x4e, 0x9c, 0x9f, 0x13, 0xe7, 0xe3, 0x24, 0xf9, 0xb7, 0xed, 0xca, 0x44, 0x60, 0xe7, 0xd3, 0x12,
-		0x69, 0xb1, 0x95, 0x31, 0xf4, 0xd4, 0x90, 0x53, 0x5e, 0xa6, 0x10, 0xec, 0x84, 0x71, 0x8e, 0xab,
-		0xd8, 0xe6, 0xd6, 0xeb, 0x87, 0x13, 0x8b, 0x7b, 0xb8, 0xaa, 0x9b, 0xb7, 0xc5, 0x36, 0xbe, 0x29,
-		0xf1, 0x0e, 0x0d, 0x95, 0x92, 0x84, 0xe5, 0xe3, 0xad, 0xf1, 0xc1, 0x5f, 0xd3, 0xa3, 0x90, 0x1a,
-		0x1e, 0xbc, 0x35, 0x3b, 0xa8, 0x77, 0x12, 0xb3, 0x53, 0x5e, 0xa4, 0x33, 0xce, 0xa0, 0x4d, 0x2b,
-		0x7e, 0xe0, 0x8d, 0x07, 0x81, 0x13, 0xab, 0xed, 0x8b, 0xe9, 0x15, 0x7d, 0xf9, 0x34, 0x77, 0x79,
-		0x5f, 0x2e, 0x46, 0x0f, 0x9d, 0x2f, 0xe7, 0x93, 0x8b, 0xfa, 0xf2, 0xd9, 0xf3, 0x46, 0xbf, 0x47,
-		0x97, 0xf3, 0xa6, 0x9c, 0xfa, 0x89, 0x66, 0x24, 0xa2, 0x7d, 0x59, 0x51, 0xdf, 0x02, 0xcf, 0xd0,
-		0xc7, 0x8e, 0x1f, 0x18, 0x5f, 0x6d, 0x5a, 0xeb, 0x5b, 0xf1, 0xd4, 0x50, 0x79, 0xca, 0x19, 0xd0,
-		0x07, 0x70, 0x18, 0x04, 0x67, 0xea, 0x1a, 0x5c, 0xff, 0xf6, 0xab, 0x76, 0x7c, 0x72, 0x76, 0xaa,
-		0xe9, 0xda, 0xe7, 0x38, 0xe5, 0xe1, 0x3a, 0x52, 0xf7, 0xda, 0xb5, 0x32, 0xc7, 0x8e, 0x69, 0x38,
-		0x83, 0x47, 0xed, 0xca, 0x73, 0x03, 0x77, 0xe0, 0xda, 0x3d, 0xa7, 0xfa, 0xf9, 0xfa, 0xfa, 0xaa,
-		0xa6, 0x7d, 0x56, 0x9e, 0x6f, 0xb9, 0x8e, 0x76, 0x34, 0x4d, 0xbb, 0x6b, 0x6b, 0x86, 0x63, 0x46,
-		0xe9, 0x14, 0x1c, 0x62, 0xc1, 0xec, 0x8c, 0xcf, 0x3b, 0xe1, 0xb3, 0x45, 0x64, 0xf2, 0xfa, 0xa4,
-		0xfc, 0xef, 0x05, 0xbf, 0x9b, 0x7e, 0x95, 0xcb, 0xee, 0x11, 0xbd, 0x29, 0x17, 0x09, 0x49, 0xa5,
-		0xbf, 0x98, 0xec, 0xa9, 0xb8, 0x1d, 0xa5, 0xd9, 0x3f, 0xf9, 0x57, 0x39, 0xdf, 0x08, 0x39, 0x57,
-		0x75, 0xea, 0x67, 0xe7, 0x66, 0x32, 0x68, 0x1d, 0x69, 0x7a, 0xc7, 0x59, 0xc4, 0x51, 0xa6, 0x75,
-		0x8c, 0xf3, 0x2e, 0x2d, 0xed, 0x31, 0x03, 0x1a, 0x49, 0x2f, 0xee, 0xd8, 0x00, 0x91, 0x31, 0x7d,
-		0xe1, 0x20, 0xc0, 0xc5, 0x95, 0x16, 0xce, 0xa5, 0x0d, 0x8d, 0x7b, 0xcb, 0x7e, 0xd4, 0x26, 0xca,
-		0x6b, 0xec, 0x45, 0xaa, 0x32, 0x34, 0x5f, 0x3d, 0x87, 0xfc, 0x5c, 0x00, 0x71, 0xfe, 0x3f, 0x39,
-		0x41, 0xcb, 0x41, 0xc8, 0xb2, 0x11, 0xb0, 0x5c, 0x3e, 0x1e, 0x3b, 0xc1, 0xca, 0xee, 0xd0, 0x71,
-		0x12, 0xa8, 0xe5, 0x02, 0x67, 0xd4, 0xf9, 0xf0, 0x95, 0xc8, 0xd3, 0x20, 0xdf, 0x51, 0x49, 0x60,
-		0x28, 0x1c, 0x9d, 0x78, 0xad, 0x97, 0x14, 0xdc, 0x07, 0x67, 0x60, 0xbb, 0xbe, 0xe5, 0xdc, 0x86,
-		0x0a, 0x2d, 0x30, 0x2c, 0x47, 0x79, 0x91, 0x2f, 0x1e, 0xe5, 0xaf, 0x47, 0x2c, 0x84, 0xaf, 0xdd,
-		0x19, 0x8e, 0x69, 0x2b, 0x53, 0xfb, 0xfa, 0xa8, 0x05, 0x77, 0x96, 0xdf, 0x73, 0x2e, 0xae, 0x66,
-		0x29, 0xed, 0xd4, 0xd7, 0xc7, 0x73, 0xe4, 0x89, 0x2d, 0x36, 0xc5, 0x19, 0x93, 0x62, 0x8f, 0x45,
-		0x49, 0xc2, 0x5e, 0xd6, 0xd8, 0x53, 0x31, 0x98, 0x97, 0x29, 0xd6, 0x54, 0x6e, 0xe6, 0x9e, 0x91,
-		0xdf, 0x12, 0xe0, 0xb9, 0xf8, 0xf8, 0xae, 0xad, 0xe4, 0xbd, 0xa4, 0x14, 0x41, 0x11, 0x3c, 0x98,
-		0xb8, 0x6e, 0xd8, 0x56, 0x5e, 0x8c, 0x47, 0xef, 0xf0, 0x8d, 0xda, 0xdf, 0xed, 0x38, 0x44, 0xc1,
-		0x8c, 0x53, 0x3f, 0x2f, 0x2d, 0x41, 0xcb, 0x1f, 0x4a, 0xf2, 0x86, 0x04, 0x8a, 0x28, 0x7d, 0x7c,
-		0x2d, 0x9f, 0x3c, 0x67, 0x5f, 0xeb, 0x6c, 0x9f, 0xcc, 0xe8, 0x4d, 0x50, 0xed, 0x0a, 0x89, 0xdd,
-		0x90, 0x6d, 0x41, 0xd2, 0xdf, 0xce, 0x0c, 0xb7, 0xb2, 0x32, 0x98, 0xa2, 0x98, 0x6c, 0xb7, 0x30,
-		0xf1, 0x4d, 0xe2, 0x71, 0x32, 0x2e, 0x66, 0x3e, 0x88, 0x96, 0x1b, 0x8a, 0x51, 0x40, 0x2e, 0x32,
-		0x68, 0x45, 0xe5, 0x39, 0x91, 0x43, 0x25, 0x72, 0xb7, 0x87, 0x12, 0xfa, 0xc8, 0x2a, 0x9f, 0xbc,
-		0xac, 0x4f, 0xc5, 0x1c, 0x4f, 0x8e, 0x87, 0xea, 0xa6, 0x0a, 0xd4, 0x20, 0xd0, 0x03, 0xcf, 0x70,
-		0xfc, 0xfb, 0x49, 0x11, 0xac, 0x9c, 0xcb, 0x3e, 0xdd, 0x87, 0xcf, 0x4f, 0x91, 0x73, 0xd5, 0xde,
-		0xab, 0xa1, 0x31, 0xb6, 0x03, 0x12, 0x24, 0x55, 0x69, 0x56, 0x0a, 0xf5, 0x2d, 0x68, 0xf2, 0x97,
-		0xc9, 0xb8, 0x20, 0x4a, 0xee, 0x87, 0x9c, 0xeb, 0xa1, 0x86, 0x74, 0x6c, 0x5c, 0x0e, 0x1b, 0x3e,
-		0xe3, 0xe0, 0x6a, 0x8a, 0x8d, 0xe6, 0x92, 0xe5, 0xf3, 0x2e, 0x94, 0x30, 0x3d, 0x6a, 0x51, 0x6c,
-		0xb7, 0x58, 0x36, 0x09, 0xb2, 0x73, 0x89, 0x6b, 0x90, 0x12, 0xb2, 0xfd, 0x1c, 0x35, 0x44, 0x93,
-		0x82, 0x8e, 0xd4, 0xc4, 0x34, 0x77, 0xb9, 0x46, 0xbe, 0x72, 0x8c, 0x84, 0x94, 0x25, 0x4b, 0x8d,
-		0xce, 0x64, 0xc9, 0xda, 0xad, 0xb3, 0xf6, 0x59, 0xe7, 0xa4, 0x75, 0x76, 0x8c, 0xb5, 0x2b, 0x19,
-		0x09, 0xd2, 0x2f, 0x54, 0x51, 0x33, 0x90, 0xe4, 0x0c, 0xa4, 0x38, 0x43, 0xd0, 0xfe, 0xfa, 0xb7,
-		0x5f, 0xb5, 0xf6, 0x69, 0xa7, 0xd5, 0x9d, 0x14, 0xbe, 0xfa, 0x14, 0x18, 0x81, 0xb2, 0x95, 0xef,
-		0x6b, 0x31, 0xdb, 0xa1, 0x9d, 0x8f, 0x03, 0x77, 0x21, 0x75, 0x62, 0xcb, 0x22, 0xfc, 0x5c, 0xac,
-		0xb5, 0x6c, 0x90, 0x3f, 0xc3, 0x32, 0x41, 0x43, 0x90, 0x6a, 0x08, 0x6a, 0x9a, 0xf4, 0xf1, 0xd6,
-		0x0d, 0x74, 0x77, 0xa0, 0x0f, 0xdc, 0xfb, 0x51, 0xc4, 0x63, 0x99, 0xba, 0xad, 0x8c, 0x61, 0x38,
-		0xf8, 0x53, 0x51, 0x0c, 0x63, 0x0e, 0x56, 0x45, 0x39, 0xa1, 0xe2, 0x34, 0xe9, 0xf0, 0xf5, 0x74,
-		0xc0, 0x32, 0xa1, 0xe9, 0x50, 0xc8, 0x01, 0xa8, 0x01, 0xa8, 0x01, 0xa8, 0x77, 0x07, 0x50, 0x7f,
-		0x75, 0x5d, 0x5b, 0x19, 0x0e, 0x21, 0xa2, 0x6e, 0x36, 0x61, 0xa8, 0xca, 0x6b, 0xa8, 0xee, 0x83,
-		0x31, 0x9d, 0x91, 0x0a, 0x07, 0x83, 0x46, 0x87, 0x46, 0x87, 0x46, 0x2f, 0x91, 0x46, 0x07, 0x45,
-		0x9a, 0x8a, 0x6f, 0xe3, 0xa4, 0x48, 0x9b, 0xad, 0x53, 0xb0, 0xa4, 0x44, 0x92, 0xb2, 0xb8, 0x6a,
-		0x60, 0x49, 0x65, 0xd7, 0x0e, 0x1c, 0xc8, 0xbe, 0xb3, 0xa4, 0xad, 0x76, 0xa7, 0xd1, 0xd5, 0xa2,
-		0x04, 0x31, 0x47, 0x05, 0x49, 0x0a, 0xe8, 0x41, 0x92, 0xfb, 0xd9, 0xd1, 0xaa, 0x17, 0x57, 0x0f,
-		0x9d, 0x9a, 0xf6, 0x69, 0xa4, 0x06, 0xd6, 0xd0, 0x1a, 0x44, 0x4e, 0x78, 0xcf, 0x49, 0x86, 0xfb,
-		0xa4, 0xa2, 0xdd, 0xaf, 0x1d, 0x83, 0x42, 0xe5, 0xf5, 0x92, 0xd6, 0x7a, 0x4b, 0xd4, 0x6b, 0x08,
-		0xdd, 0x02, 0xd8, 0x2a, 0xf1, 0xc9, 0xdd, 0xcd, 0xe0, 0x8c, 0x93, 0x1a, 0x4b, 0x9c, 0xbe, 0xe9,
-		0x28, 0xeb, 0xf6, 0xee, 0xab, 0xeb, 0xf9, 0xf9, 0x33, 0x38, 0x67, 0x43, 0x21, 0x89, 0x13, 0x49,
-		0x9c, 0x85, 0x00, 0xfe, 0x2d, 0x4b, 0xe2, 0x9c, 0x4a, 0x0c, 0x1d, 0x5d, 0x97, 0x8c, 0x48, 0xc3,
-		0xd9, 0x35, 0xc1, 0xd9, 0x81, 0xb3, 0xdb, 0x47, 0xce, 0x8e, 0xea, 0x54, 0x7e, 0xde, 0xe3, 0x11,
-		0xcf, 0x6e, 0xde, 0x5c, 0xc7, 0x25, 0x98, 0xc4, 0x9d, 0x5c, 0xec, 0x39, 0xc4, 0x9f, 0x4d, 0x0d,
-		0x48, 0xe2, 0x55, 0x14, 0xf5, 0x60, 0x82, 0x98, 0x65, 0x2d, 0xea, 0x61, 0x31, 0x96, 0xf4, 0x20,
-		0xaf, 0xe3, 0xc0, 0x54, 0xd4, 0x1d, 0x05, 0x33, 0x24, 0xd5, 0x8d, 0x98, 0xda, 0x11, 0x53, 0x3f,
-		0x12, 0x6a, 0x88, 0x56, 0x1d, 0x11, 0xab, 0xa5, 0xe4, 0x06, 0xb0, 0x15, 0x61, 0x5f, 0xdb, 0x1c,
-		0x4b, 0x77, 0x5c, 0xfd, 0xbf, 0xae, 0xc3, 0x51, 0x54, 0x81, 0xb3, 0x09, 0x16, 0x7b, 0x93, 0x2b,
-		0x34, 0xb1, 0x7a, 0xf9, 0xe6, 0xd0, 0x37, 0xa9, 0x62, 0xba, 0xd4, 0xd9, 0xfd, 0xef, 0xf6, 0x7a,
-		0xf5, 0xfe, 0xdb, 0x4a, 0xd9, 0xeb, 0x4b, 0x6c, 0x59, 0xbd, 0x73, 0x36, 0xa2, 0x9b, 0x4f, 0x6b,
-		0x53, 0x46, 0xc2, 0x27, 0xcd, 0xc3, 0x8d, 0x47, 0xe5, 0x25, 0xed, 0x06, 0xd9, 0x7c, 0xc1, 0x35,
-		0x73, 0xc1, 0x37, 0x84, 0x6f, 0x08, 0xdf, 0x70, 0x6f, 0x7c, 0xc3, 0x4b, 0xc3, 0x31, 0x8d, 0xc0,
-		0xf5, 0x1e, 0x19, 0x2a, 0x1e, 0xf2, 0xfb, 0x9d, 0xa3, 0xbb, 0x47, 0x9f, 0xb1, 0x29, 0xeb, 0x96,
-		0xfb, 0x9b, 0xf3, 0x8e, 0x62, 0xeb, 0xa9, 0xda, 0x5d, 0x7c, 0x5e, 0x7b, 0xcb, 0xd1, 0xc7, 0x13,
-		0xae, 0xcb, 0xb6, 0xb9, 0x2e, 0xa8, 0xfd, 0x9f, 0x2b, 0xe6, 0x9f, 0x84, 0xc1, 0x93, 0x47, 0xb9,
-		0xd2, 0x00, 0xe8, 0x97, 0x87, 0x60, 0x69, 0x28, 0xf9, 0x48, 0x7a, 0x1e, 0x92, 0xd8, 0xc7, 0x44,
-		0x38, 0x03, 0xe1, 0x0c, 0x69, 0x5f, 0xb1, 0x5c, 0x4a, 0x98, 0xdc, 0x6f, 0x63, 0x6c, 0xd2, 0xc8,
-		0xd1, 0x94, 0x71, 0xbe, 0x09, 0xe3, 0xa4, 0xc5, 0x43, 0xdc, 0x8a, 0x71, 0xda, 0x0a, 0x62, 0x97,
-		0x54, 0xfb, 0xa4, 0xdd, 0x32, 0xb9, 0x76, 0x9f, 0x0c, 0x5b, 0xf2, 0x78, 0x75, 0x0b, 0x0a, 0x1e,
-		0x0a, 0x7e, 0x0f, 0x15, 0x3c, 0xe2, 0xd5, 0xe0, 0x24, 0x99, 0xd5, 0x0c, 0xb7, 0xba, 0x11, 0x53,
-		0x3b, 0x62, 0xea, 0x47, 0x42, 0x0d, 0xd1, 0xd3, 0x07, 0x1a, 0xe2, 0xd5, 0x1b, 0x38, 0x2e, 0x88,
-		0x57, 0x23, 0x5e, 0x8d, 0x78, 0xb5, 0x3c, 0xb7, 0x49, 0xe8, 0x36, 0x5b, 0x7e, 0xdc, 0x23, 0x9e,
-		0xd1, 0xd3, 0x49, 0xa6, 0x80, 0xc3, 0x03, 0x87, 0x07, 0x0e, 0x0f, 0x1c, 0x1e, 0xb2, 0xdd, 0xae,
-		0xee, 0x47, 0xc1, 0x23, 0xa7, 0x87, 0x73, 0x84, 0xcc, 0x1c, 0xd2, 0x05, 0x43, 0x66, 0x0e, 0x8c,
-		0x02, 0x8c, 0x02, 0x32, 0x73, 0x90, 0x99, 0xb3, 0x8b, 0xc8, 0x7a, 0x07, 0x32, 0x73, 0x4a, 0x69,
-		0xa1, 0xa7, 0x29, 0x18, 0x3a, 0x6d, 0x54, 0x6b, 0x65, 0x77, 0x2f, 0xcd, 0x03, 0xcb, 0x0c, 0xcb,
-		0x0c, 0xcb, 0x0c, 0xb8, 0x46, 0x07, 0xd7, 0x9c, 0xf1, 0xbd, 0x22, 0xef, 0x4e, 0xb0, 0x62, 0x3c,
-		0xdb, 0x0c, 0x63, 0x7f, 0x70, 0xc6, 0xf7, 0x0c, 0xf9, 0x8e, 0xe8, 0x21, 0xbd, 0xe6, 0x47, 0xa8,
-		0x87, 0x74, 0xfb, 0xb4, 0xd3, 0xec, 0x6a, 0x7f, 0xc4, 0x36, 0x4f, 0x7b, 0x6f, 0xf9, 0x03, 0xf7,
-		0x41, 0x79, 0x8f, 0x71, 0x6b, 0x60, 0xed, 0x61, 0xa9, 0xd6, 0xd8, 0xa4, 0xba, 0xd8, 0xb4, 0xb0,
-		0xd8, 0x49, 0xfd, 0xa8, 0xde, 0x42, 0xe7, 0xe8, 0x72, 0x2b, 0xf5, 0xb5, 0xca, 0x9d, 0x6a, 0xed,
-		0xd1, 0x2f, 0x7a, 0x0f, 0x5c, 0x6f, 0xd7, 0xb3, 0x6e, 0x39, 0x2a, 0xef, 0x26, 0x0e, 0xe0, 0x64,
-		0x7c, 0xb8, 0xda, 0x70, 0xb5, 0xe1, 0x6a, 0xc3, 0xd5, 0xa6, 0x87, 0xf2, 0x2c, 0x0a, 0x66, 0x5b,
-		0xdd, 0x6d, 0x1c, 0x2f, 0x4a, 0x35, 0xae, 0xe8, 0xf1, 0xa2, 0x09, 0xe7, 0x54, 0x96, 0x14, 0xf4,
-		0x42, 0xeb, 0xb6, 0xfd, 0xff, 0xea, 0x91, 0x24, 0x45, 0xb4, 0xf2, 0xd1, 0xf2, 0x83, 0xf3, 0x20,
-		0x20, 0xaa, 0x02, 0x77, 0x69, 0x39, 0x1f, 0x6c, 0x15, 0x2a, 0x6e, 0xa2, 0x9a, 0xf0, 0x95, 0x4b,
-		0xe3, 0xdb, 0xdc, 0x88, 0xcd, 0xd3, 0x76, 0xbb, 0x73, 0xd2, 0x6e, 0x37, 0x4e, 0x8e, 0x4e, 0x1a,
-		0x67, 0xc7, 0xc7, 0xcd, 0x4e, 0x93, 0xa0, 0xb2, 0x7d, 0xe5, 0x4f, 0xcf, 0x54, 0x9e, 0x32, 0x7f,
-		0x09, 0xef, 0xa9, 0x33, 0xb6, 0xed, 0x9d, 0x2a, 0x9f, 0x2c, 0x29, 0xa2, 0x15, 0x92, 0x73, 0x19,
-		0xde, 0x78, 0x10, 0x38, 0xb1, 0x95, 0xba, 0x98, 0x4e, 0xf9, 0xe5, 0xd3, 0xdc, 0xfc, 0x5f, 0x2e,
-		0x46, 0x0f, 0x9d, 0x2f, 0x53, 0x70, 0x56, 0x41, 0x15, 0xe8, 0x72, 0xed, 0x87, 0x32, 0x17, 0x82,
-		0xce, 0x17, 0x20, 0x21, 0x39, 0xe6, 0x43, 0x56, 0x00, 0xba, 0x85, 0x02, 0xd0, 0x7c, 0x20, 0x08,
-		0x05, 0xa0, 0x93, 0x0b, 0xcf, 0x5d, 0x00, 0xda, 0x1c, 0x8f, 0xa2, 0x98, 0xb8, 0x6e, 0xaa, 0x40,
-		0x0d, 0x02, 0x3d, 0xf0, 0x0c, 0xc7, 0xbf, 0x9f, 0x74, 0x8e, 0x21, 0xaa, 0x08, 0xfd, 0xfc, 0x14,
-		0x65, 0xea, 0x3b, 0xda, 0x44, 0xd3, 0x51, 0x26, 0x56, 0x07, 0xe5, 0xae, 0x51, 0xee, 0x5a, 0x8e,
-		0x55, 0x41, 0x8b, 0xba, 0xac, 0xf8, 0x90, 0xaf, 0xd9, 0x19, 0xfa, 0xd3, 0x31, 0x90, 0x91, 0xe8,
-		0x4f, 0x27, 0xbd, 0x76, 0xe8, 0x21, 0xb5, 0xef, 0xfd, 0xe9, 0xda, 0xa7, 0x9d, 0x56, 0x57, 0xbb,
-		0xb8, 0x7a, 0xe8, 0x68, 0x9f, 0x42, 0x98, 0x69, 0x2b, 0xdf, 0xd7, 0xce, 0x27, 0x29, 0xa5, 0xda,
-		0xf9, 0x38, 0x70, 0x27, 0x78, 0x7d, 0x4c, 0x9e, 0x27, 0x83, 0x16, 0x74, 0x9b, 0x7a, 0x43, 0x19,
-		0x96, 0x09, 0x1a, 0x82, 0x8a, 0x76, 0xcb, 0x41, 0x36, 0x28, 0x27, 0xd4, 0x27, 0x26, 0x1d, 0xec,
-		0x9c, 0x0e, 0x58, 0x26, 0x90, 0x19, 0xee, 0x7d, 0xe0, 0x4c, 0xe0, 0x4c, 0xe0, 0xcc, 0xdd, 0xc1,
-		0x99, 0x5f, 0x5d, 0xd7, 0x56, 0x86, 0x43, 0x08, 0x34, 0x9b, 0xcd, 0x2d, 0xd4, 0xdf, 0xf7, 0xc1,
-		0x98, 0x4e, 0x77, 0x87, 0x83, 0x41, 0xd1, 0x41, 0xd1, 0x41, 0xd1, 0x81, 0x50, 0x03, 0xa1, 0xb6,
-		0xca, 0xce, 0x34, 0x5b, 0xa7, 0xe0, 0xd4, 0xc0, 0xa9, 0x81, 0x53, 0x03, 0xa7, 0xb6, 0xf5, 0x9c,
-		0x5a, 0xab, 0xdd, 0x69, 0x74, 0xb5, 0x28, 0x9b, 0xc8, 0x51, 0x81, 0x76, 0xe5, 0xb9, 0x81, 0x3b,
-		0x70, 0xed, 0x03, 0xed, 0xf3, 0xd2, 0x39, 0x0e, 0xed, 0xd3, 0x48, 0x0d, 0xac, 0xa1, 0x35, 0x88,
-		0x58, 0x9b, 0xc9, 0xa9, 0x8e, 0xe8, 0x67, 0x7a, 0xb4, 0xe3, 0x18, 0x84, 0x5b, 0x41, 0x84, 0x1b,
-		0xe5, 0x1a, 0x42, 0xb7, 0x50, 0xa1, 0x39, 0x24, 0xc1, 0x2d, 0x24, 0xc1, 0xe5, 0x48, 0x52, 0x96,
-		0x49, 0x80, 0x1b, 0x3b, 0xce, 0xf8, 0xfe, 0xab, 0xf2, 0x72, 0xb0, 0xa0, 0x33, 0x67, 0x7d, 0x36,
-		0x56, 0xc1, 0xa9, 0x70, 0x8d, 0x92, 0xa4, 0xc2, 0x0d, 0x91, 0x0a, 0x97, 0x05, 0x08, 0x0f, 0xf7,
-		0x26, 0x15, 0x8e, 0xa8, 0x51, 0x3a, 0x6d, 0x83, 0x74, 0xa2, 0x42, 0xf3, 0xe5, 0xe7, 0xb1, 0x86,
-		0xe0, 0xb1, 0x38, 0x78, 0xac, 0xe1, 0xb6, 0xf3, 0x58, 0x54, 0x85, 0xe1, 0xc9, 0x02, 0x8c, 0x2b,
-		0xbb, 0x97, 0x26, 0xd0, 0x38, 0xfb, 0xc2, 0x84, 0x01, 0xc7, 0x64, 0xd0, 0x08, 0xb5, 0xd3, 0xb8,
-		0xd6, 0x7d, 0xb4, 0x48, 0x2a, 0x8d, 0xc2, 0x93, 0x44, 0xab, 0x3b, 0xd2, 0x41, 0x63, 0x88, 0x16,
-		0x49, 0x69, 0x77, 0x2b, 0x5d, 0x84, 0x73, 0xc5, 0xab, 0x69, 0xee, 0xc5, 0x59, 0x5c, 0xf6, 0x1e,
-		0x8c, 0xdb, 0x7e, 0x68, 0x76, 0x7b, 0x4e, 0x56, 0xce, 0x70, 0x35, 0x49, 0x4f, 0xc5, 0x62, 0x62,
-		0xf5, 0xc9, 0x77, 0xd2, 0x3d, 0x35, 0xa4, 0x03, 0x3c, 0x8b, 0xc3, 0x02, 0xf7, 0x00, 0xf7, 0x00,
-		0xf7, 0x14, 0x8f, 0x7b, 0x88, 0x68, 0x0d, 0x1e, 0x7a, 0x83, 0x58, 0xdc, 0x81, 0x06, 0x80, 0x06,
-		0x80, 0x06, 0x78, 0xfa, 0xe9, 0x4d, 0x8d, 0x3b, 0x63, 0xb3, 0x99, 0x64, 0x0a, 0x94, 0x54, 0x13,
-		0x29, 0xa9, 0x36, 0x44, 0x49, 0xb5, 0x02, 0x95, 0x91, 0x84, 0x52, 0xa2, 0x55, 0x4e, 0xc4, 0x4a,
-		0x8a, 0x8f, 0xba, 0x58, 0xd9, 0xed, 0xf4, 0x5d, 0x9e, 0x57, 0x3c, 0x97, 0x13, 0x9e, 0xb2, 0xff,
-		0x31, 0x82, 0x0d, 0xb7, 0x45, 0x77, 0x0e, 0x99, 0x2e, 0xbd, 0x10, 0x3f, 0x8f, 0x6a, 0xf3, 0x94,
-		0x7a, 0xa9, 0x99, 0x28, 0x16, 0x39, 0xaa, 0x85, 0x5e, 0xa2, 0x28, 0xf3, 0x17, 0xe7, 0x09, 0x0b,
-		0x3e, 0x43, 0xbd, 0x30, 0x0b, 0x6c, 0x35, 0x6c, 0x35, 0x6c, 0x35, 0x6c, 0x35, 0x6c, 0xf5, 0x86,
-		0xb6, 0xfa, 0x66, 0x66, 0xab, 0xff, 0x31, 0x18, 0x7b, 0x9e, 0x72, 0x82, 0x6a, 0xed, 0xb0, 0x5e,
-		0x9f, 0xb1, 0xd0, 0xfd, 0xf8, 0x23, 0x8b, 0x64, 0xf4, 0xea, 0x6b, 0xc9, 0xc8, 0xa6, 0xfa, 0x06,
-		0xb3, 0xbf, 0x6d, 0x66, 0x1f, 0x65, 0x68, 0xa9, 0x22, 0x31, 0x0b, 0x81, 0x07, 0x92, 0xb8, 0x0c,
-		0xdd, 0x22, 0x3d, 0x91, 0x14, 0xde, 0xa4, 0x6c, 0x1d, 0x45, 0x52, 0x29, 0xf1, 0x59, 0xd5, 0x4a,
-		0x4d, 0xe0, 0xb6, 0x40, 0xe0, 0x6e, 0x8f, 0x3f, 0x06, 0x02, 0x17, 0x04, 0x2e, 0x40, 0x21, 0x40,
-		0x21, 0x40, 0x21, 0x40, 0x21, 0x40, 0x61, 0x39, 0x08, 0x5c, 0xf0, 0x94, 0x30, 0x49, 0x30, 0x49,
-		0x30, 0x49, 0x30, 0x49, 0x30, 0x49, 0xbb, 0xc7, 0x53, 0x82, 0x8e, 0x2b, 0x3b, 0x1d, 0x87, 0xde,
-		0x50, 0x5c, 0xab, 0x5a, 0xc0, 0x6a, 0xca, 0xb6, 0x11, 0xfa, 0x67, 0x72, 0x15, 0x5f, 0x92, 0xb7,
-		0x5d, 0xab, 0xe1, 0x36, 0xa6, 0xdc, 0xd3, 0x50, 0xb8, 0xa4, 0xd4, 0x2d, 0x79, 0x8a, 0x7d, 0x0b,
-		0x29, 0xf6, 0xc5, 0xbb, 0x9c, 0x48, 0xb1, 0xdf, 0xf8, 0x0b, 0xe1, 0x68, 0x31, 0xc5, 0xa0, 0x38,
-		0x5a, 0x5c, 0x06, 0x2c, 0x8e, 0x58, 0x94, 0x38, 0xd6, 0xc6, 0xd1, 0xe2, 0xfc, 0xbb, 0xb5, 0xfc,
-		0x47, 0x8b, 0x01, 0x39, 0xe4, 0x21, 0x07, 0x01, 0x64, 0x44, 0xd9, 0x34, 0xfa, 0x75, 0xa9, 0xe4,
-		0xc2, 0x3f, 0xe9, 0x41, 0x9f, 0x58, 0xad, 0xb6, 0x37, 0x8c, 0x2b, 0x9d, 0x77, 0x85, 0xf9, 0x56,
-		0xb6, 0x92, 0xa9, 0x47, 0xeb, 0x86, 0xcb, 0x98, 0x6e, 0xed, 0x36, 0x5f, 0x81, 0x14, 0x77, 0x3f,
-		0x23, 0xe6, 0xce, 0x85, 0xb1, 0x33, 0x62, 0xea, 0xcc, 0x18, 0x3a, 0x8f, 0x2b, 0x99, 0xdb, 0x65,
-		0xcc, 0xeb, 0x1a, 0x92, 0xb9, 0x80, 0x64, 0xae, 0x1e, 0x85, 0x4b, 0xc7, 0xab, 0x4d, 0xb2, 0x62,
-		0xd6, 0x8a, 0x61, 0xde, 0x5b, 0x8e, 0x1e, 0xee, 0xe9, 0xb1, 0x9f, 0xbf, 0xbc, 0xe4, 0xc2, 0x68,
-		0xf9, 0x0a, 0x4c, 0x36, 0x50, 0x60, 0x12, 0x05, 0x26, 0xb7, 0xc1, 0x55, 0xbb, 0x34, 0x1c, 0xd3,
-		0x08, 0x5c, 0xef, 0x31, 0x47, 0x55, 0xd4, 0xdc, 0x48, 0x6a, 0x8e, 0x1a, 0x1a, 0xdf, 0xab, 0xdc,
-		0x7d, 0xe6, 0x12, 0x7b, 0xd5, 0xce, 0x31, 0xc6, 0x07, 0x67, 0x7c, 0x9f, 0x3d, 0xd3, 0x3e, 0xeb,
-		0x72, 0x10, 0x16, 0x4d, 0x27, 0x2c, 0x96, 0x4e, 0x48, 0x5d, 0x47, 0x85, 0xb5, 0x4f, 0x3b, 0x47,
-		0x5d, 0xed, 0xaf, 0x3b, 0xa5, 0x25, 0xbe, 0x96, 0xaf, 0xfd, 0xee, 0xb9, 0xe3, 0x91, 0x76, 0x79,
-		0xf1, 0x8b, 0xa6, 0x6b, 0xd6, 0xf0, 0x3c, 0x54, 0xc5, 0x9f, 0xf2, 0x68, 0x62, 0x4e, 0xba, 0x87,
-		0xb3, 0xde, 0xb9, 0x0c, 0xd5, 0x9d, 0x61, 0x19, 0x8a, 0xe6, 0x0c, 0x32, 0x7f, 0xba, 0x5f, 0xe2,
-		0xea, 0xd8, 0x03, 0x77, 0x1c, 0xde, 0x7b, 0x02, 0xe7, 0x25, 0x19, 0x09, 0x95, 0xb1, 0xe1, 0xb8,
-		0xec, 0x81, 0xe3, 0x92, 0xbb, 0x32, 0xb6, 0xe5, 0xe8, 0x5f, 0x3d, 0xd7, 0x30, 0x07, 0x86, 0x1f,
-		0xe8, 0xa3, 0xbf, 0x03, 0x9f, 0xb2, 0x66, 0xdc, 0xf2, 0xd0, 0xe8, 0xfb, 0xc6, 0x2e, 0xb4, 0x12,
-		0xc6, 0x1e, 0x41, 0xed, 0x8c, 0x9f, 0x2e, 0x5d, 0xdf, 0xb7, 0xd8, 0x5c, 0x76, 0xda, 0x84, 0xad,
-		0xdf, 0x4e, 0xd1, 0xfa, 0x2d, 0xe5, 0xa0, 0xd3, 0x26, 0x62, 0xe8, 0xfb, 0x46, 0x24, 0x29, 0x4b,
-		0xd8, 0x9e, 0xb1, 0xef, 0x5b, 0xf3, 0xb4, 0xdd, 0xee, 0x9c, 0xb4, 0xdb, 0x8d, 0x93, 0xa3, 0x93,
-		0xc6, 0xd9, 0xf1, 0x71, 0xb3, 0xd3, 0x44, 0x07, 0x38, 0x1a, 0x6d, 0x49, 0x37, 0x0a, 0x3a, 0xc0,
-		0x89, 0xea, 0xc8, 0x34, 0x24, 0xc7, 0x5c, 0x9b, 0x30, 0xcd, 0x1a, 0xfe, 0x7f, 0xbf, 0x5e, 0x38,
-		0xbf, 0x4c, 0x5d, 0xc6, 0xab, 0xfc, 0x1e, 0x23, 0xa7, 0x43, 0x24, 0xc1, 0x82, 0xb0, 0xfb, 0x48,
-		0x99, 0x59, 0x91, 0xd7, 0xd6, 0x0d, 0x3a, 0x84, 0x68, 0xfe, 0x7c, 0xb5, 0xc0, 0x75, 0xd3, 0xf2,
-		0x07, 0x86, 0x67, 0xd2, 0xa2, 0xba, 0x64, 0x50, 0xe0, 0x39, 0xe0, 0x39, 0xe0, 0x39, 0xe0, 0x39,
-		0xe0, 0x39, 0xe0, 0x39, 0xe0, 0x39, 0xe0, 0x39, 0xe0, 0xb9, 0x7d, 0xc7, 0x73, 0x9a, 0x35, 0xbc,
-		0x70, 0xde, 0xd3, 0xf8, 0x87, 0x40, 0x6f, 0x5c, 0xe8, 0x6d, 0x71, 0x95, 0xa0, 0x1f, 0xca, 0x81,
-		0xd5, 0x94, 0xe7, 0xb9, 0x1e, 0x2d, 0x52, 0x8b, 0x87, 0x04, 0x4e, 0x03, 0x4e, 0x03, 0x4e, 0x03,
-		0x4e, 0x03, 0x4e, 0x03, 0x4e, 0x03, 0x4e, 0x03, 0x4e, 0x03, 0x4e, 0x03, 0x4e, 0x1b, 0x5e, 0x38,
-		0x1f, 0x28, 0xbc, 0x43, 0xa0, 0x34, 0x56, 0x94, 0x16, 0xaf, 0x11, 0x74, 0x43, 0x39, 0x30, 0xda,
-		0xfd, 0xd8, 0x0e, 0x2c, 0x9e, 0x5c, 0xc9, 0xa5, 0xa1, 0x81, 0xd9, 0x80, 0xd9, 0x80, 0xd9, 0x80,
-		0xd9, 0x80, 0xd9, 0x80, 0xd9, 0x80, 0xd9, 0x80, 0xd9, 0x80, 0xd9, 0xf6, 0x1d, 0xb3, 0xad, 0xe6,
-		0xdc, 0x5d, 0x4e, 0x5d, 0x46, 0xe4, 0x4a, 0x96, 0x18, 0xc7, 0xbd, 0xb6, 0x6e, 0xd0, 0x21, 0xe5,
-		0xc0, 0x76, 0xee, 0x20, 0x50, 0xc4, 0x98, 0x2e, 0x1e, 0x12, 0x58, 0x0e, 0x58, 0x0e, 0x58, 0x0e,
-		0x58, 0x0e, 0x58, 0x0e, 0x58, 0x0e, 0x58, 0x0e, 0x58, 0x0e, 0x58, 0x6e, 0xdf, 0xb1, 0x5c, 0x0c,
-		0x04, 0xfe, 0xa4, 0xf0, 0x0f, 0x81, 0xdc, 0xb8, 0x90, 0xdb, 0xe2, 0x2a, 0x41, 0x3f, 0x94, 0x03,
-		0xa7, 0x8d, 0x1d, 0xa6, 0x08, 0xdc, 0xc2, 0xc0, 0xc0, 0x6c, 0xc0, 0x6c, 0xc0, 0x6c, 0xc0, 0x6c,
-		0xc0, 0x6c, 0xc0, 0x6c, 0xc0, 0x6c, 0xc0, 0x6c, 0xc0, 0x6c, 0xc0, 0x6c, 0x11, 0x1a, 0xf8, 0x27,
-		0x02, 0x6e, 0xdb, 0x01, 0xdb, 0xfe, 0x89, 0x08, 0x5b, 0xe9, 0x90, 0xdb, 0xdf, 0x8e, 0xfb, 0x1f,
-		0x47, 0x1f, 0x79, 0x6e, 0xe0, 0x52, 0x63, 0xb7, 0x85, 0xa1, 0x81, 0xde, 0x80, 0xde, 0x80, 0xde,
-		0xca, 0x87, 0xde, 0x8e, 0x5a, 0x84, 0xe8, 0xed, 0x04, 0xe8, 0x0d, 0xe8, 0x6d, 0x5f, 0xd0, 0x5b,
-		0xbb, 0x75, 0xd6, 0x3e, 0xeb, 0x9c, 0xb4, 0xce, 0x80, 0xd9, 0x80, 0xd9, 0x80, 0xd9, 0xd2, 0x9c,
-		0xa1, 0xfa, 0xe7, 0xc4, 0x37, 0xbc, 0xa2, 0x70, 0x0d, 0x81, 0xda, 0x18, 0x51, 0xdb, 0xf2, 0x52,
-		0x41, 0x53, 0x94, 0x00, 0xb7, 0xd9, 0x86, 0x1f, 0xe8, 0x03, 0x5b, 0x19, 0x1e, 0x1d, 0x60, 0x9b,
-		0x1b, 0x13, 0x48, 0x0d, 0x48, 0x0d, 0x48, 0xad, 0x44, 0x48, 0xcd, 0x34, 0x02, 0xa5, 0x1b, 0x8e,
-		0xa9, 0x07, 0xd6, 0xbd, 0x22, 0x44, 0x6b, 0x4d, 0x8a, 0x60, 0xdb, 0x95, 0x11, 0x04, 0xca, 0x73,
-		0xc8, 0xdc, 0x92, 0x4a, 0xaf, 0x67, 0x7e, 0x6f, 0x3f, 0xe9, 0xe1, 0x9f, 0xd6, 0xf4, 0xcf, 0x5f,
-		0x93, 0x3f, 0xdd, 0x85, 0x3f, 0xd5, 0x5e, 0xaf, 0xde, 0xeb, 0x99, 0x3f, 0xd7, 0xde, 0x55, 0xff,
-		0xef, 0x8f, 0x9b, 0x5e, 0xef, 0xe7, 0x5e, 0x4f, 0xef, 0x2f, 0xbc, 0xa3, 0x56, 0xd9, 0x4b, 0xfb,
-		0xe0, 0x8e, 0x03, 0xb6, 0x16, 0x32, 0x6b, 0xc6, 0x86, 0xbd, 0x80, 0xbd, 0x80, 0xbd, 0x28, 0x1f,
-		0xb3, 0x87, 0xbc, 0x0c, 0x30, 0x7b, 0x60, 0xf6, 0xb2, 0x2c, 0x19, 0xf2, 0x32, 0xc0, 0xf1, 0x81,
-		0xe3, 0xcb, 0xce, 0xf1, 0x2d, 0x9f, 0xaf, 0xfd, 0x73, 0x1c, 0xa0, 0x89, 0xcc, 0x36, 0x30, 0x7e,
-		0xaf, 0x2e, 0x1c, 0xb4, 0x48, 0x49, 0xf0, 0x1d, 0x7d, 0x1b, 0x99, 0x85, 0x51, 0x81, 0xe9, 0x80,
-		0xe9, 0x80, 0xe9, 0x80, 0xe9, 0x80, 0xe9, 0x80, 0xe9, 0x80, 0xe9, 0x80, 0xe9, 0x80, 0xe9, 0xf6,
-		0x1d, 0xd3, 0x69, 0xd6, 0xf0, 0xcf, 0x71, 0x80, 0x46, 0x32, 0x25, 0x47, 0x70, 0x4b, 0xcb, 0x04,
-		0x0d, 0x51, 0x12, 0xbc, 0x46, 0xdd, 0x4a, 0x66, 0x6e, 0x4c, 0x60, 0x35, 0x60, 0x35, 0x60, 0x35,
-		0x60, 0x35, 0x60, 0x35, 0x60, 0x35, 0x60, 0x35, 0x60, 0x35, 0x60, 0x35, 0x60, 0xb5, 0x10, 0x04,
-		0xa0, 0x99, 0xcc, 0x16, 0x20, 0x35, 0x74, 0x93, 0x29, 0x17, 0x4e, 0xe3, 0x6a, 0x27, 0xb3, 0x66,
-		0x6c, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0,
-		0x36, 0xe0, 0xb6, 0x7d, 0xc7, 0x6d, 0x6b, 0xd2, 0xef, 0xd0, 0x50, 0x66, 0x1b, 0xb0, 0xdc, 0xab,
-		0x0b, 0x07, 0x2d, 0x52, 0x12, 0x7c, 0x47, 0xdd, 0x52, 0x66, 0x6e, 0x4c, 0xe0, 0x39, 0xe0, 0x39,
-		0xe0, 0x39, 0xe0, 0x39, 0xe0, 0x39, 0xe0, 0x39, 0xe0, 0x39, 0xe0, 0x39, 0xe0, 0xb9, 0x7d, 0xc7,
-		0x73, 0x53, 0x2c, 0x80, 0xa6, 0x32, 0xa5, 0x46, 0x6f, 0x4b, 0xcb, 0x04, 0x0d, 0x51, 0x12, 0xac,
-		0xc6, 0xd3, 0x56, 0x66, 0x65, 0x64, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36,
-		0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0x36, 0xe0, 0xb6, 0x09, 0x20, 0x40, 0x63, 0x99, 0x6d,
-		0x81, 0x6e, 0xe8, 0x2c, 0x43, 0x8f, 0xde, 0xde, 0x08, 0xea, 0x25, 0x4a, 0x7d, 0x44, 0xa8, 0x87,
-		0x08, 0x9d, 0xda, 0x70, 0x37, 0x9f, 0xb4, 0x5a, 0x47, 0x9a, 0xae, 0x9d, 0x6b, 0xff, 0x3a, 0xff,
-		0xe3, 0x77, 0xed, 0xbd, 0x11, 0x18, 0xda, 0xa5, 0x6b, 0x2a, 0x5b, 0x1b, 0xba, 0xde, 0x6c, 0x83,
-		0xf7, 0x9c, 0x4b, 0xc3, 0x31, 0x6e, 0x55, 0x24, 0x07, 0xe5, 0xc6, 0x5c, 0xd4, 0x8a, 0x46, 0x06,
-		0x76, 0x65, 0x5c, 0x8a, 0xa2, 0x71, 0xda, 0x1b, 0x59, 0x0d, 0x92, 0x55, 0x92, 0xcf, 0x1d, 0xc7,
-		0x0d, 0x8c, 0xd0, 0x37, 0xcc, 0x27, 0xc5, 0xfe, 0xe0, 0x4e, 0xdd, 0x1b, 0x23, 0x23, 0xb8, 0x0b,
-		0xd7, 0xec, 0xd0, 0x1d, 0x29, 0x67, 0x10, 0x71, 0x24, 0xba, 0x95, 0x98, 0x82, 0xc3, 0x75, 0x0f,
-		0x0f, 0xfd, 0xf1, 0xd7, 0xb9, 0xd7, 0xe7, 0x9f, 0x1d, 0xfa, 0x81, 0x11, 0xa8, 0xc3, 0x18, 0x60,
-		0xe6, 0x31, 0xeb, 0x15, 0x3f, 0xf0, 0xc6, 0x83, 0xc0, 0x89, 0xa5, 0x3b, 0xd9, 0x31, 0x5f, 0x3e,
-		0xcd, 0x4d, 0xf7, 0xe5, 0xd7, 0xe9, 0x44, 0x6f, 0x64, 0x56, 0x3c, 0xc3, 0x9a, 0x55, 0x4c, 0xe5,
-		0x0f, 0x3c, 0x6b, 0x94, 0x6b, 0xc1, 0x66, 0xa5, 0xaa, 0xe7, 0x06, 0xcb, 0xb8, 0x7f, 0xf2, 0xf1,
-		0x69, 0xb9, 0x79, 0x34, 0x0a, 0xfe, 0x8c, 0x8c, 0x37, 0xa3, 0xd2, 0xdd, 0xe4, 0x3c, 0x19, 0xb9,
-		0xa2, 0xa6, 0xe4, 0xc5, 0x64, 0x3d, 0x97, 0xdc, 0xfc, 0x57, 0xb2, 0x5b, 0xfc, 0xc0, 0xb3, 0x9c,
-		0xdb, 0x3c, 0xdb, 0x25, 0x29, 0xec, 0x0e, 0xdf, 0x8d, 0xda, 0x77, 0xdb, 0x00, 0x89, 0x9c, 0xdb,
-		0x96, 0xe1, 0xc3, 0x63, 0x63, 0xf2, 0xd8, 0x36, 0x5e, 0x80, 0x7d, 0xf3, 0xd3, 0x44, 0xbc, 0x04,
-		0xe5, 0x84, 0xb2, 0x6d, 0xe6, 0xf7, 0x10, 0xa6, 0x03, 0x65, 0xd4, 0x35, 0xef, 0xd5, 0xd0, 0x18,
-		0xdb, 0x41, 0x2e, 0xed, 0x50, 0x09, 0x77, 0x69, 0xb6, 0x6d, 0xd2, 0x87, 0x53, 0x03, 0xa7, 0x06,
-		0x4e, 0x4d, 0x8a, 0xdd, 0xf2, 0xd5, 0x75, 0x6d, 0x65, 0x38, 0x14, 0x5e, 0x4d, 0x13, 0x5e, 0x4d,
-		0x11, 0x5e, 0x8d, 0x79, 0x6f, 0x39, 0x9f, 0x02, 0x23, 0x18, 0xc3, 0xb7, 0x29, 0xd2, 0xb7, 0x99,
-		0x5b, 0x06, 0x78, 0x38, 0x0c, 0x1e, 0x8e, 0x35, 0xb4, 0x1c, 0x53, 0x7d, 0xcb, 0xef, 0xe1, 0x4c,
-		0x07, 0x82, 0xab, 0x00, 0x57, 0x01, 0xae, 0x42, 0x8a, 0xdd, 0x32, 0xb6, 0x9c, 0x20, 0x57, 0x3b,
-		0x72, 0x82, 0x36, 0xe4, 0x44, 0x49, 0x3e, 0x04, 0x66, 0x9a, 0x32, 0xa9, 0x87, 0x3a, 0x99, 0x87,
-		0x2d, 0xfd, 0x83, 0x3e, 0xed, 0x83, 0x20, 0x69, 0x87, 0x34, 0x59, 0x87, 0xad, 0x7d, 0xf8, 0x36,
-		0xad, 0xc9, 0x5e, 0x04, 0xc2, 0xf6, 0x06, 0x40, 0x68, 0xfa, 0xb3, 0xae, 0x2b, 0x10, 0x03, 0x23,
-		0x62, 0x78, 0xe9, 0xbe, 0x03, 0x22, 0x70, 0x40, 0x04, 0x22, 0x80, 0x90, 0x07, 0x1e, 0x90, 0x10,
-		0xa0, 0x0d, 0xb0, 0x9f, 0x80, 0x34, 0x80, 0x34, 0x80, 0x34, 0x80, 0x34, 0x80, 0x34, 0x80, 0x34,
-		0xfb, 0x07, 0x69, 0x44, 0xdc, 0x25, 0xdb, 0xf0, 0x03, 0x7d, 0x70, 0x17, 0x6b, 0x9f, 0x9c, 0x4e,
-		0xd3, 0xfc, 0x60, 0x70, 0x43, 0xe0, 0x86, 0xc0, 0x0d, 0x49, 0xb1, 0x5b, 0x02, 0xeb, 0x5e, 0x05,
-		0xd6, 0xe0, 0x6f, 0x1f, 0x9e, 0x08, 0x3c, 0x11, 0x78, 0x22, 0xf0, 0x44, 0xca, 0xe3, 0x89, 0x80,
-		0x5c, 0x5d, 0xaf, 0xb2, 0x37, 0x4c, 0x0b, 0xf8, 0x68, 0xf8, 0xc1, 0xaf, 0x79, 0xbc, 0x22, 0x0e,
-		0xa7, 0x60, 0x9d, 0x73, 0xb0, 0xd3, 0xc9, 0x19, 0x73, 0xab, 0x00, 0x24, 0xc1, 0x80, 0x24, 0xe2,
-		0x63, 0x36, 0x39, 0x21, 0x44, 0x34, 0x0a, 0xb0, 0x03, 0xb0, 0x03, 0xb0, 0x43, 0x8a, 0xdd, 0x82,
-		0x53, 0x29, 0x25, 0x3f, 0x51, 0xdc, 0x7d, 0xed, 0x14, 0xab, 0x86, 0xf3, 0xc4, 0x22, 0xe7, 0x89,
-		0x53, 0x2d, 0x04, 0xfc, 0x04, 0x06, 0x3f, 0xc1, 0x1d, 0x29, 0x4f, 0xf7, 0x27, 0x49, 0xb2, 0xb9,
-		0xdd, 0x85, 0xf9, 0xc1, 0xe0, 0x35, 0xc0, 0x6b, 0xd8, 0x03, 0xaf, 0xe1, 0xd2, 0x70, 0x4c, 0x23,
-		0x70, 0xbd, 0xc7, 0xd0, 0x5c, 0x17, 0xee, 0x79, 0x28, 0x67, 0x7c, 0xaf, 0x3c, 0x23, 0xc7, 0x61,
-		0xf2, 0x05, 0xf7, 0xa3, 0x9d, 0x63, 0x8c, 0x0f, 0xce, 0xf8, 0x3e, 0xfc, 0x52, 0x28, 0x8a, 0x52,
-		0x04, 0xc9, 0xf1, 0xe7, 0x48, 0x79, 0x38, 0x81, 0x52, 0x34, 0xc9, 0x31, 0xb7, 0x0a, 0x70, 0x5e,
-		0x48, 0x3f, 0x91, 0x52, 0x3f, 0xe4, 0x2d, 0xb1, 0xc2, 0x58, 0x5a, 0x25, 0xdd, 0xce, 0xd8, 0xfc,
-		0x2e, 0xa5, 0xb8, 0x43, 0x95, 0x07, 0xdb, 0x48, 0x7f, 0x5f, 0x12, 0x95, 0x14, 0x7d, 0x3a, 0xe5,
-		0x7a, 0x4c, 0x2d, 0x4c, 0xca, 0x8f, 0x65, 0x75, 0xef, 0xf2, 0xb8, 0x75, 0xf3, 0xee, 0x5c, 0x86,
-		0xaf, 0x4a, 0xa1, 0x40, 0xc9, 0x1c, 0x38, 0x32, 0xed, 0xb8, 0xec, 0xb0, 0x45, 0x37, 0xa6, 0x64,
-		0x32, 0xff, 0xde, 0xf2, 0xb2, 0x2d, 0xf8, 0x60, 0xba, 0xcb, 0x72, 0xc2, 0xa1, 0x78, 0x9c, 0x7c,
-		0x48, 0xa8, 0xb9, 0x23, 0x48, 0x28, 0xa3, 0xe8, 0x00, 0x0b, 0x65, 0x13, 0xad, 0x62, 0xd0, 0x50,
-		0x56, 0x91, 0x5b, 0xb0, 0x44, 0xba, 0x65, 0xd2, 0xd5, 0xae, 0x9f, 0x0e, 0x88, 0x92, 0xf5, 0x02,
-		0x42, 0x2a, 0x01, 0x18, 0xb6, 0xb3, 0x68, 0x7d, 0x3e, 0x21, 0x26, 0xc2, 0x00, 0xa5, 0x2b, 0x5b,
-		0x3f, 0x76, 0xf2, 0x11, 0x15, 0x2b, 0xb6, 0xf2, 0x8c, 0x60, 0xac, 0xf8, 0x6b, 0x96, 0xb6, 0x1c,
-		0x33, 0x8d, 0x46, 0x5b, 0x77, 0xfb, 0x3a, 0x84, 0x43, 0xd2, 0x56, 0xfe, 0xa7, 0xbf, 0x9d, 0x33,
-		0x02, 0x8f, 0xa1, 0x13, 0x40, 0x32, 0x78, 0x52, 0x5e, 0xfe, 0x80, 0x67, 0x7c, 0xee, 0x9a, 0xf2,
-		0xb3, 0xcd, 0xc7, 0x55, 0x5b, 0x9e, 0x48, 0x39, 0xad, 0x5f, 0x5a, 0x86, 0x8e, 0x01, 0x2b, 0x4b,
-		0xdb, 0x6e, 0x9c, 0xb5, 0xb1, 0xba, 0xac, 0x86, 0x8b, 0x6f, 0xb4, 0xfe, 0x9b, 0x12, 0xed, 0x5d,
-		0x06, 0x5b, 0xf1, 0xbf, 0x96, 0xf3, 0xbf, 0x3c, 0xb6, 0xa2, 0x79, 0x4a, 0x38, 0xe6, 0x95, 0x11,
-		0x04, 0xca, 0x73, 0xc8, 0xcd, 0x45, 0xa5, 0xda, 0x6e, 0x9c, 0xdd, 0x34, 0xf4, 0x76, 0xff, 0x47,
-		0xbb, 0x71, 0xd3, 0xd0, 0x4f, 0xfb, 0x37, 0x0d, 0xfd, 0xac, 0xff, 0xe3, 0xa6, 0xa9, 0x1f, 0x4d,
-		0x1e, 0x7e, 0x3f, 0x7a, 0x0a, 0x9f, 0x9d, 0xc5, 0xcf, 0x9a, 0x07, 0xad, 0xf8, 0x79, 0xad, 0xd7,
-		0xab, 0x57, 0x73, 0x7c, 0xfc, 0x47, 0xaf, 0xf7, 0xb6, 0x56, 0x29, 0xdb, 0x46, 0xdd, 0xf2, 0x16,
-		0x1c, 0x44, 0x85, 0xb2, 0x93, 0xf1, 0x1e, 0x6f, 0xdd, 0x40, 0x77, 0x07, 0xfa, 0xc0, 0xbd, 0x1f,
-		0x79, 0xca, 0xf7, 0x95, 0xa9, 0xdb, 0xca, 0x18, 0x66, 0x0f, 0x5b, 0xe5, 0xbf, 0xd1, 0x4f, 0xa8,
-		0x3c, 0x3e, 0xfd, 0x57, 0xe8, 0xe8, 0x1e, 0xc6, 0x1c, 0x5a, 0x89, 0x33, 0x28, 0x26, 0x2c, 0x7e,
-		0x6e, 0xb2, 0x70, 0x32, 0x4c, 0xc1, 0x5c, 0x61, 0x0b, 0x5c, 0x21, 0xb8, 0x42, 0x70, 0x85, 0xe0,
-		0x0a, 0xc1, 0x15, 0x82, 0x2b, 0x04, 0x57, 0x08, 0xae, 0x10, 0x5c, 0x21, 0xb8, 0x42, 0x70, 0x85,
-		0xe0, 0x0a, 0xc1, 0x15, 0x82, 0x2b, 0x04, 0x57, 0x08, 0xae, 0x50, 0x90, 0x2b, 0x04, 0x85, 0x46,
-		0x44, 0xa1, 0x65, 0x48, 0x33, 0xcd, 0xc1, 0xa0, 0xed, 0x5f, 0x1a, 0x6f, 0xd6, 0xa4, 0xd0, 0x8d,
-		0xfa, 0x21, 0x7e, 0x4e, 0x0d, 0x06, 0x53, 0xa4, 0x08, 0xbf, 0x21, 0x5c, 0xa3, 0x10, 0x77, 0xa7,
-		0x2d, 0xd5, 0x59, 0xf9, 0x68, 0xf9, 0xc1, 0x79, 0x10, 0xa4, 0x23, 0x82, 0x42, 0x37, 0xfc, 0x83,
-		0x1d, 0x9d, 0x09, 0x4c, 0xe9, 0xe8, 0x84, 0x5e, 0xde, 0xdc, 0x27, 0xf3, 0x35, 0x7d, 0xaf, 0xfc,
-		0xe9, 0x99, 0xca, 0x53, 0xe6, 0x2f, 0xe1, 0xd7, 0x76, 0xc6, 0xb6, 0x4d, 0x7a, 0x37, 0xcf, 0xc7,
-		0xb7, 0xe1, 0x65, 0x46, 0xfd, 0xa3, 0x36, 0x37, 0x74, 0x19, 0x33, 0xb8, 0x0f, 0xa3, 0x23, 0x57,
-		0xdd, 0xb9, 0x0d, 0xbe, 0xf4, 0x42, 0xfc, 0x7c, 0x51, 0x08, 0x56, 0x5f, 0x4b, 0x9b, 0x05, 0xfe,
-		0x7e, 0xa1, 0x91, 0x66, 0xe5, 0xdc, 0x34, 0x7d, 0xed, 0xf3, 0xc7, 0xf3, 0x3f, 0x34, 0x5f, 0x05,
-		0x81, 0xe5, 0xdc, 0xfa, 0x5a, 0xe0, 0x6a, 0x96, 0x63, 0x5a, 0x0f, 0x96, 0x39, 0x36, 0x6c, 0x6d,
-		0x61, 0x7e, 0xa4, 0x9c, 0x73, 0xd2, 0x6a, 0x48, 0x39, 0xd7, 0x24, 0x53, 0xce, 0x33, 0x9d, 0xc0,
-		0x58, 0x4b, 0xf7, 0x64, 0xaf, 0x92, 0xbc, 0x20, 0x8b, 0x1f, 0x9c, 0x81, 0xed, 0xfa, 0x96, 0x73,
-		0xab, 0x0d, 0x5c, 0x27, 0x30, 0x2c, 0x47, 0x79, 0xd1, 0xc9, 0xec, 0x48, 0x3c, 0x13, 0x21, 0xd4,
-		0xfd, 0x91, 0x1a, 0x58, 0x43, 0x6b, 0xd0, 0x73, 0x4c, 0x23, 0x30, 0x34, 0xd7, 0xc9, 0x25, 0xa3,
-		0x39, 0x65, 0x35, 0xb7, 0xcc, 0x52, 0xc8, 0x2e, 0xa1, 0x0c, 0x53, 0xc9, 0x32, 0xb9, 0x4c, 0x93,
-		0xcb, 0x36, 0xad, 0x8c, 0xe7, 0x04, 0x02, 0xdc, 0x3a, 0x85, 0xe3, 0x08, 0xd7, 0x76, 0x1b, 0xfe,
-		0x8b, 0xab, 0x87, 0xb6, 0x66, 0x98, 0xa6, 0xa7, 0x0d, 0x8d, 0x7b, 0xcb, 0x7e, 0xd4, 0x26, 0x4e,
-		0xf9, 0x78, 0x72, 0x92, 0x39, 0xd4, 0x3b, 0x3d, 0x67, 0xef, 0x0c, 0xbf, 0x35, 0x82, 0xd9, 0x5f,
-		0x57, 0x1a, 0x60, 0xb4, 0x33, 0x46, 0xdf, 0x1a, 0x3d, 0xb4, 0x09, 0x7a, 0x23, 0x84, 0xa3, 0xd0,
-		0x18, 0xfd, 0x2b, 0xc3, 0x33, 0xee, 0x55, 0xa0, 0x3c, 0x3f, 0xb2, 0xf5, 0xc1, 0x9d, 0xd2, 0x12,
-		0xd1, 0x54, 0xbe, 0x1f, 0x4b, 0x67, 0x1d, 0x56, 0x3d, 0xbb, 0x80, 0xc2, 0xa6, 0x67, 0x11, 0x60,
-		0x58, 0xf4, 0xed, 0xb3, 0xe8, 0x1d, 0x58, 0x74, 0x58, 0xf4, 0xfd, 0xb3, 0xe8, 0x1d, 0x12, 0x8b,
-		0xde, 0x61, 0xb5, 0xe8, 0x1d, 0x58, 0x74, 0x58, 0x74, 0x58, 0x74, 0x12, 0x8b, 0xbe, 0xd1, 0x3b,
-		0xfb, 0x9b, 0xb2, 0xfe, 0xd9, 0xe2, 0x5b, 0x2c, 0x71, 0xad, 0x14, 0xbb, 0x6e, 0xa3, 0x50, 0xd6,
-		0x66, 0x7b, 0xe4, 0xf5, 0xfb, 0xf9, 0xf2, 0x3b, 0x5e, 0x51, 0xf5, 0x69, 0xef, 0x30, 0xd5, 0x9d,
-		0x7d, 0xf9, 0xcb, 0x3f, 0xff, 0x95, 0xd6, 0xff, 0xe7, 0x99, 0x2f, 0x39, 0x0d, 0xc1, 0xbd, 0x50,
-		0xb5, 0x79, 0xb3, 0x88, 0xdb, 0xe6, 0x11, 0xb6, 0x5c, 0x11, 0xb5, 0xcd, 0x22, 0x68, 0xcf, 0x7d,
-		0xd9, 0xcd, 0x22, 0x64, 0x2f, 0x2f, 0xf1, 0xe6, 0x6e, 0xf3, 0x2b, 0xd2, 0xb0, 0x36, 0x94, 0xf5,
-		0xf1, 0xfc, 0xf7, 0x25, 0xbf, 0x37, 0x70, 0x23, 0x13, 0x9c, 0x8c, 0xaa, 0xdd, 0xbb, 0xe6, 0xd8,
-		0x7e, 0x75, 0xf0, 0xcd, 0xcc, 0xec, 0xc6, 0xe6, 0x34, 0x8d, 0xd9, 0x9c, 0x37, 0x8f, 0xb6, 0xb1,
-		0x49, 0x31, 0x9b, 0xb4, 0x76, 0x30, 0xb3, 0xbd, 0xcb, 0x6c, 0xd7, 0x96, 0xed, 0x57, 0xf8, 0xbd,
-		0x98, 0xd5, 0xce, 0xa6, 0x1e, 0x65, 0xc5, 0xb8, 0xbd, 0xf5, 0xa2, 0x0c, 0xbb, 0x14, 0x26, 0x20,
-		0x59, 0xa3, 0xf9, 0x0f, 0x6f, 0x78, 0x2b, 0x96, 0xf6, 0xed, 0x9f, 0xd1, 0xa3, 0x89, 0xb3, 0x68,
-		0xbb, 0xb7, 0xd6, 0xc0, 0xb0, 0x67, 0xbb, 0xd5, 0xd7, 0x3c, 0x35, 0xf2, 0x94, 0xaf, 0x9c, 0xc0,
-		0x72, 0x6e, 0x7b, 0xce, 0x74, 0xba, 0x8d, 0x01, 0x5c, 0x4a, 0x7f, 0x31, 0xb5, 0x7f, 0x98, 0xc5,
-		0x1f, 0x4c, 0xbf, 0xc1, 0xf3, 0x3a, 0x7c, 0xb9, 0x1d, 0xbc, 0xdc, 0x0e, 0x5d, 0x26, 0x01, 0x48,
-		0xe7, 0xf1, 0x70, 0xdb, 0xf1, 0x0c, 0x85, 0x3e, 0x2b, 0xff, 0xb9, 0x53, 0x9b, 0xe7, 0x0d, 0x66,
-		0xdb, 0x41, 0xd6, 0xb0, 0x1b, 0x3c, 0x8e, 0x94, 0xf6, 0x0f, 0xed, 0x7f, 0xac, 0x61, 0xd0, 0xb5,
-		0x94, 0x52, 0xa7, 0x8d, 0xd6, 0x91, 0x61, 0x7e, 0x34, 0x6e, 0xff, 0x47, 0x78, 0x6f, 0x45, 0x5f,
-		0xb7, 0xe0, 0x9d, 0xf5, 0xf2, 0xfd, 0x60, 0x29, 0x66, 0xb8, 0xa8, 0xd1, 0x32, 0xd2, 0x68, 0xc6,
-		0x20, 0xb0, 0x1e, 0x94, 0x16, 0xde, 0xc2, 0x25, 0x8b, 0x6d, 0xf9, 0x9a, 0xaf, 0x82, 0xc8, 0x92,
-		0x87, 0x5f, 0xec, 0xe3, 0xf9, 0xef, 0x69, 0xef, 0x71, 0x0e, 0x9c, 0x38, 0xbf, 0xbc, 0xe6, 0xdc,
-		0xf7, 0xcc, 0x40, 0x2b, 0x51, 0x80, 0xc2, 0x85, 0xd5, 0x4e, 0x75, 0xcb, 0x8a, 0xcd, 0x68, 0x7b,
-		0xf5, 0x5d, 0xfd, 0xac, 0xee, 0xfa, 0x41, 0x29, 0x1d, 0xd1, 0x45, 0x27, 0xd4, 0x70, 0x4c, 0x2d,
-		0xca, 0x09, 0x8d, 0xac, 0xbc, 0xe7, 0x8e, 0x03, 0x65, 0x2e, 0xe5, 0x75, 0xf8, 0x5b, 0xe2, 0x91,
-		0x6e, 0x98, 0x58, 0xb1, 0x7d, 0x2e, 0xe9, 0x66, 0x89, 0x0f, 0x42, 0x3e, 0xe9, 0x64, 0x8b, 0xe8,
-		0xa9, 0x72, 0x94, 0x92, 0x55, 0x9a, 0xff, 0x70, 0x36, 0x9f, 0xf4, 0x2f, 0x77, 0xa4, 0xdb, 0xea,
-		0x41, 0xd9, 0x4b, 0xa9, 0x48, 0xf1, 0xce, 0x0d, 0x47, 0x9e, 0xdb, 0xb9, 0x75, 0x4d, 0xfb, 0xeb,
-		0x4e, 0xf9, 0xaa, 0xe7, 0xac, 0xf1, 0x5f, 0x0d, 0x4f, 0x69, 0x86, 0xed, 0xbb, 0xda, 0xdf, 0x8e,
-		0xfb, 0x1f, 0x47, 0x33, 0x7c, 0xed, 0xd3, 0xe7, 0x0b, 0xad, 0xea, 0xff, 0xc7, 0x0a, 0x06, 0x77,
-		0xe1, 0x58, 0x96, 0x17, 0x8c, 0x0d, 0x7b, 0x2e, 0x36, 0x51, 0x3b, 0xd0, 0x2e, 0xae, 0x7f, 0xd1,
-		0xaa, 0xe1, 0x0b, 0xb7, 0x9e, 0x11, 0x4e, 0x18, 0xce, 0x6b, 0x39, 0xb7, 0x91, 0x1c, 0x7d, 0xf5,
-		0x2c, 0xf3, 0xd6, 0x72, 0x6e, 0x6b, 0x07, 0xda, 0xf5, 0xe7, 0x8b, 0x9e, 0x53, 0x5d, 0x2b, 0x4e,
-		0xb5, 0x1d, 0xf1, 0x8f, 0x53, 0xe6, 0x31, 0xed, 0x8e, 0x83, 0x9c, 0x2e, 0x0f, 0x09, 0x1e, 0xf2,
-		0xe6, 0x1e, 0xb2, 0x7d, 0x64, 0x8d, 0xc2, 0xbb, 0x0b, 0xe7, 0x78, 0xee, 0x56, 0x94, 0xd7, 0x2f,
-		0x3e, 0x7f, 0xc9, 0xc9, 0x33, 0x56, 0x29, 0x03, 0x6d, 0xe4, 0xb9, 0x0f, 0x96, 0x19, 0xd1, 0x05,
-		0x1f, 0x8f, 0x12, 0xd5, 0x39, 0xcd, 0x24, 0xf5, 0xe1, 0x3b, 0x07, 0x2c, 0xb7, 0x15, 0xfe, 0xb5,
-		0xa4, 0x7f, 0x6d, 0x98, 0x66, 0xf8, 0xdc, 0xb0, 0xb5, 0x0f, 0xc1, 0x9d, 0xf2, 0x1c, 0x15, 0x24,
-		0x89, 0xd1, 0x2b, 0x04, 0xf0, 0x7c, 0xde, 0x83, 0x76, 0xef, 0x9a, 0xca, 0xde, 0x12, 0x5f, 0x5b,
-		0x05, 0x77, 0x3b, 0xe9, 0x6a, 0x87, 0xdf, 0xab, 0x2c, 0x9e, 0xb6, 0x8a, 0xb7, 0x4f, 0x7a, 0x37,
-		0x3b, 0xf9, 0x24, 0xad, 0x8f, 0x3d, 0x1d, 0x76, 0x71, 0x1b, 0xf7, 0x9c, 0x04, 0x43, 0xee, 0x88,
-		0x67, 0xbb, 0xd9, 0xe6, 0xde, 0x3d, 0xc7, 0x76, 0xa3, 0xcd, 0x2f, 0xec, 0xd7, 0xa6, 0x0c, 0x86,
-		0xf6, 0x9f, 0x8b, 0x0f, 0x6e, 0x16, 0xe9, 0xcd, 0x1e, 0xe1, 0x7d, 0x61, 0xed, 0x9e, 0x0b, 0x88,
-		0xaf, 0xbf, 0xd7, 0xab, 0xdf, 0x6b, 0xf1, 0x95, 0x25, 0xf5, 0xf2, 0xda, 0x37, 0x4b, 0xfb, 0x8d,
-		0x16, 0x2f, 0x6a, 0x36, 0xf5, 0xdc, 0xb4, 0xd1, 0xc9, 0xa2, 0xd5, 0x06, 0x81, 0x0b, 0x07, 0x8f,
-		0x96, 0x7d, 0xb9, 0x67, 0x44, 0xfe, 0x59, 0xd1, 0x7e, 0x49, 0x84, 0x37, 0x00, 0xa1, 0xaf, 0xc9,
-		0xe4, 0xc6, 0xb2, 0xb7, 0xb1, 0x8c, 0x6d, 0x06, 0x12, 0x5f, 0x5e, 0xca, 0xe7, 0x2c, 0xc3, 0xcb,
-		0x07, 0xc2, 0x36, 0x39, 0xf0, 0xf5, 0x8a, 0xc6, 0x7d, 0x55, 0xc3, 0x6e, 0xa2, 0x51, 0x53, 0x70,
-		0x03, 0x9b, 0xaa, 0xcc, 0xd4, 0x2a, 0x32, 0xb5, 0x4a, 0x4c, 0x87, 0xed, 0xd3, 0x25, 0x60, 0xbc,
-		0x66, 0xe8, 0x37, 0x6d, 0x2d, 0x93, 0xae, 0x85, 0x0c, 0xd8, 0xd8, 0x7d, 0x62, 0x63, 0x53, 0x75,
-		0x76, 0xcf, 0xd2, 0xc9, 0x3d, 0x65, 0x01, 0x43, 0xb0, 0x90, 0x5b, 0xcd, 0x42, 0x6e, 0xb8, 0x66,
-		0xa9, 0x0b, 0xfa, 0x65, 0xef, 0x86, 0x9e, 0xb2, 0xfb, 0xf9, 0x13, 0x73, 0x6e, 0x65, 0xee, 0x22,
-		0xd1, 0x1b, 0x38, 0xc4, 0x1b, 0x28, 0xcd, 0x94, 0x3d, 0x9a, 0x17, 0xea, 0x0a, 0x6f, 0xdc, 0x05,
-		0xb4, 0xf2, 0x5e, 0x0d, 0x8d, 0xb1, 0x1d, 0xa4, 0x2a, 0x3a, 0x51, 0x39, 0xff, 0xf5, 0xaf, 0x8b,
-		0xcf, 0x1f, 0x2a, 0xa4, 0x99, 0xb0, 0xd0, 0x41, 0xd0, 0x41, 0x74, 0x3a, 0x28, 0x5b, 0x5f, 0xe4,
-		0x2c, 0x7d, 0x90, 0x53, 0xf6, 0x3d, 0xde, 0x13, 0xe5, 0x15, 0x8c, 0x52, 0xd4, 0x69, 0x4e, 0x56,
-		0x2d, 0xfa, 0x14, 0xa7, 0xe2, 0x8a, 0xf7, 0xb3, 0x1e, 0x3c, 0x8e, 0x94, 0xdf, 0xfd, 0xeb, 0xea,
-		0xe2, 0xfd, 0x97, 0xc6, 0xb7, 0xd3, 0x66, 0xa3, 0x01, 0x5d, 0x06, 0x5d, 0x56, 0x56, 0x5d, 0x66,
-		0x99, 0xca, 0x09, 0xac, 0xe0, 0xd1, 0x53, 0xc3, 0x2c, 0xba, 0x2c, 0x4d, 0x85, 0xab, 0x8b, 0x78,
-		0xaa, 0x5f, 0x0c, 0x5f, 0x65, 0x8f, 0x19, 0x46, 0x72, 0xf5, 0xd7, 0xbf, 0xae, 0x3e, 0x7c, 0x4a,
-		0xbb, 0xe0, 0x51, 0xdd, 0x54, 0x3f, 0x53, 0xa9, 0xc7, 0x9c, 0x47, 0xf0, 0x26, 0xba, 0xe0, 0xff,
-		0x9c, 0xb5, 0x36, 0xd5, 0x05, 0x19, 0xf6, 0x00, 0xf9, 0xe5, 0x7e, 0x3b, 0x3d, 0x3f, 0x3d, 0xdd,
-		0xa6, 0xcb, 0x6d, 0x6e, 0xd5, 0xdd, 0x3d, 0xcb, 0x76, 0xb9, 0xa9, 0x3e, 0xd1, 0x2f, 0x48, 0x1d,
-		0x6d, 0xb9, 0x7f, 0x91, 0xb6, 0x15, 0x44, 0xc6, 0x02, 0xe9, 0xb0, 0xe4, 0xb0, 0xe4, 0x84, 0x96,
-		0x3c, 0x7d, 0x75, 0xfe, 0x0c, 0xd5, 0xf7, 0x33, 0x56, 0xd7, 0xcf, 0x90, 0x5f, 0x93, 0xa7, 0x3a,
-		0x7e, 0xde, 0xea, 0xf7, 0x64, 0xf5, 0xcf, 0xf3, 0xd7, 0x37, 0xcf, 0xd2, 0x43, 0x2a, 0x4f, 0xf5,
-		0x79, 0x82, 0xea, 0xf2, 0x65, 0xba, 0x7b, 0x4c, 0x99, 0x4b, 0xfd, 0xbd, 0x31, 0x97, 0x25, 0x39,
-		0x1e, 0x1d, 0x95, 0x9e, 0x8e, 0xe2, 0xc2, 0x9b, 0x37, 0x72, 0xcb, 0x94, 0xf8, 0x55, 0xb9, 0x57,
-		0xf7, 0x5f, 0x95, 0xe7, 0x6f, 0x1e, 0x54, 0x9b, 0x7e, 0x00, 0x51, 0x35, 0x44, 0xd5, 0x16, 0x37,
-		0x51, 0x7a, 0x07, 0x32, 0xfe, 0x1c, 0x73, 0x16, 0x54, 0x0b, 0xfe, 0xe3, 0xce, 0xfa, 0x8f, 0x69,
-		0x8b, 0x0d, 0x55, 0x66, 0xd5, 0x77, 0x3d, 0x35, 0xcc, 0x4e, 0xcb, 0x2c, 0x0e, 0xb3, 0x27, 0x15,
-		0xb8, 0x86, 0xa8, 0xc0, 0xb5, 0xf6, 0x20, 0xc2, 0xce, 0x54, 0xe0, 0x42, 0x37, 0x56, 0xfa, 0xaa,
-		0x58, 0x43, 0x54, 0xc5, 0x12, 0x12, 0xaa, 0x6c, 0xc2, 0x95, 0x03, 0x79, 0x6a, 0x24, 0x9d, 0x58,
-		0x67, 0x29, 0xb1, 0x64, 0xbd, 0x58, 0xb3, 0x16, 0xa2, 0xcc, 0x49, 0xd9, 0x91, 0x5b, 0x2e, 0x0e,
-		0xe1, 0x24, 0x17, 0x52, 0x6a, 0x61, 0x65, 0x13, 0x5a, 0x36, 0xe1, 0xe5, 0x10, 0xe2, 0x7c, 0xc2,
-		0x9c, 0x53, 0xa8, 0xb3, 0xd3, 0x93, 0xaf, 0xee, 0x36, 0x5b, 0x19, 0xc3, 0xf4, 0x2e, 0xe3, 0x8b,
-		0x96, 0xf2, 0x84, 0x60, 0xac, 0xab, 0x84, 0x84, 0xd8, 0xac, 0x02, 0x6e, 0x94, 0x11, 0x59, 0x54,
-		0x8b, 0xb2, 0x1c, 0x06, 0x75, 0xa1, 0x46, 0x1f, 0x99, 0xbe, 0xcd, 0x51, 0xfb, 0x17, 0x2a, 0x17,
-		0x2a, 0x17, 0x2a, 0x17, 0x2a, 0xf7, 0x05, 0x95, 0x7b, 0x33, 0x53, 0xb9, 0xff, 0x18, 0x8c, 0x3d,
-		0x4f, 0x39, 0x41, 0xb5, 0x76, 0x58, 0xaf, 0xcf, 0x4e, 0x21, 0xf5, 0x37, 0xac, 0x4b, 0x9e, 0x8c,
-		0x6c, 0xaa, 0x6f, 0x15, 0x34, 0x98, 0x4c, 0x63, 0x37, 0x36, 0xa6, 0xea, 0x63, 0x7e, 0x3c, 0xfe,
-		0x7b, 0xb8, 0x40, 0x14, 0xa1, 0x9b, 0x24, 0xf5, 0x1d, 0xcd, 0xdf, 0x3a, 0xf2, 0xb3, 0x6d, 0x38,
-		0x5f, 0x2e, 0xa3, 0xa1, 0xbf, 0x24, 0x47, 0x0d, 0xaf, 0xd5, 0x70, 0x3b, 0xfa, 0x46, 0xa2, 0x07,
-		0x24, 0xf1, 0xfe, 0xcc, 0xbc, 0x2f, 0xb3, 0x57, 0x7c, 0x9e, 0xdb, 0x81, 0x95, 0x1d, 0x8f, 0x63,
-		0x4e, 0x83, 0x87, 0x0c, 0x81, 0xcc, 0xcd, 0xb8, 0xcb, 0x54, 0x1c, 0x65, 0xea, 0x20, 0x66, 0x0b,
-		0x41, 0xcc, 0x54, 0xde, 0x2a, 0x8e, 0x06, 0x52, 0x42, 0x38, 0x04, 0x30, 0x33, 0x82, 0x26, 0x1c,
-		0x0d, 0xcc, 0x68, 0x65, 0x70, 0xf2, 0x0e, 0x22, 0x0e, 0x11, 0xe7, 0x13, 0xf1, 0xf2, 0x9e, 0xbc,
-		0xc3, 0xc1, 0x36, 0xa8, 0x0a, 0xa8, 0x0a, 0x1c, 0x6c, 0xc3, 0xc1, 0x36, 0x4e, 0x0a, 0x13, 0x07,
-		0xdb, 0xca, 0x7f, 0xb9, 0x5b, 0x79, 0xb0, 0x0d, 0xe7, 0xc6, 0x60, 0x28, 0x71, 0x6e, 0x2c, 0xa5,
-		0x91, 0xc4, 0xb9, 0xb1, 0xe5, 0xcf, 0xe3, 0xdc, 0x18, 0xce, 0x8d, 0xe5, 0xb7, 0x5b, 0xdb, 0x1b,
-		0xce, 0xd8, 0x20, 0x9c, 0x9b, 0x2d, 0x98, 0xb1, 0xa9, 0x69, 0x4d, 0xa9, 0xd5, 0x36, 0x34, 0xa5,
-		0x38, 0x95, 0x55, 0xe6, 0x80, 0xc6, 0xc6, 0xa6, 0x2f, 0x43, 0x02, 0x4c, 0x9a, 0x04, 0x97, 0x24,
-		0x81, 0xa5, 0x5e, 0x8f, 0xcf, 0x27, 0x1e, 0x4e, 0x77, 0xa1, 0x64, 0xbf, 0xd6, 0x97, 0x77, 0xfe,
-		0x2e, 0xb5, 0x6c, 0xcd, 0x5d, 0x92, 0x79, 0x49, 0x7d, 0x6d, 0x5e, 0x82, 0xf9, 0xf3, 0xb3, 0xdb,
-		0x5a, 0xae, 0xfa, 0xf2, 0xec, 0xe2, 0x9f, 0x2d, 0xb9, 0xfc, 0x66, 0x6e, 0xc6, 0xe7, 0x66, 0xaa,
-		0x58, 0xfe, 0xaf, 0xc9, 0xf9, 0xe1, 0x4f, 0xd1, 0x6c, 0x2b, 0x12, 0x5f, 0xb1, 0xfc, 0xdf, 0x8c,
-		0xbf, 0xd5, 0xb5, 0xeb, 0xae, 0x6a, 0x83, 0xe5, 0x2b, 0xac, 0xcc, 0xff, 0x6b, 0xe1, 0xa6, 0xbd,
-		0x57, 0x0f, 0xd6, 0xb4, 0x68, 0xf5, 0xd3, 0x9b, 0xa7, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x01,
-		0x00, 0x00, 0xff, 0xff, 0x9d, 0x17, 0xcc, 0x09, 0x40, 0x89, 0x13, 0x00,
-	}
-)
-
-// ΛEnumTypes is a map, keyed by a YANG schema path, of the enumerated types that
-// correspond with the leaf. The type is represented as a reflect.Type. The naming
-// of the map ensures that there are no clashes with valid YANG identifiers.
-func initΛEnumTypes() {
-	ΛEnumTypes = map[string][]reflect.Type{
-		"/interfaces/interface/aggregation/config/lag-type": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfAggregate_AggregationType)(0)),
-		},
-		"/interfaces/interface/aggregation/switched-vlan/config/interface-mode": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigVlanTypes_VlanModeType)(0)),
-		},
-		"/interfaces/interface/config/type": []reflect.Type{
-			reflect.TypeOf((E_IETFInterfaces_InterfaceType)(0)),
-		},
-		"/interfaces/interface/ethernet/config/duplex-mode": []reflect.Type{
-			reflect.TypeOf((E_Ethernet_DuplexMode)(0)),
-		},
-		"/interfaces/interface/ethernet/config/port-speed": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfEthernet_ETHERNET_SPEED)(0)),
-		},
-		"/interfaces/interface/ethernet/state/negotiated-duplex-mode": []reflect.Type{
-			reflect.TypeOf((E_Ethernet_NegotiatedDuplexMode)(0)),
-		},
-		"/interfaces/interface/ethernet/state/negotiated-port-speed": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfEthernet_ETHERNET_SPEED)(0)),
-		},
-		"/interfaces/interface/ethernet/switched-vlan/config/interface-mode": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigVlanTypes_VlanModeType)(0)),
-		},
-		"/interfaces/interface/routed-vlan/ipv4/addresses/address/state/origin": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfIp_IpAddressOrigin)(0)),
-		},
-		"/interfaces/interface/routed-vlan/ipv4/neighbors/neighbor/state/origin": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfIp_NeighborOrigin)(0)),
-		},
-		"/interfaces/interface/routed-vlan/ipv6/addresses/address/state/origin": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfIp_IpAddressOrigin)(0)),
-		},
-		"/interfaces/interface/routed-vlan/ipv6/addresses/address/state/status": []reflect.Type{
-			reflect.TypeOf((E_Address_Status)(0)),
-		},
-		"/interfaces/interface/routed-vlan/ipv6/neighbors/neighbor/state/neighbor-state": []reflect.Type{
-			reflect.TypeOf((E_Neighbor_NeighborState)(0)),
-		},
-		"/interfaces/interface/routed-vlan/ipv6/neighbors/neighbor/state/origin": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfIp_NeighborOrigin)(0)),
-		},
-		"/interfaces/interface/state/admin-status": []reflect.Type{
-			reflect.TypeOf((E_Interface_AdminStatus)(0)),
-		},
-		"/interfaces/interface/state/oper-status": []reflect.Type{
-			reflect.TypeOf((E_Interface_OperStatus)(0)),
-		},
-		"/interfaces/interface/subinterfaces/subinterface/ipv4/addresses/address/state/origin": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfIp_IpAddressOrigin)(0)),
-		},
-		"/interfaces/interface/subinterfaces/subinterface/ipv4/neighbors/neighbor/state/origin": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfIp_NeighborOrigin)(0)),
-		},
-		"/interfaces/interface/subinterfaces/subinterface/ipv6/addresses/address/state/origin": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfIp_IpAddressOrigin)(0)),
-		},
-		"/interfaces/interface/subinterfaces/subinterface/ipv6/addresses/address/state/status": []reflect.Type{
-			reflect.TypeOf((E_Address_Status)(0)),
-		},
-		"/interfaces/interface/subinterfaces/subinterface/ipv6/neighbors/neighbor/state/neighbor-state": []reflect.Type{
-			reflect.TypeOf((E_Neighbor_NeighborState)(0)),
-		},
-		"/interfaces/interface/subinterfaces/subinterface/ipv6/neighbors/neighbor/state/origin": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigIfIp_NeighborOrigin)(0)),
-		},
-		"/interfaces/interface/subinterfaces/subinterface/state/admin-status": []reflect.Type{
-			reflect.TypeOf((E_Interface_AdminStatus)(0)),
-		},
-		"/interfaces/interface/subinterfaces/subinterface/state/oper-status": []reflect.Type{
-			reflect.TypeOf((E_Interface_OperStatus)(0)),
-		},
-		"/vlans/vlan/config/status": []reflect.Type{
-			reflect.TypeOf((E_Vlan_Status)(0)),
-		},
-		"/vlans/vlan/config/tpid": []reflect.Type{
-			reflect.TypeOf((E_OpenconfigVlanTypes_TPID_TYPES)(0)),
-		},
-	}
-}
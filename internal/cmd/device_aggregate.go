@@ -30,7 +30,14 @@ import (
 )
 
 const (
-	FlagAggregateAddr = "aggregate-addr"
+	FlagAggregateAddr        = "aggregate-addr"
+	FlagAggregateGRPCAddr    = "aggregate-grpc-addr"
+	FlagAggregateAuthzConfig = "aggregate-authz-config"
+
+	FlagAggregateCommitInterval   = "aggregate-commit-interval"
+	FlagAggregateMaxBodySize      = "aggregate-max-body-size"
+	FlagAggregateQueueSize        = "aggregate-queue-size"
+	FlagAggregateLastSeenInterval = "aggregate-last-seen-interval"
 )
 
 func newDeviceAggregateCmd() *cobra.Command {
@@ -48,6 +55,12 @@ func newDeviceAggregateCmd() *cobra.Command {
 		},
 	}
 	cmd.PersistentFlags().StringP(FlagAggregateAddr, "", ":8000", "Bind address of device aggregator")
+	cmd.PersistentFlags().StringP(FlagAggregateGRPCAddr, "", "", "Bind address of device aggregator gRPC streaming API. gRPC API is disabled if not set.")
+	cmd.PersistentFlags().StringP(FlagAggregateAuthzConfig, "", "", "Path to the YAML file which binds client identities to the devices they may report for. Client authentication is disabled if not set.")
+	cmd.PersistentFlags().DurationP(FlagAggregateCommitInterval, "", core.DefaultAggregateCommitInterval, "Interval to write buffered device configs and push them as batch commit")
+	cmd.PersistentFlags().Int64P(FlagAggregateMaxBodySize, "", core.DefaultAggregateMaxBodySize, "Max size of the request body in bytes")
+	cmd.PersistentFlags().IntP(FlagAggregateQueueSize, "", core.DefaultAggregateQueueSize, "Max number of devices whose config updates are buffered until the next commit")
+	cmd.PersistentFlags().DurationP(FlagAggregateLastSeenInterval, "", core.DefaultAggregateLastSeenInterval, "Interval to write the last seen time of the device whose status is not changed. It must be shorter than the device staleness threshold")
 	mustBindToViper(cmd)
 
 	return cmd
@@ -66,6 +79,14 @@ func newDeviceAggregateCfg(cmd *cobra.Command, args []string) (*core.DeviceAggre
 		TLSCrtPath:   viper.GetString(FlagTLSCrt),
 		TLSKeyPath:   viper.GetString(FlagTLSKey),
 		TLSCACrtPath: viper.GetString(FlagTLSCACrt),
+
+		GRPCAddr:        viper.GetString(FlagAggregateGRPCAddr),
+		AuthzConfigPath: viper.GetString(FlagAggregateAuthzConfig),
+		CommitInterval:  viper.GetDuration(FlagAggregateCommitInterval),
+		MaxBodySize:     viper.GetInt64(FlagAggregateMaxBodySize),
+		QueueSize:       viper.GetInt(FlagAggregateQueueSize),
+
+		LastSeenInterval: viper.GetDuration(FlagAggregateLastSeenInterval),
 	}
 	return cfg, cfg.Validate()
 }
@@ -0,0 +1,125 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/nttcom/kuesta/internal/core"
+	"github.com/nttcom/kuesta/internal/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	FlagKubeconfig = "kubeconfig"
+	FlagNamespace  = "namespace"
+)
+
+func newRolloutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollout",
+		Short: "Control the DeviceRollout",
+	}
+	cmd.PersistentFlags().StringP(FlagKubeconfig, "", "", "path to the kubeconfig file (default loading rules are used if not set)")
+	cmd.PersistentFlags().StringP(FlagNamespace, "n", "default", "namespace of the DeviceRollout")
+	mustBindToViper(cmd)
+
+	cmd.AddCommand(newRolloutControlCmd("pause", "Pause the DeviceRollout before starting the next transaction or batch", core.RunRolloutPause))
+	cmd.AddCommand(newRolloutControlCmd("resume", "Resume the paused DeviceRollout", core.RunRolloutResume))
+	cmd.AddCommand(newRolloutControlCmd("abort", "Abort the running DeviceRollout, leaving devices as they are", core.RunRolloutAbort))
+	cmd.AddCommand(newRolloutControlCmd("retry", "Retry provisioning only the failed or aborted devices", core.RunRolloutRetry))
+	cmd.AddCommand(newRolloutSkipCmd("skip", "Exclude the given devices from the DeviceRollout", core.RunRolloutSkip))
+	cmd.AddCommand(newRolloutSkipCmd("unskip", "Include the skipped devices in the DeviceRollout again", core.RunRolloutUnskip))
+	return cmd
+}
+
+func newRolloutControlCmd(use, short string, run func(context.Context, *core.RolloutCfg) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " NAME",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := newRolloutCfg(cmd, args)
+			if err != nil {
+				return err
+			}
+			logger.Setup(cfg.Devel, cfg.Verbose)
+
+			return run(cmd.Context(), cfg)
+		},
+	}
+	mustBindToViper(cmd)
+
+	return cmd
+}
+
+func newRolloutSkipCmd(use, short string, run func(context.Context, *core.RolloutSkipCfg) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " NAME DEVICE...",
+		Short: short,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := newRolloutSkipCfg(cmd, args)
+			if err != nil {
+				return err
+			}
+			logger.Setup(cfg.Devel, cfg.Verbose)
+
+			return run(cmd.Context(), cfg)
+		},
+	}
+	mustBindToViper(cmd)
+
+	return cmd
+}
+
+func newRolloutCfg(cmd *cobra.Command, args []string) (*core.RolloutCfg, error) {
+	rootCfg, err := newRootCfg(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &core.RolloutCfg{
+		RootCfg:    *rootCfg,
+		Kubeconfig: viper.GetString(FlagKubeconfig),
+		Namespace:  viper.GetString(FlagNamespace),
+	}
+	if len(args) > 0 {
+		cfg.Name = args[0]
+	}
+	return cfg, cfg.Validate()
+}
+
+func newRolloutSkipCfg(cmd *cobra.Command, args []string) (*core.RolloutSkipCfg, error) {
+	rolloutCfg, err := newRolloutCfg(cmd, args)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &core.RolloutSkipCfg{
+		RolloutCfg: *rolloutCfg,
+	}
+	if len(args) > 1 {
+		cfg.Devices = args[1:]
+	}
+	return cfg, cfg.Validate()
+}
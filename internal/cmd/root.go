@@ -67,6 +67,16 @@ const (
 	FlagTLSKey         = "tls-key"
 	FlagTLSCACrt       = "tls-ca-crt"
 	FlagInsecure       = "insecure"
+
+	FlagConfigGitSigningKey = "config-git-signing-key"
+	FlagStatusGitSigningKey = "status-git-signing-key"
+)
+
+// NOTE the passphrases of the signing keys are given only by the environment variables or the mounted secret dirs
+// so as not to be exposed in the process list.
+const (
+	EnvConfigGitSigningKeyPassphrase = "KUESTA_CONFIG_GIT_SIGNING_KEY_PASSPHRASE"
+	EnvStatusGitSigningKeyPassphrase = "KUESTA_STATUS_GIT_SIGNING_KEY_PASSPHRASE"
 )
 
 // NewRootCmd creates command root.
@@ -98,6 +108,8 @@ func NewRootCmd() *cobra.Command {
 	cmd.PersistentFlags().StringP(FlagTLSCrt, "", "", "path to the certificate file")
 	cmd.PersistentFlags().StringP(FlagTLSKey, "", "", "path to the private key file")
 	cmd.PersistentFlags().StringP(FlagTLSCACrt, "", "", "path to the CA certificate file")
+	cmd.PersistentFlags().StringP(FlagConfigGitSigningKey, "", "", "path to the armored OpenPGP private key file, or the mounted secret dir, to sign commits to config repository. The passphrase is read from $"+EnvConfigGitSigningKeyPassphrase+" or the secret dir")
+	cmd.PersistentFlags().StringP(FlagStatusGitSigningKey, "", "", "path to the armored OpenPGP private key file, or the mounted secret dir, to sign commits to status repository. The passphrase is read from $"+EnvStatusGitSigningKeyPassphrase+" or the secret dir")
 
 	mustBindToViper(cmd)
 	cmd.Version = getVcsRevision()
@@ -107,6 +119,7 @@ func NewRootCmd() *cobra.Command {
 	cmd.AddCommand(newGitCmd())
 	cmd.AddCommand(newServeCmd())
 	cmd.AddCommand(newCueCmd())
+	cmd.AddCommand(newRolloutCmd())
 	cmd.AddCommand(newVersionCmd())
 
 	return cmd
@@ -132,6 +145,11 @@ func newRootCfg(cmd *cobra.Command) (*core.RootCfg, error) {
 		GitUser:        gitUser,
 		GitEmail:       gitEmail,
 		PushToMain:     viper.GetBool(FlagPushToMain),
+
+		ConfigGitSigningKeyPath:       viper.GetString(FlagConfigGitSigningKey),
+		ConfigGitSigningKeyPassphrase: os.Getenv(EnvConfigGitSigningKeyPassphrase),
+		StatusGitSigningKeyPath:       viper.GetString(FlagStatusGitSigningKey),
+		StatusGitSigningKeyPassphrase: os.Getenv(EnvStatusGitSigningKeyPassphrase),
 	}
 	return cfg, cfg.Validate()
 }
@@ -39,7 +39,11 @@ func TestNewRootCmd(t *testing.T) {
 	dummyRootpath := "dummy-rootpath"
 	testhelper.ExitOnErr(t, os.Setenv("KUESTA_CONFIG_ROOT_PATH", dummyRootpath))
 
-	_ = cmd.NewRootCmd()
+	c := cmd.NewRootCmd()
 	assert.Equal(t, dummyToken, viper.GetString(cmd.FlagGitToken))
 	assert.Equal(t, dummyRootpath, viper.GetString(cmd.FlagConfigRootPath))
+
+	// the passphrases of the signing keys are not given by flags
+	assert.Nil(t, c.PersistentFlags().Lookup("config-git-signing-key-passphrase"))
+	assert.Nil(t, c.PersistentFlags().Lookup("status-git-signing-key-passphrase"))
 }
@@ -33,6 +33,8 @@ const (
 	FlagServeAddr       = "serve-addr"
 	FlagSyncInterval    = "sync-interval"
 	FlagPersistGitState = "persist-git-state"
+
+	FlagDeviceStalenessThreshold = "device-staleness-threshold"
 )
 
 func newServeCmd() *cobra.Command {
@@ -52,6 +54,7 @@ func newServeCmd() *cobra.Command {
 	cmd.Flags().StringP(FlagServeAddr, "a", ":9339", "Bind address of gNMI northbound API.")
 	cmd.Flags().IntP(FlagSyncInterval, "", 10, "Interval to exec git-pull from status repo.")
 	cmd.Flags().BoolP(FlagPersistGitState, "", false, "Persist git workspace even when api call closed without performing hard-reset.")
+	cmd.Flags().DurationP(FlagDeviceStalenessThreshold, "", 0, "Duration after which the device that has not reported to the aggregator is regarded as unreachable. It must be longer than the last seen interval of the aggregator. Disabled if zero.")
 	mustBindToViper(cmd)
 
 	return cmd
@@ -72,6 +75,8 @@ func newServeCfg(cmd *cobra.Command, args []string) (*core.ServeCfg, error) {
 		TLSCrtPath:      viper.GetString(FlagTLSCrt),
 		TLSKeyPath:      viper.GetString(FlagTLSKey),
 		TLSCACrtPath:    viper.GetString(FlagTLSCACrt),
+
+		DeviceStalenessThreshold: viper.GetDuration(FlagDeviceStalenessThreshold),
 	}
 	return cfg, cfg.Validate()
 }
@@ -24,8 +24,13 @@ package core
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 type _keyWriter struct{}
@@ -44,3 +49,29 @@ func WriterFromContext(ctx context.Context) io.Writer {
 		return v
 	}
 }
+
+type _keyDynamicClient struct{}
+
+// WithDynamicClient sets dynamic.Interface to context to be used instead of the one built from kubeconfig.
+func WithDynamicClient(parent context.Context, c dynamic.Interface) context.Context {
+	return context.WithValue(parent, _keyDynamicClient{}, c)
+}
+
+// DynamicClientFromContext extracts dynamic.Interface from context.
+// If not set, a new client is created from the given kubeconfig path, or from the default loading rules if empty.
+func DynamicClientFromContext(ctx context.Context, kubeconfig string) (dynamic.Interface, error) {
+	if v, ok := ctx.Value(_keyDynamicClient{}).(dynamic.Interface); ok {
+		return v, nil
+	}
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kubeconfig
+	restCfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("load kubeconfig: %w", err))
+	}
+	c, err := dynamic.NewForConfig(restCfg)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("create kubernetes client: %w", err))
+	}
+	return c, nil
+}
@@ -23,15 +23,21 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"expvar"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-git/go-git/v5"
@@ -40,12 +46,28 @@ import (
 	"github.com/nttcom/kuesta/internal/logger"
 	"github.com/nttcom/kuesta/internal/util"
 	"github.com/nttcom/kuesta/internal/validator"
+	"github.com/nttcom/kuesta/pkg/aggregatorpb"
 	"github.com/nttcom/kuesta/pkg/credentials"
 	"github.com/nttcom/kuesta/pkg/kuesta"
 	"github.com/pkg/errors"
 )
 
-var UpdateCheckDuration = 5 * time.Second // TODO parameterize
+const (
+	DefaultAggregateCommitInterval   = 5 * time.Second
+	DefaultAggregateMaxBodySize      = 16 << 20 // 16MiB
+	DefaultAggregateQueueSize        = 1024
+	DefaultAggregateLastSeenInterval = 5 * time.Minute
+)
+
+var (
+	ErrBodyTooLarge = errors.New("request body too large")
+	ErrQueueFull    = errors.New("update queue is full")
+	ErrNotRunning   = errors.New("aggregator is not running")
+
+	// AggregateWrittenConfigs counts the actual device configs written to the status repo, which is less than
+	// the number of received reports when they are coalesced. It is exposed on /debug/vars of the aggregator.
+	AggregateWrittenConfigs = expvar.NewInt("kuesta_aggregator_written_configs")
+)
 
 type DeviceAggregateCfg struct {
 	RootCfg
@@ -56,6 +78,27 @@ type DeviceAggregateCfg struct {
 	TLSCrtPath   string
 	TLSKeyPath   string
 	TLSCACrtPath string
+
+	// GRPCAddr is the address to serve the gRPC streaming API. gRPC API is disabled if not set.
+	GRPCAddr string
+
+	// AuthzConfigPath is the path to the YAML file of AggregateAuthzConfig.
+	// Client authentication and per-device authorization are disabled if not set.
+	AuthzConfigPath string
+
+	// CommitInterval is the interval to write buffered device configs and push them as batch commit.
+	CommitInterval time.Duration `validate:"min=0"`
+
+	// MaxBodySize is the max size of the request body in bytes.
+	MaxBodySize int64 `validate:"min=0"`
+
+	// QueueSize is the max number of devices whose config updates are buffered until the next commit.
+	QueueSize int `validate:"min=0"`
+
+	// LastSeenInterval is the interval to write the last seen time of the device whose reported status is not
+	// changed, so that the reports do not produce the commits updating only the timestamps. It must be shorter than
+	// the device staleness threshold.
+	LastSeenInterval time.Duration `validate:"min=0"`
 }
 
 func (c *DeviceAggregateCfg) TLSServerConfig() *credentials.TLSServerConfig {
@@ -102,8 +145,31 @@ func RunDeviceAggregate(ctx context.Context, cfg *DeviceAggregateCfg) error {
 	defer cancel()
 
 	s := NewDeviceAggregateServer(cfg)
+	if cfg.AuthzConfigPath != "" {
+		authzCfg, err := ReadAggregateAuthzConfig(cfg.AuthzConfigPath)
+		if err != nil {
+			return fmt.Errorf("read authz config: %w", err)
+		}
+		s.Authorizer = NewDeviceAuthorizer(authzCfg)
+	}
 	s.Run(ctx)
 
+	errCh := make(chan error, 2)
+	go func() {
+		errCh <- s.serveHTTP(ctx)
+	}()
+	if cfg.GRPCAddr != "" {
+		go func() {
+			errCh <- s.serveGRPC(ctx, cfg.GRPCAddr)
+		}()
+	}
+	return <-errCh
+}
+
+// serveHTTP serves the commit API.
+func (s *DeviceAggregateServer) serveHTTP(ctx context.Context) error {
+	cfg := s.cfg
+	l := logger.FromContext(ctx)
 	l.Infof("starting simple api server on %s", cfg.Addr)
 	http.HandleFunc("/commit", s.HandleFunc)
 	if cfg.NoTLS {
@@ -133,76 +199,286 @@ func RunDeviceAggregate(ctx context.Context, cfg *DeviceAggregateCfg) error {
 	return nil
 }
 
-// DeviceAggregateServer runs saver loop and committer loop along with serving commit API to persist device config to git.
-// Device config are written locally and added to git just after commit API call. Updated configs are aggregated
-// and git-pushed as batch commit periodically.
+// DeviceAggregateServer runs committer loop along with serving commit API to persist device config to git.
+// Device configs received by commit API are buffered per device, so that only the latest config of each device is
+// written locally just before the batch commit. Updated configs are aggregated and git-pushed as batch commit periodically.
+// When the buffer is full, commit API rejects new devices with 429 Too Many Requests until the next commit.
 type DeviceAggregateServer struct {
-	ch  chan *SaveConfigRequest
+	aggregatorpb.UnimplementedAggregatorServer
+
 	cfg *DeviceAggregateCfg
+
+	// fmu guards the actual config files and git worktree between committer and gRPC API.
+	fmu     sync.Mutex
+	mu      sync.Mutex
+	pending map[string]*pendingUpdate
+	running bool
+	// commit is the next batch commit, which contains the actual configs written so far.
+	commit *batchCommit
+
+	// Authorizer authorizes the client to report the actual config of the device. Any request is accepted if nil.
+	Authorizer *DeviceAuthorizer
+
+	now func() time.Time
+}
+
+// pendingUpdate is the buffered update of the device, which is either the report or the deletion.
+type pendingUpdate struct {
+	req        *SaveConfigRequest
+	deleted    bool
+	receivedAt time.Time
+}
+
+// batchCommit is closed once the batch commit is pushed, or failed with err.
+type batchCommit struct {
+	done chan struct{}
+	err  error
 }
 
 // NewDeviceAggregateServer creates new DeviceAggregateServer.
 func NewDeviceAggregateServer(cfg *DeviceAggregateCfg) *DeviceAggregateServer {
 	return &DeviceAggregateServer{
-		ch:  make(chan *SaveConfigRequest),
-		cfg: cfg,
+		cfg:     cfg,
+		pending: map[string]*pendingUpdate{},
+		commit:  &batchCommit{done: make(chan struct{})},
+		now:     time.Now,
+	}
+}
+
+func (s *DeviceAggregateServer) commitInterval() time.Duration {
+	if s.cfg.CommitInterval > 0 {
+		return s.cfg.CommitInterval
+	}
+	return DefaultAggregateCommitInterval
+}
+
+func (s *DeviceAggregateServer) lastSeenInterval() time.Duration {
+	if s.cfg.LastSeenInterval > 0 {
+		return s.cfg.LastSeenInterval
+	}
+	return DefaultAggregateLastSeenInterval
+}
+
+func (s *DeviceAggregateServer) maxBodySize() int64 {
+	if s.cfg.MaxBodySize > 0 {
+		return s.cfg.MaxBodySize
 	}
+	return DefaultAggregateMaxBodySize
+}
+
+func (s *DeviceAggregateServer) queueSize() int {
+	if s.cfg.QueueSize > 0 {
+		return s.cfg.QueueSize
+	}
+	return DefaultAggregateQueueSize
 }
 
 // HandleFunc handles API call to persist actual device config.
 func (s *DeviceAggregateServer) HandleFunc(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodPost:
-		ctx := r.Context()
-		if err, code := s.add(ctx, r.Body); err != nil {
-			http.Error(w, err.Error(), code)
-		}
 		defer r.Body.Close()
+		if err, code := s.add(r); err != nil {
+			s.httpError(w, err, code)
+		}
+		return
+	case http.MethodDelete:
+		if err, code := s.remove(r); err != nil {
+			s.httpError(w, err, code)
+		}
 		return
 	default:
-		http.Error(w, `{"status": "only POST allowed"}`, http.StatusMethodNotAllowed)
+		http.Error(w, `{"status": "only POST and DELETE allowed"}`, http.StatusMethodNotAllowed)
 	}
 }
 
-func (s *DeviceAggregateServer) add(ctx context.Context, r io.Reader) (error, int) {
-	req, err := DecodeSaveConfigRequest(r)
+func (s *DeviceAggregateServer) httpError(w http.ResponseWriter, err error, code int) {
+	if code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(s.commitInterval().Seconds()))))
+	}
+	http.Error(w, err.Error(), code)
+}
+
+func (s *DeviceAggregateServer) add(r *http.Request) (error, int) {
+	max := s.maxBodySize()
+	body, err := io.ReadAll(io.LimitReader(r.Body, max+1))
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("read request body: %w", err)), http.StatusBadRequest
+	}
+	if int64(len(body)) > max {
+		return errors.WithStack(fmt.Errorf("%w: exceeds %d bytes", ErrBodyTooLarge, max)), http.StatusRequestEntityTooLarge
+	}
+	req, err := DecodeSaveConfigRequest(bytes.NewReader(body))
 	if err != nil {
-		return err, 400
+		return err, http.StatusBadRequest
 	}
-	s.ch <- req
-	return nil, 200
+	if err := checkDeviceName(req.Device); err != nil {
+		return err, http.StatusBadRequest
+	}
+	if err, code := s.authorize(r, req.Device); err != nil {
+		return err, code
+	}
+	return s.enqueueWithCode(&pendingUpdate{req: req})
 }
 
-func (s *DeviceAggregateServer) Run(ctx context.Context) {
-	s.runSaver(ctx)
-	s.runCommitter(ctx)
+// remove handles the deletion of the device given by `device` query parameter.
+func (s *DeviceAggregateServer) remove(r *http.Request) (error, int) {
+	device := r.URL.Query().Get("device")
+	if err := checkDeviceName(device); err != nil {
+		return err, http.StatusBadRequest
+	}
+	if err, code := s.authorize(r, device); err != nil {
+		return err, code
+	}
+	return s.enqueueWithCode(&pendingUpdate{req: &SaveConfigRequest{Device: device}, deleted: true})
 }
 
-func (s *DeviceAggregateServer) runSaver(ctx context.Context) {
-	l := logger.FromContext(ctx)
+func (s *DeviceAggregateServer) enqueueWithCode(u *pendingUpdate) (error, int) {
+	if err := s.enqueue(u); err != nil {
+		if errors.Is(err, ErrNotRunning) {
+			return err, http.StatusServiceUnavailable
+		}
+		return err, http.StatusTooManyRequests
+	}
+	return nil, http.StatusOK
+}
+
+// enqueue buffers the device update. The pending update of the same device is replaced with the new one,
+// except that the report only with the sync error keeps the pending config.
+func (s *DeviceAggregateServer) enqueue(u *pendingUpdate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return errors.WithStack(ErrNotRunning)
+	}
+	prev, ok := s.pending[u.req.Device]
+	if !ok && len(s.pending) >= s.queueSize() {
+		return errors.WithStack(fmt.Errorf("%w: %d devices are pending", ErrQueueFull, len(s.pending)))
+	}
+	if ok && !u.deleted && !prev.deleted && u.req.Config == nil {
+		u.req.Config = prev.req.Config
+	}
+	u.receivedAt = s.now()
+	s.pending[u.req.Device] = u
+	return nil
+}
+
+// dequeueAll takes all the buffered device updates.
+func (s *DeviceAggregateServer) dequeueAll() []*pendingUpdate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	updates := make([]*pendingUpdate, 0, len(s.pending))
+	for _, k := range util.SortedMapKeys(s.pending) {
+		updates = append(updates, s.pending[k])
+	}
+	s.pending = map[string]*pendingUpdate{}
+	return updates
+}
+
+// takePending takes the buffered update of the device, or returns nil if nothing is buffered.
+func (s *DeviceAggregateServer) takePending(device string) *pendingUpdate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u := s.pending[device]
+	delete(s.pending, device)
+	return u
+}
 
+// nextCommit returns the next batch commit, which contains the actual configs written before the call.
+func (s *DeviceAggregateServer) nextCommit() *batchCommit {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.commit
+}
+
+// completeCommit notifies the result of the batch commit, and starts the next one.
+func (s *DeviceAggregateServer) completeCommit(err error) {
+	s.mu.Lock()
+	c := s.commit
+	s.commit = &batchCommit{done: make(chan struct{})}
+	s.mu.Unlock()
+	c.err = err
+	close(c.done)
+}
+
+// authorize checks the client is allowed to report for the device, and logs and counts the rejected requests.
+func (s *DeviceAggregateServer) authorize(r *http.Request, device string) (error, int) {
+	if s.Authorizer == nil {
+		return nil, 200
+	}
+	client, err := s.Authorizer.Authorize(r, device)
+	if err == nil {
+		logger.FromContext(r.Context()).Debugw("request authorized", "device", device, "client", client)
+		return nil, 200
+	}
+
+	_, code := s.reject(r.Context(), device, r.RemoteAddr, err)
+	return err, code
+}
+
+// reject logs and counts the rejected request, and returns the reason and HTTP status code for the authorization error.
+func (s *DeviceAggregateServer) reject(ctx context.Context, device, remote string, err error) (string, int) {
+	reason, code := RejectReasonForbidden, http.StatusForbidden
+	if errors.Is(err, ErrUnauthenticated) {
+		reason, code = RejectReasonUnauthenticated, http.StatusUnauthorized
+	}
+	AggregateRejectedRequests.Add(reason, 1)
+	logger.FromContext(ctx).Warnw("request rejected", "device", device, "reason", reason, "remote", remote, "error", err.Error())
+	return reason, code
+}
+
+func (s *DeviceAggregateServer) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.running = true
+	s.mu.Unlock()
 	go func() {
-		for {
-			select {
-			case r := <-s.ch:
-				l.Infof("update received: device=%s", r.Device)
-				if err := s.SaveConfig(ctx, r); err != nil {
-					logger.ErrorWithStack(ctx, err, "save actual device config", "device", r.Device)
-				}
-			case <-ctx.Done():
-				return
-			}
-		}
+		<-ctx.Done()
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
 	}()
-	l.Info("saver loop started")
+
+	s.runCommitter(ctx)
 }
 
 func (s *DeviceAggregateServer) runCommitter(ctx context.Context) {
 	util.SetInterval(ctx, func() {
-		if err := s.GitPushDeviceConfig(ctx); err != nil {
+		s.fmu.Lock()
+		defer s.fmu.Unlock()
+		s.flush(ctx)
+		err := s.GitPushDeviceConfig(ctx)
+		if err != nil {
 			logger.ErrorWithStack(ctx, err, "push sync branch")
 		}
-	}, UpdateCheckDuration)
+		s.completeCommit(err)
+	}, s.commitInterval())
+}
+
+// flush writes the latest buffered config and status of each device, or deletes the device.
+func (s *DeviceAggregateServer) flush(ctx context.Context) {
+	for _, u := range s.dequeueAll() {
+		if err := s.applyPending(ctx, u); err != nil {
+			logger.ErrorWithStack(ctx, err, "apply buffered update", "device", u.req.Device)
+		}
+	}
+}
+
+// applyPending writes the config and status of the buffered update, or deletes the device.
+func (s *DeviceAggregateServer) applyPending(ctx context.Context, u *pendingUpdate) error {
+	l := logger.FromContext(ctx)
+	r := u.req
+	if u.deleted {
+		l.Infof("deletion received: device=%s", r.Device)
+		return s.DeleteDevice(ctx, r.Device)
+	}
+
+	l.Infof("update received: device=%s", r.Device)
+	if r.Config != nil {
+		if err := s.SaveConfig(ctx, r); err != nil {
+			return err
+		}
+	}
+	return s.SaveStatus(ctx, r, u.receivedAt)
 }
 
 // SaveConfig writes device config contained in supplied SaveConfigRequest.
@@ -211,10 +487,43 @@ func (s *DeviceAggregateServer) SaveConfig(ctx context.Context, r *SaveConfigReq
 	if err := file.WriteFileWithMkdir(dp.DeviceActualConfigPath(kuesta.IncludeRoot), []byte(*r.Config)); err != nil {
 		return fmt.Errorf("write actual device config: %w", err)
 	}
+	AggregateWrittenConfigs.Add(1)
+	return nil
+}
+
+// SaveStatus writes the device status with the subscriber version and the sync error contained in supplied SaveConfigRequest.
+// The status is not written if only the last seen time is updated within the last seen interval.
+func (s *DeviceAggregateServer) SaveStatus(ctx context.Context, r *SaveConfigRequest, lastSeen time.Time) error {
+	dp := kuesta.DevicePath{RootDir: s.cfg.StatusRootPath, Device: r.Device}
+	st := &kuesta.DeviceStatus{
+		LastSeen:          lastSeen.UTC(),
+		SubscriberVersion: r.SubscriberVersion,
+		SyncError:         r.SyncError,
+	}
+	if cur, err := dp.ReadDeviceStatus(); err == nil {
+		elapsed := st.LastSeen.Sub(cur.LastSeen)
+		if cur.SubscriberVersion == st.SubscriberVersion && cur.SyncError == st.SyncError && elapsed >= 0 && elapsed < s.lastSeenInterval() {
+			return nil
+		}
+	}
+	if err := dp.WriteDeviceStatus(st); err != nil {
+		return fmt.Errorf("write device status: %w", err)
+	}
+	return nil
+}
+
+// DeleteDevice removes the actual config and the status of the device.
+func (s *DeviceAggregateServer) DeleteDevice(ctx context.Context, device string) error {
+	dp := kuesta.DevicePath{RootDir: s.cfg.StatusRootPath, Device: device}
+	if err := os.RemoveAll(dp.DevicePath(kuesta.IncludeRoot)); err != nil {
+		return errors.WithStack(fmt.Errorf("remove device dir: %w", err))
+	}
 	return nil
 }
 
 // GitPushDeviceConfig runs git-commit all unstaged device config updates as batch commit then git-push to remote origin.
+// NOTE git-pull is not performed in advance since it discards unstaged updates. Instead, when the push is rejected
+// since the remote has been updated by another writer, the batch commit is rebased onto the remote branch and pushed again.
 func (s *DeviceAggregateServer) GitPushDeviceConfig(ctx context.Context) error {
 	l := logger.FromContext(ctx)
 
@@ -223,10 +532,6 @@ func (s *DeviceAggregateServer) GitPushDeviceConfig(ctx context.Context) error {
 		return fmt.Errorf("init git: %w", err)
 	}
 
-	if err := g.Pull(); err != nil {
-		return fmt.Errorf("git pull: %w", err)
-	}
-
 	w, err := g.Checkout()
 	if err != nil {
 		return fmt.Errorf("git checkout to trunk: %w", err)
@@ -245,30 +550,66 @@ func (s *DeviceAggregateServer) GitPushDeviceConfig(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("get status map: %w", err)
 	}
-	// TODO check only staged files
-	if len(stmap) == 0 {
-		l.Info("skipped: there are no update")
-		return nil
+	// NOTE git-add of the directory does not stage the removed files of the deleted devices
+	removed := false
+	for path, st := range stmap {
+		if st.Worktree == git.Deleted && strings.HasPrefix(path, "devices/") {
+			if _, err := w.Remove(path); err != nil {
+				return errors.WithStack(fmt.Errorf("git rm %s: %w", path, err))
+			}
+			removed = true
+		}
 	}
-	if err := CheckGitIsStagedOrUnmodified(stmap); err != nil {
-		return fmt.Errorf("check files are either staged or unmodified: %w", err)
+	if removed {
+		if stmap, err = w.Status(); err != nil {
+			return fmt.Errorf("get status map: %w", err)
+		}
 	}
+	// TODO check only staged files
+	if len(stmap) == 0 {
+		if !hasUnpushedCommits(g) {
+			l.Info("skipped: there are no update")
+			return nil
+		}
+	} else {
+		if err := CheckGitIsStagedOrUnmodified(stmap); err != nil {
+			return fmt.Errorf("check files are either staged or unmodified: %w", err)
+		}
 
-	commitMsg := MakeSyncCommitMessage(stmap)
-	if _, err := g.Commit(commitMsg); err != nil {
-		return fmt.Errorf("git commit: %w", err)
+		commitMsg := MakeSyncCommitMessage(stmap)
+		if _, err := g.Commit(commitMsg); err != nil {
+			return fmt.Errorf("git commit: %w", err)
+		}
+		l.Infof("committed: %s\n", commitMsg)
 	}
-	l.Infof("committed: %s\n", commitMsg)
-	if err := g.Push(); err != nil {
+
+	if err := g.PushWithRetry(ctx, g.Options().TrunkBranch); err != nil {
 		return fmt.Errorf("git push: %w", err)
 	}
 
 	return nil
 }
 
+func hasUnpushedCommits(g *gogit.Git) bool {
+	head, err := g.Head()
+	if err != nil {
+		return false
+	}
+	remoteHead, err := g.RemoteHead(g.Options().TrunkBranch)
+	if err != nil {
+		return true
+	}
+	return head.Hash != remoteHead.Hash
+}
+
 type SaveConfigRequest struct {
 	Device string  `json:"device" validate:"required"`
-	Config *string `json:"config" validate:"required"`
+	Config *string `json:"config" validate:"required_without=SyncError"`
+
+	// SubscriberVersion is the version of the subscriber which reports the device config.
+	SubscriberVersion string `json:"subscriberVersion,omitempty"`
+	// SyncError is the error occurred on the subscriber. Config can be omitted if set.
+	SyncError string `json:"syncError,omitempty"`
 }
 
 func (r *SaveConfigRequest) Validate() error {
@@ -285,10 +626,12 @@ func DecodeSaveConfigRequest(r io.Reader) (*SaveConfigRequest, error) {
 }
 
 // MakeSyncCommitMessage returns the commit message that shows the device actual config updates.
+// Devices whose status is only updated are listed separately.
 func MakeSyncCommitMessage(stmap git.Status) string {
 	var devicesAdded []string
 	var devicesModified []string
 	var devicesDeleted []string
+	statusUpdated := map[string]bool{}
 
 	for path, st := range stmap {
 		dir, file := filepath.Split(path)
@@ -306,7 +649,16 @@ func MakeSyncCommitMessage(stmap git.Status) string {
 				// noop
 			}
 		}
+		if dirElem[0] == "devices" && file == kuesta.FileDeviceStatus {
+			if st.Staging == git.Added || st.Staging == git.Modified {
+				statusUpdated[dirElem[1]] = true
+			}
+		}
 	}
+	for _, d := range append(append(devicesAdded, devicesModified...), devicesDeleted...) {
+		delete(statusUpdated, d)
+	}
+	devicesStatus := util.SortedMapKeys(statusUpdated)
 	for _, v := range [][]string{devicesAdded, devicesModified, devicesDeleted} {
 		sort.Slice(v, func(i, j int) bool { return v[i] < v[j] })
 	}
@@ -317,6 +669,9 @@ func MakeSyncCommitMessage(stmap git.Status) string {
 	devices = append(devices, devicesModified...)
 
 	title := fmt.Sprintf("Updated: %s", strings.Join(devices, " "))
+	if len(devices) == 0 && len(devicesStatus) > 0 {
+		title = fmt.Sprintf("Updated status: %s", strings.Join(devicesStatus, " "))
+	}
 	var bodylines []string
 	bodylines = append(bodylines, "", "Devices:")
 	for _, d := range devicesAdded {
@@ -328,6 +683,12 @@ func MakeSyncCommitMessage(stmap git.Status) string {
 	for _, d := range devicesModified {
 		bodylines = append(bodylines, fmt.Sprintf("\tmodified:  %s", d))
 	}
+	if len(devicesStatus) > 0 {
+		bodylines = append(bodylines, "", "Status:")
+		for _, d := range devicesStatus {
+			bodylines = append(bodylines, fmt.Sprintf("\tupdated:   %s", d))
+		}
+	}
 
 	return title + "\n" + strings.Join(bodylines, "\n")
 }
@@ -0,0 +1,219 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package core
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"expvar"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/nttcom/kuesta/internal/util"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// AuthzAnyDevice matches any device in AggregateAuthzRule.Devices.
+	AuthzAnyDevice = "*"
+
+	RejectReasonUnauthenticated = "unauthenticated"
+	RejectReasonForbidden       = "forbidden"
+)
+
+var (
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+
+	// AggregateRejectedRequests counts requests rejected by the device aggregator per reason.
+	// It is exposed on /debug/vars of the aggregator.
+	AggregateRejectedRequests = expvar.NewMap("kuesta_aggregator_rejected_requests")
+)
+
+// AggregateAuthzConfig provides which client is allowed to report the actual config of which devices.
+type AggregateAuthzConfig struct {
+	Rules []AggregateAuthzRule `yaml:"rules"`
+}
+
+// AggregateAuthzRule binds the client identity to the devices it is allowed to report for.
+type AggregateAuthzRule struct {
+	// Name is the name of the client used in logs.
+	Name string `yaml:"name"`
+
+	// Token is the bearer token the client presents in the Authorization header.
+	Token string `yaml:"token,omitempty"`
+
+	// Subjects are the Common Name or Subject Alternative Names of the client certificate.
+	Subjects []string `yaml:"subjects,omitempty"`
+
+	// Devices are the names of the devices the client is allowed to report for. "*" matches any device.
+	Devices []string `yaml:"devices"`
+}
+
+// ReadAggregateAuthzConfig reads AggregateAuthzConfig from the YAML file on the given path.
+func ReadAggregateAuthzConfig(path string) (*AggregateAuthzConfig, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("read authz config: %w", err))
+	}
+	var cfg AggregateAuthzConfig
+	if err := yaml.Unmarshal(buf, &cfg); err != nil {
+		return nil, errors.WithStack(fmt.Errorf("decode authz config: %w", err))
+	}
+	for i, r := range cfg.Rules {
+		if r.Token == "" && len(r.Subjects) == 0 {
+			return nil, errors.WithStack(fmt.Errorf("rules[%d]: either token or subjects must be set", i))
+		}
+	}
+	return &cfg, nil
+}
+
+// DeviceAuthorizer authenticates the client of the device aggregator by the bearer token or the verified client
+// certificate, and authorizes it to report the actual config of the devices bound to the identity.
+// The client whose certificate Common Name or Subject Alternative Name equals to the device name is always allowed
+// to report for its own device.
+type DeviceAuthorizer struct {
+	cfg *AggregateAuthzConfig
+}
+
+// NewDeviceAuthorizer creates new DeviceAuthorizer.
+func NewDeviceAuthorizer(cfg *AggregateAuthzConfig) *DeviceAuthorizer {
+	return &DeviceAuthorizer{cfg: cfg}
+}
+
+// Authorize checks whether the client of the given request is allowed to report the actual config of the device.
+// It returns the client name on success.
+func (a *DeviceAuthorizer) Authorize(r *http.Request, device string) (string, error) {
+	token, hasToken := bearerToken(r.Header.Get("Authorization"))
+	return a.authorize(token, hasToken, verifiedClientCert(r.TLS), device)
+}
+
+// AuthorizeContext checks whether the gRPC client is allowed to report the actual config of the device.
+// The bearer token is taken from the `authorization` metadata, and the client certificate from the peer.
+func (a *DeviceAuthorizer) AuthorizeContext(ctx context.Context, device string) (string, error) {
+	var token string
+	var hasToken bool
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("authorization"); len(v) > 0 {
+			token, hasToken = bearerToken(v[0])
+		}
+	}
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &info.State
+		}
+	}
+	return a.authorize(token, hasToken, verifiedClientCert(state), device)
+}
+
+func (a *DeviceAuthorizer) authorize(token string, hasToken bool, cert *x509.Certificate, device string) (string, error) {
+	var matched []AggregateAuthzRule
+	var subjects []string
+
+	if hasToken {
+		for _, rule := range a.cfg.Rules {
+			if rule.Token != "" && subtle.ConstantTimeCompare([]byte(rule.Token), []byte(token)) == 1 {
+				matched = append(matched, rule)
+			}
+		}
+		if len(matched) == 0 {
+			return "", errors.WithStack(fmt.Errorf("%w: invalid bearer token", ErrUnauthenticated))
+		}
+	}
+
+	if cert != nil {
+		subjects = certSubjects(cert)
+		for _, rule := range a.cfg.Rules {
+			ruleSubjects := util.NewSet(rule.Subjects...)
+			if hasAny(&ruleSubjects, subjects) {
+				matched = append(matched, rule)
+			}
+		}
+	}
+
+	if len(matched) == 0 && len(subjects) == 0 {
+		return "", errors.WithStack(fmt.Errorf("%w: neither bearer token nor client certificate given", ErrUnauthenticated))
+	}
+
+	for _, rule := range matched {
+		devices := util.NewSet(rule.Devices...)
+		if devices.Has(AuthzAnyDevice) || devices.Has(device) {
+			return rule.Name, nil
+		}
+	}
+	if ownSubjects := util.NewSet(subjects...); ownSubjects.Has(device) {
+		return device, nil
+	}
+
+	name := strings.Join(subjects, ",")
+	if len(matched) > 0 {
+		name = matched[0].Name
+	}
+	return "", errors.WithStack(fmt.Errorf("%w: client %s is not allowed to report for device %s", ErrForbidden, name, device))
+}
+
+func bearerToken(h string) (string, bool) {
+	const prefix = "Bearer "
+	if len(h) < len(prefix) || !strings.EqualFold(h[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(h[len(prefix):]), true
+}
+
+func verifiedClientCert(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
+
+func certSubjects(cert *x509.Certificate) []string {
+	var subjects []string
+	if cert.Subject.CommonName != "" {
+		subjects = append(subjects, cert.Subject.CommonName)
+	}
+	subjects = append(subjects, cert.DNSNames...)
+	subjects = append(subjects, cert.EmailAddresses...)
+	for _, u := range cert.URIs {
+		subjects = append(subjects, u.String())
+	}
+	return subjects
+}
+
+func hasAny(s *util.Set[string], items []string) bool {
+	for _, v := range items {
+		if s.Has(v) {
+			return true
+		}
+	}
+	return false
+}
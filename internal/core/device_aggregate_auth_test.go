@@ -0,0 +1,177 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package core_test
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nttcom/kuesta/internal/core"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func withClientCert(r *http.Request, cn string, dnsNames ...string) *http.Request {
+	r.TLS = &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{
+			{Subject: pkix.Name{CommonName: cn}, DNSNames: dnsNames},
+		}},
+	}
+	return r
+}
+
+func withToken(r *http.Request, token string) *http.Request {
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+func TestReadAggregateAuthzConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("ok", func(t *testing.T) {
+		path := filepath.Join(dir, "ok.yaml")
+		testhelper.ExitOnErr(t, os.WriteFile(path, []byte(`
+rules:
+  - name: subscriber-a
+    token: token-a
+    devices: [device1, device2]
+  - name: subscriber-b
+    subjects: [subscriber-b.kuesta-system.svc]
+    devices: ["*"]
+`), 0o600))
+		want := &core.AggregateAuthzConfig{
+			Rules: []core.AggregateAuthzRule{
+				{Name: "subscriber-a", Token: "token-a", Devices: []string{"device1", "device2"}},
+				{Name: "subscriber-b", Subjects: []string{"subscriber-b.kuesta-system.svc"}, Devices: []string{"*"}},
+			},
+		}
+		got, err := core.ReadAggregateAuthzConfig(path)
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("err: no identity", func(t *testing.T) {
+		path := filepath.Join(dir, "bad.yaml")
+		testhelper.ExitOnErr(t, os.WriteFile(path, []byte(`
+rules:
+  - name: subscriber-a
+    devices: [device1]
+`), 0o600))
+		_, err := core.ReadAggregateAuthzConfig(path)
+		assert.Error(t, err)
+	})
+
+	t.Run("err: file not exist", func(t *testing.T) {
+		_, err := core.ReadAggregateAuthzConfig(filepath.Join(dir, "not-exist.yaml"))
+		assert.Error(t, err)
+	})
+}
+
+func TestDeviceAuthorizer_Authorize(t *testing.T) {
+	a := core.NewDeviceAuthorizer(&core.AggregateAuthzConfig{
+		Rules: []core.AggregateAuthzRule{
+			{Name: "subscriber-a", Token: "token-a", Devices: []string{"device1", "device2"}},
+			{Name: "subscriber-b", Subjects: []string{"subscriber-b"}, Devices: []string{"device3"}},
+			{Name: "admin", Token: "token-admin", Devices: []string{core.AuthzAnyDevice}},
+		},
+	})
+	newRequest := func() *http.Request {
+		return httptest.NewRequest(http.MethodPost, "/commit", nil)
+	}
+
+	tests := []struct {
+		name    string
+		req     *http.Request
+		device  string
+		want    string
+		wantErr error
+	}{
+		{"ok: token", withToken(newRequest(), "token-a"), "device2", "subscriber-a", nil},
+		{"ok: token allowed any device", withToken(newRequest(), "token-admin"), "device9", "admin", nil},
+		{"ok: cert subject", withClientCert(newRequest(), "subscriber-b"), "device3", "subscriber-b", nil},
+		{"ok: own device", withClientCert(newRequest(), "foo", "device4"), "device4", "device4", nil},
+		{"err: no identity", newRequest(), "device1", "", core.ErrUnauthenticated},
+		{"err: invalid token", withToken(newRequest(), "wrong"), "device1", "", core.ErrUnauthenticated},
+		{"err: token not allowed", withToken(newRequest(), "token-a"), "device3", "", core.ErrForbidden},
+		{"err: cert subject not allowed", withClientCert(newRequest(), "subscriber-b"), "device1", "", core.ErrForbidden},
+		{"err: other device", withClientCert(newRequest(), "device4"), "device5", "", core.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Authorize(tt.req, tt.device)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDeviceAggregateServer_HandleFunc_Authorization(t *testing.T) {
+	s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{})
+	s.Authorizer = core.NewDeviceAuthorizer(&core.AggregateAuthzConfig{
+		Rules: []core.AggregateAuthzRule{
+			{Name: "subscriber-a", Token: "token-a", Devices: []string{"device1"}},
+		},
+	})
+	newRequest := func(device string) *http.Request {
+		config := "foobar"
+		buf, err := json.Marshal(core.SaveConfigRequest{Device: device, Config: &config})
+		testhelper.ExitOnErr(t, err)
+		return httptest.NewRequest(http.MethodPost, "/commit", bytes.NewBuffer(buf))
+	}
+	rejected := func(reason string) int64 {
+		v, ok := core.AggregateRejectedRequests.Get(reason).(interface{ Value() int64 })
+		if !ok {
+			return 0
+		}
+		return v.Value()
+	}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		before := rejected(core.RejectReasonUnauthenticated)
+		response := httptest.NewRecorder()
+		s.HandleFunc(response, newRequest("device1"))
+		assert.Equal(t, http.StatusUnauthorized, response.Result().StatusCode)
+		assert.Equal(t, before+1, rejected(core.RejectReasonUnauthenticated))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		before := rejected(core.RejectReasonForbidden)
+		response := httptest.NewRecorder()
+		s.HandleFunc(response, withToken(newRequest("device2"), "token-a"))
+		assert.Equal(t, http.StatusForbidden, response.Result().StatusCode)
+		assert.Equal(t, before+1, rejected(core.RejectReasonForbidden))
+	})
+}
@@ -0,0 +1,451 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/nttcom/kuesta/internal/file"
+	"github.com/nttcom/kuesta/internal/logger"
+	"github.com/nttcom/kuesta/pkg/aggregatorpb"
+	"github.com/nttcom/kuesta/pkg/credentials"
+	kcue "github.com/nttcom/kuesta/pkg/cue"
+	"github.com/nttcom/kuesta/pkg/kuesta"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// serveGRPC serves the aggregator gRPC API on the given address.
+func (s *DeviceAggregateServer) serveGRPC(ctx context.Context, addr string) error {
+	l := logger.FromContext(ctx)
+
+	credOpts, err := credentials.GRPCServerCredentials(s.cfg.TLSServerConfig())
+	if err != nil {
+		return fmt.Errorf("setup credentials: %w", err)
+	}
+	opts := append(credOpts, grpc.MaxRecvMsgSize(int(s.maxBodySize())))
+	g := grpc.NewServer(opts...)
+	aggregatorpb.RegisterAggregatorServer(g, s)
+
+	l.Infof("starting gRPC api server on %s", addr)
+	listen, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("listen: %w", err))
+	}
+	go func() {
+		<-ctx.Done()
+		g.GracefulStop()
+	}()
+	if err := g.Serve(listen); err != nil {
+		return errors.WithStack(fmt.Errorf("serve gRPC: %w", err))
+	}
+	return nil
+}
+
+// Stream receives the device config updates and acknowledges each of them once it is committed and pushed to the
+// status repo by the next batch commit. The update is acknowledged with the error if it cannot be applied or pushed.
+func (s *DeviceAggregateServer) Stream(stream aggregatorpb.Aggregator_StreamServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	acks := make(chan *streamAck, s.queueSize())
+	sent := make(chan error, 1)
+	go func() {
+		sent <- s.sendAcks(ctx, stream, acks)
+	}()
+
+	err := s.receiveUpdates(ctx, stream, acks)
+	if err != nil {
+		// the stream is broken, so that the pending acknowledgements are not sent
+		cancel()
+	}
+	close(acks)
+	if sendErr := <-sent; err == nil {
+		err = sendErr
+	}
+	return err
+}
+
+// streamAck is the acknowledgement of the StreamRequest sent once the batch commit is done.
+type streamAck struct {
+	resp   *aggregatorpb.StreamResponse
+	commit *batchCommit
+}
+
+// receiveUpdates applies the StreamRequests until the client closes the stream, and queues their acknowledgements.
+func (s *DeviceAggregateServer) receiveUpdates(ctx context.Context, stream aggregatorpb.Aggregator_StreamServer, acks chan<- *streamAck) error {
+	l := logger.FromContext(ctx)
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if s.Authorizer != nil {
+			if _, err := s.Authorizer.AuthorizeContext(ctx, req.GetDevice()); err != nil {
+				if reason, _ := s.reject(ctx, req.GetDevice(), peerAddr(ctx), err); reason == RejectReasonUnauthenticated {
+					return status.Error(codes.Unauthenticated, err.Error())
+				}
+				return status.Error(codes.PermissionDenied, err.Error())
+			}
+		}
+
+		ack := &streamAck{resp: &aggregatorpb.StreamResponse{Id: req.GetId(), Device: req.GetDevice()}}
+		if err := s.ApplyUpdate(ctx, req); err != nil {
+			logger.ErrorWithStack(ctx, err, "apply device config update", "device", req.GetDevice())
+			ack.resp.Error = err.Error()
+		} else {
+			l.Infof("update applied: device=%s id=%d", req.GetDevice(), req.GetId())
+			ack.commit = s.nextCommit()
+		}
+		select {
+		case acks <- ack:
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		}
+	}
+}
+
+// sendAcks sends the acknowledgements in order, each of which waits for the batch commit containing the update.
+func (s *DeviceAggregateServer) sendAcks(ctx context.Context, stream aggregatorpb.Aggregator_StreamServer, acks <-chan *streamAck) error {
+	var err error
+	for ack := range acks {
+		if err != nil {
+			continue
+		}
+		if ack.commit != nil {
+			select {
+			case <-ack.commit.done:
+				if ack.commit.err != nil {
+					ack.resp.Error = fmt.Sprintf("push actual device config: %s", ack.commit.err)
+				}
+			case <-ctx.Done():
+				err = errors.WithStack(ctx.Err())
+				continue
+			}
+		}
+		err = stream.Send(ack.resp)
+	}
+	return err
+}
+
+// ApplyUpdate writes the snapshot or applies the notifications of the supplied StreamRequest to the actual config,
+// and then records the device status. The device is removed instead if the deletion is requested.
+// The update buffered by the commit API for the device is older than this one, so that it is discarded if this one
+// replaces the entire actual config, or else it is written in advance as the base of this one.
+func (s *DeviceAggregateServer) ApplyUpdate(ctx context.Context, req *aggregatorpb.StreamRequest) error {
+	if err := checkDeviceName(req.GetDevice()); err != nil {
+		return err
+	}
+
+	s.fmu.Lock()
+	defer s.fmu.Unlock()
+	if p := s.takePending(req.GetDevice()); p != nil && !replacesConfig(req) {
+		if err := s.applyPending(ctx, p); err != nil {
+			return fmt.Errorf("apply buffered update: %w", err)
+		}
+	}
+
+	dp := kuesta.DevicePath{RootDir: s.cfg.StatusRootPath, Device: req.GetDevice()}
+	path := dp.DeviceActualConfigPath(kuesta.IncludeRoot)
+
+	var buf []byte
+	switch u := req.GetUpdate().(type) {
+	case *aggregatorpb.StreamRequest_Delete:
+		return s.DeleteDevice(ctx, req.GetDevice())
+	case *aggregatorpb.StreamRequest_Snapshot:
+		buf = u.Snapshot.GetConfig()
+	case *aggregatorpb.StreamRequest_Notifications:
+		tree, err := readConfigTree(path)
+		if err != nil {
+			return err
+		}
+		if err := ApplyNotifications(tree, u.Notifications.GetNotification()...); err != nil {
+			return fmt.Errorf("apply notifications: %w", err)
+		}
+		if buf, err = formatConfigTree(tree); err != nil {
+			return err
+		}
+	default:
+		if req.GetSyncError() == "" {
+			return errors.WithStack(fmt.Errorf("neither snapshot, notifications, delete nor sync_error given"))
+		}
+	}
+
+	if buf != nil {
+		if err := file.WriteFileWithMkdir(path, buf); err != nil {
+			return fmt.Errorf("write actual device config: %w", err)
+		}
+	}
+	return s.SaveStatus(ctx, &SaveConfigRequest{
+		Device:            req.GetDevice(),
+		SubscriberVersion: req.GetSubscriberVersion(),
+		SyncError:         req.GetSyncError(),
+	}, s.now())
+}
+
+// replacesConfig returns true if the StreamRequest replaces or removes the entire actual config.
+func replacesConfig(req *aggregatorpb.StreamRequest) bool {
+	switch req.GetUpdate().(type) {
+	case *aggregatorpb.StreamRequest_Snapshot, *aggregatorpb.StreamRequest_Delete:
+		return true
+	default:
+		return false
+	}
+}
+
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+func checkDeviceName(device string) error {
+	if device == "" || device == "." || device == ".." || strings.ContainsAny(device, `/\`) {
+		return errors.WithStack(fmt.Errorf("invalid device name: %q", device))
+	}
+	return nil
+}
+
+func readConfigTree(path string) (map[string]any, error) {
+	tree := map[string]any{}
+	buf, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return tree, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("read actual device config: %w", err))
+	}
+	v, err := kcue.NewValueFromBytes(cuecontext.New(), buf)
+	if err != nil {
+		return nil, fmt.Errorf("load actual device config: %w", err)
+	}
+	if err := v.Decode(&tree); err != nil {
+		return nil, errors.WithStack(fmt.Errorf("decode actual device config: %w", err))
+	}
+	return tree, nil
+}
+
+func formatConfigTree(tree map[string]any) ([]byte, error) {
+	v := cuecontext.New().Encode(tree)
+	if v.Err() != nil {
+		return nil, errors.WithStack(fmt.Errorf("encode actual device config: %w", v.Err()))
+	}
+	buf, err := kcue.FormatCue(v, cue.Final())
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("format actual device config: %w", err))
+	}
+	return buf, nil
+}
+
+// ApplyNotifications applies the deletes and updates of the gNMI notifications to the config tree in order.
+// Path element names are the field labels of the tree, and the path element with a key addresses the entry of
+// the keyed list by its key value.
+func ApplyNotifications(tree map[string]any, notifications ...*pb.Notification) error {
+	for _, n := range notifications {
+		prefix := n.GetPrefix().GetElem()
+		for _, p := range n.GetDelete() {
+			labels, err := pathLabels(prefix, p.GetElem())
+			if err != nil {
+				return err
+			}
+			deleteTreeNode(tree, labels)
+		}
+		for _, u := range n.GetUpdate() {
+			if err := setTreeNode(tree, prefix, u); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func pathLabels(elemsList ...[]*pb.PathElem) ([]string, error) {
+	var labels []string
+	for _, elems := range elemsList {
+		for _, e := range elems {
+			labels = append(labels, e.GetName())
+			switch len(e.GetKey()) {
+			case 0:
+			case 1:
+				for _, v := range e.GetKey() {
+					labels = append(labels, v)
+				}
+			default:
+				return nil, errors.WithStack(fmt.Errorf("multiple keys are not supported: %s", e.GetName()))
+			}
+		}
+	}
+	return labels, nil
+}
+
+func setTreeNode(tree map[string]any, prefix []*pb.PathElem, u *pb.Update) error {
+	labels, err := pathLabels(prefix, u.GetPath().GetElem())
+	if err != nil {
+		return err
+	}
+	val, err := decodeTypedValue(u.GetVal())
+	if err != nil {
+		return err
+	}
+
+	if len(labels) == 0 {
+		m, ok := val.(map[string]any)
+		if !ok {
+			return errors.WithStack(fmt.Errorf("root value must be an object"))
+		}
+		mergeTree(tree, m)
+		return nil
+	}
+
+	parent := tree
+	for _, l := range labels[:len(labels)-1] {
+		child, ok := parent[l].(map[string]any)
+		if !ok {
+			child = map[string]any{}
+			parent[l] = child
+		}
+		parent = child
+	}
+	last := labels[len(labels)-1]
+	if existing, ok := parent[last].(map[string]any); ok {
+		if m, ok := val.(map[string]any); ok {
+			mergeTree(existing, m)
+			return nil
+		}
+	}
+	parent[last] = val
+	return nil
+}
+
+func mergeTree(dst, src map[string]any) {
+	for k, v := range src {
+		if dm, ok := dst[k].(map[string]any); ok {
+			if sm, ok := v.(map[string]any); ok {
+				mergeTree(dm, sm)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+func deleteTreeNode(tree map[string]any, labels []string) {
+	if len(labels) == 0 {
+		for k := range tree {
+			delete(tree, k)
+		}
+		return
+	}
+	parent := tree
+	for _, l := range labels[:len(labels)-1] {
+		child, ok := parent[l].(map[string]any)
+		if !ok {
+			return
+		}
+		parent = child
+	}
+	delete(parent, labels[len(labels)-1])
+}
+
+func decodeTypedValue(tv *pb.TypedValue) (any, error) {
+	switch v := tv.GetValue().(type) {
+	case *pb.TypedValue_JsonIetfVal:
+		return decodeJSONValue(v.JsonIetfVal)
+	case *pb.TypedValue_JsonVal:
+		return decodeJSONValue(v.JsonVal)
+	case *pb.TypedValue_StringVal:
+		return v.StringVal, nil
+	case *pb.TypedValue_AsciiVal:
+		return v.AsciiVal, nil
+	case *pb.TypedValue_BoolVal:
+		return v.BoolVal, nil
+	case *pb.TypedValue_IntVal:
+		return int(v.IntVal), nil
+	case *pb.TypedValue_UintVal:
+		// NOTE uint64 is kept as it is since it overflows int above math.MaxInt64
+		return v.UintVal, nil
+	case *pb.TypedValue_DoubleVal:
+		return v.DoubleVal, nil
+	case *pb.TypedValue_FloatVal:
+		return float64(v.FloatVal), nil
+	default:
+		return nil, errors.WithStack(fmt.Errorf("unsupported value type: %T", v))
+	}
+}
+
+func decodeJSONValue(buf []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(buf))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, errors.WithStack(fmt.Errorf("decode JSON value: %w", err))
+	}
+	return normalizeJSONNumber(v), nil
+}
+
+// normalizeJSONNumber converts json.Number to int, uint64 or float64 so that integers are kept as CUE int.
+func normalizeJSONNumber(v any) any {
+	switch vv := v.(type) {
+	case json.Number:
+		if i, err := vv.Int64(); err == nil {
+			return int(i)
+		}
+		if u, err := strconv.ParseUint(vv.String(), 10, 64); err == nil {
+			return u
+		}
+		f, _ := vv.Float64()
+		return f
+	case map[string]any:
+		for k, e := range vv {
+			vv[k] = normalizeJSONNumber(e)
+		}
+		return vv
+	case []any:
+		for i, e := range vv {
+			vv[i] = normalizeJSONNumber(e)
+		}
+		return vv
+	default:
+		return v
+	}
+}
@@ -0,0 +1,395 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package core_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	extgogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/nttcom/kuesta/internal/core"
+	"github.com/nttcom/kuesta/pkg/aggregatorpb"
+	"github.com/nttcom/kuesta/pkg/kuesta"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func gnmiPath(elems ...*pb.PathElem) *pb.Path {
+	return &pb.Path{Elem: elems}
+}
+
+func TestApplyNotifications(t *testing.T) {
+	newTree := func() map[string]any {
+		return map[string]any{
+			"Interface": map[string]any{
+				"Ethernet1": map[string]any{"Name": "Ethernet1", "Mtu": 1500, "Description": "foo"},
+				"Ethernet2": map[string]any{"Name": "Ethernet2", "Mtu": 1500},
+			},
+		}
+	}
+	intf := func(name string) []*pb.PathElem {
+		return []*pb.PathElem{{Name: "Interface", Key: map[string]string{"name": name}}}
+	}
+
+	tests := []struct {
+		name    string
+		given   []*pb.Notification
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			"ok: update leaf",
+			[]*pb.Notification{{
+				Prefix: gnmiPath(intf("Ethernet1")...),
+				Update: []*pb.Update{{
+					Path: gnmiPath(&pb.PathElem{Name: "Mtu"}),
+					Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: 9000}},
+				}},
+			}},
+			map[string]any{
+				"Interface": map[string]any{
+					"Ethernet1": map[string]any{"Name": "Ethernet1", "Mtu": uint64(9000), "Description": "foo"},
+					"Ethernet2": map[string]any{"Name": "Ethernet2", "Mtu": 1500},
+				},
+			},
+			false,
+		},
+		{
+			"ok: update uint64 leaf above max int64",
+			[]*pb.Notification{{
+				Prefix: gnmiPath(intf("Ethernet1")...),
+				Update: []*pb.Update{{
+					Path: gnmiPath(&pb.PathElem{Name: "InOctets"}),
+					Val:  &pb.TypedValue{Value: &pb.TypedValue_UintVal{UintVal: math.MaxUint64}},
+				}, {
+					Path: gnmiPath(&pb.PathElem{Name: "OutOctets"}),
+					Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`18446744073709551615`)}},
+				}},
+			}},
+			map[string]any{
+				"Interface": map[string]any{
+					"Ethernet1": map[string]any{"Name": "Ethernet1", "Mtu": 1500, "Description": "foo", "InOctets": uint64(math.MaxUint64), "OutOctets": uint64(math.MaxUint64)},
+					"Ethernet2": map[string]any{"Name": "Ethernet2", "Mtu": 1500},
+				},
+			},
+			false,
+		},
+		{
+			"ok: merge json and delete",
+			[]*pb.Notification{{
+				Delete: []*pb.Path{gnmiPath(intf("Ethernet2")...)},
+				Update: []*pb.Update{{
+					Path: gnmiPath(intf("Ethernet1")...),
+					Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"Mtu": 9000, "Enabled": true}`)}},
+				}, {
+					Path: gnmiPath(intf("Ethernet3")...),
+					Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonVal{JsonVal: []byte(`{"Name": "Ethernet3"}`)}},
+				}},
+			}},
+			map[string]any{
+				"Interface": map[string]any{
+					"Ethernet1": map[string]any{"Name": "Ethernet1", "Mtu": 9000, "Description": "foo", "Enabled": true},
+					"Ethernet3": map[string]any{"Name": "Ethernet3"},
+				},
+			},
+			false,
+		},
+		{
+			"ok: delete root",
+			[]*pb.Notification{{Delete: []*pb.Path{gnmiPath()}}},
+			map[string]any{},
+			false,
+		},
+		{
+			"err: multiple keys",
+			[]*pb.Notification{{
+				Delete: []*pb.Path{gnmiPath(&pb.PathElem{Name: "Route", Key: map[string]string{"prefix": "10.0.0.0/8", "vrf": "default"}})},
+			}},
+			nil,
+			true,
+		},
+		{
+			"err: unsupported value",
+			[]*pb.Notification{{
+				Update: []*pb.Update{{
+					Path: gnmiPath(&pb.PathElem{Name: "Foo"}),
+					Val:  &pb.TypedValue{Value: &pb.TypedValue_BytesVal{BytesVal: []byte("foo")}},
+				}},
+			}},
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := newTree()
+			err := core.ApplyNotifications(tree, tt.given...)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, tree)
+			}
+		})
+	}
+}
+
+// remoteFile returns the content of the file in the main branch of the remote repo.
+func remoteFile(t *testing.T, url, path string) string {
+	repo, err := extgogit.PlainOpen(url)
+	testhelper.ExitOnErr(t, err)
+	ref, err := repo.Reference(plumbing.NewBranchReferenceName("main"), true)
+	testhelper.ExitOnErr(t, err)
+	c, err := repo.CommitObject(ref.Hash())
+	testhelper.ExitOnErr(t, err)
+	f, err := c.File(path)
+	testhelper.ExitOnErr(t, err)
+	content, err := f.Contents()
+	testhelper.ExitOnErr(t, err)
+	return content
+}
+
+func TestDeviceAggregateServer_Stream(t *testing.T) {
+	testRemote := "test-remote"
+	_, dir, url := core.SetupGitRepoWithRemote(t, testRemote)
+	s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{
+		RootCfg: core.RootCfg{
+			StatusRootPath: dir,
+			GitRemote:      testRemote,
+		},
+		CommitInterval: 100 * time.Millisecond,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Run(ctx)
+	s.Authorizer = core.NewDeviceAuthorizer(&core.AggregateAuthzConfig{
+		Rules: []core.AggregateAuthzRule{{Name: "subscriber", Token: "token", Devices: []string{"device1"}}},
+	})
+
+	lis := bufconn.Listen(1024 * 1024)
+	g := grpc.NewServer()
+	aggregatorpb.RegisterAggregatorServer(g, s)
+	go func() {
+		_ = g.Serve(lis)
+	}()
+	defer g.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	testhelper.ExitOnErr(t, err)
+	defer conn.Close()
+	client := aggregatorpb.NewAggregatorClient(conn)
+
+	t.Run("ok", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+		stream, err := client.Stream(ctx)
+		testhelper.ExitOnErr(t, err)
+
+		testhelper.ExitOnErr(t, stream.Send(&aggregatorpb.StreamRequest{
+			Id:     1,
+			Device: "device1",
+			Update: &aggregatorpb.StreamRequest_Snapshot{Snapshot: &aggregatorpb.Snapshot{
+				Config: []byte(`{Interface: {Ethernet1: {Name: "Ethernet1", Mtu: 1500}}}`),
+			}},
+		}))
+		resp, err := stream.Recv()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, uint64(1), resp.Id)
+		assert.Equal(t, "device1", resp.Device)
+		assert.Empty(t, resp.Error)
+		// acknowledged once pushed
+		assert.Contains(t, remoteFile(t, url, "devices/device1/actual_config.cue"), "Mtu: 1500")
+
+		testhelper.ExitOnErr(t, stream.Send(&aggregatorpb.StreamRequest{
+			Id:     2,
+			Device: "device1",
+			Update: &aggregatorpb.StreamRequest_Notifications{Notifications: &aggregatorpb.Notifications{
+				Notification: []*pb.Notification{{
+					Update: []*pb.Update{{
+						Path: gnmiPath(&pb.PathElem{Name: "Interface", Key: map[string]string{"name": "Ethernet1"}}, &pb.PathElem{Name: "Mtu"}),
+						Val:  &pb.TypedValue{Value: &pb.TypedValue_IntVal{IntVal: 9000}},
+					}},
+				}},
+			}},
+		}))
+		resp, err = stream.Recv()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, uint64(2), resp.Id)
+		assert.Empty(t, resp.Error)
+
+		got := remoteFile(t, url, "devices/device1/actual_config.cue")
+		assert.Contains(t, got, "Mtu:  9000")
+		assert.Contains(t, got, `Name: "Ethernet1"`)
+		testhelper.ExitOnErr(t, stream.CloseSend())
+	})
+
+	t.Run("err: push failed", func(t *testing.T) {
+		s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{
+			RootCfg: core.RootCfg{
+				StatusRootPath: t.TempDir(),
+				GitRemote:      testRemote,
+			},
+			CommitInterval: 100 * time.Millisecond,
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Run(ctx)
+
+		lis := bufconn.Listen(1024 * 1024)
+		g := grpc.NewServer()
+		aggregatorpb.RegisterAggregatorServer(g, s)
+		go func() {
+			_ = g.Serve(lis)
+		}()
+		defer g.Stop()
+		conn, err := grpc.DialContext(ctx, "bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		testhelper.ExitOnErr(t, err)
+		defer conn.Close()
+
+		stream, err := aggregatorpb.NewAggregatorClient(conn).Stream(ctx)
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, stream.Send(&aggregatorpb.StreamRequest{
+			Id:     1,
+			Device: "device1",
+			Update: &aggregatorpb.StreamRequest_Snapshot{Snapshot: &aggregatorpb.Snapshot{Config: []byte("{}")}},
+		}))
+		resp, err := stream.Recv()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, uint64(1), resp.Id)
+		assert.NotEmpty(t, resp.Error)
+	})
+
+	t.Run("ok: status and delete", func(t *testing.T) {
+		dp := kuesta.DevicePath{RootDir: dir, Device: "device1"}
+		err := s.ApplyUpdate(context.Background(), &aggregatorpb.StreamRequest{
+			Device:            "device1",
+			SubscriberVersion: "v0.1.0",
+			SyncError:         "failed",
+		})
+		assert.Nil(t, err)
+		st, err := dp.ReadDeviceStatus()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, "v0.1.0", st.SubscriberVersion)
+		assert.Equal(t, "failed", st.SyncError)
+
+		err = s.ApplyUpdate(context.Background(), &aggregatorpb.StreamRequest{
+			Device: "device1",
+			Update: &aggregatorpb.StreamRequest_Delete{Delete: &aggregatorpb.DeleteDevice{}},
+		})
+		assert.Nil(t, err)
+		_, err = os.Stat(dp.DevicePath(kuesta.IncludeRoot))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("ok: notifications applied on top of buffered config", func(t *testing.T) {
+		dir := t.TempDir()
+		dp := kuesta.DevicePath{RootDir: dir, Device: "device1"}
+		testhelper.ExitOnErr(t, os.MkdirAll(dp.DevicePath(kuesta.IncludeRoot), 0o755))
+		testhelper.ExitOnErr(t, os.WriteFile(dp.DeviceActualConfigPath(kuesta.IncludeRoot), []byte(`{Hostname: "old", Mtu: 1500}`), 0o644))
+
+		s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{
+			RootCfg:        core.RootCfg{StatusRootPath: dir},
+			CommitInterval: time.Hour,
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Run(ctx)
+
+		config := `{Hostname: "new", Mtu: 1500}`
+		buf, err := json.Marshal(core.SaveConfigRequest{Device: "device1", Config: &config})
+		testhelper.ExitOnErr(t, err)
+		response := httptest.NewRecorder()
+		s.HandleFunc(response, httptest.NewRequest(http.MethodPost, "/commit", bytes.NewBuffer(buf)))
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+
+		err = s.ApplyUpdate(ctx, &aggregatorpb.StreamRequest{
+			Device: "device1",
+			Update: &aggregatorpb.StreamRequest_Notifications{Notifications: &aggregatorpb.Notifications{
+				Notification: []*pb.Notification{{
+					Update: []*pb.Update{{
+						Path: gnmiPath(&pb.PathElem{Name: "Mtu"}),
+						Val:  &pb.TypedValue{Value: &pb.TypedValue_IntVal{IntVal: 9000}},
+					}},
+				}},
+			}},
+		})
+		testhelper.ExitOnErr(t, err)
+
+		got, err := os.ReadFile(dp.DeviceActualConfigPath(kuesta.IncludeRoot))
+		testhelper.ExitOnErr(t, err)
+		assert.Contains(t, string(got), `Hostname: "new"`)
+		assert.Contains(t, string(got), "Mtu:      9000")
+	})
+
+	t.Run("err: invalid device name", func(t *testing.T) {
+		s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{
+			RootCfg: core.RootCfg{StatusRootPath: dir},
+		})
+		err := s.ApplyUpdate(context.Background(), &aggregatorpb.StreamRequest{
+			Device: "../device1",
+			Update: &aggregatorpb.StreamRequest_Snapshot{Snapshot: &aggregatorpb.Snapshot{Config: []byte("{}")}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("err: unauthenticated", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer invalid")
+		stream, err := client.Stream(ctx)
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, stream.Send(&aggregatorpb.StreamRequest{Id: 1, Device: "device1"}))
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("err: forbidden", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+		stream, err := client.Stream(ctx)
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, stream.Send(&aggregatorpb.StreamRequest{Id: 1, Device: "device2"}))
+		_, err = stream.Recv()
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -35,8 +35,11 @@ import (
 	"time"
 
 	extgogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/nttcom/kuesta/internal/core"
+	"github.com/nttcom/kuesta/internal/gogit"
 	"github.com/nttcom/kuesta/internal/testing/githelper"
+	"github.com/nttcom/kuesta/pkg/kuesta"
 	"github.com/nttcom/kuesta/pkg/testing/testhelper"
 	"github.com/stretchr/testify/assert"
 )
@@ -109,6 +112,35 @@ Devices:
 	assert.Equal(t, want, core.MakeSyncCommitMessage(stmap))
 }
 
+func TestMakeSyncCommitMessage_StatusOnly(t *testing.T) {
+	stmap := extgogit.Status{
+		"devices/dvc1/status.json":       &extgogit.FileStatus{Staging: extgogit.Modified},
+		"devices/dvc2/status.json":       &extgogit.FileStatus{Staging: extgogit.Added},
+		"devices/dvc3/status.json":       &extgogit.FileStatus{Staging: extgogit.Modified},
+		"devices/dvc3/actual_config.cue": &extgogit.FileStatus{Staging: extgogit.Modified},
+	}
+	want := `Updated: dvc3
+
+Devices:
+	modified:  dvc3
+
+Status:
+	updated:   dvc1
+	updated:   dvc2`
+	assert.Equal(t, want, core.MakeSyncCommitMessage(stmap))
+
+	delete(stmap, "devices/dvc3/actual_config.cue")
+	delete(stmap, "devices/dvc3/status.json")
+	want = `Updated status: dvc1 dvc2
+
+Devices:
+
+Status:
+	updated:   dvc1
+	updated:   dvc2`
+	assert.Equal(t, want, core.MakeSyncCommitMessage(stmap))
+}
+
 func TestDeviceAggregateServer_SaveConfig(t *testing.T) {
 	dir := t.TempDir()
 	config := "foobar"
@@ -128,6 +160,42 @@ func TestDeviceAggregateServer_SaveConfig(t *testing.T) {
 	assert.Equal(t, []byte(config), got)
 }
 
+func TestDeviceAggregateServer_SaveStatus(t *testing.T) {
+	dir := t.TempDir()
+	dp := kuesta.DevicePath{RootDir: dir, Device: "device1"}
+	s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{
+		RootCfg:          core.RootCfg{StatusRootPath: dir},
+		LastSeenInterval: time.Minute,
+	})
+	ctx := context.Background()
+	t0 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	given := &core.SaveConfigRequest{Device: "device1", SubscriberVersion: "v0.1.0"}
+	lastSeen := func() time.Time {
+		st, err := dp.ReadDeviceStatus()
+		testhelper.ExitOnErr(t, err)
+		return st.LastSeen
+	}
+
+	testhelper.ExitOnErr(t, s.SaveStatus(ctx, given, t0))
+	assert.Equal(t, t0, lastSeen())
+
+	// only the last seen time is updated within the interval
+	testhelper.ExitOnErr(t, s.SaveStatus(ctx, given, t0.Add(30*time.Second)))
+	assert.Equal(t, t0, lastSeen())
+
+	// the last seen time is updated after the interval
+	testhelper.ExitOnErr(t, s.SaveStatus(ctx, given, t0.Add(time.Minute)))
+	assert.Equal(t, t0.Add(time.Minute), lastSeen())
+
+	// the status is changed
+	failed := &core.SaveConfigRequest{Device: "device1", SubscriberVersion: "v0.1.0", SyncError: "failed"}
+	testhelper.ExitOnErr(t, s.SaveStatus(ctx, failed, t0.Add(70*time.Second)))
+	st, err := dp.ReadDeviceStatus()
+	testhelper.ExitOnErr(t, err)
+	assert.Equal(t, "failed", st.SyncError)
+	assert.Equal(t, t0.Add(70*time.Second), st.LastSeen)
+}
+
 func TestDeviceAggregateServer_GitPushSyncBranch(t *testing.T) {
 	testRemote := "test-remote"
 
@@ -151,6 +219,47 @@ func TestDeviceAggregateServer_GitPushSyncBranch(t *testing.T) {
 		assert.NotEqual(t, localRef.Hash().String(), oldRef.Hash().String())
 		assert.Equal(t, localRef.Hash().String(), remoteRef.Hash().String())
 	})
+
+	t.Run("ok: rebase when remote is updated by another writer", func(t *testing.T) {
+		repo, dir, url := core.SetupGitRepoWithRemote(t, testRemote)
+
+		otherRepo, _ := githelper.CloneRepo(t, &extgogit.CloneOptions{
+			URL:           url,
+			RemoteName:    testRemote,
+			ReferenceName: plumbing.NewBranchReferenceName("main"),
+		})
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(otherRepo, "devices/device4/actual_config.cue", "{other: _}"))
+		otherHash, err := githelper.Commit(otherRepo, time.Now())
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, githelper.Push(otherRepo, "main", testRemote))
+
+		s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{
+			RootCfg: core.RootCfg{
+				StatusRootPath: dir,
+				GitRemote:      testRemote,
+			},
+		})
+		err = s.GitPushDeviceConfig(context.Background())
+		assert.Nil(t, err)
+
+		// NOTE reopen repo since objects fetched by another go-git repository are not visible from the cached one.
+		g, err := gogit.NewGit(&gogit.GitOptions{Path: dir})
+		testhelper.ExitOnErr(t, err)
+		repo = g.Repo()
+		assert.Equal(t, len(githelper.GetStatus(t, repo)), 0)
+
+		localRef, _ := repo.Head()
+		remoteRef := githelper.GetRemoteBranch(t, repo, testRemote, "main")
+		assert.Equal(t, localRef.Hash().String(), remoteRef.Hash().String())
+
+		c, err := g.Head()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, []plumbing.Hash{otherHash}, c.ParentHashes)
+		_, err = c.File("devices/device4/actual_config.cue")
+		assert.Nil(t, err)
+		_, err = c.File("devices/device3/actual_config.cue")
+		assert.Nil(t, err)
+	})
 }
 
 func TestDeviceAggregateServer_Run(t *testing.T) {
@@ -167,8 +276,8 @@ func TestDeviceAggregateServer_Run(t *testing.T) {
 			StatusRootPath: dir,
 			GitRemote:      testRemote,
 		},
+		CommitInterval: 100 * time.Millisecond,
 	})
-	core.UpdateCheckDuration = 100 * time.Millisecond
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	s.Run(ctx)
@@ -180,6 +289,7 @@ func TestDeviceAggregateServer_Run(t *testing.T) {
 	s.HandleFunc(response, request)
 	res := response.Result()
 	assert.Equal(t, 200, res.StatusCode)
+	assert.Greater(t, len(githelper.GetStatus(t, repo)), 0)
 
 	var got []byte
 	assert.Eventually(t, func() bool {
@@ -188,7 +298,6 @@ func TestDeviceAggregateServer_Run(t *testing.T) {
 	}, time.Second, 100*time.Millisecond)
 	assert.Equal(t, []byte(config), got)
 
-	assert.Greater(t, len(githelper.GetStatus(t, repo)), 0)
 	assert.Eventually(t, func() bool {
 		localRef, _ := repo.Head()
 		remoteRef := githelper.GetRemoteBranch(t, repo, testRemote, "main")
@@ -197,3 +306,158 @@ func TestDeviceAggregateServer_Run(t *testing.T) {
 
 	assert.Equal(t, len(githelper.GetStatus(t, repo)), 0)
 }
+
+func TestDeviceAggregateServer_HandleFunc(t *testing.T) {
+	newRequest := func(device, config string) *http.Request {
+		buf, err := json.Marshal(core.SaveConfigRequest{Device: device, Config: &config})
+		testhelper.ExitOnErr(t, err)
+		return httptest.NewRequest(http.MethodPost, "/commit", bytes.NewBuffer(buf))
+	}
+	newRunningServer := func(t *testing.T, cfg *core.DeviceAggregateCfg) *core.DeviceAggregateServer {
+		cfg.CommitInterval = time.Hour
+		s := core.NewDeviceAggregateServer(cfg)
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		s.Run(ctx)
+		return s
+	}
+
+	t.Run("err: body too large", func(t *testing.T) {
+		s := newRunningServer(t, &core.DeviceAggregateCfg{MaxBodySize: 32})
+		response := httptest.NewRecorder()
+		s.HandleFunc(response, newRequest("device1", strings.Repeat("a", 32)))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, response.Result().StatusCode)
+	})
+
+	t.Run("err: queue full", func(t *testing.T) {
+		s := newRunningServer(t, &core.DeviceAggregateCfg{QueueSize: 2})
+		for _, d := range []string{"device1", "device2"} {
+			response := httptest.NewRecorder()
+			s.HandleFunc(response, newRequest(d, "foo"))
+			assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+		}
+
+		// update of the pending device is coalesced
+		response := httptest.NewRecorder()
+		s.HandleFunc(response, newRequest("device1", "bar"))
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+
+		response = httptest.NewRecorder()
+		s.HandleFunc(response, newRequest("device3", "foo"))
+		assert.Equal(t, http.StatusTooManyRequests, response.Result().StatusCode)
+		assert.Equal(t, "3600", response.Result().Header.Get("Retry-After"))
+	})
+
+	t.Run("err: not running", func(t *testing.T) {
+		s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{})
+		response := httptest.NewRecorder()
+		s.HandleFunc(response, newRequest("device1", "foo"))
+		assert.Equal(t, http.StatusServiceUnavailable, response.Result().StatusCode)
+		assert.Equal(t, "5", response.Result().Header.Get("Retry-After"))
+	})
+}
+
+func TestDeviceAggregateServer_Run_Coalesce(t *testing.T) {
+	testRemote := "test-remote"
+	repo, dir, _ := core.SetupGitRepoWithRemote(t, testRemote)
+	initial, err := repo.Head()
+	testhelper.ExitOnErr(t, err)
+	written := core.AggregateWrittenConfigs.Value()
+
+	s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{
+		RootCfg: core.RootCfg{
+			StatusRootPath: dir,
+			GitRemote:      testRemote,
+		},
+		CommitInterval: 500 * time.Millisecond,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Run(ctx)
+
+	for _, config := range []string{"first", "second", "latest"} {
+		buf, err := json.Marshal(core.SaveConfigRequest{Device: "device1", Config: &config})
+		testhelper.ExitOnErr(t, err)
+		response := httptest.NewRecorder()
+		s.HandleFunc(response, httptest.NewRequest(http.MethodPost, "/commit", bytes.NewBuffer(buf)))
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+	}
+
+	assert.Eventually(t, func() bool {
+		localRef, _ := repo.Head()
+		remoteRef := githelper.GetRemoteBranch(t, repo, testRemote, "main")
+		return localRef.Hash() != initial.Hash() &&
+			localRef.Hash().String() == remoteRef.Hash().String() && len(githelper.GetStatus(t, repo)) == 0
+	}, 3*time.Second, 100*time.Millisecond)
+
+	got, err := os.ReadFile(filepath.Join(dir, "devices", "device1", "actual_config.cue"))
+	testhelper.ExitOnErr(t, err)
+	assert.Equal(t, []byte("latest"), got)
+
+	// the intermediate configs are neither written nor committed
+	assert.Equal(t, int64(1), core.AggregateWrittenConfigs.Value()-written)
+	head, err := repo.Head()
+	testhelper.ExitOnErr(t, err)
+	c, err := repo.CommitObject(head.Hash())
+	testhelper.ExitOnErr(t, err)
+	assert.Equal(t, []plumbing.Hash{initial.Hash()}, c.ParentHashes)
+	f, err := c.File("devices/device1/actual_config.cue")
+	testhelper.ExitOnErr(t, err)
+	content, err := f.Contents()
+	testhelper.ExitOnErr(t, err)
+	assert.Equal(t, "latest", content)
+}
+
+func TestDeviceAggregateServer_Run_DeleteAndStatus(t *testing.T) {
+	testRemote := "test-remote"
+	repo, dir, _ := core.SetupGitRepoWithRemote(t, testRemote)
+
+	s := core.NewDeviceAggregateServer(&core.DeviceAggregateCfg{
+		RootCfg: core.RootCfg{
+			StatusRootPath: dir,
+			GitRemote:      testRemote,
+		},
+		CommitInterval: 200 * time.Millisecond,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Run(ctx)
+
+	response := httptest.NewRecorder()
+	s.HandleFunc(response, httptest.NewRequest(http.MethodDelete, "/commit?device=device2", nil))
+	assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+
+	buf, err := json.Marshal(core.SaveConfigRequest{Device: "device3", SubscriberVersion: "v0.1.0", SyncError: "failed"})
+	testhelper.ExitOnErr(t, err)
+	response = httptest.NewRecorder()
+	s.HandleFunc(response, httptest.NewRequest(http.MethodPost, "/commit", bytes.NewBuffer(buf)))
+	assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+
+	assert.Eventually(t, func() bool {
+		localRef, _ := repo.Head()
+		remoteRef := githelper.GetRemoteBranch(t, repo, testRemote, "main")
+		return localRef.Hash().String() == remoteRef.Hash().String() && len(githelper.GetStatus(t, repo)) == 0
+	}, 2*time.Second, 100*time.Millisecond)
+
+	head, err := repo.Head()
+	testhelper.ExitOnErr(t, err)
+	c, err := repo.CommitObject(head.Hash())
+	testhelper.ExitOnErr(t, err)
+	_, err = c.File("devices/device2/actual_config.cue")
+	assert.Error(t, err)
+	_, err = c.File("devices/device3/actual_config.cue")
+	assert.Nil(t, err)
+
+	dp := kuesta.DevicePath{RootDir: dir, Device: "device3"}
+	st, err := dp.ReadDeviceStatus()
+	testhelper.ExitOnErr(t, err)
+	assert.Equal(t, "v0.1.0", st.SubscriberVersion)
+	assert.Equal(t, "failed", st.SyncError)
+	assert.WithinDuration(t, time.Now(), st.LastSeen, 5*time.Second)
+
+	t.Run("err: invalid device", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		s.HandleFunc(response, httptest.NewRequest(http.MethodDelete, "/commit?device=..", nil))
+		assert.Equal(t, http.StatusBadRequest, response.Result().StatusCode)
+	})
+}
@@ -95,8 +95,14 @@ func RunGitCommit(ctx context.Context, cfg *GitCommitCfg) error {
 	if _, err := git.Commit(commitMsg); err != nil {
 		return fmt.Errorf("git commit: %w", err)
 	}
-	if err := git.Push(gogit.PushOptBranch(branchName)); err != nil {
-		return fmt.Errorf("git push: %w", err)
+	if cfg.PushToMain {
+		if err := git.PushWithRetry(ctx, branchName); err != nil {
+			return fmt.Errorf("git push: %w", err)
+		}
+	} else {
+		if err := git.Push(gogit.PushOptBranch(branchName)); err != nil {
+			return fmt.Errorf("git push: %w", err)
+		}
 	}
 
 	if !cfg.PushToMain {
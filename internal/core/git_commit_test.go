@@ -31,6 +31,7 @@ import (
 
 	extgogit "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/golang/mock/gomock"
 	"github.com/nttcom/kuesta/internal/core"
 	"github.com/nttcom/kuesta/internal/gitrepo"
@@ -100,6 +101,43 @@ Devices:
 		assert.Equal(t, wantMsg, h.Message)
 	})
 
+	t.Run("ok: push to main after remote is updated", func(t *testing.T) {
+		repo, dir := setup(t)
+		remote, err := repo.Remote("origin")
+		testhelper.ExitOnErr(t, err)
+		url := remote.Config().URLs[0]
+		testhelper.ExitOnErr(t, githelper.Push(repo, "main", "origin"))
+
+		otherRepo, _ := githelper.CloneRepo(t, &extgogit.CloneOptions{
+			URL:           url,
+			ReferenceName: plumbing.NewBranchReferenceName("main"),
+		})
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(otherRepo, "services/bar/one/input.cue", "{}"))
+		otherHash, err := githelper.Commit(otherRepo, time.Now())
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, githelper.Push(otherRepo, "main", "origin"))
+
+		err = core.RunGitCommit(context.Background(), &core.GitCommitCfg{
+			RootCfg: core.RootCfg{
+				ConfigRootPath: dir,
+				GitTrunk:       "main",
+				PushToMain:     true,
+			},
+		})
+		assert.Nil(t, err)
+
+		g, err := gogit.NewGit(&gogit.GitOptions{
+			Path:        dir,
+			TrunkBranch: "main",
+		})
+		testhelper.ExitOnErr(t, err)
+		h, err := g.Head()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, wantMsg, h.Message)
+		assert.Equal(t, []plumbing.Hash{otherHash}, h.ParentHashes)
+		assert.Equal(t, h.Hash, githelper.GetRemoteBranch(t, repo, "origin", "main").Hash())
+	})
+
 	t.Run("ok: push to new branch", func(t *testing.T) {
 		repo, dir := setup(t)
 		mockGitClient := mock.NewMockGitRepoClient(gomock.NewController(t))
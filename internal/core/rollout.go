@@ -0,0 +1,197 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nttcom/kuesta/internal/logger"
+	"github.com/nttcom/kuesta/internal/validator"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DeviceRolloutGVR is the resource of the DeviceRollout managed by kuesta provisioner.
+var DeviceRolloutGVR = schema.GroupVersionResource{
+	Group:    "kuesta.hrk091.dev",
+	Version:  "v1alpha1",
+	Resource: "devicerollouts",
+}
+
+const (
+	// NOTE keep in sync with provisioner/api/v1alpha1, which cannot be imported from this module.
+	annKeyRolloutAbort = "kuesta.hrk091.dev/abort"
+	annKeyRolloutRetry = "kuesta.hrk091.dev/retry"
+)
+
+type RolloutCfg struct {
+	RootCfg
+
+	Kubeconfig string
+	Namespace  string `validate:"required"`
+	Name       string `validate:"required"`
+}
+
+// Validate validates exposed fields according to the `validate` tag.
+func (c *RolloutCfg) Validate() error {
+	return validator.Validate(c)
+}
+
+// Mask returns the copy whose sensitive data are masked.
+func (c *RolloutCfg) Mask() *RolloutCfg {
+	cc := *c
+	cc.RootCfg = *c.RootCfg.Mask()
+	return &cc
+}
+
+type RolloutSkipCfg struct {
+	RolloutCfg
+
+	Devices []string `validate:"min=1"`
+}
+
+// Validate validates exposed fields according to the `validate` tag.
+func (c *RolloutSkipCfg) Validate() error {
+	return validator.Validate(c)
+}
+
+// Mask returns the copy whose sensitive data are masked.
+func (c *RolloutSkipCfg) Mask() *RolloutSkipCfg {
+	cc := *c
+	cc.RolloutCfg = *c.RolloutCfg.Mask()
+	return &cc
+}
+
+// RunRolloutPause runs the main process of the `rollout pause` command.
+func RunRolloutPause(ctx context.Context, cfg *RolloutCfg) error {
+	logger.FromContext(ctx).Debugw("rollout pause called", "config", cfg.Mask())
+	return patchRollout(ctx, cfg, "paused", map[string]any{
+		"spec": map[string]any{"paused": true},
+	})
+}
+
+// RunRolloutResume runs the main process of the `rollout resume` command.
+func RunRolloutResume(ctx context.Context, cfg *RolloutCfg) error {
+	logger.FromContext(ctx).Debugw("rollout resume called", "config", cfg.Mask())
+	return patchRollout(ctx, cfg, "resumed", map[string]any{
+		"spec": map[string]any{"paused": false},
+	})
+}
+
+// RunRolloutAbort runs the main process of the `rollout abort` command.
+func RunRolloutAbort(ctx context.Context, cfg *RolloutCfg) error {
+	logger.FromContext(ctx).Debugw("rollout abort called", "config", cfg.Mask())
+	return patchRollout(ctx, cfg, "aborted", requestPatch(annKeyRolloutAbort))
+}
+
+// RunRolloutRetry runs the main process of the `rollout retry` command.
+func RunRolloutRetry(ctx context.Context, cfg *RolloutCfg) error {
+	logger.FromContext(ctx).Debugw("rollout retry called", "config", cfg.Mask())
+	return patchRollout(ctx, cfg, "retried", requestPatch(annKeyRolloutRetry))
+}
+
+// RunRolloutSkip runs the main process of the `rollout skip` command.
+func RunRolloutSkip(ctx context.Context, cfg *RolloutSkipCfg) error {
+	logger.FromContext(ctx).Debugw("rollout skip called", "config", cfg.Mask())
+	return updateSkipDevices(ctx, cfg, func(skip map[string]struct{}) {
+		for _, d := range cfg.Devices {
+			skip[d] = struct{}{}
+		}
+	})
+}
+
+// RunRolloutUnskip runs the main process of the `rollout unskip` command.
+func RunRolloutUnskip(ctx context.Context, cfg *RolloutSkipCfg) error {
+	logger.FromContext(ctx).Debugw("rollout unskip called", "config", cfg.Mask())
+	return updateSkipDevices(ctx, cfg, func(skip map[string]struct{}) {
+		for _, d := range cfg.Devices {
+			delete(skip, d)
+		}
+	})
+}
+
+// requestPatch returns the patch to set the request annotation. The value is a timestamp so that
+// every request is handled only once by the provisioner.
+func requestPatch(key string) map[string]any {
+	return map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{key: time.Now().UTC().Format(time.RFC3339Nano)},
+		},
+	}
+}
+
+func updateSkipDevices(ctx context.Context, cfg *RolloutSkipCfg, fn func(map[string]struct{})) error {
+	c, err := DynamicClientFromContext(ctx, cfg.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	dr, err := c.Resource(DeviceRolloutGVR).Namespace(cfg.Namespace).Get(ctx, cfg.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("get devicerollout: %w", err))
+	}
+	current, _, err := unstructured.NestedStringSlice(dr.Object, "spec", "skipDevices")
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("read spec.skipDevices: %w", err))
+	}
+
+	skip := map[string]struct{}{}
+	for _, d := range current {
+		skip[d] = struct{}{}
+	}
+	fn(skip)
+	devices := make([]string, 0, len(skip))
+	for d := range skip {
+		devices = append(devices, d)
+	}
+	sort.Strings(devices)
+
+	// resourceVersion makes the merge patch fail on conflict instead of overwriting concurrent changes
+	return patchRollout(ctx, &cfg.RolloutCfg, "updated", map[string]any{
+		"metadata": map[string]any{"resourceVersion": dr.GetResourceVersion()},
+		"spec":     map[string]any{"skipDevices": devices},
+	})
+}
+
+func patchRollout(ctx context.Context, cfg *RolloutCfg, verb string, patch map[string]any) error {
+	out := WriterFromContext(ctx)
+	c, err := DynamicClientFromContext(ctx, cfg.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("encode patch: %w", err))
+	}
+	if _, err := c.Resource(DeviceRolloutGVR).Namespace(cfg.Namespace).Patch(ctx, cfg.Name, types.MergePatchType, data, metav1.PatchOptions{}); err != nil {
+		return errors.WithStack(fmt.Errorf("patch devicerollout: %w", err))
+	}
+	fmt.Fprintf(out, "devicerollout %s/%s %s\n", cfg.Namespace, cfg.Name, verb)
+	return nil
+}
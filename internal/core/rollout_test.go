@@ -0,0 +1,165 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package core_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/nttcom/kuesta/internal/core"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestRolloutCfg_Validate(t *testing.T) {
+	newValidStruct := func(t func(cfg *core.RolloutSkipCfg)) *core.RolloutSkipCfg {
+		cfg := &core.RolloutSkipCfg{
+			RolloutCfg: core.RolloutCfg{
+				Namespace: "default",
+				Name:      "test",
+			},
+			Devices: []string{"device1"},
+		}
+		t(cfg)
+		return cfg
+	}
+
+	tests := []struct {
+		name      string
+		transform func(cfg *core.RolloutSkipCfg)
+		wantError bool
+	}{
+		{
+			"ok",
+			func(cfg *core.RolloutSkipCfg) {},
+			false,
+		},
+		{
+			"bad: namespace is empty",
+			func(cfg *core.RolloutSkipCfg) {
+				cfg.Namespace = ""
+			},
+			true,
+		},
+		{
+			"bad: name is empty",
+			func(cfg *core.RolloutSkipCfg) {
+				cfg.Name = ""
+			},
+			true,
+		},
+		{
+			"bad: devices are empty",
+			func(cfg *core.RolloutSkipCfg) {
+				cfg.Devices = nil
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newValidStruct(tt.transform)
+			err := cfg.Validate()
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestRunRollout(t *testing.T) {
+	newClient := func() *fake.FakeDynamicClient {
+		dr := &unstructured.Unstructured{}
+		dr.SetAPIVersion("kuesta.hrk091.dev/v1alpha1")
+		dr.SetKind("DeviceRollout")
+		dr.SetNamespace("default")
+		dr.SetName("test")
+		testhelper.ExitOnErr(t, unstructured.SetNestedStringSlice(dr.Object, []string{"device2"}, "spec", "skipDevices"))
+		return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{core.DeviceRolloutGVR: "DeviceRolloutList"}, dr)
+	}
+	cfg := core.RolloutCfg{Namespace: "default", Name: "test"}
+	get := func(t *testing.T, c *fake.FakeDynamicClient) *unstructured.Unstructured {
+		dr, err := c.Resource(core.DeviceRolloutGVR).Namespace("default").Get(context.Background(), "test", metav1.GetOptions{})
+		testhelper.ExitOnErr(t, err)
+		return dr
+	}
+
+	t.Run("pause and resume", func(t *testing.T) {
+		c := newClient()
+		buf := &bytes.Buffer{}
+		ctx := core.WithWriter(core.WithDynamicClient(context.Background(), c), buf)
+
+		assert.Nil(t, core.RunRolloutPause(ctx, &cfg))
+		paused, _, _ := unstructured.NestedBool(get(t, c).Object, "spec", "paused")
+		assert.True(t, paused)
+		assert.Equal(t, "devicerollout default/test paused\n", buf.String())
+
+		assert.Nil(t, core.RunRolloutResume(ctx, &cfg))
+		paused, found, _ := unstructured.NestedBool(get(t, c).Object, "spec", "paused")
+		assert.True(t, found)
+		assert.False(t, paused)
+	})
+
+	t.Run("abort and retry", func(t *testing.T) {
+		c := newClient()
+		ctx := core.WithWriter(core.WithDynamicClient(context.Background(), c), &bytes.Buffer{})
+
+		assert.Nil(t, core.RunRolloutAbort(ctx, &cfg))
+		abort := get(t, c).GetAnnotations()["kuesta.hrk091.dev/abort"]
+		assert.NotEmpty(t, abort)
+
+		assert.Nil(t, core.RunRolloutRetry(ctx, &cfg))
+		ann := get(t, c).GetAnnotations()
+		assert.Equal(t, abort, ann["kuesta.hrk091.dev/abort"])
+		assert.NotEmpty(t, ann["kuesta.hrk091.dev/retry"])
+	})
+
+	t.Run("skip and unskip", func(t *testing.T) {
+		c := newClient()
+		ctx := core.WithWriter(core.WithDynamicClient(context.Background(), c), &bytes.Buffer{})
+
+		assert.Nil(t, core.RunRolloutSkip(ctx, &core.RolloutSkipCfg{RolloutCfg: cfg, Devices: []string{"device3", "device1", "device2"}}))
+		skip, _, _ := unstructured.NestedStringSlice(get(t, c).Object, "spec", "skipDevices")
+		assert.Equal(t, []string{"device1", "device2", "device3"}, skip)
+
+		assert.Nil(t, core.RunRolloutUnskip(ctx, &core.RolloutSkipCfg{RolloutCfg: cfg, Devices: []string{"device2", "device4"}}))
+		skip, _, _ = unstructured.NestedStringSlice(get(t, c).Object, "spec", "skipDevices")
+		assert.Equal(t, []string{"device1", "device3"}, skip)
+	})
+
+	t.Run("err: not found", func(t *testing.T) {
+		ctx := core.WithWriter(core.WithDynamicClient(context.Background(), newClient()), &bytes.Buffer{})
+		notFound := core.RolloutCfg{Namespace: "default", Name: "notfound"}
+		assert.Error(t, core.RunRolloutPause(ctx, &notFound))
+		assert.Error(t, core.RunRolloutSkip(ctx, &core.RolloutSkipCfg{RolloutCfg: notFound, Devices: []string{"device1"}}))
+	})
+}
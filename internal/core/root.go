@@ -25,6 +25,7 @@ package core
 import (
 	"github.com/nttcom/kuesta/internal/gogit"
 	"github.com/nttcom/kuesta/internal/validator"
+	"github.com/nttcom/kuesta/pkg/gitsign"
 )
 
 type RootCfg struct {
@@ -40,6 +41,11 @@ type RootCfg struct {
 	GitUser        string
 	GitEmail       string
 	PushToMain     bool
+
+	ConfigGitSigningKeyPath       string
+	ConfigGitSigningKeyPassphrase string
+	StatusGitSigningKeyPath       string
+	StatusGitSigningKeyPassphrase string
 }
 
 // Validate validates exposed fields according to the `validate` tag.
@@ -51,6 +57,12 @@ func (c *RootCfg) Validate() error {
 func (c *RootCfg) Mask() *RootCfg {
 	cc := *c
 	cc.GitToken = "***"
+	if cc.ConfigGitSigningKeyPassphrase != "" {
+		cc.ConfigGitSigningKeyPassphrase = "***"
+	}
+	if cc.StatusGitSigningKeyPassphrase != "" {
+		cc.StatusGitSigningKeyPassphrase = "***"
+	}
 	return &cc
 }
 
@@ -63,6 +75,7 @@ func (c *RootCfg) ConfigGitOptions() *gogit.GitOptions {
 		Token:       c.GitToken,
 		User:        c.GitUser,
 		Email:       c.GitEmail,
+		SigningKey:  signingKeyConfig(c.ConfigGitSigningKeyPath, c.ConfigGitSigningKeyPassphrase),
 	}
 }
 
@@ -75,5 +88,18 @@ func (c *RootCfg) StatusGitOptions() *gogit.GitOptions {
 		Token:       c.GitToken,
 		User:        c.GitUser,
 		Email:       c.GitEmail,
+		SigningKey:  signingKeyConfig(c.StatusGitSigningKeyPath, c.StatusGitSigningKeyPassphrase),
+		// NOTE the status files are owned by the aggregator, so its changes take precedence on push conflicts
+		ReapplyOnConflict: true,
+	}
+}
+
+func signingKeyConfig(path, passphrase string) *gitsign.SigningKeyConfig {
+	if path == "" {
+		return nil
+	}
+	return &gitsign.SigningKeyConfig{
+		KeyPath:    path,
+		Passphrase: passphrase,
 	}
 }
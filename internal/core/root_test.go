@@ -76,13 +76,18 @@ func TestRootCfg_MaskCopy(t *testing.T) {
 	user := "alice"
 	token := "dummy"
 	want := "***"
+	passphrase := "dummy-passphrase"
 	cfg := &core.RootCfg{
-		GitUser:  user,
-		GitToken: token,
+		GitUser:                       user,
+		GitToken:                      token,
+		ConfigGitSigningKeyPassphrase: passphrase,
 	}
 	cc := cfg.Mask()
 	assert.Equal(t, user, cfg.GitUser)
 	assert.Equal(t, token, cfg.GitToken)
+	assert.Equal(t, passphrase, cfg.ConfigGitSigningKeyPassphrase)
 	assert.Equal(t, user, cc.GitUser)
 	assert.Equal(t, want, cc.GitToken)
+	assert.Equal(t, want, cc.ConfigGitSigningKeyPassphrase)
+	assert.Equal(t, "", cc.StatusGitSigningKeyPassphrase)
 }
@@ -67,6 +67,10 @@ type ServeCfg struct {
 	TLSCrtPath      string
 	TLSKeyPath      string
 	TLSCACrtPath    string
+
+	// DeviceStalenessThreshold is the duration after which the device that has not reported is regarded as unreachable.
+	// Staleness is not checked if zero.
+	DeviceStalenessThreshold time.Duration `validate:"min=0"`
 }
 
 func (c *ServeCfg) TLSServerConfig() *credentials.TLSServerConfig {
@@ -112,6 +116,7 @@ type PathType string
 const (
 	NodeService              = "service"
 	NodeDevice               = "device"
+	NodeState                = "state"
 	KeyServiceKind           = "kind"
 	KeyDeviceName            = "name"
 	PathTypeService PathType = NodeService
@@ -493,7 +498,11 @@ func (s *NorthboundServerImpl) Get(ctx context.Context, prefix, path *pb.Path) (
 	case ServicePathReq:
 		buf, err = r.Path().ReadServiceInput()
 	case DevicePathReq:
-		buf, err = r.Path().ReadActualDeviceConfigFile()
+		if r.State() {
+			buf, err = s.readDeviceState(r.Path())
+		} else {
+			buf, err = r.Path().ReadActualDeviceConfigFile()
+		}
 	}
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -535,6 +544,33 @@ func (s *NorthboundServerImpl) Get(ctx context.Context, prefix, path *pb.Path) (
 	return &pb.Notification{Prefix: prefix, Update: []*pb.Update{update}}, nil
 }
 
+// DeviceState is the liveness of the device exposed on the device state path.
+type DeviceState struct {
+	Reachable         bool      `json:"reachable"`
+	LastSeen          time.Time `json:"lastSeen"`
+	SubscriberVersion string    `json:"subscriberVersion,omitempty"`
+	SyncError         string    `json:"syncError,omitempty"`
+}
+
+// readDeviceState returns the device state in JSON. The device is unreachable if its status is stale.
+func (s *NorthboundServerImpl) readDeviceState(dp *kuesta.DevicePath) ([]byte, error) {
+	st, err := dp.ReadDeviceStatus()
+	if err != nil {
+		return nil, err
+	}
+	state := DeviceState{
+		Reachable:         !st.IsStale(time.Now(), s.cfg.DeviceStalenessThreshold),
+		LastSeen:          st.LastSeen,
+		SubscriberVersion: st.SubscriberVersion,
+		SyncError:         st.SyncError,
+	}
+	buf, err := json.Marshal(state)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf, nil
+}
+
 // Delete deletes the service input stored at the supplied path.
 func (s *NorthboundServerImpl) Delete(ctx context.Context, prefix, path *pb.Path) (*pb.UpdateResult, error) {
 	l := logger.FromContext(ctx)
@@ -24,6 +24,7 @@ package core
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"cuelang.org/go/cue/cuecontext"
 	"github.com/nttcom/kuesta/pkg/kuesta"
@@ -61,6 +62,7 @@ func (s *ServicePathReq) Keys() map[string]string {
 type DevicePathReq struct {
 	path   *kuesta.DevicePath
 	device string
+	state  bool
 }
 
 func (DevicePathReq) Type() PathType {
@@ -68,6 +70,9 @@ func (DevicePathReq) Type() PathType {
 }
 
 func (s DevicePathReq) String() string {
+	if s.state {
+		return filepath.Join(s.path.DevicePath(kuesta.ExcludeRoot), NodeState)
+	}
 	return s.path.DevicePath(kuesta.ExcludeRoot)
 }
 
@@ -75,6 +80,11 @@ func (s DevicePathReq) Path() *kuesta.DevicePath {
 	return s.path
 }
 
+// State returns true if the request is for the device state instead of the actual device config.
+func (s DevicePathReq) State() bool {
+	return s.state
+}
+
 type GnmiPathConverter struct {
 	cfg *ServeCfg
 
@@ -154,7 +164,8 @@ func (c *GnmiPathConverter) convertDevice(elem []*gnmi.PathElem) (DevicePathReq,
 	}
 
 	p := kuesta.DevicePath{RootDir: c.cfg.StatusRootPath, Device: deviceName}
-	return DevicePathReq{path: &p, device: deviceName}, nil
+	state := len(elem) > 1 && elem[1].GetName() == NodeState
+	return DevicePathReq{path: &p, device: deviceName, state: state}, nil
 }
 
 func gnmiFullPath(prefix, path *gnmi.Path) *gnmi.Path {
@@ -24,6 +24,7 @@ package core_test
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -36,6 +37,7 @@ import (
 	"github.com/nttcom/kuesta/internal/derrors"
 	"github.com/nttcom/kuesta/internal/gogit"
 	"github.com/nttcom/kuesta/internal/testing/githelper"
+	"github.com/nttcom/kuesta/pkg/kuesta"
 	"github.com/nttcom/kuesta/pkg/testing/testhelper"
 	pb "github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/pkg/errors"
@@ -440,6 +442,53 @@ func TestNorthboundServerImpl_Get(t *testing.T) {
 	}
 }
 
+func TestNorthboundServerImpl_Get_DeviceState(t *testing.T) {
+	dir := t.TempDir()
+	lastSeen := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+	dp := kuesta.DevicePath{RootDir: dir, Device: "device1"}
+	testhelper.ExitOnErr(t, dp.WriteDeviceStatus(&kuesta.DeviceStatus{LastSeen: lastSeen, SubscriberVersion: "v0.1.0"}))
+
+	statePath := func(device string) *pb.Path {
+		return &pb.Path{
+			Elem: []*pb.PathElem{
+				{Name: "devices"},
+				{Name: "device", Key: map[string]string{"name": device}},
+				{Name: "state"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		threshold time.Duration
+		device    string
+		want      *core.DeviceState
+		wantErr   codes.Code
+	}{
+		{"ok: staleness not checked", 0, "device1", &core.DeviceState{Reachable: true, LastSeen: lastSeen, SubscriberVersion: "v0.1.0"}, codes.OK},
+		{"ok: reachable", 2 * time.Minute, "device1", &core.DeviceState{Reachable: true, LastSeen: lastSeen, SubscriberVersion: "v0.1.0"}, codes.OK},
+		{"ok: unreachable", 30 * time.Second, "device1", &core.DeviceState{Reachable: false, LastSeen: lastSeen, SubscriberVersion: "v0.1.0"}, codes.OK},
+		{"err: no status", 0, "device2", nil, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := core.NewNorthboundServerImpl(&core.ServeCfg{
+				RootCfg:                  core.RootCfg{ConfigRootPath: dir, StatusRootPath: dir},
+				DeviceStalenessThreshold: tt.threshold,
+			})
+			got, err := s.Get(context.Background(), nil, statePath(tt.device))
+			if tt.wantErr != codes.OK {
+				assert.Equal(t, tt.wantErr, status.Code(err))
+				return
+			}
+			testhelper.ExitOnErr(t, err)
+			var state core.DeviceState
+			testhelper.ExitOnErr(t, json.Unmarshal(got.GetUpdate()[0].GetVal().GetJsonVal(), &state))
+			assert.Equal(t, tt.want, &state)
+		})
+	}
+}
+
 func TestNorthboundServerImpl_Delete(t *testing.T) {
 	serviceInput := []byte(`{port: 1}`)
 	transformCue := []byte(`
@@ -23,19 +23,24 @@
 package gogit
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	extgogit "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	gogithttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/nttcom/kuesta/internal/util"
 	"github.com/nttcom/kuesta/internal/validator"
+	"github.com/nttcom/kuesta/pkg/gitsign"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 )
@@ -46,6 +51,17 @@ const (
 	DefaultRemoteName  = "origin"
 	DefaultGitUser     = "kuesta"
 	DefaultGitEmail    = "kuesta@example.com"
+
+	DefaultPushRetries       = 5
+	NoPushRetry              = -1
+	DefaultPushRetryInterval = 500 * time.Millisecond
+	MaxPushRetryInterval     = 10 * time.Second
+)
+
+var (
+	ErrRebaseConflict    = errors.New("rebase conflict")
+	ErrUnrelatedHistory  = errors.New("no common ancestor with remote branch")
+	ErrPushRetryExceeded = errors.New("push retry count exceeded")
 )
 
 type GitOptions struct {
@@ -58,6 +74,18 @@ type GitOptions struct {
 	RemoteName  string
 	User        string
 	Email       string
+
+	// PushRetries is the max number of push retries on non-fast-forward rejection. DefaultPushRetries is used if 0,
+	// and NoPushRetry disables retries.
+	PushRetries int `validate:"min=-1"`
+	// PushRetryInterval is the initial backoff interval of push retries, which doubles on every attempt.
+	PushRetryInterval time.Duration
+	// ReapplyOnConflict makes push retries re-apply the local changes over the remote ones when the rebase conflicts,
+	// which discards the conflicting remote changes. It must be enabled only for the repository whose files are
+	// owned by this writer, otherwise ErrRebaseConflict is returned to let the caller regenerate the changes.
+	ReapplyOnConflict bool
+	// SigningKey is the OpenPGP key to sign commits. Commits are not signed if not given.
+	SigningKey *gitsign.SigningKeyConfig
 }
 
 // Validate validates exposed fields according to the `validate` tag.
@@ -66,6 +94,12 @@ func (g *GitOptions) Validate() error {
 	g.Email = util.Or(g.Email, DefaultGitEmail)
 	g.TrunkBranch = util.Or(g.TrunkBranch, DefaultTrunkBranch)
 	g.RemoteName = util.Or(g.RemoteName, DefaultRemoteName)
+	if g.PushRetries == 0 {
+		g.PushRetries = DefaultPushRetries
+	}
+	if g.PushRetryInterval == 0 {
+		g.PushRetryInterval = DefaultPushRetryInterval
+	}
 
 	return validator.Validate(g)
 }
@@ -108,8 +142,9 @@ func (g *GitOptions) signature() *object.Signature {
 }
 
 type Git struct {
-	opts *GitOptions
-	repo *extgogit.Repository
+	opts    *GitOptions
+	repo    *extgogit.Repository
+	signKey *openpgp.Entity
 }
 
 // NewGit creates Git with a go-git repository.
@@ -190,6 +225,19 @@ func (g *Git) Signature() *object.Signature {
 	return g.opts.signature()
 }
 
+// SignKey returns the OpenPGP entity to sign commits if the signing key is configured, otherwise nil.
+func (g *Git) SignKey() (*openpgp.Entity, error) {
+	if g.signKey != nil || !g.opts.SigningKey.Enabled() {
+		return g.signKey, nil
+	}
+	e, err := g.opts.SigningKey.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load signing key: %w", err)
+	}
+	g.signKey = e
+	return e, nil
+}
+
 // Branch returns the current branch name.
 func (g *Git) Branch() (string, error) {
 	ref, err := g.repo.Head()
@@ -302,9 +350,14 @@ func (g *Git) Commit(msg string, opts ...CommitOpts) (plumbing.Hash, error) {
 		return plumbing.ZeroHash, errors.WithStack(fmt.Errorf("get worktree: %w", err))
 	}
 
+	signKey, err := g.SignKey()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
 	o := &extgogit.CommitOptions{
 		Author:    g.Signature(),
 		Committer: g.Signature(),
+		SignKey:   signKey,
 	}
 	for _, tr := range opts {
 		if tr != nil {
@@ -321,6 +374,12 @@ func (g *Git) Commit(msg string, opts ...CommitOpts) (plumbing.Hash, error) {
 // CommitOpts enables modification of the go-git CommitOptions.
 type CommitOpts func(o *extgogit.CommitOptions)
 
+func CommitOptsAuthor(sig *object.Signature) CommitOpts {
+	return func(o *extgogit.CommitOptions) {
+		o.Author = sig
+	}
+}
+
 // Add executes `git add` for all created/modified/deleted files included in the given path.
 func (g *Git) Add(path string) error {
 	w, err := g.repo.Worktree()
@@ -428,6 +487,313 @@ func PullOptsReference(name plumbing.ReferenceName) PullOpts {
 	}
 }
 
+// FetchOpts enables modification of the go-git FetchOptions.
+type FetchOpts func(o *extgogit.FetchOptions)
+
+// Fetch fetches all branches from remote and updates the remote-tracking branches.
+func (g *Git) Fetch(opts ...FetchOpts) error {
+	o := &extgogit.FetchOptions{
+		RemoteName: g.opts.RemoteName,
+		Progress:   os.Stdout,
+		Auth:       g.BasicAuth(),
+	}
+	for _, tr := range opts {
+		if tr != nil {
+			tr(o)
+		}
+	}
+	if err := g.repo.Fetch(o); err != nil {
+		if !errors.Is(err, extgogit.NoErrAlreadyUpToDate) {
+			return errors.WithStack(fmt.Errorf("git fetch: %w", err))
+		}
+	}
+	return nil
+}
+
+// RemoteHead returns the object.Commit which the remote-tracking branch of the given branch points to.
+func (g *Git) RemoteHead(branch string) (*object.Commit, error) {
+	rn := plumbing.NewRemoteReferenceName(g.opts.RemoteName, branch)
+	ref, err := g.repo.Reference(rn, true)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("resolve %s: %w", rn, err))
+	}
+	c, err := g.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("create commit object: %w", err))
+	}
+	return c, nil
+}
+
+// PushWithRetry pushes the specified git branch to remote. When the push is rejected as non-fast-forward,
+// it fetches the remote, rebases the local commits onto the remote branch and retries with exponential backoff.
+// If the rebase conflicts, ErrRebaseConflict is returned unless ReapplyOnConflict is enabled, in which case the local
+// branch is reset to the remote one and the local changes are re-applied on it. It returns the context error if the context is done while waiting for the next attempt.
+func (g *Git) PushWithRetry(ctx context.Context, branch string, opts ...PushOpts) error {
+	interval := g.opts.PushRetryInterval
+	for i := 0; ; i++ {
+		err := g.Push(append([]PushOpts{PushOptBranch(branch)}, opts...)...)
+		if err == nil {
+			return nil
+		}
+		if !IsNonFastForwardErr(err) || g.opts.PushRetries == NoPushRetry {
+			return err
+		}
+		if i >= g.opts.PushRetries {
+			return errors.WithStack(fmt.Errorf("%w: %d times: %v", ErrPushRetryExceeded, i, err))
+		}
+
+		t := time.NewTimer(jitter(interval))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return errors.WithStack(ctx.Err())
+		case <-t.C:
+		}
+		interval *= 2
+		if interval > MaxPushRetryInterval {
+			interval = MaxPushRetryInterval
+		}
+
+		if err := g.Fetch(); err != nil {
+			return err
+		}
+		err = g.Rebase(branch)
+		if errors.Is(err, ErrRebaseConflict) && g.opts.ReapplyOnConflict {
+			err = g.ResetAndReapply(branch)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Rebase replays the local commits onto the remote-tracking branch of the given branch.
+// The remote-tracking branch must be updated by Fetch in advance.
+// It returns ErrRebaseConflict without any modification if the local commits and the remote ones
+// change the same file.
+func (g *Git) Rebase(branch string) error {
+	head, upstream, base, err := g.rebaseTargets(branch)
+	if err != nil {
+		return err
+	}
+	if head.Hash == upstream.Hash || base.Hash == upstream.Hash {
+		return nil
+	}
+	if base.Hash == head.Hash {
+		return g.Reset(ResetOptsHard(), ResetOptsTo(upstream.Hash))
+	}
+
+	commits, err := commitsBetween(base, head)
+	if err != nil {
+		return err
+	}
+	theirs, err := diffCommits(base, upstream)
+	if err != nil {
+		return err
+	}
+	changed := util.NewSet[string]()
+	for _, ch := range theirs {
+		changed.Add(changePath(ch))
+	}
+
+	localChanges := make([]object.Changes, len(commits))
+	for i, c := range commits {
+		parent, err := c.Parent(0)
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("get parent of %s: %w", c.Hash, err))
+		}
+		chs, err := diffCommits(parent, c)
+		if err != nil {
+			return err
+		}
+		for _, ch := range chs {
+			if changed.Has(changePath(ch)) {
+				return errors.WithStack(fmt.Errorf("%w: %s", ErrRebaseConflict, changePath(ch)))
+			}
+		}
+		localChanges[i] = chs
+	}
+
+	if err := g.Reset(ResetOptsHard(), ResetOptsTo(upstream.Hash)); err != nil {
+		return err
+	}
+	for i, c := range commits {
+		if err := g.applyChanges(localChanges[i]); err != nil {
+			return err
+		}
+		if _, err := g.Commit(c.Message, CommitOptsAuthor(&c.Author)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetAndReapply resets the local branch to the remote-tracking branch of the given branch, then re-applies
+// the whole changes of the local commits on it as one commit. The local changes take precedence over the remote ones.
+func (g *Git) ResetAndReapply(branch string) error {
+	head, upstream, base, err := g.rebaseTargets(branch)
+	if err != nil {
+		return err
+	}
+	ours, err := diffCommits(base, head)
+	if err != nil {
+		return err
+	}
+
+	if err := g.Reset(ResetOptsHard(), ResetOptsTo(upstream.Hash)); err != nil {
+		return err
+	}
+	if err := g.applyChanges(ours); err != nil {
+		return err
+	}
+
+	w, err := g.repo.Worktree()
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("get worktree: %w", err))
+	}
+	stmap, err := w.Status()
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("get status: %w", err))
+	}
+	if stmap.IsClean() {
+		return nil
+	}
+	if _, err := g.Commit(head.Message, CommitOptsAuthor(&head.Author)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (g *Git) rebaseTargets(branch string) (head, upstream, base *object.Commit, err error) {
+	head, err = g.Head()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	upstream, err = g.RemoteHead(branch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	bases, err := head.MergeBase(upstream)
+	if err != nil {
+		return nil, nil, nil, errors.WithStack(fmt.Errorf("get merge base: %w", err))
+	}
+	if len(bases) == 0 {
+		return nil, nil, nil, errors.WithStack(ErrUnrelatedHistory)
+	}
+	return head, upstream, bases[0], nil
+}
+
+func (g *Git) applyChanges(changes object.Changes) error {
+	w, err := g.repo.Worktree()
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("get worktree: %w", err))
+	}
+	remove := func(path string) error {
+		if _, err := w.Remove(path); err != nil && !errors.Is(err, index.ErrEntryNotFound) {
+			return errors.WithStack(fmt.Errorf("git rm %s: %w", path, err))
+		}
+		return nil
+	}
+
+	for _, ch := range changes {
+		// NOTE object.File returned by Change.Files() has only base name, so full path is taken from ChangeEntry.
+		_, to, err := ch.Files()
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("get changed files: %w", err))
+		}
+		if to == nil {
+			if err := remove(ch.From.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		path := ch.To.Name
+		if ch.From.Name != "" && ch.From.Name != path {
+			if err := remove(ch.From.Name); err != nil {
+				return err
+			}
+		}
+		content, err := to.Contents()
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("read %s: %w", path, err))
+		}
+		if err := w.Filesystem.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return errors.WithStack(fmt.Errorf("make dir for %s: %w", path, err))
+		}
+		f, err := w.Filesystem.Create(path)
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("create %s: %w", path, err))
+		}
+		_, err = f.Write([]byte(content))
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("write %s: %w", path, err))
+		}
+		if _, err := w.Add(path); err != nil {
+			return errors.WithStack(fmt.Errorf("git add %s: %w", path, err))
+		}
+	}
+	return nil
+}
+
+// commitsBetween returns the first-parent commits after base up to head in chronological order.
+func commitsBetween(base, head *object.Commit) ([]*object.Commit, error) {
+	var commits []*object.Commit
+	for c := head; c.Hash != base.Hash; {
+		commits = append([]*object.Commit{c}, commits...)
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, errors.WithStack(fmt.Errorf("get parent of %s: %w", c.Hash, err))
+		}
+		c = parent
+	}
+	return commits, nil
+}
+
+func diffCommits(from, to *object.Commit) (object.Changes, error) {
+	fromTree, err := from.Tree()
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("get tree of %s: %w", from.Hash, err))
+	}
+	toTree, err := to.Tree()
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("get tree of %s: %w", to.Hash, err))
+	}
+	changes, err := object.DiffTree(fromTree, toTree)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("diff tree: %w", err))
+	}
+	return changes, nil
+}
+
+func changePath(ch *object.Change) string {
+	if ch.To.Name != "" {
+		return ch.To.Name
+	}
+	return ch.From.Name
+}
+
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// IsNonFastForwardErr returns true if the given error is caused by the non-fast-forward rejection of git push or pull.
+func IsNonFastForwardErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, extgogit.ErrNonFastForwardUpdate) || errors.Is(err, extgogit.ErrForceNeeded) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "non-fast-forward") || strings.Contains(msg, "fetch first")
+}
+
 // ResetOpts enables modification of the go-git ResetOptions.
 type ResetOpts func(o *extgogit.ResetOptions)
 
@@ -437,6 +803,12 @@ func ResetOptsHard() ResetOpts {
 	}
 }
 
+func ResetOptsTo(h plumbing.Hash) ResetOpts {
+	return func(o *extgogit.ResetOptions) {
+		o.Commit = h
+	}
+}
+
 // Reset runs git-reset with supplied options.
 func (g *Git) Reset(opts ...ResetOpts) error {
 	o := &extgogit.ResetOptions{}
@@ -23,15 +23,20 @@
 package gogit_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	extgogit "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/nttcom/kuesta/internal/gogit"
 	"github.com/nttcom/kuesta/internal/testing/githelper"
+	"github.com/nttcom/kuesta/pkg/gitsign"
 	"github.com/nttcom/kuesta/pkg/testing/testhelper"
 	"github.com/stretchr/testify/assert"
 )
@@ -55,6 +60,13 @@ func TestGitOptions_Validate(t *testing.T) {
 			func(g *gogit.GitOptions) {},
 			false,
 		},
+		{
+			"ok: push retry disabled",
+			func(g *gogit.GitOptions) {
+				g.PushRetries = gogit.NoPushRetry
+			},
+			false,
+		},
 		{
 			"err: path is empty",
 			func(g *gogit.GitOptions) {
@@ -62,6 +74,13 @@ func TestGitOptions_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"err: push retries is less than -1",
+			func(g *gogit.GitOptions) {
+				g.PushRetries = -2
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -350,6 +369,40 @@ func TestGit_Commit(t *testing.T) {
 	})
 }
 
+func TestGit_Commit_Signed(t *testing.T) {
+	priv, pub := testhelper.NewArmoredKeyPair(t, "")
+
+	t.Run("ok", func(t *testing.T) {
+		repo, dir := githelper.InitRepo(t, "main")
+		g, err := gogit.NewGit(&gogit.GitOptions{
+			Path:       dir,
+			SigningKey: &gitsign.SigningKeyConfig{KeyData: []byte(priv)},
+		})
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(repo, "test", "signed"))
+
+		h, err := g.Commit("signed commit")
+		assert.Nil(t, err)
+		c, err := g.Repo().CommitObject(h)
+		testhelper.ExitOnErr(t, err)
+		_, err = gitsign.VerifyCommit(c, pub)
+		assert.Nil(t, err)
+	})
+
+	t.Run("err: invalid signing key", func(t *testing.T) {
+		repo, dir := githelper.InitRepo(t, "main")
+		g, err := gogit.NewGit(&gogit.GitOptions{
+			Path:       dir,
+			SigningKey: &gitsign.SigningKeyConfig{KeyData: []byte("invalid")},
+		})
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(repo, "test", "signed"))
+
+		_, err = g.Commit("signed commit")
+		assert.Error(t, err)
+	})
+}
+
 func TestGit_Add(t *testing.T) {
 	t.Run("ok: create new", func(t *testing.T) {
 		repo, dir := githelper.InitRepo(t, "main")
@@ -491,6 +544,244 @@ func TestGit_Push(t *testing.T) {
 	})
 }
 
+func TestGit_PushWithRetry(t *testing.T) {
+	testRemote := "test-remote"
+
+	setup := func(t *testing.T, retries int, reapply bool) (*gogit.Git, *gogit.Git, *extgogit.Repository) {
+		remoteRepo, dirBare := githelper.InitBareRepo(t)
+
+		repo, dir := githelper.InitRepo(t, "main")
+		_, err := repo.CreateRemote(&config.RemoteConfig{
+			Name: testRemote,
+			URLs: []string{dirBare},
+		})
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, githelper.Push(repo, "main", testRemote))
+		gitWriter, err := gogit.NewGit(&gogit.GitOptions{
+			Path:              dir,
+			RemoteName:        testRemote,
+			PushRetries:       retries,
+			PushRetryInterval: time.Millisecond,
+			ReapplyOnConflict: reapply,
+		})
+		testhelper.ExitOnErr(t, err)
+
+		_, dirOther := githelper.CloneRepo(t, &extgogit.CloneOptions{
+			URL:           dirBare,
+			RemoteName:    testRemote,
+			ReferenceName: plumbing.NewBranchReferenceName("main"),
+		})
+		gitOther, err := gogit.NewGit(&gogit.GitOptions{
+			Path:       dirOther,
+			RemoteName: testRemote,
+		})
+		testhelper.ExitOnErr(t, err)
+
+		return gitWriter, gitOther, remoteRepo
+	}
+
+	remoteHead := func(t *testing.T, remoteRepo *extgogit.Repository) *object.Commit {
+		ref, err := remoteRepo.Reference(plumbing.NewBranchReferenceName("main"), false)
+		testhelper.ExitOnErr(t, err)
+		c, err := remoteRepo.CommitObject(ref.Hash())
+		testhelper.ExitOnErr(t, err)
+		return c
+	}
+
+	readFile := func(t *testing.T, c *object.Commit, path string) string {
+		f, err := c.File(path)
+		testhelper.ExitOnErr(t, err)
+		content, err := f.Contents()
+		testhelper.ExitOnErr(t, err)
+		return content
+	}
+
+	t.Run("ok: rebase onto remote", func(t *testing.T) {
+		gitWriter, gitOther, remoteRepo := setup(t, 0, false)
+
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitOther.Repo(), "other", "other"))
+		otherHash, err := gitOther.Commit("pushed by other")
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, gitOther.Push())
+
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitWriter.Repo(), "mine", "mine"))
+		wantMsg := "pushed after rebase"
+		_, err = gitWriter.Commit(wantMsg)
+		testhelper.ExitOnErr(t, err)
+
+		err = gitWriter.PushWithRetry(context.Background(), "main")
+		assert.Nil(t, err)
+
+		got := remoteHead(t, remoteRepo)
+		assert.Equal(t, wantMsg, got.Message)
+		assert.Equal(t, []plumbing.Hash{otherHash}, got.ParentHashes)
+		assert.Equal(t, "other", readFile(t, got, "other"))
+		assert.Equal(t, "mine", readFile(t, got, "mine"))
+
+		localHead, err := gitWriter.Head()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, got.Hash, localHead.Hash)
+	})
+
+	t.Run("ok: reset and reapply on conflict", func(t *testing.T) {
+		gitWriter, gitOther, remoteRepo := setup(t, 0, true)
+
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitOther.Repo(), "README.md", "# other"))
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitOther.Repo(), "other", "other"))
+		otherHash, err := gitOther.Commit("pushed by other")
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, gitOther.Push())
+
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitWriter.Repo(), "README.md", "# mine"))
+		wantMsg := "pushed after reapply"
+		_, err = gitWriter.Commit(wantMsg)
+		testhelper.ExitOnErr(t, err)
+
+		err = gitWriter.PushWithRetry(context.Background(), "main")
+		assert.Nil(t, err)
+
+		got := remoteHead(t, remoteRepo)
+		assert.Equal(t, wantMsg, got.Message)
+		assert.Equal(t, []plumbing.Hash{otherHash}, got.ParentHashes)
+		assert.Equal(t, "other", readFile(t, got, "other"))
+		assert.Equal(t, "# mine", readFile(t, got, "README.md"))
+		assert.Equal(t, 0, len(githelper.GetStatus(t, gitWriter.Repo())))
+	})
+
+	t.Run("err: conflict", func(t *testing.T) {
+		gitWriter, gitOther, remoteRepo := setup(t, 0, false)
+
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitOther.Repo(), "README.md", "# other"))
+		otherHash, err := gitOther.Commit("pushed by other")
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, gitOther.Push())
+
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitWriter.Repo(), "README.md", "# mine"))
+		_, err = gitWriter.Commit("conflicted")
+		testhelper.ExitOnErr(t, err)
+
+		err = gitWriter.PushWithRetry(context.Background(), "main")
+		assert.ErrorIs(t, err, gogit.ErrRebaseConflict)
+
+		got := remoteHead(t, remoteRepo)
+		assert.Equal(t, otherHash, got.Hash)
+		assert.Equal(t, "# other", readFile(t, got, "README.md"))
+	})
+
+	t.Run("err: remote not exist", func(t *testing.T) {
+		repo, dir := githelper.InitRepo(t, "main")
+		g, err := gogit.NewGit(&gogit.GitOptions{
+			Path:       dir,
+			RemoteName: "not-exist",
+		})
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(repo, "test", "push"))
+		_, err = g.Commit("added: test")
+		testhelper.ExitOnErr(t, err)
+
+		err = g.PushWithRetry(context.Background(), "main")
+		assert.Error(t, err)
+	})
+
+	rejectedWriter := func(t *testing.T, retries int) *gogit.Git {
+		gitWriter, gitOther, _ := setup(t, retries, false)
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitOther.Repo(), "other", "other"))
+		_, err := gitOther.Commit("pushed by other")
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, gitOther.Push())
+
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gitWriter.Repo(), "mine", "mine"))
+		_, err = gitWriter.Commit("rejected")
+		testhelper.ExitOnErr(t, err)
+		return gitWriter
+	}
+
+	t.Run("err: retry disabled", func(t *testing.T) {
+		gitWriter := rejectedWriter(t, gogit.NoPushRetry)
+
+		err := gitWriter.PushWithRetry(context.Background(), "main")
+		assert.True(t, gogit.IsNonFastForwardErr(err))
+		assert.False(t, errors.Is(err, gogit.ErrPushRetryExceeded))
+	})
+
+	t.Run("err: context canceled", func(t *testing.T) {
+		gitWriter := rejectedWriter(t, 0)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := gitWriter.PushWithRetry(ctx, "main")
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
+
+func TestGit_Rebase(t *testing.T) {
+	testRemote := "test-remote"
+
+	setup := func(t *testing.T) (*gogit.Git, *gogit.Git) {
+		_, dirBare := githelper.InitBareRepo(t)
+		repo, dir := githelper.InitRepo(t, "main")
+		_, err := repo.CreateRemote(&config.RemoteConfig{
+			Name: testRemote,
+			URLs: []string{dirBare},
+		})
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, githelper.Push(repo, "main", testRemote))
+		g, err := gogit.NewGit(&gogit.GitOptions{
+			Path:       dir,
+			RemoteName: testRemote,
+		})
+		testhelper.ExitOnErr(t, err)
+
+		_, dirOther := githelper.CloneRepo(t, &extgogit.CloneOptions{
+			URL:           dirBare,
+			RemoteName:    testRemote,
+			ReferenceName: plumbing.NewBranchReferenceName("main"),
+		})
+		gOther, err := gogit.NewGit(&gogit.GitOptions{
+			Path:       dirOther,
+			RemoteName: testRemote,
+		})
+		testhelper.ExitOnErr(t, err)
+		return g, gOther
+	}
+
+	t.Run("ok: fast-forward", func(t *testing.T) {
+		g, gOther := setup(t)
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gOther.Repo(), "other", "other"))
+		want, err := gOther.Commit("pushed by other")
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, gOther.Push())
+
+		testhelper.ExitOnErr(t, g.Fetch())
+		err = g.Rebase("main")
+		assert.Nil(t, err)
+
+		got, err := g.Head()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, want, got.Hash)
+	})
+
+	t.Run("err: conflict", func(t *testing.T) {
+		g, gOther := setup(t)
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(gOther.Repo(), "README.md", "# other"))
+		_, err := gOther.Commit("pushed by other")
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, gOther.Push())
+
+		testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(g.Repo(), "README.md", "# mine"))
+		want, err := g.Commit("local commit")
+		testhelper.ExitOnErr(t, err)
+
+		testhelper.ExitOnErr(t, g.Fetch())
+		err = g.Rebase("main")
+		assert.ErrorIs(t, err, gogit.ErrRebaseConflict)
+
+		got, err := g.Head()
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, want, got.Hash)
+	})
+}
+
 func TestGit_SetUpstream(t *testing.T) {
 	_, dirBare := githelper.InitBareRepo(t)
 	testRemote := "test-remote"
@@ -878,6 +1169,25 @@ func TestGitRemote_RemoveBranch(t *testing.T) {
 	})
 }
 
+func TestIsNonFastForwardErr(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"pull", extgogit.ErrNonFastForwardUpdate, true},
+		{"push", fmt.Errorf("git push: %w", errors.New("non-fast-forward update: refs/heads/main")), true},
+		{"push rejected by remote", errors.New("command error on refs/heads/main: fetch first"), true},
+		{"other", errors.New("repository not found"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, gogit.IsNonFastForwardErr(tt.err))
+		})
+	}
+}
+
 func TestIsTrackedAndChanged(t *testing.T) {
 	tests := []struct {
 		given extgogit.StatusCode
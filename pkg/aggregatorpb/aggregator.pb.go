@@ -0,0 +1,567 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.21.5
+// source: github.com/nttcom/kuesta/pkg/aggregatorpb/aggregator.proto
+
+package aggregatorpb
+
+import (
+	gnmi "github.com/openconfig/gnmi/proto/gnmi"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StreamRequest is a batch of the device config update.
+type StreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the batch identifier chosen by the client, which is echoed back in the StreamResponse.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// device is the name of the device.
+	Device string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	// Types that are assignable to Update:
+	//	*StreamRequest_Snapshot
+	//	*StreamRequest_Notifications
+	//	*StreamRequest_Delete
+	Update isStreamRequest_Update `protobuf_oneof:"update"`
+	// subscriber_version is the version of the subscriber recorded in the device status.
+	SubscriberVersion string `protobuf:"bytes,5,opt,name=subscriber_version,json=subscriberVersion,proto3" json:"subscriber_version,omitempty"`
+	// sync_error is the error occurred on the subscriber recorded in the device status.
+	// The update can be omitted if set.
+	SyncError string `protobuf:"bytes,6,opt,name=sync_error,json=syncError,proto3" json:"sync_error,omitempty"`
+}
+
+func (x *StreamRequest) Reset() {
+	*x = StreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRequest) ProtoMessage() {}
+
+func (x *StreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StreamRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (m *StreamRequest) GetUpdate() isStreamRequest_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetSnapshot() *Snapshot {
+	if x, ok := x.GetUpdate().(*StreamRequest_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetNotifications() *Notifications {
+	if x, ok := x.GetUpdate().(*StreamRequest_Notifications); ok {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetDelete() *DeleteDevice {
+	if x, ok := x.GetUpdate().(*StreamRequest_Delete); ok {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetSubscriberVersion() string {
+	if x != nil {
+		return x.SubscriberVersion
+	}
+	return ""
+}
+
+func (x *StreamRequest) GetSyncError() string {
+	if x != nil {
+		return x.SyncError
+	}
+	return ""
+}
+
+type isStreamRequest_Update interface {
+	isStreamRequest_Update()
+}
+
+type StreamRequest_Snapshot struct {
+	// snapshot replaces the entire actual config of the device.
+	Snapshot *Snapshot `protobuf:"bytes,3,opt,name=snapshot,proto3,oneof"`
+}
+
+type StreamRequest_Notifications struct {
+	// notifications are applied incrementally to the actual config of the device.
+	Notifications *Notifications `protobuf:"bytes,4,opt,name=notifications,proto3,oneof"`
+}
+
+type StreamRequest_Delete struct {
+	// delete removes the device from the status repository.
+	Delete *DeleteDevice `protobuf:"bytes,7,opt,name=delete,proto3,oneof"`
+}
+
+func (*StreamRequest_Snapshot) isStreamRequest_Update() {}
+
+func (*StreamRequest_Notifications) isStreamRequest_Update() {}
+
+func (*StreamRequest_Delete) isStreamRequest_Update() {}
+
+// Snapshot is the entire device config.
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// config is the device config in CUE format.
+	Config []byte `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Snapshot) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// Notifications are the incremental updates of the device config.
+// Each path addresses the field of the stored actual config: path element names are field labels,
+// and a path element with a key addresses the entry of the keyed list by its key value.
+// Deletes remove the subtree, and then updates are merged to the existing tree.
+type Notifications struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notification []*gnmi.Notification `protobuf:"bytes,1,rep,name=notification,proto3" json:"notification,omitempty"`
+}
+
+func (x *Notifications) Reset() {
+	*x = Notifications{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notifications) ProtoMessage() {}
+
+func (x *Notifications) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notifications.ProtoReflect.Descriptor instead.
+func (*Notifications) Descriptor() ([]byte, []int) {
+	return file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Notifications) GetNotification() []*gnmi.Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// DeleteDevice is the deletion of the decommissioned device.
+type DeleteDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDevice) Reset() {
+	*x = DeleteDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDevice) ProtoMessage() {}
+
+func (x *DeleteDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDevice.ProtoReflect.Descriptor instead.
+func (*DeleteDevice) Descriptor() ([]byte, []int) {
+	return file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescGZIP(), []int{3}
+}
+
+// StreamResponse acknowledges the StreamRequest.
+type StreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the batch identifier of the acknowledged StreamRequest.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// device is the name of the device.
+	Device string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	// error is the reason why the batch is not applied to the actual config or not pushed. It is empty on success.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StreamResponse) Reset() {
+	*x = StreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse) ProtoMessage() {}
+
+func (x *StreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse.ProtoReflect.Descriptor instead.
+func (*StreamResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StreamResponse) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *StreamResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto protoreflect.FileDescriptor
+
+var file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDesc = []byte{
+	0x0a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x74, 0x74,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x65, 0x73, 0x74, 0x61, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x2f, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x6b, 0x75,
+	0x65, 0x73, 0x74, 0x61, 0x2e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x1a,
+	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x67, 0x6e, 0x6d, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6e, 0x6d, 0x69, 0x2f, 0x67, 0x6e, 0x6d, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xcf, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6b, 0x75, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x48, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6b, 0x75, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48,
+	0x00, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x39, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6b, 0x75, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x79, 0x6e, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x22, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x47, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x6e, 0x6d, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x22, 0x4e, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x32, 0x5f, 0x0a, 0x0a, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x51,
+	0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x6b, 0x75, 0x65, 0x73, 0x74,
+	0x61, 0x2e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x75, 0x65,
+	0x73, 0x74, 0x61, 0x2e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30,
+	0x01, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6e, 0x74, 0x74, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x65, 0x73, 0x74, 0x61, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescOnce sync.Once
+	file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescData = file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDesc
+)
+
+func file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescGZIP() []byte {
+	file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescOnce.Do(func() {
+		file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescData)
+	})
+	return file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDescData
+}
+
+var file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_goTypes = []interface{}{
+	(*StreamRequest)(nil),     // 0: kuesta.aggregator.StreamRequest
+	(*Snapshot)(nil),          // 1: kuesta.aggregator.Snapshot
+	(*Notifications)(nil),     // 2: kuesta.aggregator.Notifications
+	(*DeleteDevice)(nil),      // 3: kuesta.aggregator.DeleteDevice
+	(*StreamResponse)(nil),    // 4: kuesta.aggregator.StreamResponse
+	(*gnmi.Notification)(nil), // 5: gnmi.Notification
+}
+var file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_depIdxs = []int32{
+	1, // 0: kuesta.aggregator.StreamRequest.snapshot:type_name -> kuesta.aggregator.Snapshot
+	2, // 1: kuesta.aggregator.StreamRequest.notifications:type_name -> kuesta.aggregator.Notifications
+	3, // 2: kuesta.aggregator.StreamRequest.delete:type_name -> kuesta.aggregator.DeleteDevice
+	5, // 3: kuesta.aggregator.Notifications.notification:type_name -> gnmi.Notification
+	0, // 4: kuesta.aggregator.Aggregator.Stream:input_type -> kuesta.aggregator.StreamRequest
+	4, // 5: kuesta.aggregator.Aggregator.Stream:output_type -> kuesta.aggregator.StreamResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_init() }
+func file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_init() {
+	if File_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notifications); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*StreamRequest_Snapshot)(nil),
+		(*StreamRequest_Notifications)(nil),
+		(*StreamRequest_Delete)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_goTypes,
+		DependencyIndexes: file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_depIdxs,
+		MessageInfos:      file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_msgTypes,
+	}.Build()
+	File_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto = out.File
+	file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_rawDesc = nil
+	file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_goTypes = nil
+	file_github_com_nttcom_kuesta_pkg_aggregatorpb_aggregator_proto_depIdxs = nil
+}
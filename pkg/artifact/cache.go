@@ -0,0 +1,226 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package artifact
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultCacheSize is the max number of the artifacts kept in Cache by default.
+const DefaultCacheSize = 16
+
+// DefaultCacheFetchTimeout is the timeout of fetching the artifact to Cache.
+const DefaultCacheFetchTimeout = 5 * time.Minute
+
+const maxCacheFetchAttempts = 3
+
+// cacheDirPattern matches the names of the directories where Cache extracts the artifacts.
+var cacheDirPattern = regexp.MustCompile(`^[0-9a-f]{64}-[0-9]+$`)
+
+// Cache is the local cache of the artifacts extracted on disk, keyed by the source and the revision along with the
+// checksum of the artifact if advertised by ChecksumSource. The least recently used artifacts are evicted once the
+// number of the cached artifacts exceeds the size, and the concurrent fetches of the same artifact are
+// de-duplicated. It is safe for concurrent use.
+type Cache struct {
+	dir  string
+	size int
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	group   singleflight.Group
+}
+
+type cacheEntry struct {
+	key     string
+	dir     string
+	refs    int
+	evicted bool
+}
+
+// NewCache creates the Cache which extracts the artifacts under the given directory. DefaultCacheSize is used if
+// the size is not positive. The artifacts left under the directory by the previous process are removed since they
+// are no longer tracked.
+func NewCache(dir string, size int) (*Cache, error) {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, errors.WithStack(fmt.Errorf("create cache dir: %w", err))
+	}
+	if err := removeStaleCacheDirs(dir); err != nil {
+		return nil, err
+	}
+	return &Cache{
+		dir:     dir,
+		size:    size,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}, nil
+}
+
+// Acquire returns the directory where the artifact of the given revision is extracted, fetching it from the source
+// if not cached yet. The latest revision of the source is used if the revision is empty. The name identifies the
+// source, such as `<namespace>/<name>`.
+//
+// The directory must not be modified, and is kept until the returned release function is called even if evicted
+// in the meantime.
+func (c *Cache) Acquire(ctx context.Context, name string, src Source, revision string) (string, func(), error) {
+	if revision == "" {
+		rev, err := src.Revision(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+		revision = rev
+	}
+	key := name + "@" + revision
+	if cs, ok := src.(ChecksumSource); ok {
+		if checksum := cs.Checksum(revision); checksum != "" {
+			key += "#" + checksum
+		}
+	}
+
+	for i := 0; i < maxCacheFetchAttempts; i++ {
+		if e := c.acquire(key); e != nil {
+			return e.dir, c.releaseFunc(e), nil
+		}
+		// NOTE the fetch is detached from the context of the caller since it is shared with the other callers, but the
+		// caller stops waiting for it once its own context is done
+		ch := c.group.DoChan(key, func() (interface{}, error) {
+			if c.contains(key) {
+				return nil, nil
+			}
+			fetchCtx, cancel := context.WithTimeout(context.Background(), DefaultCacheFetchTimeout)
+			defer cancel()
+			return nil, c.fetch(fetchCtx, key, src, revision)
+		})
+		select {
+		case <-ctx.Done():
+			return "", nil, errors.WithStack(fmt.Errorf("wait for artifact %s: %w", key, ctx.Err()))
+		case res := <-ch:
+			if res.Err != nil {
+				return "", nil, res.Err
+			}
+		}
+	}
+	return "", nil, fmt.Errorf("artifact %s is evicted right after fetched: cache size %d is too small", key, c.size)
+}
+
+// Len returns the number of the cached artifacts.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *Cache) contains(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.entries[key]
+	return ok
+}
+
+func (c *Cache) acquire(key string) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(el)
+	e := el.Value.(*cacheEntry)
+	e.refs++
+	return e
+}
+
+func (c *Cache) releaseFunc(e *cacheEntry) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			e.refs--
+			if e.evicted && e.refs == 0 {
+				os.RemoveAll(e.dir)
+			}
+		})
+	}
+}
+
+func (c *Cache) fetch(ctx context.Context, key string, src Source, revision string) error {
+	// NOTE the evicted directory of the same key may still be in use
+	dir, err := os.MkdirTemp(c.dir, fmt.Sprintf("%x-*", sha256.Sum256([]byte(key))))
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("create cache dir: %w", err))
+	}
+	if _, err := src.Fetch(ctx, dir, revision); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, dir: dir})
+	for c.lru.Len() > c.size {
+		c.evict(c.lru.Back())
+	}
+	return nil
+}
+
+// removeStaleCacheDirs removes the directories of the artifacts extracted under dir.
+func removeStaleCacheDirs(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("read cache dir: %w", err))
+	}
+	for _, e := range entries {
+		if !e.IsDir() || !cacheDirPattern.MatchString(e.Name()) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, e.Name())); err != nil {
+			return errors.WithStack(fmt.Errorf("remove stale cache dir: %w", err))
+		}
+	}
+	return nil
+}
+
+func (c *Cache) evict(el *list.Element) {
+	e := el.Value.(*cacheEntry)
+	c.lru.Remove(el)
+	delete(c.entries, e.key)
+	e.evicted = true
+	if e.refs == 0 {
+		os.RemoveAll(e.dir)
+	}
+}
@@ -0,0 +1,238 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package artifact_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nttcom/kuesta/pkg/artifact"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSource struct {
+	latest  string
+	fetched int32
+	err     error
+	block   chan struct{}
+}
+
+func (s *fakeSource) Revision(ctx context.Context) (string, error) {
+	return s.latest, nil
+}
+
+func (s *fakeSource) Fetch(ctx context.Context, dir, revision string) (string, error) {
+	atomic.AddInt32(&s.fetched, 1)
+	if s.block != nil {
+		<-s.block
+	}
+	if s.err != nil {
+		return "", s.err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "revision"), []byte(revision), 0o600); err != nil {
+		return "", err
+	}
+	return revision, nil
+}
+
+func readRevision(t *testing.T, dir string) string {
+	buf, err := os.ReadFile(filepath.Join(dir, "revision"))
+	testhelper.ExitOnErr(t, err)
+	return string(buf)
+}
+
+func TestNewCache(t *testing.T) {
+	dir := t.TempDir()
+	stale := filepath.Join(dir, fmt.Sprintf("%064x-123456", 0))
+	other := filepath.Join(dir, "other")
+	testhelper.ExitOnErr(t, os.MkdirAll(stale, 0o750))
+	testhelper.ExitOnErr(t, os.MkdirAll(other, 0o750))
+
+	_, err := artifact.NewCache(dir, 2)
+	assert.Nil(t, err)
+	assert.NoDirExists(t, stale)
+	assert.DirExists(t, other)
+}
+
+func TestCache_Acquire(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cache hit", func(t *testing.T) {
+		c, err := artifact.NewCache(t.TempDir(), 2)
+		testhelper.ExitOnErr(t, err)
+		src := &fakeSource{latest: "rev1"}
+
+		dir1, release1, err := c.Acquire(ctx, "ns/test", src, "")
+		assert.Nil(t, err)
+		defer release1()
+		assert.Equal(t, "rev1", readRevision(t, dir1))
+
+		dir2, release2, err := c.Acquire(ctx, "ns/test", src, "rev1")
+		assert.Nil(t, err)
+		defer release2()
+		assert.Equal(t, dir1, dir2)
+		assert.Equal(t, int32(1), src.fetched)
+
+		_, release3, err := c.Acquire(ctx, "ns/other", src, "rev1")
+		assert.Nil(t, err)
+		defer release3()
+		assert.Equal(t, int32(2), src.fetched)
+	})
+
+	t.Run("evict least recently used", func(t *testing.T) {
+		c, err := artifact.NewCache(t.TempDir(), 2)
+		testhelper.ExitOnErr(t, err)
+		src := &fakeSource{}
+
+		dirs := map[string]string{}
+		for _, rev := range []string{"rev1", "rev2", "rev1", "rev3"} {
+			dir, release, err := c.Acquire(ctx, "ns/test", src, rev)
+			testhelper.ExitOnErr(t, err)
+			release()
+			dirs[rev] = dir
+		}
+		assert.Equal(t, 2, c.Len())
+		assert.Equal(t, int32(3), src.fetched)
+		assert.DirExists(t, dirs["rev1"])
+		assert.NoDirExists(t, dirs["rev2"])
+		assert.DirExists(t, dirs["rev3"])
+	})
+
+	t.Run("keep evicted artifact until released", func(t *testing.T) {
+		c, err := artifact.NewCache(t.TempDir(), 1)
+		testhelper.ExitOnErr(t, err)
+		src := &fakeSource{}
+
+		dir1, release1, err := c.Acquire(ctx, "ns/test", src, "rev1")
+		testhelper.ExitOnErr(t, err)
+		_, release2, err := c.Acquire(ctx, "ns/test", src, "rev2")
+		testhelper.ExitOnErr(t, err)
+		defer release2()
+
+		assert.DirExists(t, dir1)
+		assert.Equal(t, "rev1", readRevision(t, dir1))
+		release1()
+		assert.NoDirExists(t, dir1)
+	})
+
+	t.Run("de-duplicate concurrent fetches", func(t *testing.T) {
+		c, err := artifact.NewCache(t.TempDir(), 2)
+		testhelper.ExitOnErr(t, err)
+		src := &fakeSource{block: make(chan struct{})}
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 10)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				dir, release, err := c.Acquire(ctx, "ns/test", src, "rev1")
+				if err != nil {
+					errs <- err
+					return
+				}
+				defer release()
+				if got := readRevision(t, dir); got != "rev1" {
+					errs <- fmt.Errorf("unexpected revision: %s", got)
+				}
+			}()
+		}
+		close(src.block)
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, int32(1), src.fetched)
+	})
+
+	t.Run("err: context canceled while waiting for fetch", func(t *testing.T) {
+		c, err := artifact.NewCache(t.TempDir(), 2)
+		testhelper.ExitOnErr(t, err)
+		src := &fakeSource{block: make(chan struct{})}
+
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, _, err = c.Acquire(cctx, "ns/test", src, "rev1")
+		assert.ErrorIs(t, err, context.Canceled)
+
+		// the shared fetch is not canceled by the caller
+		close(src.block)
+		dir, release, err := c.Acquire(ctx, "ns/test", src, "rev1")
+		assert.Nil(t, err)
+		defer release()
+		assert.Equal(t, "rev1", readRevision(t, dir))
+		assert.Equal(t, int32(1), src.fetched)
+	})
+
+	t.Run("err: fetch failed", func(t *testing.T) {
+		c, err := artifact.NewCache(t.TempDir(), 2)
+		testhelper.ExitOnErr(t, err)
+		src := &fakeSource{err: fmt.Errorf("failed")}
+
+		_, _, err = c.Acquire(ctx, "ns/test", src, "rev1")
+		assert.Error(t, err)
+		assert.Equal(t, 0, c.Len())
+
+		src.err = nil
+		_, release, err := c.Acquire(ctx, "ns/test", src, "rev1")
+		assert.Nil(t, err)
+		release()
+		assert.Equal(t, int32(2), src.fetched)
+	})
+}
+
+type fakeChecksumSource struct {
+	fakeSource
+	checksum string
+}
+
+func (s *fakeChecksumSource) Checksum(revision string) string {
+	return s.checksum
+}
+
+func TestCache_Acquire_Checksum(t *testing.T) {
+	ctx := context.Background()
+	c, err := artifact.NewCache(t.TempDir(), 2)
+	testhelper.ExitOnErr(t, err)
+	src := &fakeChecksumSource{checksum: "checksum1"}
+
+	dir1, release1, err := c.Acquire(ctx, "ns/test", src, "rev1")
+	testhelper.ExitOnErr(t, err)
+	defer release1()
+
+	// NOTE the artifact rebuilt with the same revision is fetched again
+	src.checksum = "checksum2"
+	dir2, release2, err := c.Acquire(ctx, "ns/test", src, "rev1")
+	testhelper.ExitOnErr(t, err)
+	defer release2()
+
+	assert.NotEqual(t, dir1, dir2)
+	assert.Equal(t, int32(2), src.fetched)
+}
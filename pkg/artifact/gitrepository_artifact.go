@@ -29,7 +29,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	neturl "net/url"
 	"os"
+	"path"
 	"regexp"
 	"strings"
 
@@ -42,91 +44,119 @@ const (
 	EnvSourceHost = "SOURCE_HOST"
 )
 
-func FetchArtifact(ctx context.Context, repository sourcev1.GitRepository, dir string) (string, error) {
-	if repository.Status.Artifact == nil {
-		return "", fmt.Errorf("repository %s does not contain an artifact", repository.Name)
-	}
+// ErrArtifactNotFound is returned when the artifact is not found in the storage of source-controller.
+var ErrArtifactNotFound = errors.New("artifact not found")
 
-	url := repository.Status.Artifact.URL
+// UnknownRevisionError is returned when the artifact of the revision is not available from the source, or the
+// checksum to verify it is not known.
+type UnknownRevisionError struct {
+	Source   string
+	Revision string
+	Reason   string
+}
 
-	// for local run:
-	// kubectl -n flux-system port-forward svc/source-controller 8080:80
-	// export SOURCE_HOST=localhost:8080
-	if hostname := os.Getenv(EnvSourceHost); hostname != "" {
-		url = fmt.Sprintf("http://%s/gitrepository/%s/%s/latest.tar.gz", hostname, repository.Namespace, repository.Name)
-	}
+func (e *UnknownRevisionError) Error() string {
+	return fmt.Sprintf("unknown revision %s of %s: %s", e.Revision, e.Source, e.Reason)
+}
 
-	if url == "" {
-		return "", fmt.Errorf("no url given")
-	}
+// IsUnknownRevision returns true if the error is caused by UnknownRevisionError.
+func IsUnknownRevision(err error) bool {
+	var e *UnknownRevisionError
+	return errors.As(err, &e)
+}
 
-	// download the tarball
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return "", errors.WithStack(fmt.Errorf("create HTTP request: %w", err))
+func FetchArtifact(ctx context.Context, repository sourcev1.GitRepository, dir string) (string, error) {
+	if repository.Status.Artifact == nil {
+		return "", fmt.Errorf("repository %s does not contain an artifact", repository.Name)
 	}
+	url := artifactURL(sourcev1.GitRepositoryKind, repository.Namespace, repository.Name, repository.Status.Artifact)
+	return download(ctx, DefaultHTTPClient, url, dir, repository.GetArtifact())
+}
 
-	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+// FetchArtifactAt fetches the artifact of the given revision of GitRepository, and verifies it with the checksum
+// obtained for the revision. The checksum of the current artifact is used if the revision is the current one.
+func FetchArtifactAt(ctx context.Context, repository sourcev1.GitRepository, dir, revision, checksum string) error {
+	s, err := NewFluxSource(&repository)
 	if err != nil {
-		return "", errors.WithStack(fmt.Errorf("download artifact from %s: %w", url, err))
+		return err
 	}
-	defer resp.Body.Close()
+	s.Checksums = map[string]string{revision: checksum}
+	_, err = s.Fetch(ctx, dir, revision)
+	return err
+}
 
-	// check response
-	if resp.StatusCode != http.StatusOK {
-		return "", errors.WithStack(fmt.Errorf("download artifact, status: %s", resp.Status))
+// Deprecated: ReplaceRevision is not used to resolve the artifact url of the revision any more. Use FluxSource
+// instead.
+func ReplaceRevision(url, revision string) string {
+	re := regexp.MustCompile(`/(\w+).tar.gz$`)
+	exRev := re.FindStringSubmatch(url)
+	if exRev == nil {
+		return url
 	}
+	return strings.ReplaceAll(url, exRev[1], revision)
+}
 
-	var buf bytes.Buffer
-
-	// verify checksum matches origin
-	if err := verifyArtifact(repository.GetArtifact(), &buf, resp.Body); err != nil {
-		return "", err
+// artifactURL returns the URL to download the artifact of the Flux source object.
+func artifactURL(kind, namespace, name string, artifact *sourcev1.Artifact) string {
+	// for local run:
+	// kubectl -n flux-system port-forward svc/source-controller 8080:80
+	// export SOURCE_HOST=localhost:8080
+	if hostname := os.Getenv(EnvSourceHost); hostname != "" {
+		return fmt.Sprintf("http://%s/%s/%s/%s/latest.tar.gz", hostname, strings.ToLower(kind), namespace, name)
 	}
+	return artifact.URL
+}
 
-	// extract
-	summary, err := untar.Untar(&buf, dir)
+// revisionURL returns the URL of the artifact of the given commit, which is stored next to the current artifact as
+// `<commit sha>.tar.gz` by source-controller.
+func revisionURL(current, sha string) (string, error) {
+	u, err := neturl.Parse(current)
 	if err != nil {
-		return "", errors.WithStack(fmt.Errorf("untar artifact: %w", err))
+		return "", errors.WithStack(fmt.Errorf("parse artifact url: %w", err))
 	}
-
-	return summary, nil
+	u.Path = path.Join("/", path.Dir(u.Path), sha+".tar.gz")
+	return u.String(), nil
 }
 
-func FetchArtifactAt(ctx context.Context, repository sourcev1.GitRepository, dir, revision string) (string, error) {
-	if repository.Status.Artifact == nil {
-		return "", fmt.Errorf("repository %s does not contain an artifact", repository.Name)
-	}
-
-	url := repository.Status.Artifact.URL
-	if hostname := os.Getenv(EnvSourceHost); hostname != "" {
-		url = fmt.Sprintf("http://%s/gitrepository/%s/%s/latest.tar.gz", hostname, repository.Namespace, repository.Name)
-	}
-	url = ReplaceRevision(url, revision)
-
+// download downloads the tarball from the given url and extracts it to dir. The checksum of the tarball is verified
+// if the artifact is given.
+func download(ctx context.Context, client HTTPClient, url, dir string, artifact *sourcev1.Artifact) (string, error) {
 	if url == "" {
 		return "", fmt.Errorf("no url given")
 	}
 
 	// download the tarball
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return "", errors.WithStack(fmt.Errorf("create HTTP request: %w", err))
 	}
 
-	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	resp, err := client.Do(req)
 	if err != nil {
 		return "", errors.WithStack(fmt.Errorf("download artifact from %s: %w", url, err))
 	}
 	defer resp.Body.Close()
 
 	// check response
+	if resp.StatusCode == http.StatusNotFound {
+		return "", errors.WithStack(fmt.Errorf("download artifact from %s: %w", url, ErrArtifactNotFound))
+	}
 	if resp.StatusCode != http.StatusOK {
 		return "", errors.WithStack(fmt.Errorf("download artifact, status: %s", resp.Status))
 	}
 
+	var r io.Reader = resp.Body
+	if artifact != nil {
+		var buf bytes.Buffer
+		// verify checksum matches origin
+		if err := verifyArtifact(artifact, &buf, resp.Body); err != nil {
+			return "", err
+		}
+		r = &buf
+	}
+
 	// extract
-	summary, err := untar.Untar(resp.Body, dir)
+	summary, err := untar.Untar(r, dir)
 	if err != nil {
 		return "", errors.WithStack(fmt.Errorf("untar artifact: %w", err))
 	}
@@ -134,15 +164,6 @@ func FetchArtifactAt(ctx context.Context, repository sourcev1.GitRepository, dir
 	return summary, nil
 }
 
-func ReplaceRevision(url, revision string) string {
-	re := regexp.MustCompile(`/(\w+).tar.gz$`)
-	exRev := re.FindStringSubmatch(url)
-	if exRev == nil {
-		return url
-	}
-	return strings.ReplaceAll(url, exRev[1], revision)
-}
-
 func verifyArtifact(artifact *sourcev1.Artifact, buf *bytes.Buffer, reader io.Reader) error {
 	hasher := sha256.New()
 
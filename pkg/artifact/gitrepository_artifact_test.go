@@ -30,6 +30,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/nttcom/kuesta/pkg/artifact"
@@ -39,6 +40,9 @@ import (
 )
 
 func TestFetchArtifact(t *testing.T) {
+	defer func(c artifact.HTTPClient) { artifact.DefaultHTTPClient = c }(artifact.DefaultHTTPClient)
+	artifact.DefaultHTTPClient = &artifact.RetryClient{Retries: 1, Backoff: time.Millisecond}
+
 	dir := t.TempDir()
 	want := []byte("dummy")
 	checksum, buf := testhelper.MustGenTgzArchive("test.txt", string(want))
@@ -136,6 +140,54 @@ func TestFetchArtifact(t *testing.T) {
 	})
 }
 
+func TestFetchArtifactAt(t *testing.T) {
+	want := []byte("dummy")
+	checksum, buf := testhelper.MustGenTgzArchive("test.txt", string(want))
+	tgz, err := io.ReadAll(buf)
+	testhelper.ExitOnErr(t, err)
+
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write(tgz); err != nil {
+			panic(err)
+		}
+	}))
+	defer h.Close()
+
+	repo := sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test-ns",
+		},
+		Status: sourcev1.GitRepositoryStatus{
+			Artifact: &sourcev1.Artifact{
+				URL:      h.URL + "/latest.tar.gz",
+				Revision: "main/5394cb7f48332b2de7c17dd8b8384bbc84b7e738",
+				Checksum: "checksum of latest",
+			},
+		},
+	}
+	revision := "main/1111111111111111111111111111111111111111"
+
+	t.Run("ok", func(t *testing.T) {
+		dir := t.TempDir()
+		err := artifact.FetchArtifactAt(context.Background(), repo, dir, revision, checksum)
+		assert.Nil(t, err)
+		got, err := os.ReadFile(filepath.Join(dir, "test.txt"))
+		testhelper.ExitOnErr(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("err: wrong checksum", func(t *testing.T) {
+		err := artifact.FetchArtifactAt(context.Background(), repo, t.TempDir(), revision, "wrong checksum")
+		assert.Error(t, err)
+	})
+
+	t.Run("err: checksum not given", func(t *testing.T) {
+		err := artifact.FetchArtifactAt(context.Background(), repo, t.TempDir(), revision, "")
+		assert.True(t, artifact.IsUnknownRevision(err))
+	})
+}
+
 func TestReplaceRevision(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,174 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	extgogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// Keys of the GitRepository auth Secret data, which are the same as Flux source-controller.
+const (
+	SecretKeyUsername    = "username"
+	SecretKeyPassword    = "password"
+	SecretKeyBearerToken = "bearerToken"
+	SecretKeyIdentity    = "identity"
+	SecretKeyKnownHosts  = "known_hosts"
+)
+
+// ParseRevision splits the GitRepository artifact revision in the form of `<ref>/<commit sha>` into the reference
+// name and the commit hash. The reference name is empty if the revision only contains the commit sha.
+func ParseRevision(revision string) (string, plumbing.Hash, error) {
+	ref, sha := "", revision
+	if i := strings.LastIndex(revision, "/"); i >= 0 {
+		ref, sha = revision[:i], revision[i+1:]
+	}
+	if len(sha) != 40 || plumbing.NewHash(sha).IsZero() {
+		return "", plumbing.ZeroHash, errors.WithStack(fmt.Errorf("invalid revision: %s", revision))
+	}
+	return ref, plumbing.NewHash(sha), nil
+}
+
+// FetchCommit fetches the commit object of the artifact revision from the remote git repository of GitRepository.
+// Only the tip of the revision reference is fetched, and the history of the reference is fetched only if the
+// reference has already moved past the revision.
+func FetchCommit(ctx context.Context, repository sourcev1.GitRepository, auth transport.AuthMethod) (*object.Commit, error) {
+	if repository.Status.Artifact == nil {
+		return nil, fmt.Errorf("repository %s does not contain an artifact", repository.Name)
+	}
+	ref, hash, err := ParseRevision(repository.Status.Artifact.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &extgogit.CloneOptions{
+		URL:          repository.Spec.URL,
+		Auth:         auth,
+		NoCheckout:   true,
+		SingleBranch: true,
+		Tags:         extgogit.NoTags,
+		Depth:        1,
+	}
+	if r := repository.Spec.Reference; r != nil && r.Tag != "" {
+		opts.ReferenceName = plumbing.NewTagReferenceName(r.Tag)
+	} else if ref != "" {
+		opts.ReferenceName = plumbing.NewBranchReferenceName(ref)
+	}
+
+	c, err := cloneCommit(ctx, opts, hash)
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		opts.Depth = 0
+		c, err = cloneCommit(ctx, opts, hash)
+	}
+	return c, err
+}
+
+func cloneCommit(ctx context.Context, opts *extgogit.CloneOptions, hash plumbing.Hash) (*object.Commit, error) {
+	repo, err := extgogit.CloneContext(ctx, memory.NewStorage(), nil, opts)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("clone %s: %w", opts.URL, err))
+	}
+	c, err := repo.CommitObject(hash)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("get commit %s: %w", hash, err))
+	}
+	return c, nil
+}
+
+// GitAuthFromSecretData returns the git transport auth method from the data of GitRepository auth Secret.
+// It returns nil if no credential is provided.
+func GitAuthFromSecretData(url string, data map[string][]byte) (transport.AuthMethod, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("parse url: %w", err))
+	}
+
+	switch ep.Protocol {
+	case "ssh":
+		identity := data[SecretKeyIdentity]
+		if len(identity) == 0 {
+			return nil, nil
+		}
+		user := ep.User
+		if user == "" {
+			user = gitssh.DefaultUsername
+		}
+		auth, err := gitssh.NewPublicKeys(user, identity, string(data[SecretKeyPassword]))
+		if err != nil {
+			return nil, errors.WithStack(fmt.Errorf("read identity: %w", err))
+		}
+		if kh := data[SecretKeyKnownHosts]; len(kh) > 0 {
+			cb, err := knownHostsCallback(kh)
+			if err != nil {
+				return nil, err
+			}
+			auth.HostKeyCallback = cb
+		}
+		return auth, nil
+	default:
+		if token := data[SecretKeyBearerToken]; len(token) > 0 {
+			return &http.TokenAuth{Token: string(token)}, nil
+		}
+		if username := data[SecretKeyUsername]; len(username) > 0 {
+			return &http.BasicAuth{Username: string(username), Password: string(data[SecretKeyPassword])}, nil
+		}
+		return nil, nil
+	}
+}
+
+// knownHostsCallback returns the callback which accepts only the host keys listed in known_hosts for the host.
+func knownHostsCallback(knownHosts []byte) (ssh.HostKeyCallback, error) {
+	// NOTE knownhosts reads the known_hosts only from files, so it is written to the temp file
+	f, err := os.CreateTemp("", "known_hosts-*")
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("create known_hosts: %w", err))
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(knownHosts)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("write known_hosts: %w", err))
+	}
+
+	cb, err := knownhosts.New(f.Name())
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("parse known_hosts: %w", err))
+	}
+	return cb, nil
+}
@@ -0,0 +1,174 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package artifact_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+	"time"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/nttcom/kuesta/internal/testing/githelper"
+	"github.com/nttcom/kuesta/pkg/artifact"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestParseRevision(t *testing.T) {
+	sha := "5394cb7f48332b2de7c17dd8b8384bbc84b7e738"
+	tests := []struct {
+		given   string
+		wantRef string
+		wantErr bool
+	}{
+		{"main/" + sha, "main", false},
+		{"feature/foo/" + sha, "feature/foo", false},
+		{sha, "", false},
+		{"main/latest", "", true},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.given, func(t *testing.T) {
+			ref, h, err := artifact.ParseRevision(tt.given)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.wantRef, ref)
+				assert.Equal(t, plumbing.NewHash(sha), h)
+			}
+		})
+	}
+}
+
+func TestFetchCommit(t *testing.T) {
+	repo, _, url := githelper.InitRepoWithRemote(t, "main", "origin")
+	testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(repo, "devices/device1/config.cue", "{}"))
+	hOld, err := githelper.Commit(repo, time.Now())
+	testhelper.ExitOnErr(t, err)
+	testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(repo, "devices/device2/config.cue", "{}"))
+	h, err := githelper.Commit(repo, time.Now())
+	testhelper.ExitOnErr(t, err)
+	testhelper.ExitOnErr(t, githelper.Push(repo, "main", "origin"))
+
+	newRepository := func(revision string) sourcev1.GitRepository {
+		return sourcev1.GitRepository{
+			Spec:   sourcev1.GitRepositorySpec{URL: url},
+			Status: sourcev1.GitRepositoryStatus{Artifact: &sourcev1.Artifact{Revision: revision}},
+		}
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		c, err := artifact.FetchCommit(context.Background(), newRepository("main/"+h.String()), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, h, c.Hash)
+		_, err = c.Parent(0)
+		assert.Error(t, err, "only the tip should be fetched")
+	})
+
+	t.Run("ok: reference moved past the revision", func(t *testing.T) {
+		c, err := artifact.FetchCommit(context.Background(), newRepository("main/"+hOld.String()), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, hOld, c.Hash)
+	})
+
+	t.Run("err: commit not found", func(t *testing.T) {
+		_, err := artifact.FetchCommit(context.Background(), newRepository("main/5394cb7f48332b2de7c17dd8b8384bbc84b7e738"), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("err: no artifact", func(t *testing.T) {
+		_, err := artifact.FetchCommit(context.Background(), sourcev1.GitRepository{}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestGitAuthFromSecretData(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		auth, err := artifact.GitAuthFromSecretData("https://example.com/repo.git", map[string][]byte{
+			artifact.SecretKeyUsername: []byte("user"),
+			artifact.SecretKeyPassword: []byte("pass"),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &http.BasicAuth{Username: "user", Password: "pass"}, auth)
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		auth, err := artifact.GitAuthFromSecretData("https://example.com/repo.git", map[string][]byte{
+			artifact.SecretKeyBearerToken: []byte("token"),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &http.TokenAuth{Token: "token"}, auth)
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		auth, err := artifact.GitAuthFromSecretData("https://example.com/repo.git", nil)
+		assert.Nil(t, err)
+		assert.Nil(t, auth)
+	})
+
+	t.Run("ssh with known_hosts", func(t *testing.T) {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		testhelper.ExitOnErr(t, err)
+		identity := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+		hostKey := func() ssh.PublicKey {
+			pub, _, err := ed25519.GenerateKey(rand.Reader)
+			testhelper.ExitOnErr(t, err)
+			k, err := ssh.NewPublicKey(pub)
+			testhelper.ExitOnErr(t, err)
+			return k
+		}
+		keyA, keyB := hostKey(), hostKey()
+		knownHosts := knownhosts.Line([]string{"a.example.com"}, keyA) + "\n" + knownhosts.Line([]string{"b.example.com"}, keyB) + "\n"
+
+		auth, err := artifact.GitAuthFromSecretData("ssh://git@a.example.com/repo.git", map[string][]byte{
+			artifact.SecretKeyIdentity:   identity,
+			artifact.SecretKeyKnownHosts: []byte(knownHosts),
+		})
+		testhelper.ExitOnErr(t, err)
+		cb := auth.(*gitssh.PublicKeys).HostKeyCallback
+		addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 22}
+
+		assert.Nil(t, cb("a.example.com:22", addr, keyA))
+		assert.Error(t, cb("a.example.com:22", addr, keyB), "key of other host must be rejected")
+		assert.Error(t, cb("c.example.com:22", addr, keyA), "unknown host must be rejected")
+	})
+
+	t.Run("err: invalid identity", func(t *testing.T) {
+		_, err := artifact.GitAuthFromSecretData("ssh://git@example.com/repo.git", map[string][]byte{
+			artifact.SecretKeyIdentity: []byte("invalid"),
+		})
+		assert.Error(t, err)
+	})
+}
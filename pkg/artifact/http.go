@@ -0,0 +1,99 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package artifact
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultHTTPTimeout is the timeout of DefaultHTTPClient to download an artifact.
+	DefaultHTTPTimeout = time.Minute
+	// DefaultHTTPRetries is the max number of the retries of DefaultHTTPClient.
+	DefaultHTTPRetries = 3
+	// DefaultRetryBackoff is the backoff before the first retry of RetryClient, which is doubled on every retry.
+	DefaultRetryBackoff = time.Second
+)
+
+// HTTPClient sends the HTTP requests to download the artifacts. *http.Client satisfies it.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DefaultHTTPClient is the HTTPClient used to download the artifacts if not given.
+var DefaultHTTPClient HTTPClient = &RetryClient{
+	Client:  &http.Client{Timeout: DefaultHTTPTimeout},
+	Retries: DefaultHTTPRetries,
+}
+
+// RetryClient is the HTTPClient which retries the requests on the network errors, the server errors and the
+// throttling with the exponential backoff. It only supports the requests without body.
+type RetryClient struct {
+	// Client sends the requests. http.DefaultClient is used if nil.
+	Client HTTPClient
+
+	// Retries is the max number of the retries.
+	Retries int
+
+	// Backoff is the backoff before the first retry. DefaultRetryBackoff is used if not positive.
+	Backoff time.Duration
+}
+
+func (c *RetryClient) Do(req *http.Request) (*http.Response, error) {
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	backoff := c.Backoff
+	if backoff <= 0 {
+		backoff = DefaultRetryBackoff
+	}
+
+	for i := 0; ; i++ {
+		resp, err := client.Do(req)
+		if i >= c.Retries || !shouldRetry(resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, errors.WithStack(req.Context().Err())
+		case <-time.After(backoff << i):
+		}
+	}
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
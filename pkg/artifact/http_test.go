@@ -0,0 +1,86 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package artifact_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nttcom/kuesta/pkg/artifact"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryClient_Do(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		retries    int
+		wantStatus int
+		wantCalled int32
+	}{
+		{"ok", []int{200}, 3, 200, 1},
+		{"retry on server error", []int{500, 503, 200}, 3, 200, 3},
+		{"retry on throttling", []int{429, 200}, 3, 200, 2},
+		{"give up after retries", []int{500, 500, 500}, 2, 500, 3},
+		{"no retry on client error", []int{404, 200}, 3, 404, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called int32
+			h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := atomic.AddInt32(&called, 1) - 1
+				w.WriteHeader(tt.statuses[i])
+			}))
+			defer h.Close()
+
+			c := &artifact.RetryClient{Retries: tt.retries, Backoff: time.Millisecond}
+			req, err := http.NewRequest(http.MethodGet, h.URL, nil)
+			testhelper.ExitOnErr(t, err)
+			resp, err := c.Do(req)
+			assert.Nil(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantCalled, atomic.LoadInt32(&called))
+		})
+	}
+
+	t.Run("err: context canceled while waiting retry", func(t *testing.T) {
+		h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer h.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		c := &artifact.RetryClient{Retries: 3, Backoff: time.Hour}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.URL, nil)
+		testhelper.ExitOnErr(t, err)
+		_, err = c.Do(req)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,249 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package artifact
+
+import (
+	"context"
+	"fmt"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-git/go-billy/v5/osfs"
+	extgogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultGitBranch is the branch of the git repository used by GitSource if not given.
+const DefaultGitBranch = "main"
+
+// Source provides the contents of the config repository at a revision.
+type Source interface {
+	// Revision returns the latest revision of the source.
+	Revision(ctx context.Context) (string, error)
+
+	// Fetch extracts the contents of the given revision to dir, and returns the fetched revision. The latest
+	// revision is fetched if the revision is empty.
+	Fetch(ctx context.Context, dir, revision string) (string, error)
+}
+
+// ChecksumSource is the Source which advertises the checksum of the artifact of a revision before fetching it. The
+// checksum tells apart the artifacts of the same revision with different contents, such as the rebuilt ones.
+type ChecksumSource interface {
+	Source
+
+	// Checksum returns the checksum of the artifact of the given revision, or empty if unknown.
+	Checksum(revision string) string
+}
+
+var (
+	_ ChecksumSource = &FluxSource{}
+	_ Source         = &GitSource{}
+)
+
+// FluxSource is the Source backed by the artifact of the Flux source object, such as GitRepository, OCIRepository
+// and Bucket.
+type FluxSource struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Artifact  *sourcev1.Artifact
+
+	// Checksums is a map from the revisions other than the current one to the checksums of their artifacts, which
+	// are used to verify the revision-specific fetches.
+	Checksums map[string]string
+
+	// HTTPClient downloads the artifacts. DefaultHTTPClient is used if nil.
+	HTTPClient HTTPClient
+}
+
+// NewFluxSource returns the FluxSource of the given Flux source object.
+func NewFluxSource(obj sourcev1.Source) (*FluxSource, error) {
+	var kind string
+	switch obj.(type) {
+	case *sourcev1.GitRepository:
+		kind = sourcev1.GitRepositoryKind
+	case *sourcev1.OCIRepository:
+		kind = sourcev1.OCIRepositoryKind
+	case *sourcev1.Bucket:
+		kind = sourcev1.BucketKind
+	default:
+		return nil, errors.WithStack(fmt.Errorf("unsupported source type: %T", obj))
+	}
+	m, ok := obj.(metav1.Object)
+	if !ok {
+		return nil, errors.WithStack(fmt.Errorf("source %T does not have object meta", obj))
+	}
+	return &FluxSource{Kind: kind, Namespace: m.GetNamespace(), Name: m.GetName(), Artifact: obj.GetArtifact()}, nil
+}
+
+// Revision returns the revision of the current artifact.
+func (s *FluxSource) Revision(ctx context.Context) (string, error) {
+	if s.Artifact == nil {
+		return "", fmt.Errorf("%s %s does not contain an artifact", s.Kind, s.Name)
+	}
+	return s.Artifact.Revision, nil
+}
+
+// Checksum returns the checksum of the artifact of the revision, which is either the current one or given by
+// Checksums.
+func (s *FluxSource) Checksum(revision string) string {
+	if s.Artifact != nil && revision == s.Artifact.Revision {
+		return s.Artifact.Checksum
+	}
+	return s.Checksums[revision]
+}
+
+// Fetch downloads the artifact and extracts it to dir after verifying its checksum. Only GitRepository can fetch
+// the revisions other than the current one, which are verified with Checksums. UnknownRevisionError is returned if
+// the artifact or the checksum of the revision is not available.
+func (s *FluxSource) Fetch(ctx context.Context, dir, revision string) (string, error) {
+	if s.Artifact == nil {
+		return "", fmt.Errorf("%s %s does not contain an artifact", s.Kind, s.Name)
+	}
+	client := s.HTTPClient
+	if client == nil {
+		client = DefaultHTTPClient
+	}
+	url := artifactURL(s.Kind, s.Namespace, s.Name, s.Artifact)
+	if revision == "" || revision == s.Artifact.Revision {
+		if _, err := download(ctx, client, url, dir, s.Artifact); err != nil {
+			return "", err
+		}
+		return s.Artifact.Revision, nil
+	}
+
+	if s.Kind != sourcev1.GitRepositoryKind {
+		return "", s.unknownRevision(revision, "only the latest artifact is available")
+	}
+	checksum := s.Checksums[revision]
+	if checksum == "" {
+		return "", s.unknownRevision(revision, "checksum of the artifact is not known")
+	}
+	at := revision
+	if _, hash, err := ParseRevision(revision); err == nil {
+		at = hash.String()
+	}
+	url, err := revisionURL(url, at)
+	if err != nil {
+		return "", err
+	}
+	if _, err := download(ctx, client, url, dir, &sourcev1.Artifact{Revision: revision, Checksum: checksum}); err != nil {
+		if errors.Is(err, ErrArtifactNotFound) {
+			return "", s.unknownRevision(revision, "artifact not found")
+		}
+		return "", err
+	}
+	return revision, nil
+}
+
+func (s *FluxSource) unknownRevision(revision, reason string) error {
+	return errors.WithStack(&UnknownRevisionError{
+		Source:   fmt.Sprintf("%s %s/%s", s.Kind, s.Namespace, s.Name),
+		Revision: revision,
+		Reason:   reason,
+	})
+}
+
+// GitSource is the Source which clones the git repository directly without Flux. The revision is given in the form
+// of `<branch>/<commit sha>` as the same as GitRepository.
+type GitSource struct {
+	// URL is the url of the git repository.
+	URL string
+
+	// Branch is the branch to follow. DefaultGitBranch is used if empty.
+	Branch string
+
+	// Auth is the auth method to access the git repository. The repository is accessed anonymously if nil.
+	Auth transport.AuthMethod
+}
+
+func (s *GitSource) branch() string {
+	if s.Branch == "" {
+		return DefaultGitBranch
+	}
+	return s.Branch
+}
+
+// Revision returns the revision of the head of the branch in the remote repository.
+func (s *GitSource) Revision(ctx context.Context) (string, error) {
+	remote := extgogit.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: extgogit.DefaultRemoteName,
+		URLs: []string{s.URL},
+	})
+	refs, err := remote.ListContext(ctx, &extgogit.ListOptions{Auth: s.Auth})
+	if err != nil {
+		return "", errors.WithStack(fmt.Errorf("list remote refs of %s: %w", s.URL, err))
+	}
+	name := plumbing.NewBranchReferenceName(s.branch())
+	for _, ref := range refs {
+		if ref.Name() == name {
+			return fmt.Sprintf("%s/%s", s.branch(), ref.Hash()), nil
+		}
+	}
+	return "", errors.WithStack(fmt.Errorf("branch %s not found in %s", s.branch(), s.URL))
+}
+
+// Fetch clones the branch and checks out the given revision to dir. The git metadata is not written to dir.
+func (s *GitSource) Fetch(ctx context.Context, dir, revision string) (string, error) {
+	if revision == "" {
+		rev, err := s.Revision(ctx)
+		if err != nil {
+			return "", err
+		}
+		revision = rev
+	}
+	_, hash, err := ParseRevision(revision)
+	if err != nil {
+		return "", err
+	}
+
+	repo, err := extgogit.CloneContext(ctx, memory.NewStorage(), osfs.New(dir), &extgogit.CloneOptions{
+		URL:           s.URL,
+		Auth:          s.Auth,
+		ReferenceName: plumbing.NewBranchReferenceName(s.branch()),
+		SingleBranch:  true,
+		NoCheckout:    true,
+		Tags:          extgogit.NoTags,
+	})
+	if err != nil {
+		return "", errors.WithStack(fmt.Errorf("clone %s: %w", s.URL, err))
+	}
+	if _, err := repo.CommitObject(hash); err != nil {
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			return "", errors.WithStack(&UnknownRevisionError{Source: s.URL, Revision: revision, Reason: "commit not found"})
+		}
+		return "", errors.WithStack(fmt.Errorf("get commit %s: %w", hash, err))
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		return "", errors.WithStack(fmt.Errorf("get worktree: %w", err))
+	}
+	if err := w.Checkout(&extgogit.CheckoutOptions{Hash: hash, Force: true}); err != nil {
+		return "", errors.WithStack(fmt.Errorf("checkout %s: %w", hash, err))
+	}
+	return fmt.Sprintf("%s/%s", s.branch(), hash), nil
+}
@@ -0,0 +1,220 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package artifact_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/nttcom/kuesta/internal/testing/githelper"
+	"github.com/nttcom/kuesta/pkg/artifact"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewFluxSource(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: "test", Namespace: "test-ns"}
+	a := &sourcev1.Artifact{Revision: "main/rev"}
+	tests := []struct {
+		name     string
+		given    sourcev1.Source
+		wantKind string
+		wantErr  bool
+	}{
+		{"git repository", &sourcev1.GitRepository{ObjectMeta: meta, Status: sourcev1.GitRepositoryStatus{Artifact: a}}, sourcev1.GitRepositoryKind, false},
+		{"oci repository", &sourcev1.OCIRepository{ObjectMeta: meta, Status: sourcev1.OCIRepositoryStatus{Artifact: a}}, sourcev1.OCIRepositoryKind, false},
+		{"bucket", &sourcev1.Bucket{ObjectMeta: meta, Status: sourcev1.BucketStatus{Artifact: a}}, sourcev1.BucketKind, false},
+		{"err: unsupported", &sourcev1.HelmChart{ObjectMeta: meta}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := artifact.NewFluxSource(tt.given)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, &artifact.FluxSource{Kind: tt.wantKind, Namespace: "test-ns", Name: "test", Artifact: a}, s)
+		})
+	}
+}
+
+func TestFluxSource_Fetch(t *testing.T) {
+	want := []byte("dummy")
+	checksum, buf := testhelper.MustGenTgzArchive("test.txt", string(want))
+	tgz, err := io.ReadAll(buf)
+	testhelper.ExitOnErr(t, err)
+
+	notFoundSha := "2222222222222222222222222222222222222222"
+	var requested string
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		if r.URL.Path == fmt.Sprintf("/gitrepository/test-ns/test/%s.tar.gz", notFoundSha) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if _, err := w.Write(tgz); err != nil {
+			panic(err)
+		}
+	}))
+	defer h.Close()
+
+	sha := "5394cb7f48332b2de7c17dd8b8384bbc84b7e738"
+	otherRev := "main/1111111111111111111111111111111111111111"
+	newSource := func(kind string, checksums map[string]string) *artifact.FluxSource {
+		return &artifact.FluxSource{
+			Kind:      kind,
+			Namespace: "test-ns",
+			Name:      "test",
+			Artifact: &sourcev1.Artifact{
+				URL:      h.URL + "/gitrepository/test-ns/test/latest.tar.gz",
+				Revision: "main/" + sha,
+				Checksum: checksum,
+			},
+			Checksums: checksums,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		source      *artifact.FluxSource
+		revision    string
+		wantRev     string
+		wantPath    string
+		wantErr     bool
+		wantUnknown bool
+	}{
+		{"ok: latest", newSource(sourcev1.GitRepositoryKind, nil), "", "main/" + sha, "/gitrepository/test-ns/test/latest.tar.gz", false, false},
+		{"ok: current revision", newSource(sourcev1.OCIRepositoryKind, nil), "main/" + sha, "main/" + sha, "/gitrepository/test-ns/test/latest.tar.gz", false, false},
+		{
+			"ok: other revision",
+			newSource(sourcev1.GitRepositoryKind, map[string]string{otherRev: checksum}),
+			otherRev,
+			otherRev,
+			"/gitrepository/test-ns/test/1111111111111111111111111111111111111111.tar.gz",
+			false,
+			false,
+		},
+		{"err: other revision with wrong checksum", newSource(sourcev1.GitRepositoryKind, map[string]string{otherRev: "wrong"}), otherRev, "", "", true, false},
+		{"err: checksum of other revision unknown", newSource(sourcev1.GitRepositoryKind, nil), otherRev, "", "", true, true},
+		{"err: other revision not found", newSource(sourcev1.GitRepositoryKind, map[string]string{"main/" + notFoundSha: checksum}), "main/" + notFoundSha, "", "", true, true},
+		{"err: other revision of bucket", newSource(sourcev1.BucketKind, nil), "rev", "", "", true, true},
+		{"err: no artifact", &artifact.FluxSource{Kind: sourcev1.GitRepositoryKind, Name: "test"}, "", "", "", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested = ""
+			dir := t.TempDir()
+			rev, err := tt.source.Fetch(context.Background(), dir, tt.revision)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantUnknown, artifact.IsUnknownRevision(err))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantRev, rev)
+			assert.Equal(t, tt.wantPath, requested)
+			got, err := os.ReadFile(filepath.Join(dir, "test.txt"))
+			testhelper.ExitOnErr(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestGitSource(t *testing.T) {
+	repo, _, url := githelper.InitRepoWithRemote(t, "main", "origin")
+	testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(repo, "devices/device1/config.cue", "{v: 1}"))
+	h1, err := githelper.Commit(repo, time.Now())
+	testhelper.ExitOnErr(t, err)
+	testhelper.ExitOnErr(t, githelper.CreateFileWithAdding(repo, "devices/device1/config.cue", "{v: 2}"))
+	h2, err := githelper.Commit(repo, time.Now())
+	testhelper.ExitOnErr(t, err)
+	testhelper.ExitOnErr(t, githelper.Push(repo, "main", "origin"))
+
+	s := &artifact.GitSource{URL: url}
+
+	t.Run("revision", func(t *testing.T) {
+		rev, err := s.Revision(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "main/"+h2.String(), rev)
+	})
+
+	tests := []struct {
+		name     string
+		revision string
+		wantRev  string
+		want     string
+		wantErr  bool
+	}{
+		{"ok: latest", "", "main/" + h2.String(), "{v: 2}", false},
+		{"ok: revision", "main/" + h1.String(), "main/" + h1.String(), "{v: 1}", false},
+		{"ok: commit sha", h1.String(), "main/" + h1.String(), "{v: 1}", false},
+		{"err: invalid revision", "main/latest", "", "", true},
+		{"err: commit not found", "main/5394cb7f48332b2de7c17dd8b8384bbc84b7e738", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			rev, err := s.Fetch(context.Background(), dir, tt.revision)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantRev, rev)
+			got, err := os.ReadFile(filepath.Join(dir, "devices/device1/config.cue"))
+			testhelper.ExitOnErr(t, err)
+			assert.Equal(t, tt.want, string(got))
+			assert.NoDirExists(t, filepath.Join(dir, ".git"))
+		})
+	}
+
+	t.Run("err: commit not found is unknown revision", func(t *testing.T) {
+		_, err := s.Fetch(context.Background(), t.TempDir(), "main/5394cb7f48332b2de7c17dd8b8384bbc84b7e738")
+		assert.True(t, artifact.IsUnknownRevision(err))
+	})
+
+	t.Run("err: branch not found", func(t *testing.T) {
+		_, err := (&artifact.GitSource{URL: url, Branch: "notfound"}).Revision(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestFluxSource_Checksum(t *testing.T) {
+	s := &artifact.FluxSource{Artifact: &sourcev1.Artifact{Revision: "main/rev", Checksum: "checksum"}}
+	assert.Equal(t, "checksum", s.Checksum("main/rev"))
+	assert.Equal(t, "", s.Checksum("main/other"))
+
+	s.Checksums = map[string]string{"main/other": "other"}
+	assert.Equal(t, "other", s.Checksum("main/other"))
+	assert.Equal(t, "", (&artifact.FluxSource{}).Checksum("main/rev"))
+}
@@ -22,6 +22,10 @@
 
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionInfo) DeepCopyInto(out *ConnectionInfo) {
 	*out = *in
@@ -40,7 +44,7 @@ func (in *ConnectionInfo) DeepCopy() *ConnectionInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Device) DeepCopyInto(out *Device) {
 	*out = *in
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -59,6 +63,7 @@ func (in *DeviceSpec) DeepCopyInto(out *DeviceSpec) {
 	*out = *in
 	out.ConnectionInfo = in.ConnectionInfo
 	out.TLS = in.TLS
+	in.Subscription.DeepCopyInto(&out.Subscription)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceSpec.
@@ -79,6 +84,14 @@ func (in *DeviceStatus) DeepCopyInto(out *DeviceStatus) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	out.Subscriber = in.Subscriber
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceStatus.
@@ -91,6 +104,47 @@ func (in *DeviceStatus) DeepCopy() *DeviceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionSpec) DeepCopyInto(out *SubscriptionSpec) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GetPaths != nil {
+		in, out := &in.GetPaths, &out.GetPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.SampleInterval = in.SampleInterval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionSpec.
+func (in *SubscriptionSpec) DeepCopy() *SubscriptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriberStatus) DeepCopyInto(out *SubscriberStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriberStatus.
+func (in *SubscriberStatus) DeepCopy() *SubscriberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 	*out = *in
@@ -29,10 +29,15 @@ import (
 	"github.com/nttcom/kuesta/pkg/credentials"
 	gnmiclient "github.com/openconfig/gnmi/client"
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 const (
 	RefField = ".spec.rolloutRef"
+	// SecretRefField is the index of the devices by the names of the Secrets which they refer to.
+	SecretRefField = ".spec.secretRefs"
 
 	KeyUsername = "username"
 	KeyPassword = "password"
@@ -41,6 +46,8 @@ const (
 type DeviceResource interface {
 	kuestaDevice()
 
+	// GetDevice returns the embedded Device to read and update its spec and status in place.
+	GetDevice() *Device
 	SpecCopy() *DeviceSpec
 	UpdateSpec(func(*DeviceSpec) error) error
 	StatusCopy() *DeviceStatus
@@ -49,6 +56,13 @@ type DeviceResource interface {
 
 var _ DeviceResource = &Device{}
 
+// Object is the Kubernetes object of a device kind, which embeds Device inline.
+type Object interface {
+	metav1.Object
+	runtime.Object
+	DeviceResource
+}
+
 type Device struct {
 	Spec   DeviceSpec   `json:"spec,omitempty"`
 	Status DeviceStatus `json:"status,omitempty"`
@@ -56,6 +70,10 @@ type Device struct {
 
 func (Device) kuestaDevice() {}
 
+func (d *Device) GetDevice() *Device {
+	return d
+}
+
 func (d *Device) SpecCopy() *DeviceSpec {
 	return d.Spec.DeepCopy()
 }
@@ -87,6 +105,9 @@ type DeviceSpec struct {
 	ConnectionInfo `json:",inline"`
 
 	TLS TLSSpec `json:"tls,omitempty"`
+
+	// Subscription is the gNMI subscription with which the subscriber watches the device config.
+	Subscription SubscriptionSpec `json:"subscription,omitempty"`
 }
 
 func (s *DeviceSpec) GnmiDestination(tlsData, credData map[string][]byte) (gnmiclient.Destination, error) {
@@ -119,6 +140,107 @@ type DeviceStatus struct {
 
 	// BaseRevision is the git revision to assume that the device config of the specified version has been already provisioned.
 	BaseRevision string `json:"baseRevision,omitempty"`
+
+	// Subscriber is the observed state of the subscriber of the device.
+	Subscriber SubscriberStatus `json:"subscriber,omitempty"`
+
+	// Conditions represent the latest available observations of the provisioning of the device.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// ObservedGeneration is the generation of the device observed when the conditions are updated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+const (
+	// ConditionTypeReady indicates whether the device is provisioned with the device config of the DeviceRollout.
+	ConditionTypeReady = "Ready"
+	// ConditionTypeProgressing indicates whether the device is being provisioned.
+	ConditionTypeProgressing = "Progressing"
+	// ConditionTypeDegraded indicates whether the last provisioning of the device is failed.
+	ConditionTypeDegraded = "Degraded"
+	// ConditionTypeRolledBack indicates whether the device is rolled back to the previous device config.
+	ConditionTypeRolledBack = "RolledBack"
+
+	// ReasonProvisioning indicates the device config is being provisioned.
+	ReasonProvisioning = "Provisioning"
+	// ReasonProvisioned indicates the device config is provisioned.
+	ReasonProvisioned = "Provisioned"
+	// ReasonRolledBack indicates the previous device config is provisioned on rollback.
+	ReasonRolledBack = "RolledBack"
+	// ReasonProvisionFailed indicates the device rejected the device config.
+	ReasonProvisionFailed = "ProvisionFailed"
+	// ReasonConnectionError indicates the device config is not provisioned since the device is unreachable.
+	ReasonConnectionError = "ConnectionError"
+	// ReasonChecksumMismatch indicates the fetched device config is different from the one of the DeviceRollout.
+	ReasonChecksumMismatch = "ChecksumMismatch"
+)
+
+// MarkProvisioning records that the device config is being provisioned on the status conditions.
+func (s *DeviceStatus) MarkProvisioning(generation int64, msg string) {
+	s.setCondition(generation, ConditionTypeReady, false, ReasonProvisioning, msg)
+	s.setCondition(generation, ConditionTypeProgressing, true, ReasonProvisioning, msg)
+}
+
+// MarkProvisioned records that the device config is provisioned on the status conditions. rolledBack must be true
+// if the device config provisioned is the previous one on rollback.
+func (s *DeviceStatus) MarkProvisioned(generation int64, rolledBack bool, msg string) {
+	reason := ReasonProvisioned
+	if rolledBack {
+		reason = ReasonRolledBack
+	}
+	s.setCondition(generation, ConditionTypeReady, true, reason, msg)
+	s.setCondition(generation, ConditionTypeProgressing, false, reason, msg)
+	s.setCondition(generation, ConditionTypeDegraded, false, reason, msg)
+	s.setCondition(generation, ConditionTypeRolledBack, rolledBack, reason, msg)
+}
+
+// MarkFailed records that the device config is failed to be provisioned on the status conditions.
+func (s *DeviceStatus) MarkFailed(generation int64, reason, msg string) {
+	s.setCondition(generation, ConditionTypeReady, false, reason, msg)
+	s.setCondition(generation, ConditionTypeProgressing, false, reason, msg)
+	s.setCondition(generation, ConditionTypeDegraded, true, reason, msg)
+}
+
+func (s *DeviceStatus) setCondition(generation int64, typ string, status bool, reason, msg string) {
+	cs := metav1.ConditionFalse
+	if status {
+		cs = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+		Type:               typ,
+		Status:             cs,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            msg,
+	})
+	s.ObservedGeneration = generation
+}
+
+// SubscriberStatus defines the observed state of the subscriber Pod or Deployment.
+type SubscriberStatus struct {
+	// Name is the name of the subscriber Pod, or the subscriber Deployment if the devices are sharded.
+	Name string `json:"name,omitempty"`
+
+	// Phase is the phase of the subscriber Pod.
+	Phase core.PodPhase `json:"phase,omitempty"`
+
+	// Ready is true if the subscriber is connected to the device.
+	Ready bool `json:"ready"`
+
+	// Restarts is the number of the restarts of the subscriber container.
+	Restarts int32 `json:"restarts,omitempty"`
+
+	// SpecHash is the hash of the spec with which the subscriber is running.
+	SpecHash string `json:"specHash,omitempty"`
+
+	// Message is the human-readable reason why the subscriber is not ready.
+	Message string `json:"message,omitempty"`
 }
 
 // ConnectionInfo defines the parameters to connect target device.
@@ -152,6 +274,34 @@ func (d *ConnectionInfo) GnmiCredentials(sData map[string][]byte) *gnmiclient.Cr
 	}
 }
 
+// SubscriptionSpec defines the gNMI paths, modes and encoding with which the subscriber gets and subscribes
+// the device config.
+type SubscriptionSpec struct {
+	// Paths are the gNMI paths to subscribe, such as /interfaces/interface[name=Ethernet1].
+	// The root path is subscribed if empty.
+	Paths []string `json:"paths,omitempty"`
+
+	// GetPaths are the gNMI paths to get the entire device config on resync. The root path is used if empty.
+	GetPaths []string `json:"getPaths,omitempty"`
+
+	// Origin is the origin of the gNMI paths.
+	Origin string `json:"origin,omitempty"`
+
+	// Target is the target of the gNMI paths.
+	Target string `json:"target,omitempty"`
+
+	// Mode is the subscription mode.
+	// +kubebuilder:validation:Enum=TARGET_DEFINED;ON_CHANGE;SAMPLE
+	Mode string `json:"mode,omitempty"`
+
+	// SampleInterval is the interval of SAMPLE subscription mode. The device decides the interval if not set.
+	SampleInterval metav1.Duration `json:"sampleInterval,omitempty"`
+
+	// Encoding is the encoding of the gNMI values.
+	// +kubebuilder:validation:Enum=JSON_IETF;JSON;PROTO
+	Encoding string `json:"encoding,omitempty"`
+}
+
 // TLSSpec defines TLS parameters to access the associated network device.
 type TLSSpec struct {
 	NoTLS bool `json:"notls,omitempty"`
@@ -0,0 +1,282 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+// Package diff compares two device config trees decoded from CUE or JSON without the generated Go bindings, and
+// returns the minimal gNMI SetRequest which turns one into the other.
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+)
+
+// Differ compares the device config trees. The elements of the keyed lists are matched by their keys, and the other
+// lists are replaced as a whole when changed.
+type Differ struct {
+	// Keys are the key names of the keyed lists.
+	Keys Keys
+}
+
+// SetRequest returns the gNMI SetRequest which turns the device config tree cur into next. The paths address the
+// labels of the trees, and the values are encoded in JSON IETF. Nothing is deleted if cur is nil.
+func (d *Differ) SetRequest(cur, next map[string]any) (*gnmi.SetRequest, error) {
+	sr := &gnmi.SetRequest{}
+	if err := d.diffMap(sr, nil, "", cur, next); err != nil {
+		return nil, err
+	}
+	return sr, nil
+}
+
+func (d *Differ) diffValue(sr *gnmi.SetRequest, path []*gnmi.PathElem, schemaPath string, cur, next any) error {
+	switch n := next.(type) {
+	case map[string]any:
+		if c, ok := cur.(map[string]any); ok {
+			return d.diffMap(sr, path, schemaPath, c, n)
+		}
+	case []any:
+		if keys := d.Keys.Of(schemaPath); keys != nil {
+			if c, ok := cur.([]any); ok {
+				return d.diffList(sr, path, schemaPath, keys, c, n)
+			}
+		}
+	}
+	if reflect.DeepEqual(cur, next) {
+		return nil
+	}
+
+	// NOTE the lists without keys and the nodes whose type is changed cannot be merged
+	_, curIsLeaf := leafOf(cur)
+	_, nextIsLeaf := leafOf(next)
+	if curIsLeaf && nextIsLeaf {
+		return appendUpdate(&sr.Update, path, next)
+	}
+	return appendUpdate(&sr.Replace, path, next)
+}
+
+func (d *Differ) diffMap(sr *gnmi.SetRequest, path []*gnmi.PathElem, schemaPath string, cur, next map[string]any) error {
+	for _, k := range sortedKeys(cur) {
+		if _, ok := next[k]; !ok {
+			sr.Delete = append(sr.Delete, &gnmi.Path{Elem: appendElem(path, k)})
+		}
+	}
+	for _, k := range sortedKeys(next) {
+		p := appendElem(path, k)
+		sp := schemaPath + "/" + trimModule(k)
+		c, ok := cur[k]
+		if !ok {
+			// NOTE the elements of the new keyed list are updated one by one to be addressed by the keys
+			if l, isList := next[k].([]any); isList && d.Keys.Of(sp) != nil {
+				if err := d.diffList(sr, p, sp, d.Keys.Of(sp), nil, l); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := appendUpdate(&sr.Update, p, next[k]); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := d.diffValue(sr, p, sp, c, next[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Differ) diffList(sr *gnmi.SetRequest, path []*gnmi.PathElem, schemaPath string, keys []string, cur, next []any) error {
+	curElems, curOrder, err := indexList(path, keys, cur)
+	if err != nil {
+		return err
+	}
+	nextElems, nextOrder, err := indexList(path, keys, next)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range curOrder {
+		if _, ok := nextElems[id]; !ok {
+			sr.Delete = append(sr.Delete, &gnmi.Path{Elem: curElems[id].path})
+		}
+	}
+	for _, id := range nextOrder {
+		n := nextElems[id]
+		c, ok := curElems[id]
+		if !ok {
+			if err := appendUpdate(&sr.Update, n.path, n.value); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := d.diffMap(sr, n.path, schemaPath, c.value, n.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type listElem struct {
+	path  []*gnmi.PathElem
+	value map[string]any
+}
+
+// indexList returns the elements of the keyed list indexed by their keys, and the order of the keys.
+func indexList(path []*gnmi.PathElem, keys []string, l []any) (map[string]listElem, []string, error) {
+	elems := make(map[string]listElem, len(l))
+	order := make([]string, 0, len(l))
+	for _, v := range l {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, nil, errors.WithStack(fmt.Errorf("element of keyed list %s is not an object: %v", pathString(path), v))
+		}
+		keyVals := make(map[string]string, len(keys))
+		var id strings.Builder
+		for _, k := range keys {
+			kv, ok := lookupKey(m, k)
+			if !ok {
+				return nil, nil, errors.WithStack(fmt.Errorf("element of keyed list %s has no key %s", pathString(path), k))
+			}
+			keyVals[k] = kv
+			id.WriteString(strconv.Quote(kv))
+		}
+		if _, ok := elems[id.String()]; ok {
+			return nil, nil, errors.WithStack(fmt.Errorf("keyed list %s has duplicated keys %v", pathString(path), keyVals))
+		}
+
+		p := make([]*gnmi.PathElem, len(path))
+		copy(p, path)
+		p[len(p)-1] = &gnmi.PathElem{Name: path[len(path)-1].Name, Key: keyVals}
+		elems[id.String()] = listElem{path: p, value: m}
+		order = append(order, id.String())
+	}
+	return elems, order, nil
+}
+
+// lookupKey returns the string of the key leaf of the list element, whose label may be qualified by the module name.
+func lookupKey(m map[string]any, key string) (string, bool) {
+	v, ok := m[key]
+	if !ok {
+		for k, vv := range m {
+			if trimModule(k) == key {
+				v, ok = vv, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return "", false
+	}
+	return leafOf(v)
+}
+
+// leafOf returns the string of the leaf value, or false if the value is not a leaf.
+func leafOf(v any) (string, bool) {
+	switch vv := v.(type) {
+	case string:
+		return vv, true
+	case json.Number:
+		return vv.String(), true
+	case bool:
+		return strconv.FormatBool(vv), true
+	case nil:
+		return "", true
+	case map[string]any, []any:
+		return "", false
+	default:
+		return fmt.Sprint(vv), true
+	}
+}
+
+func appendUpdate(updates *[]*gnmi.Update, path []*gnmi.PathElem, v any) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("encode %s: %w", pathString(path), err))
+	}
+	*updates = append(*updates, &gnmi.Update{
+		Path: &gnmi.Path{Elem: path},
+		Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: buf}},
+	})
+	return nil
+}
+
+func appendElem(path []*gnmi.PathElem, name string) []*gnmi.PathElem {
+	p := make([]*gnmi.PathElem, 0, len(path)+1)
+	p = append(p, path...)
+	return append(p, &gnmi.PathElem{Name: name})
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func pathString(path []*gnmi.PathElem) string {
+	var b strings.Builder
+	for _, e := range path {
+		b.WriteString("/" + e.Name)
+		keys := make([]string, 0, len(e.Key))
+		for k := range e.Key {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "[%s=%s]", k, e.Key[k])
+		}
+	}
+	if b.Len() == 0 {
+		return "/"
+	}
+	return b.String()
+}
+
+// DecodeJSON decodes the JSON device config into the tree. The numbers are kept as json.Number so that they are
+// compared and encoded as they are.
+func DecodeJSON(buf []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	tree := map[string]any{}
+	if err := dec.Decode(&tree); err != nil {
+		return nil, errors.WithStack(fmt.Errorf("decode JSON: %w", err))
+	}
+	return tree, nil
+}
+
+// DecodeCUE decodes the concrete CUE device config into the tree.
+func DecodeCUE(v cue.Value) (map[string]any, error) {
+	buf, err := v.MarshalJSON()
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("encode CUE into JSON: %w", err))
+	}
+	return DecodeJSON(buf)
+}
@@ -0,0 +1,340 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package diff_test
+
+import (
+	"testing"
+
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/nttcom/kuesta/pkg/diff"
+	"github.com/nttcom/kuesta/pkg/model/openconfig"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ops returns the operations of the SetRequest in a readable form.
+func ops(t *testing.T, sr *gnmi.SetRequest) []string {
+	path := func(p *gnmi.Path) string {
+		s, err := ygot.PathToString(p)
+		require.NoError(t, err)
+		return s
+	}
+	var got []string
+	for _, p := range sr.GetDelete() {
+		got = append(got, "delete "+path(p))
+	}
+	for _, u := range sr.GetReplace() {
+		got = append(got, "replace "+path(u.GetPath())+" "+string(u.GetVal().GetJsonIetfVal()))
+	}
+	for _, u := range sr.GetUpdate() {
+		got = append(got, "update "+path(u.GetPath())+" "+string(u.GetVal().GetJsonIetfVal()))
+	}
+	return got
+}
+
+func TestDiffer_SetRequest(t *testing.T) {
+	keys := diff.Keys{
+		"/interfaces/interface":                                  {"name"},
+		"/interfaces/interface/subinterfaces/subinterface":       {"index"},
+		"/network-instances/network-instance/protocols/protocol": {"identifier", "name"},
+		"/network-instances/network-instance":                    {"name"},
+	}
+
+	tests := []struct {
+		name    string
+		cur     string
+		next    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			"ok: no change",
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 9000}}]}}`,
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 9000}}]}}`,
+			nil,
+			false,
+		},
+		{
+			"ok: update leaf",
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 9000, "description": "foo"}}]}}`,
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 1500, "description": "foo"}}]}}`,
+			[]string{`update /interfaces/interface[name=Ethernet1]/config/mtu 1500`},
+			false,
+		},
+		{
+			"ok: delete leaf",
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 9000, "description": "foo"}}]}}`,
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 9000}}]}}`,
+			[]string{`delete /interfaces/interface[name=Ethernet1]/config/description`},
+			false,
+		},
+		{
+			"ok: add container",
+			`{"interfaces": {"interface": [{"name": "Ethernet1"}]}}`,
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 9000}}]}}`,
+			[]string{`update /interfaces/interface[name=Ethernet1]/config {"mtu":9000}`},
+			false,
+		},
+		{
+			"ok: add, modify and delete list elements",
+			`{"interfaces": {"interface": [
+				{"name": "Ethernet1", "config": {"mtu": 9000}},
+				{"name": "Ethernet2", "config": {"mtu": 9000}}
+			]}}`,
+			`{"interfaces": {"interface": [
+				{"name": "Ethernet2", "config": {"mtu": 1500}},
+				{"name": "Ethernet3", "config": {"mtu": 9000}}
+			]}}`,
+			[]string{
+				`delete /interfaces/interface[name=Ethernet1]`,
+				`update /interfaces/interface[name=Ethernet2]/config/mtu 1500`,
+				`update /interfaces/interface[name=Ethernet3] {"config":{"mtu":9000},"name":"Ethernet3"}`,
+			},
+			false,
+		},
+		{
+			"ok: nested keyed list",
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "subinterfaces": {"subinterface": [{"index": 0}, {"index": 1}]}}]}}`,
+			`{"interfaces": {"interface": [{"name": "Ethernet1", "subinterfaces": {"subinterface": [{"index": 0, "config": {"enabled": true}}]}}]}}`,
+			[]string{
+				`delete /interfaces/interface[name=Ethernet1]/subinterfaces/subinterface[index=1]`,
+				`update /interfaces/interface[name=Ethernet1]/subinterfaces/subinterface[index=0]/config {"enabled":true}`,
+			},
+			false,
+		},
+		{
+			"ok: multiple keys",
+			`{"network-instances": {"network-instance": [{"name": "default", "protocols": {"protocol": [{"identifier": "BGP", "name": "bgp", "enabled": false}]}}]}}`,
+			`{"network-instances": {"network-instance": [{"name": "default", "protocols": {"protocol": [{"identifier": "BGP", "name": "bgp", "enabled": true}]}}]}}`,
+			[]string{`update /network-instances/network-instance[name=default]/protocols/protocol[identifier=BGP][name=bgp]/enabled true`},
+			false,
+		},
+		{
+			"ok: new keyed list is updated by elements",
+			`{}`,
+			`{"interfaces": {"interface": [{"name": "Ethernet1"}]}}`,
+			[]string{`update /interfaces {"interface":[{"name":"Ethernet1"}]}`},
+			false,
+		},
+		{
+			"ok: new keyed list under existing container",
+			`{"interfaces": {}}`,
+			`{"interfaces": {"interface": [{"name": "Ethernet1"}, {"name": "Ethernet2"}]}}`,
+			[]string{
+				`update /interfaces/interface[name=Ethernet1] {"name":"Ethernet1"}`,
+				`update /interfaces/interface[name=Ethernet2] {"name":"Ethernet2"}`,
+			},
+			false,
+		},
+		{
+			"ok: module names",
+			`{"openconfig-interfaces:interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 9000}}]}}`,
+			`{"openconfig-interfaces:interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 1500}}]}}`,
+			[]string{`update /openconfig-interfaces:interfaces/interface[name=Ethernet1]/config/mtu 1500`},
+			false,
+		},
+		{
+			"ok: replace list without keys",
+			`{"system": {"dns": {"servers": ["10.0.0.1", "10.0.0.2"]}}}`,
+			`{"system": {"dns": {"servers": ["10.0.0.2"]}}}`,
+			[]string{`replace /system/dns/servers ["10.0.0.2"]`},
+			false,
+		},
+		{
+			"ok: replace node whose type is changed",
+			`{"system": {"hostname": "foo"}}`,
+			`{"system": {"hostname": {"name": "foo"}}}`,
+			[]string{`replace /system/hostname {"name":"foo"}`},
+			false,
+		},
+		{
+			"ok: large number kept as it is",
+			`{"system": {"counter": 18446744073709551614}}`,
+			`{"system": {"counter": 18446744073709551615}}`,
+			[]string{`update /system/counter 18446744073709551615`},
+			false,
+		},
+		{
+			"err: list element has no key",
+			`{"interfaces": {"interface": [{"name": "Ethernet1"}]}}`,
+			`{"interfaces": {"interface": [{"config": {}}]}}`,
+			nil,
+			true,
+		},
+		{
+			"err: duplicated keys",
+			`{"interfaces": {"interface": []}}`,
+			`{"interfaces": {"interface": [{"name": "Ethernet1"}, {"name": "Ethernet1"}]}}`,
+			nil,
+			true,
+		},
+		{
+			"err: list element is not an object",
+			`{"interfaces": {"interface": []}}`,
+			`{"interfaces": {"interface": ["Ethernet1"]}}`,
+			nil,
+			true,
+		},
+	}
+
+	d := &diff.Differ{Keys: keys}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cur, err := diff.DecodeJSON([]byte(tt.cur))
+			testhelper.ExitOnErr(t, err)
+			next, err := diff.DecodeJSON([]byte(tt.next))
+			testhelper.ExitOnErr(t, err)
+
+			sr, err := d.SetRequest(cur, next)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ops(t, sr))
+		})
+	}
+}
+
+func TestDiffer_SetRequest_NoCurrent(t *testing.T) {
+	next, err := diff.DecodeJSON([]byte(`{"interfaces": {"interface": [{"name": "Ethernet1"}]}, "system": {"hostname": "foo"}}`))
+	testhelper.ExitOnErr(t, err)
+
+	d := &diff.Differ{Keys: diff.Keys{"/interfaces/interface": {"name"}}}
+	sr, err := d.SetRequest(nil, next)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`update /interfaces {"interface":[{"name":"Ethernet1"}]}`,
+		`update /system {"hostname":"foo"}`,
+	}, ops(t, sr))
+}
+
+func TestDecodeCUE(t *testing.T) {
+	v := cuecontext.New().CompileString(`{
+	interfaces: interface: [{name: "Ethernet1", config: mtu: 9000}]
+}`)
+	got, err := diff.DecodeCUE(v)
+	require.NoError(t, err)
+	want, err := diff.DecodeJSON([]byte(`{"interfaces": {"interface": [{"name": "Ethernet1", "config": {"mtu": 9000}}]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = diff.DecodeCUE(cuecontext.New().CompileString(`{mtu: int}`))
+	assert.Error(t, err)
+}
+
+func TestKeysFromYANG(t *testing.T) {
+	s, err := openconfig.Schema()
+	testhelper.ExitOnErr(t, err)
+
+	keys := diff.KeysFromYANG(s.RootSchema())
+	assert.Equal(t, []string{"name"}, keys.Of("/interfaces/interface"))
+	assert.Equal(t, []string{"index"}, keys.Of("/interfaces/interface/subinterfaces/subinterface"))
+	assert.Equal(t, []string{"vlan-id"}, keys.Of("/vlans/vlan"))
+	assert.Nil(t, keys.Of("/interfaces"))
+}
+
+func TestKeysFromCUE(t *testing.T) {
+	tests := []struct {
+		name    string
+		given   string
+		want    diff.Keys
+		wantErr bool
+	}{
+		{
+			"ok: schema",
+			`interfaces: interface: [...{
+	// kuesta:"key=1"
+	name: string
+	subinterfaces: subinterface: [...{
+		// kuesta:"key=1"
+		index: int
+	}]
+	config: {
+		name: string
+		mtu:  int
+	}
+}]`,
+			diff.Keys{
+				"/interfaces/interface":                            {"name"},
+				"/interfaces/interface/subinterfaces/subinterface": {"index"},
+			},
+			false,
+		},
+		{
+			"ok: device config with multiple keys",
+			`"openconfig-network-instance:network-instances": "network-instance": [{
+	name: "default"
+	protocols: protocol: [{
+		// kuesta:"key=2"
+		name: "bgp"
+		// kuesta:"key=1"
+		identifier: "BGP"
+	}]
+}]`,
+			diff.Keys{
+				"/network-instances/network-instance/protocols/protocol": {"identifier", "name"},
+			},
+			false,
+		},
+		{
+			"ok: no annotation",
+			`system: dns: servers: ["10.0.0.1"]`,
+			diff.Keys{},
+			false,
+		},
+		{
+			"err: invalid order",
+			`interfaces: interface: [...{
+	// kuesta:"key=first"
+	name: string
+}]`,
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := cuecontext.New().CompileString(tt.given)
+			testhelper.ExitOnErr(t, v.Err())
+			got, err := diff.KeysFromCUE(v)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKeys_Merge(t *testing.T) {
+	k := diff.Keys{"/a": {"x"}, "/b": {"y"}}
+	got := k.Merge(diff.Keys{"/b": {"z"}, "/c": {"w"}})
+	assert.Equal(t, diff.Keys{"/a": {"x"}, "/b": {"z"}, "/c": {"w"}}, got)
+	assert.Equal(t, diff.Keys{"/a": {"x"}, "/b": {"y"}}, k)
+}
@@ -0,0 +1,165 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cuelang.org/go/cue"
+	kcue "github.com/nttcom/kuesta/pkg/cue"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/pkg/errors"
+)
+
+// Keys are the key names of the keyed lists indexed by the schema path of the list, such as /interfaces/interface.
+// The schema path is made of the labels of the tree without the module names.
+type Keys map[string][]string
+
+// Of returns the key names of the list at the schema path, or nil if the list is not keyed.
+func (k Keys) Of(schemaPath string) []string {
+	return k[schemaPath]
+}
+
+// Merge returns the new Keys which has both keys. The keys of other take precedence.
+func (k Keys) Merge(other Keys) Keys {
+	merged := make(Keys, len(k)+len(other))
+	for p, names := range k {
+		merged[p] = names
+	}
+	for p, names := range other {
+		merged[p] = names
+	}
+	return merged
+}
+
+// KeysFromYANG returns the keys of the lists under the YANG entry.
+func KeysFromYANG(e *yang.Entry) Keys {
+	keys := Keys{}
+	walkYANG(keys, "", e)
+	return keys
+}
+
+func walkYANG(keys Keys, path string, e *yang.Entry) {
+	for name, child := range e.Dir {
+		p := path
+		// NOTE choice and case are not the data nodes, so they do not appear in the tree
+		if !child.IsChoice() && !child.IsCase() {
+			p = path + "/" + name
+		}
+		if child.IsList() && child.Key != "" {
+			keys[p] = strings.Fields(child.Key)
+		}
+		walkYANG(keys, p, child)
+	}
+}
+
+// KeysFromCUE returns the keys of the lists in the CUE value whose key fields are annotated with kuesta:"key=N",
+// where N is the order of the key starting from 1. The value is either the schema or the device config. The
+// annotations are read from the element type of the list if defined, or else from the first element.
+func KeysFromCUE(v cue.Value) (Keys, error) {
+	keys := Keys{}
+	if err := walkCUE(keys, "", v); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func walkCUE(keys Keys, path string, v cue.Value) error {
+	switch v.IncompleteKind() {
+	case cue.StructKind:
+		it, err := v.Fields(cue.Optional(true))
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("iterate fields of %s: %w", path, err))
+		}
+		for it.Next() {
+			if err := walkCUE(keys, path+"/"+trimModule(it.Label()), it.Value()); err != nil {
+				return err
+			}
+		}
+	case cue.ListKind:
+		elem, ok := v.Elem()
+		if !ok {
+			it, err := v.List()
+			if err != nil || !it.Next() {
+				return nil
+			}
+			elem = it.Value()
+		}
+		if elem.IncompleteKind() != cue.StructKind {
+			return nil
+		}
+		names, err := annotatedKeys(elem)
+		if err != nil {
+			return fmt.Errorf("keys of %s: %w", path, err)
+		}
+		if len(names) > 0 {
+			keys[path] = names
+		}
+		return walkCUE(keys, path, elem)
+	}
+	return nil
+}
+
+// annotatedKeys returns the names of the fields annotated as the keys in the order of the keys.
+func annotatedKeys(elem cue.Value) ([]string, error) {
+	type key struct {
+		name  string
+		order int
+	}
+	var found []key
+	it, err := elem.Fields(cue.Optional(true))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for it.Next() {
+		tag, err := kcue.GetKuestaTag(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(tag, "key=") {
+			continue
+		}
+		order, err := strconv.Atoi(strings.TrimPrefix(tag, "key="))
+		if err != nil {
+			return nil, errors.WithStack(fmt.Errorf("invalid key order of %s: %w", it.Label(), err))
+		}
+		found = append(found, key{name: trimModule(it.Label()), order: order})
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].order < found[j].order })
+	names := make([]string, len(found))
+	for i, k := range found {
+		names[i] = k.name
+	}
+	return names, nil
+}
+
+// trimModule returns the label without the module name, such as interfaces of openconfig-interfaces:interfaces.
+func trimModule(label string) string {
+	if i := strings.Index(label, ":"); i >= 0 {
+		return label[i+1:]
+	}
+	return label
+}
@@ -0,0 +1,158 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package gitsign
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SecretKeyPrivateKey is the key of Kubernetes Secret data which holds the armored OpenPGP private key.
+	SecretKeyPrivateKey = "git.asc"
+	// SecretKeyPassphrase is the key of Kubernetes Secret data which holds the passphrase of the private key.
+	SecretKeyPassphrase = "passphrase"
+)
+
+var (
+	ErrUnsigned         = errors.New("commit is not signed")
+	ErrInvalidSignature = errors.New("commit signature is invalid")
+)
+
+type SigningKeyConfig struct {
+	// Path to the armored OpenPGP private key file, or to the directory where Kubernetes Secret is mounted.
+	KeyPath string
+
+	// KeyData holds the armored OpenPGP private key.
+	// KeyData takes precedence over KeyPath
+	KeyData []byte
+
+	// Passphrase to decrypt the private key
+	Passphrase string
+}
+
+// SigningKeyConfigFromSecretData returns SigningKeyConfig from the data of Kubernetes Secret.
+func SigningKeyConfigFromSecretData(data map[string][]byte) *SigningKeyConfig {
+	return &SigningKeyConfig{
+		KeyData:    data[SecretKeyPrivateKey],
+		Passphrase: strings.TrimSpace(string(data[SecretKeyPassphrase])),
+	}
+}
+
+// Enabled returns true if the signing key is provided.
+func (c *SigningKeyConfig) Enabled() bool {
+	return c != nil && (c.KeyPath != "" || len(c.KeyData) > 0)
+}
+
+// Load reads the OpenPGP private key and decrypts it with the passphrase if given.
+// When KeyPath is a directory, it reads the private key and passphrase from the files named after the keys of
+// Kubernetes Secret data.
+func (c *SigningKeyConfig) Load() (*openpgp.Entity, error) {
+	keyData := c.KeyData
+	passphrase := c.Passphrase
+	if len(keyData) == 0 {
+		var err error
+		keyData, passphrase, err = c.readKeyPath()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ReadSigningKey(bytes.NewReader(keyData), []byte(passphrase))
+}
+
+func (c *SigningKeyConfig) readKeyPath() ([]byte, string, error) {
+	fi, err := os.Stat(c.KeyPath)
+	if err != nil {
+		return nil, "", errors.WithStack(fmt.Errorf("stat signing key: %w", err))
+	}
+	if !fi.IsDir() {
+		buf, err := os.ReadFile(c.KeyPath)
+		if err != nil {
+			return nil, "", errors.WithStack(fmt.Errorf("read signing key: %w", err))
+		}
+		return buf, c.Passphrase, nil
+	}
+
+	buf, err := os.ReadFile(filepath.Join(c.KeyPath, SecretKeyPrivateKey))
+	if err != nil {
+		return nil, "", errors.WithStack(fmt.Errorf("read signing key: %w", err))
+	}
+	passphrase := c.Passphrase
+	if passphrase == "" {
+		b, err := os.ReadFile(filepath.Join(c.KeyPath, SecretKeyPassphrase))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, "", errors.WithStack(fmt.Errorf("read passphrase: %w", err))
+		}
+		passphrase = strings.TrimSpace(string(b))
+	}
+	return buf, passphrase, nil
+}
+
+// ReadSigningKey reads the armored OpenPGP private key and decrypts it with the supplied passphrase if encrypted.
+func ReadSigningKey(r io.Reader, passphrase []byte) (*openpgp.Entity, error) {
+	el, err := openpgp.ReadArmoredKeyRing(r)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("read armored key ring: %w", err))
+	}
+	if len(el) == 0 {
+		return nil, errors.WithStack(fmt.Errorf("no key found"))
+	}
+	e := el[0]
+	if e.PrivateKey == nil {
+		return nil, errors.WithStack(fmt.Errorf("private key not found"))
+	}
+	if e.PrivateKey.Encrypted {
+		if err := e.PrivateKey.Decrypt(passphrase); err != nil {
+			return nil, errors.WithStack(fmt.Errorf("decrypt private key: %w", err))
+		}
+	}
+	for _, sub := range e.Subkeys {
+		if sub.PrivateKey != nil && sub.PrivateKey.Encrypted {
+			if err := sub.PrivateKey.Decrypt(passphrase); err != nil {
+				return nil, errors.WithStack(fmt.Errorf("decrypt private subkey: %w", err))
+			}
+		}
+	}
+	return e, nil
+}
+
+// VerifyCommit verifies the OpenPGP signature of the given commit with the armored public key ring.
+// It returns the entity which signed the commit.
+func VerifyCommit(c *object.Commit, armoredKeyRing string) (*openpgp.Entity, error) {
+	if c.PGPSignature == "" {
+		return nil, errors.WithStack(fmt.Errorf("%w: %s", ErrUnsigned, c.Hash))
+	}
+	e, err := c.Verify(armoredKeyRing)
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("%w: %s: %v", ErrInvalidSignature, c.Hash, err))
+	}
+	return e, nil
+}
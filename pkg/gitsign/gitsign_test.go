@@ -0,0 +1,142 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package gitsign_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	extgogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/nttcom/kuesta/pkg/gitsign"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningKeyConfig_Load(t *testing.T) {
+	passphrase := "dummy-pass"
+	priv, _ := testhelper.NewArmoredKeyPair(t, passphrase)
+
+	t.Run("ok: key file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "key.asc")
+		testhelper.ExitOnErr(t, os.WriteFile(path, []byte(priv), 0o600))
+		cfg := &gitsign.SigningKeyConfig{KeyPath: path, Passphrase: passphrase}
+		assert.True(t, cfg.Enabled())
+
+		e, err := cfg.Load()
+		assert.Nil(t, err)
+		assert.False(t, e.PrivateKey.Encrypted)
+	})
+
+	t.Run("ok: mounted secret dir", func(t *testing.T) {
+		dir := t.TempDir()
+		testhelper.ExitOnErr(t, os.WriteFile(filepath.Join(dir, gitsign.SecretKeyPrivateKey), []byte(priv), 0o600))
+		testhelper.ExitOnErr(t, os.WriteFile(filepath.Join(dir, gitsign.SecretKeyPassphrase), []byte(passphrase+"\n"), 0o600))
+		cfg := &gitsign.SigningKeyConfig{KeyPath: dir}
+
+		e, err := cfg.Load()
+		assert.Nil(t, err)
+		assert.False(t, e.PrivateKey.Encrypted)
+	})
+
+	t.Run("ok: secret data", func(t *testing.T) {
+		cfg := gitsign.SigningKeyConfigFromSecretData(map[string][]byte{
+			gitsign.SecretKeyPrivateKey: []byte(priv),
+			gitsign.SecretKeyPassphrase: []byte(passphrase),
+		})
+		assert.True(t, cfg.Enabled())
+
+		e, err := cfg.Load()
+		assert.Nil(t, err)
+		assert.False(t, e.PrivateKey.Encrypted)
+	})
+
+	t.Run("err: wrong passphrase", func(t *testing.T) {
+		cfg := &gitsign.SigningKeyConfig{KeyData: []byte(priv), Passphrase: "wrong"}
+		_, err := cfg.Load()
+		assert.Error(t, err)
+	})
+
+	t.Run("err: file not exist", func(t *testing.T) {
+		cfg := &gitsign.SigningKeyConfig{KeyPath: filepath.Join(t.TempDir(), "not-exist")}
+		_, err := cfg.Load()
+		assert.Error(t, err)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		var cfg *gitsign.SigningKeyConfig
+		assert.False(t, cfg.Enabled())
+		assert.False(t, (&gitsign.SigningKeyConfig{}).Enabled())
+	})
+}
+
+func TestVerifyCommit(t *testing.T) {
+	priv, pub := testhelper.NewArmoredKeyPair(t, "")
+	_, otherPub := testhelper.NewArmoredKeyPair(t, "")
+	signKey, err := gitsign.ReadSigningKey(bytes.NewBufferString(priv), nil)
+	testhelper.ExitOnErr(t, err)
+
+	commit := func(t *testing.T, signKey *openpgp.Entity) *object.Commit {
+		repo, err := extgogit.PlainInit(t.TempDir(), false)
+		testhelper.ExitOnErr(t, err)
+		w, err := repo.Worktree()
+		testhelper.ExitOnErr(t, err)
+		f, err := w.Filesystem.Create("README.md")
+		testhelper.ExitOnErr(t, err)
+		_, err = f.Write([]byte("# test"))
+		testhelper.ExitOnErr(t, err)
+		testhelper.ExitOnErr(t, f.Close())
+		_, err = w.Add("README.md")
+		testhelper.ExitOnErr(t, err)
+
+		sig := &object.Signature{Name: "kuesta", Email: "kuesta@example.com", When: time.Now()}
+		h, err := w.Commit("added: README.md", &extgogit.CommitOptions{
+			Author:  sig,
+			SignKey: signKey,
+		})
+		testhelper.ExitOnErr(t, err)
+		c, err := repo.CommitObject(h)
+		testhelper.ExitOnErr(t, err)
+		return c
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		e, err := gitsign.VerifyCommit(commit(t, signKey), pub)
+		assert.Nil(t, err)
+		assert.Equal(t, signKey.PrimaryKey.KeyId, e.PrimaryKey.KeyId)
+	})
+
+	t.Run("err: unsigned", func(t *testing.T) {
+		_, err := gitsign.VerifyCommit(commit(t, nil), pub)
+		assert.ErrorIs(t, err, gitsign.ErrUnsigned)
+	})
+
+	t.Run("err: signed by untrusted key", func(t *testing.T) {
+		_, err := gitsign.VerifyCommit(commit(t, signKey), otherPub)
+		assert.ErrorIs(t, err, gitsign.ErrInvalidSignature)
+	})
+}
@@ -0,0 +1,234 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package gitsign
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"sort"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshSigArmorStart = "-----BEGIN SSH SIGNATURE-----"
+	sshSigPEMType    = "SSH SIGNATURE"
+	sshSigMagic      = "SSHSIG"
+	sshSigVersion    = 1
+	// SSHSigNamespace is the namespace used by git to sign commits with SSH keys.
+	SSHSigNamespace = "git"
+)
+
+var ErrUntrustedKey = errors.New("commit is signed by untrusted key")
+
+// TrustedKeys is the set of public keys trusted to sign commits.
+type TrustedKeys struct {
+	openPGP openpgp.EntityList
+	ssh     []ssh.PublicKey
+}
+
+// TrustedKeysFromSecretData returns TrustedKeys from the data of Kubernetes Secret.
+// Each entry holds either armored OpenPGP public keys or SSH public keys in authorized_keys format.
+func TrustedKeysFromSecretData(data map[string][]byte) (*TrustedKeys, error) {
+	names := make([]string, 0, len(data))
+	for k := range data {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	keys := &TrustedKeys{}
+	for _, name := range names {
+		if err := keys.Add(data[name]); err != nil {
+			return nil, fmt.Errorf("read trusted keys from %s: %w", name, err)
+		}
+	}
+	return keys, nil
+}
+
+// Add parses the given armored OpenPGP public keys or SSH authorized keys and adds them to the trusted key set.
+func (k *TrustedKeys) Add(buf []byte) error {
+	if bytes.Contains(buf, []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----")) {
+		el, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(buf))
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("read armored key ring: %w", err))
+		}
+		k.openPGP = append(k.openPGP, el...)
+		return nil
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("parse ssh public key: %w", err))
+		}
+		k.ssh = append(k.ssh, pub)
+	}
+	return errors.WithStack(s.Err())
+}
+
+// Len returns the number of trusted keys.
+func (k *TrustedKeys) Len() int {
+	if k == nil {
+		return 0
+	}
+	return len(k.openPGP) + len(k.ssh)
+}
+
+// Verify verifies the OpenPGP or SSH signature of the given commit against the trusted keys.
+func (k *TrustedKeys) Verify(c *object.Commit) error {
+	if c.PGPSignature == "" {
+		return errors.WithStack(fmt.Errorf("%w: %s", ErrUnsigned, c.Hash))
+	}
+	if k.Len() == 0 {
+		return errors.WithStack(fmt.Errorf("%w: %s: no trusted key given", ErrUntrustedKey, c.Hash))
+	}
+
+	encoded := &plumbing.MemoryObject{}
+	if err := c.EncodeWithoutSignature(encoded); err != nil {
+		return errors.WithStack(fmt.Errorf("encode commit: %w", err))
+	}
+	r, err := encoded.Reader()
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("read encoded commit: %w", err))
+	}
+	var msg bytes.Buffer
+	if _, err := msg.ReadFrom(r); err != nil {
+		return errors.WithStack(fmt.Errorf("read encoded commit: %w", err))
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(c.PGPSignature), sshSigArmorStart) {
+		return k.verifySSH(c, msg.Bytes())
+	}
+	return k.verifyOpenPGP(c, msg.Bytes())
+}
+
+func (k *TrustedKeys) verifyOpenPGP(c *object.Commit, msg []byte) error {
+	if len(k.openPGP) == 0 {
+		return errors.WithStack(fmt.Errorf("%w: %s: no trusted OpenPGP key given", ErrUntrustedKey, c.Hash))
+	}
+	_, err := openpgp.CheckArmoredDetachedSignature(k.openPGP, bytes.NewReader(msg), strings.NewReader(c.PGPSignature), nil)
+	if err != nil {
+		return errors.WithStack(fmt.Errorf("%w: %s: %v", ErrInvalidSignature, c.Hash, err))
+	}
+	return nil
+}
+
+// sshSignature is the wire format of SSH signature.
+// See https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+type sshSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the data to be signed by SSH key.
+type sshSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+func (k *TrustedKeys) verifySSH(c *object.Commit, msg []byte) error {
+	invalid := func(format string, args ...interface{}) error {
+		return errors.WithStack(fmt.Errorf("%w: %s: %s", ErrInvalidSignature, c.Hash, fmt.Sprintf(format, args...)))
+	}
+
+	block, _ := pem.Decode([]byte(c.PGPSignature))
+	if block == nil || block.Type != sshSigPEMType {
+		return invalid("decode ssh signature")
+	}
+	if !bytes.HasPrefix(block.Bytes, []byte(sshSigMagic)) {
+		return invalid("invalid ssh signature magic")
+	}
+	var sig sshSignature
+	if err := ssh.Unmarshal(block.Bytes[len(sshSigMagic):], &sig); err != nil {
+		return invalid("unmarshal ssh signature: %v", err)
+	}
+	if sig.Version != sshSigVersion {
+		return invalid("unsupported ssh signature version: %d", sig.Version)
+	}
+	if sig.Namespace != SSHSigNamespace {
+		return invalid("unexpected ssh signature namespace: %s", sig.Namespace)
+	}
+
+	pub, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return invalid("parse ssh public key: %v", err)
+	}
+	if !k.trustSSH(pub) {
+		return errors.WithStack(fmt.Errorf("%w: %s: %s", ErrUntrustedKey, c.Hash, ssh.FingerprintSHA256(pub)))
+	}
+
+	var h hash.Hash
+	switch sig.HashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return invalid("unsupported ssh signature hash algorithm: %s", sig.HashAlgorithm)
+	}
+	h.Write(msg)
+
+	var s ssh.Signature
+	if err := ssh.Unmarshal(sig.Signature, &s); err != nil {
+		return invalid("unmarshal ssh signature blob: %v", err)
+	}
+	signed := append([]byte(sshSigMagic), ssh.Marshal(sshSignedData{
+		Namespace:     sig.Namespace,
+		HashAlgorithm: sig.HashAlgorithm,
+		Hash:          h.Sum(nil),
+	})...)
+	if err := pub.Verify(signed, &s); err != nil {
+		return invalid("%v", err)
+	}
+	return nil
+}
+
+func (k *TrustedKeys) trustSSH(pub ssh.PublicKey) bool {
+	for _, t := range k.ssh {
+		if bytes.Equal(t.Marshal(), pub.Marshal()) {
+			return true
+		}
+	}
+	return false
+}
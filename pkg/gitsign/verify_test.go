@@ -0,0 +1,179 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package gitsign_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/pem"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/nttcom/kuesta/pkg/gitsign"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func newCommit() *object.Commit {
+	sig := object.Signature{Name: "kuesta", Email: "kuesta@example.com", When: time.Unix(1672531200, 0)}
+	return &object.Commit{
+		Author:    sig,
+		Committer: sig,
+		Message:   "added: README.md",
+		TreeHash:  plumbing.NewHash("4b825dc642cb6eb9a060e54bf8d69288fbee4904"),
+	}
+}
+
+func encodeCommit(t *testing.T, c *object.Commit) []byte {
+	t.Helper()
+	o := &plumbing.MemoryObject{}
+	testhelper.ExitOnErr(t, c.EncodeWithoutSignature(o))
+	r, err := o.Reader()
+	testhelper.ExitOnErr(t, err)
+	buf, err := io.ReadAll(r)
+	testhelper.ExitOnErr(t, err)
+	return buf
+}
+
+func signOpenPGP(t *testing.T, c *object.Commit, e *openpgp.Entity) {
+	t.Helper()
+	var buf bytes.Buffer
+	testhelper.ExitOnErr(t, openpgp.ArmoredDetachSign(&buf, e, bytes.NewReader(encodeCommit(t, c)), nil))
+	c.PGPSignature = buf.String()
+}
+
+func signSSH(t *testing.T, c *object.Commit, signer ssh.Signer, namespace string) {
+	t.Helper()
+	h := sha512.Sum512(encodeCommit(t, c))
+	signed := append([]byte("SSHSIG"), ssh.Marshal(struct {
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          []byte
+	}{namespace, "", "sha512", h[:]})...)
+	s, err := signer.Sign(rand.Reader, signed)
+	testhelper.ExitOnErr(t, err)
+
+	blob := append([]byte("SSHSIG"), ssh.Marshal(struct {
+		Version       uint32
+		PublicKey     []byte
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Signature     []byte
+	}{1, signer.PublicKey().Marshal(), namespace, "", "sha512", ssh.Marshal(s)})...)
+	c.PGPSignature = string(pem.EncodeToMemory(&pem.Block{Type: "SSH SIGNATURE", Bytes: blob}))
+}
+
+func newSSHSigner(t *testing.T) ssh.Signer {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	testhelper.ExitOnErr(t, err)
+	signer, err := ssh.NewSignerFromKey(priv)
+	testhelper.ExitOnErr(t, err)
+	return signer
+}
+
+func TestTrustedKeys_Verify(t *testing.T) {
+	priv, pub := testhelper.NewArmoredKeyPair(t, "")
+	otherPriv, _ := testhelper.NewArmoredKeyPair(t, "")
+	pgpKey, err := gitsign.ReadSigningKey(bytes.NewBufferString(priv), nil)
+	testhelper.ExitOnErr(t, err)
+	otherPGPKey, err := gitsign.ReadSigningKey(bytes.NewBufferString(otherPriv), nil)
+	testhelper.ExitOnErr(t, err)
+	sshSigner := newSSHSigner(t)
+	otherSSHSigner := newSSHSigner(t)
+
+	keys, err := gitsign.TrustedKeysFromSecretData(map[string][]byte{
+		"kuesta.asc":      []byte(pub),
+		"authorized_keys": append([]byte("# trusted keys\n"), ssh.MarshalAuthorizedKey(sshSigner.PublicKey())...),
+	})
+	testhelper.ExitOnErr(t, err)
+	assert.Equal(t, 2, keys.Len())
+
+	t.Run("ok: openpgp", func(t *testing.T) {
+		c := newCommit()
+		signOpenPGP(t, c, pgpKey)
+		assert.Nil(t, keys.Verify(c))
+	})
+
+	t.Run("ok: ssh", func(t *testing.T) {
+		c := newCommit()
+		signSSH(t, c, sshSigner, gitsign.SSHSigNamespace)
+		assert.Nil(t, keys.Verify(c))
+	})
+
+	t.Run("err: unsigned", func(t *testing.T) {
+		assert.ErrorIs(t, keys.Verify(newCommit()), gitsign.ErrUnsigned)
+	})
+
+	t.Run("err: openpgp untrusted key", func(t *testing.T) {
+		c := newCommit()
+		signOpenPGP(t, c, otherPGPKey)
+		assert.ErrorIs(t, keys.Verify(c), gitsign.ErrInvalidSignature)
+	})
+
+	t.Run("err: ssh untrusted key", func(t *testing.T) {
+		c := newCommit()
+		signSSH(t, c, otherSSHSigner, gitsign.SSHSigNamespace)
+		assert.ErrorIs(t, keys.Verify(c), gitsign.ErrUntrustedKey)
+	})
+
+	t.Run("err: ssh wrong namespace", func(t *testing.T) {
+		c := newCommit()
+		signSSH(t, c, sshSigner, "file")
+		assert.ErrorIs(t, keys.Verify(c), gitsign.ErrInvalidSignature)
+	})
+
+	t.Run("err: tampered", func(t *testing.T) {
+		c := newCommit()
+		signSSH(t, c, sshSigner, gitsign.SSHSigNamespace)
+		c.Message = "tampered"
+		assert.ErrorIs(t, keys.Verify(c), gitsign.ErrInvalidSignature)
+
+		c = newCommit()
+		signOpenPGP(t, c, pgpKey)
+		c.Message = "tampered"
+		assert.ErrorIs(t, keys.Verify(c), gitsign.ErrInvalidSignature)
+	})
+
+	t.Run("err: no trusted key", func(t *testing.T) {
+		c := newCommit()
+		signOpenPGP(t, c, pgpKey)
+		assert.ErrorIs(t, (&gitsign.TrustedKeys{}).Verify(c), gitsign.ErrUntrustedKey)
+	})
+}
+
+func TestTrustedKeysFromSecretData(t *testing.T) {
+	_, err := gitsign.TrustedKeysFromSecretData(map[string][]byte{
+		"bad": []byte("not a key"),
+	})
+	assert.Error(t, err)
+}
@@ -27,6 +27,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/load"
@@ -77,6 +78,19 @@ func ReadServiceMeta(path string) (*ServiceMeta, error) {
 	return &meta, nil
 }
 
+// DeviceStatus is the liveness of the device reported by its subscriber.
+type DeviceStatus struct {
+	LastSeen          time.Time `json:"lastSeen"`                    // Time when the last report was received.
+	SubscriberVersion string    `json:"subscriberVersion,omitempty"` // Version of the subscriber.
+	SyncError         string    `json:"syncError,omitempty"`         // Error of the last sync, empty if succeeded.
+}
+
+// IsStale returns true if the device has not reported within the threshold. The status never becomes stale when
+// the threshold is zero.
+func (s *DeviceStatus) IsStale(now time.Time, threshold time.Duration) bool {
+	return threshold > 0 && now.Sub(s.LastSeen) > threshold
+}
+
 type ServiceTransformer struct {
 	value cue.Value
 }
@@ -25,6 +25,7 @@ package kuesta_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"cuelang.org/go/cue/cuecontext"
 	"github.com/nttcom/kuesta/pkg/kuesta"
@@ -128,6 +129,14 @@ func TestReadServiceMeta(t *testing.T) {
 	}
 }
 
+func TestDeviceStatus_IsStale(t *testing.T) {
+	now := time.Now()
+	st := &kuesta.DeviceStatus{LastSeen: now.Add(-time.Minute)}
+	assert.False(t, st.IsStale(now, 0))
+	assert.False(t, st.IsStale(now, 2*time.Minute))
+	assert.True(t, st.IsStale(now, 30*time.Second))
+}
+
 func TestNewServiceTransformer(t *testing.T) {
 	tests := []struct {
 		name    string
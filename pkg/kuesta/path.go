@@ -24,6 +24,7 @@ package kuesta
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -45,6 +46,7 @@ const (
 	FileServiceMetaYaml = "metadata.yaml"
 	FileConfigCue       = "config.cue"
 	FileActualConfigCue = "actual_config.cue"
+	FileDeviceStatus    = "status.json"
 )
 
 type PathOpt string
@@ -314,6 +316,34 @@ func (p *DevicePath) ReadActualDeviceConfigFile() ([]byte, error) {
 	return buf, nil
 }
 
+// DeviceStatusPath returns the path to specified device status.
+func (p *DevicePath) DeviceStatusPath(t PathOpt) string {
+	el := append(p.devicePathElem(), FileDeviceStatus)
+	return p.addRoot(filepath.Join(el...), t)
+}
+
+// ReadDeviceStatus loads the device status.
+func (p *DevicePath) ReadDeviceStatus() (*DeviceStatus, error) {
+	buf, err := os.ReadFile(p.DeviceStatusPath(IncludeRoot))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var st DeviceStatus
+	if err := json.Unmarshal(buf, &st); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &st, nil
+}
+
+// WriteDeviceStatus writes the device status to the corresponding device dir.
+func (p *DevicePath) WriteDeviceStatus(st *DeviceStatus) error {
+	buf, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return file.WriteFileWithMkdir(p.DeviceStatusPath(IncludeRoot), append(buf, '\n'))
+}
+
 // ReadServiceMetaAll loads all service meta stored in the git repo.
 func ReadServiceMetaAll(dir string) ([]*ServiceMeta, error) {
 	var mlist []*ServiceMeta
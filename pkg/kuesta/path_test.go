@@ -28,6 +28,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"cuelang.org/go/cue/ast"
 	"cuelang.org/go/cue/cuecontext"
@@ -575,6 +576,26 @@ func TestDevicePath_ReadActualDeviceConfigFile(t *testing.T) {
 	})
 }
 
+func TestDevicePath_DeviceStatus(t *testing.T) {
+	dir := t.TempDir()
+	p := newValidDevicePath()
+	assert.Equal(t, "devices/device1/status.json", p.DeviceStatusPath(kuesta.ExcludeRoot))
+	p.RootDir = dir
+
+	_, err := p.ReadDeviceStatus()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	want := &kuesta.DeviceStatus{
+		LastSeen:          time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		SubscriberVersion: "v0.1.0",
+		SyncError:         "failed",
+	}
+	testhelper.ExitOnErr(t, p.WriteDeviceStatus(want))
+	got, err := p.ReadDeviceStatus()
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
 func TestParseServiceInputPath(t *testing.T) {
 	tests := []struct {
 		name     string
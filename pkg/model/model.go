@@ -0,0 +1,260 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+// Package model provides the registry of the device models. A device model decides how the device config written
+// in CUE is diffed into the gNMI SetRequest, either through the Go bindings generated by ygot or schema-less.
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	kcue "github.com/nttcom/kuesta/pkg/cue"
+	"github.com/nttcom/kuesta/pkg/diff"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"github.com/pkg/errors"
+)
+
+// SchemalessModelName is the name of the schema-less JSON model registered by default.
+const SchemalessModelName = "json"
+
+// ErrModelNotFound is returned when the model is not registered.
+var ErrModelNotFound = errors.New("model not found")
+
+// Model converts the device config written in CUE into the gNMI requests.
+type Model interface {
+	// Name returns the name with which the model is registered.
+	Name() string
+
+	// SetRequest returns the gNMI SetRequest which turns the device config cur into next. cur is nil if no device
+	// config has been applied yet.
+	SetRequest(next, cur []byte) (*gnmi.SetRequest, error)
+}
+
+// SchemaFunc returns the ygot schema whose root is the empty fake root of the generated Go bindings, such as
+// the Schema function of the generated package.
+type SchemaFunc func() (*ytypes.Schema, error)
+
+// YgotModel is the model backed by the Go bindings generated by ygot.
+type YgotModel struct {
+	name     string
+	schema   SchemaFunc
+	rootType reflect.Type
+}
+
+var _ Model = &YgotModel{}
+
+// NewYgotModel creates the model of the Go bindings whose schema is returned by the given SchemaFunc.
+func NewYgotModel(name string, schema SchemaFunc) (*YgotModel, error) {
+	s, err := schema()
+	if err != nil {
+		return nil, fmt.Errorf("load schema of %s: %w", name, err)
+	}
+	if s.Root == nil {
+		return nil, errors.WithStack(fmt.Errorf("schema of %s has no root", name))
+	}
+	return &YgotModel{name: name, schema: schema, rootType: reflect.TypeOf(s.Root).Elem()}, nil
+}
+
+// Name returns the name of the model.
+func (m *YgotModel) Name() string {
+	return m.name
+}
+
+// Schema returns the ygot schema with the empty root.
+func (m *YgotModel) Schema() (*ytypes.Schema, error) {
+	return m.schema()
+}
+
+// NewRoot returns the empty fake root of the Go bindings.
+func (m *YgotModel) NewRoot() ygot.GoStruct {
+	return reflect.New(m.rootType).Interface().(ygot.GoStruct)
+}
+
+// Decode decodes the CUE device config into the fake root of the Go bindings.
+func (m *YgotModel) Decode(cctx *cue.Context, buf []byte) (ygot.GoStruct, error) {
+	val, err := kcue.NewValueFromBytes(cctx, buf)
+	if err != nil {
+		return nil, err
+	}
+	o := m.NewRoot()
+	if err := val.Decode(o); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return o, nil
+}
+
+// SetRequest returns the gNMI SetRequest made of the diff of the Go bindings.
+func (m *YgotModel) SetRequest(next, cur []byte) (*gnmi.SetRequest, error) {
+	cctx := cuecontext.New()
+
+	newObj, err := m.Decode(cctx, next)
+	if err != nil {
+		return nil, fmt.Errorf("load new device config: %w", err)
+	}
+	curObj := m.NewRoot()
+	if cur != nil {
+		curObj, err = m.Decode(cctx, cur)
+		if err != nil {
+			return nil, fmt.Errorf("load current device config: %w", err)
+		}
+	}
+
+	// TODO enhance performance
+	n, err := ygot.Diff(curObj, newObj, &ygot.DiffPathOpt{
+		MapToSinglePath: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get config diff: %w", err)
+	}
+
+	return &gnmi.SetRequest{
+		Prefix: n.Prefix,
+		Delete: n.Delete,
+		Update: n.Update,
+	}, nil
+}
+
+// JSONModel is the schema-less model for the devices without the generated Go bindings. The keys of the lists are
+// given on creation or annotated with kuesta:"key=N" in the device config, and the lists without keys are replaced
+// as a whole when changed.
+type JSONModel struct {
+	name string
+	keys diff.Keys
+}
+
+var _ Model = &JSONModel{}
+
+// NewJSONModel creates the schema-less model with the keys of the lists, which may be nil.
+func NewJSONModel(name string, keys diff.Keys) *JSONModel {
+	return &JSONModel{name: name, keys: keys}
+}
+
+// Name returns the name of the model.
+func (m *JSONModel) Name() string {
+	return m.name
+}
+
+// SetRequest returns the gNMI SetRequest made of the diff of the device configs. The SetRequest is empty if the
+// device config is not changed.
+func (m *JSONModel) SetRequest(next, cur []byte) (*gnmi.SetRequest, error) {
+	cctx := cuecontext.New()
+
+	nextVal, err := kcue.NewValueFromBytes(cctx, next)
+	if err != nil {
+		return nil, fmt.Errorf("load new device config: %w", err)
+	}
+	nextTree, err := diff.DecodeCUE(nextVal)
+	if err != nil {
+		return nil, fmt.Errorf("load new device config: %w", err)
+	}
+	keys, err := diff.KeysFromCUE(nextVal)
+	if err != nil {
+		return nil, fmt.Errorf("load list keys: %w", err)
+	}
+
+	var curTree map[string]any
+	if cur != nil {
+		curVal, err := kcue.NewValueFromBytes(cctx, cur)
+		if err != nil {
+			return nil, fmt.Errorf("load current device config: %w", err)
+		}
+		curTree, err = diff.DecodeCUE(curVal)
+		if err != nil {
+			return nil, fmt.Errorf("load current device config: %w", err)
+		}
+	}
+
+	sr, err := (&diff.Differ{Keys: m.keys.Merge(keys)}).SetRequest(curTree, nextTree)
+	if err != nil {
+		return nil, fmt.Errorf("get config diff: %w", err)
+	}
+	return sr, nil
+}
+
+// Registry is the set of the models looked up by name.
+type Registry struct {
+	mu     sync.RWMutex
+	models map[string]Model
+}
+
+// NewRegistry creates the Registry which has only the schema-less model.
+func NewRegistry() *Registry {
+	return &Registry{models: map[string]Model{
+		SchemalessModelName: NewJSONModel(SchemalessModelName, nil),
+	}}
+}
+
+// Register adds the model to the registry. It returns an error if the name is already registered.
+func (r *Registry) Register(m Model) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.models[m.Name()]; ok {
+		return errors.WithStack(fmt.Errorf("model %s is already registered", m.Name()))
+	}
+	r.models[m.Name()] = m
+	return nil
+}
+
+// Get returns the model registered with the name.
+func (r *Registry) Get(name string) (Model, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	m, ok := r.models[name]
+	if !ok {
+		return nil, errors.WithStack(fmt.Errorf("%w: %s", ErrModelNotFound, name))
+	}
+	return m, nil
+}
+
+// Names returns the sorted names of the registered models.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.models))
+	for k := range r.models {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultRegistry is the registry to which the generated packages register their models on import.
+var DefaultRegistry = NewRegistry()
+
+// MustRegister adds the model to the DefaultRegistry, and panics if the name is already registered.
+func MustRegister(m Model) {
+	if err := DefaultRegistry.Register(m); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the model registered to the DefaultRegistry with the name.
+func Get(name string) (Model, error) {
+	return DefaultRegistry.Get(name)
+}
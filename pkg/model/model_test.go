@@ -0,0 +1,268 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package model_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/nttcom/kuesta/pkg/diff"
+	"github.com/nttcom/kuesta/pkg/model"
+	"github.com/nttcom/kuesta/pkg/model/openconfig"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYgotModel_SetRequest(t *testing.T) {
+	config1 := []byte(`{
+	Interface: {
+		Ethernet1: {
+			Name:        "Ethernet1"
+			Description: "foo"
+		}
+	}
+}`)
+	config2 := []byte(`{
+	Interface: {
+		Ethernet1: {
+			Name:        "Ethernet1"
+			Description: "bar"
+		}
+	}
+}`)
+
+	m, err := model.NewYgotModel(openconfig.ModelName, openconfig.Schema)
+	require.NoError(t, err)
+	assert.IsType(t, &openconfig.Device{}, m.NewRoot())
+
+	// NOTE the values of the leaves are encoded in the TypedValues of their types by ygot
+	updates := func(t *testing.T, sr *gnmi.SetRequest) map[string]string {
+		got := map[string]string{}
+		for _, u := range sr.GetUpdate() {
+			p, err := ygot.PathToString(u.GetPath())
+			require.NoError(t, err)
+			got[p] = u.GetVal().GetStringVal()
+		}
+		return got
+	}
+	deletes := func(t *testing.T, sr *gnmi.SetRequest) []string {
+		var got []string
+		for _, d := range sr.GetDelete() {
+			p, err := ygot.PathToString(d)
+			require.NoError(t, err)
+			got = append(got, p)
+		}
+		return got
+	}
+	t.Run("ok: no current config", func(t *testing.T) {
+		sr, err := m.SetRequest(config1, nil)
+		require.NoError(t, err)
+		assert.Empty(t, sr.GetDelete())
+		assert.Empty(t, sr.GetReplace())
+		assert.Equal(t, map[string]string{
+			"/interfaces/interface[name=Ethernet1]/name":               "Ethernet1",
+			"/interfaces/interface[name=Ethernet1]/config/description": "foo",
+		}, updates(t, sr))
+	})
+
+	t.Run("ok: diff", func(t *testing.T) {
+		sr, err := m.SetRequest(config2, config1)
+		require.NoError(t, err)
+		assert.Empty(t, sr.GetDelete())
+		assert.Equal(t, map[string]string{
+			"/interfaces/interface[name=Ethernet1]/config/description": "bar",
+		}, updates(t, sr))
+	})
+
+	t.Run("ok: delete leaves", func(t *testing.T) {
+		sr, err := m.SetRequest([]byte(`{}`), config1)
+		require.NoError(t, err)
+		assert.Empty(t, sr.GetUpdate())
+		assert.ElementsMatch(t, []string{
+			"/interfaces/interface[name=Ethernet1]/name",
+			"/interfaces/interface[name=Ethernet1]/config/description",
+		}, deletes(t, sr))
+	})
+
+	t.Run("ok: delete leaves of list element", func(t *testing.T) {
+		config3 := []byte(`{
+	Interface: {
+		Ethernet1: {
+			Name:        "Ethernet1"
+			Description: "foo"
+		}
+		Ethernet2: {
+			Name:        "Ethernet2"
+			Description: "bar"
+		}
+	}
+}`)
+		sr, err := m.SetRequest(config1, config3)
+		require.NoError(t, err)
+		assert.Empty(t, sr.GetUpdate())
+		assert.ElementsMatch(t, []string{
+			"/interfaces/interface[name=Ethernet2]/name",
+			"/interfaces/interface[name=Ethernet2]/config/description",
+		}, deletes(t, sr))
+	})
+
+	t.Run("err: invalid config", func(t *testing.T) {
+		_, err := m.SetRequest([]byte(`{Interface: `), nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestJSONModel_SetRequest(t *testing.T) {
+	config1 := []byte(`{
+	foo: {
+		bar: "baz"
+		list: [1, 2]
+		items: [{
+			// kuesta:"key=1"
+			name:  "a"
+			value: 1
+		}, {
+			name:  "b"
+			value: 2
+		}]
+	}
+}`)
+	config2 := []byte(`{
+	foo: {
+		bar: "qux"
+		list: [1, 2]
+		items: [{
+			// kuesta:"key=1"
+			name:  "a"
+			value: 10
+		}]
+	}
+}`)
+
+	m := model.NewJSONModel(model.SchemalessModelName, nil)
+
+	t.Run("ok: diff", func(t *testing.T) {
+		sr, err := m.SetRequest(config2, config1)
+		require.NoError(t, err)
+		assert.Empty(t, sr.GetReplace())
+		require.Len(t, sr.GetDelete(), 1)
+		p, err := ygot.PathToString(sr.GetDelete()[0])
+		require.NoError(t, err)
+		assert.Equal(t, "/foo/items[name=b]", p)
+
+		got := map[string]string{}
+		for _, u := range sr.GetUpdate() {
+			p, err := ygot.PathToString(u.GetPath())
+			require.NoError(t, err)
+			got[p] = string(u.GetVal().GetJsonIetfVal())
+		}
+		assert.Equal(t, map[string]string{
+			"/foo/bar":                 `"qux"`,
+			"/foo/items[name=a]/value": `10`,
+		}, got)
+	})
+
+	t.Run("ok: keys given on creation", func(t *testing.T) {
+		m := model.NewJSONModel("test", diff.Keys{"/foo/items": {"name"}})
+		sr, err := m.SetRequest(
+			[]byte(`{foo: items: [{name: "a", value: 10}]}`),
+			[]byte(`{foo: items: [{name: "a", value: 1}]}`),
+		)
+		require.NoError(t, err)
+		require.Len(t, sr.GetUpdate(), 1)
+		p, err := ygot.PathToString(sr.GetUpdate()[0].GetPath())
+		require.NoError(t, err)
+		assert.Equal(t, "/foo/items[name=a]/value", p)
+	})
+
+	t.Run("ok: replace list without keys", func(t *testing.T) {
+		sr, err := m.SetRequest([]byte(`{foo: list: [1, 3]}`), []byte(`{foo: list: [1, 2]}`))
+		require.NoError(t, err)
+		require.Len(t, sr.GetReplace(), 1)
+		p, err := ygot.PathToString(sr.GetReplace()[0].GetPath())
+		require.NoError(t, err)
+		assert.Equal(t, "/foo/list", p)
+		var got []int
+		require.NoError(t, json.Unmarshal(sr.GetReplace()[0].GetVal().GetJsonIetfVal(), &got))
+		assert.Equal(t, []int{1, 3}, got)
+	})
+
+	t.Run("ok: no current config", func(t *testing.T) {
+		sr, err := m.SetRequest(config1, nil)
+		require.NoError(t, err)
+		assert.Empty(t, sr.GetDelete())
+		assert.Len(t, sr.GetUpdate(), 1)
+	})
+
+	t.Run("ok: not changed", func(t *testing.T) {
+		sr, err := m.SetRequest(config1, config1)
+		require.NoError(t, err)
+		assert.Empty(t, sr.GetReplace())
+		assert.Empty(t, sr.GetUpdate())
+		assert.Empty(t, sr.GetDelete())
+	})
+
+	t.Run("err: invalid config", func(t *testing.T) {
+		_, err := m.SetRequest([]byte(`{foo: `), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("err: key not found", func(t *testing.T) {
+		_, err := m.SetRequest([]byte(`{foo: items: [{
+	// kuesta:"key=1"
+	name: "a"
+}, {value: 1}]}`), []byte(`{foo: items: []}`))
+		assert.Error(t, err)
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	r := model.NewRegistry()
+	assert.Equal(t, []string{model.SchemalessModelName}, r.Names())
+
+	m, err := model.NewYgotModel("test", openconfig.Schema)
+	require.NoError(t, err)
+	require.NoError(t, r.Register(m))
+	assert.Error(t, r.Register(m))
+	assert.Equal(t, []string{model.SchemalessModelName, "test"}, r.Names())
+
+	got, err := r.Get("test")
+	require.NoError(t, err)
+	assert.Equal(t, m, got)
+
+	_, err = r.Get("unknown")
+	assert.True(t, errors.Is(err, model.ErrModelNotFound))
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	m, err := model.Get(openconfig.ModelName)
+	require.NoError(t, err)
+	assert.Equal(t, openconfig.ModelName, m.Name())
+
+	m, err = model.Get(model.SchemalessModelName)
+	require.NoError(t, err)
+	assert.IsType(t, &model.JSONModel{}, m)
+}
@@ -21,7 +21,7 @@
 */
 
 /*
-Package model is a generated package which contains definitions
+Package openconfig is a generated package which contains definitions
 of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was true
 in this case).
@@ -33,7 +33,7 @@ using the following YANG input files:
 Imported modules were sourced from:
 	- yang/...
 */
-package model
+package openconfig
 
 import (
 	"encoding/json"
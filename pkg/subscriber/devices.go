@@ -0,0 +1,125 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+// Package subscriber provides the device list and the device health shared between the device-operator and the
+// multi-device subscriber.
+package subscriber
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// FileDevices is the name of the file of the device list in the subscriber Secret.
+	FileDevices = "devices.json"
+
+	// PathDevicesHealth is the path of the health endpoint of the subscriber which serves DeviceHealth of each device
+	// in JSON keyed by the device name.
+	PathDevicesHealth = "/devices"
+)
+
+// DeviceHealth is the state of the gNMI subscription to the device.
+type DeviceHealth struct {
+	Connected bool `json:"connected"`
+	// Error is the error of the last disconnection, or empty if not connected yet.
+	Error string `json:"error,omitempty"`
+}
+
+// DeviceList is the devices to which one subscriber process subscribes.
+type DeviceList struct {
+	Devices []Device `json:"devices"`
+}
+
+// Device is the device to subscribe and the parameters of its gNMI subscription. Empty fields fall back to
+// the flags of the subscriber.
+type Device struct {
+	// Name is the name of the device.
+	Name string `json:"name"`
+
+	// Addr is the address of the device, address:port or just :port.
+	Addr string `json:"addr"`
+
+	// Model is the name of the device model with which the device config is decoded.
+	Model string `json:"model,omitempty"`
+
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+
+	Origin         string   `json:"origin,omitempty"`
+	Target         string   `json:"target,omitempty"`
+	Paths          []string `json:"paths,omitempty"`
+	GetPaths       []string `json:"getPaths,omitempty"`
+	Mode           string   `json:"mode,omitempty"`
+	SampleInterval string   `json:"sampleInterval,omitempty"`
+	Encoding       string   `json:"encoding,omitempty"`
+
+	TLS *DeviceTLS `json:"tls,omitempty"`
+}
+
+// DeviceTLS is the TLS parameters to connect to the device. The certificates are embedded in PEM so that
+// the device list is self-contained.
+type DeviceTLS struct {
+	NoTLS      bool   `json:"notls,omitempty"`
+	SkipVerify bool   `json:"skipVerify,omitempty"`
+	ServerName string `json:"serverName,omitempty"`
+	Crt        string `json:"crt,omitempty"`
+	Key        string `json:"key,omitempty"`
+	CACrt      string `json:"caCrt,omitempty"`
+}
+
+// ParseDeviceList decodes the JSON device list, and checks that every device has the unique name and the address.
+func ParseDeviceList(buf []byte) (*DeviceList, error) {
+	var l DeviceList
+	if err := json.Unmarshal(buf, &l); err != nil {
+		return nil, errors.WithStack(fmt.Errorf("decode device list: %w", err))
+	}
+	seen := map[string]struct{}{}
+	for _, d := range l.Devices {
+		if d.Name == "" {
+			return nil, errors.WithStack(fmt.Errorf("device name is empty"))
+		}
+		if d.Addr == "" {
+			return nil, errors.WithStack(fmt.Errorf("address of device %s is empty", d.Name))
+		}
+		if _, ok := seen[d.Name]; ok {
+			return nil, errors.WithStack(fmt.Errorf("device %s is duplicated", d.Name))
+		}
+		seen[d.Name] = struct{}{}
+	}
+	return &l, nil
+}
+
+// Marshal encodes the device list sorted by the device name, so that the same devices always produce the same bytes.
+func (l *DeviceList) Marshal() ([]byte, error) {
+	devices := make([]Device, len(l.Devices))
+	copy(devices, l.Devices)
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Name < devices[j].Name })
+	buf, err := json.MarshalIndent(&DeviceList{Devices: devices}, "", "  ")
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("encode device list: %w", err))
+	}
+	return buf, nil
+}
@@ -0,0 +1,106 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package subscriber_test
+
+import (
+	"testing"
+
+	"github.com/nttcom/kuesta/pkg/subscriber"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDeviceList(t *testing.T) {
+	tests := []struct {
+		name    string
+		given   string
+		want    *subscriber.DeviceList
+		wantErr bool
+	}{
+		{
+			"ok",
+			`{"devices": [{"name": "device1", "addr": "10.0.0.1:9339", "paths": ["/interfaces"], "mode": "ON_CHANGE"}]}`,
+			&subscriber.DeviceList{Devices: []subscriber.Device{
+				{Name: "device1", Addr: "10.0.0.1:9339", Paths: []string{"/interfaces"}, Mode: "ON_CHANGE"},
+			}},
+			false,
+		},
+		{
+			"ok: empty",
+			`{"devices": []}`,
+			&subscriber.DeviceList{Devices: []subscriber.Device{}},
+			false,
+		},
+		{
+			"err: name is empty",
+			`{"devices": [{"addr": "10.0.0.1:9339"}]}`,
+			nil,
+			true,
+		},
+		{
+			"err: addr is empty",
+			`{"devices": [{"name": "device1"}]}`,
+			nil,
+			true,
+		},
+		{
+			"err: duplicated",
+			`{"devices": [{"name": "device1", "addr": ":9339"}, {"name": "device1", "addr": ":9340"}]}`,
+			nil,
+			true,
+		},
+		{
+			"err: invalid json",
+			`{"devices": [`,
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := subscriber.ParseDeviceList([]byte(tt.given))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDeviceList_Marshal(t *testing.T) {
+	l := &subscriber.DeviceList{Devices: []subscriber.Device{
+		{Name: "device2", Addr: ":9340"},
+		{Name: "device1", Addr: ":9339"},
+	}}
+	buf, err := l.Marshal()
+	testhelper.ExitOnErr(t, err)
+
+	got, err := subscriber.ParseDeviceList(buf)
+	testhelper.ExitOnErr(t, err)
+	assert.Equal(t, []string{"device1", "device2"}, []string{got.Devices[0].Name, got.Devices[1].Name})
+	// the original order is kept
+	assert.Equal(t, "device2", l.Devices[0].Name)
+}
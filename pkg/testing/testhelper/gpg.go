@@ -0,0 +1,61 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package testhelper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+)
+
+// NewArmoredKeyPair generates OpenPGP key pair and returns the armored private and public keys.
+// The private key is encrypted if passphrase is given.
+func NewArmoredKeyPair(t *testing.T, passphrase string) (string, string) {
+	t.Helper()
+	e, err := openpgp.NewEntity("kuesta", "", "kuesta@example.com", nil)
+	ExitOnErr(t, err)
+
+	var pubBuf bytes.Buffer
+	w, err := armor.Encode(&pubBuf, openpgp.PublicKeyType, nil)
+	ExitOnErr(t, err)
+	ExitOnErr(t, e.Serialize(w))
+	ExitOnErr(t, w.Close())
+
+	var privBuf bytes.Buffer
+	w, err = armor.Encode(&privBuf, openpgp.PrivateKeyType, nil)
+	ExitOnErr(t, err)
+	if passphrase == "" {
+		ExitOnErr(t, e.SerializePrivate(w, nil))
+	} else {
+		ExitOnErr(t, e.PrivateKey.Encrypt([]byte(passphrase)))
+		for _, sub := range e.Subkeys {
+			ExitOnErr(t, sub.PrivateKey.Encrypt([]byte(passphrase)))
+		}
+		ExitOnErr(t, e.SerializePrivateWithoutSigning(w, nil))
+	}
+	ExitOnErr(t, w.Close())
+
+	return privBuf.String(), pubBuf.String()
+}
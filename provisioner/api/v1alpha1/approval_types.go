@@ -0,0 +1,70 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="ROLLOUT",type="string",JSONPath=`.spec.rolloutName`
+//+kubebuilder:printcolumn:name="REVISION",type="string",JSONPath=`.spec.revision`
+//+kubebuilder:printcolumn:name="APPROVER",type="string",JSONPath=`.spec.approver`
+
+// Approval is the Schema for the approvals API. It approves a DeviceRollout requiring approval to provision the
+// devices with the given revision.
+type Approval struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ApprovalSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ApprovalList contains a list of Approval.
+type ApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Approval `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Approval{}, &ApprovalList{})
+}
+
+// ApprovalSpec defines the desired state of Approval.
+type ApprovalSpec struct {
+	// RolloutName is the name of the DeviceRollout to be approved in the same namespace
+	// +kubebuilder:validation:MinLength=1
+	RolloutName string `json:"rolloutName"`
+
+	// Revision is the git revision to be approved
+	// +kubebuilder:validation:MinLength=1
+	Revision string `json:"revision"`
+
+	// Approver is the name of the approver. It is recorded as given, so it does not prove who approved the revision.
+	// Restrict who can create Approvals with RBAC to control who can approve
+	// +kubebuilder:validation:MinLength=1
+	Approver string `json:"approver"`
+}
@@ -23,13 +23,34 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"path"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
+	// Deprecated: AnnKeyResetStatus is not handled by any controller. Use AnnKeyRetry or AnnKeyAbort instead.
 	AnnKeyResetStatus = "kuesta.hrk091.dev/reset"
+
+	// AnnKeyAbort is the annotation to request to abort the running transaction. The request is handled once per
+	// the annotation value, such as the requested time.
+	AnnKeyAbort = "kuesta.hrk091.dev/abort"
+	// AnnKeyRetry is the annotation to request to retry provisioning the failed devices. The request is handled once
+	// per the annotation value, such as the requested time.
+	AnnKeyRetry = "kuesta.hrk091.dev/retry"
+	// AnnKeyApprove is the annotation to approve the revision waiting for approval. The value is the revision.
+	AnnKeyApprove = "kuesta.hrk091.dev/approve"
+	// AnnKeyApprover is the annotation to give the name of the approver along with AnnKeyApprove. The name is
+	// recorded as given, so it does not prove who approved the revision.
+	AnnKeyApprover = "kuesta.hrk091.dev/approver"
 )
 
 //+kubebuilder:object:root=true
@@ -37,6 +58,11 @@ const (
 //+kubebuilder:resource:shortName="dr"
 //+kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=`.status.phase`
 //+kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=`.status.status`
+//+kubebuilder:printcolumn:name="PAUSED",type="boolean",JSONPath=`.spec.paused`,priority=1
+//+kubebuilder:printcolumn:name="PINNED",type="string",JSONPath=`.spec.pinnedRevision`,priority=1
+//+kubebuilder:printcolumn:name="SOURCE",type="string",JSONPath=`.spec.source.kind`,priority=1
+//+kubebuilder:printcolumn:name="READY",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="REASON",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,priority=1
 
 // DeviceRollout is the Schema for the devicerollouts API.
 type DeviceRollout struct {
@@ -62,8 +88,283 @@ func init() {
 
 // DeviceRolloutSpec defines the desired state of DeviceRollout.
 type DeviceRolloutSpec struct {
-	// DeviceConfigMap is a map to bind device name and DeviceConfig to be provisioned
+	// DeviceConfigMap is a map to bind device name and DeviceConfig to be provisioned. It is built from the source
+	// by the provisioner, and can be left empty on creation
+	// +optional
 	DeviceConfigMap DeviceConfigMap `json:"deviceConfigMap"`
+
+	// Strategy is the strategy to provision the devices in a transaction. All the devices are provisioned at once
+	// if not set
+	// +optional
+	Strategy RolloutStrategy `json:"strategy,omitempty"`
+
+	// Paused stops starting new transactions and new batches. The devices in progress are still provisioned
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// SkipDevices is a list of the names of the devices excluded from the transactions. They are left as they are,
+	// and do not block the transactions even if they are in progress or failed
+	// +optional
+	SkipDevices []string `json:"skipDevices,omitempty"`
+
+	// PinnedRevision is the git revision from which the device configs are built instead of the latest revision of
+	// the source. It is given in the form of `<ref>/<commit sha>` or `<commit sha>`. Only supported by the
+	// GitRepository and Git sources
+	// +optional
+	PinnedRevision string `json:"pinnedRevision,omitempty"`
+
+	// HistoryLimit is the max number of the finished transactions kept in the history. DefaultHistoryLimit is used
+	// if not set
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	HistoryLimit int `json:"historyLimit,omitempty"`
+
+	// MaintenanceWindows restricts when the devices are provisioned. The devices which the windows apply to are
+	// started to be provisioned only within one of the windows, and the others are not restricted. The rollback is
+	// not restricted so that the devices are restored as soon as possible
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// RequireApproval makes a new revision wait in the PendingApproval phase until it is approved by the approve
+	// annotation or an Approval referencing the revision
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+
+	// Source is the source of the config repository from which the device configs are built. The GitRepository
+	// named after the DeviceRollout is used if not set
+	// +optional
+	Source *SourceReference `json:"source,omitempty"`
+}
+
+// SourceReference specifies where to fetch the config repository from.
+type SourceReference struct {
+	// Kind is the kind of the source. GitRepository, OCIRepository and Bucket are Flux source objects, and Git
+	// clones the git repository directly without Flux
+	// +kubebuilder:validation:Enum=GitRepository;OCIRepository;Bucket;Git
+	// +kubebuilder:default=GitRepository
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the OCIRepository or Bucket in the same namespace. The name of the DeviceRollout is used if
+	// not set. GitRepository is always the one named after the DeviceRollout
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// URL is the url of the git repository. Required for Git
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Branch is the branch of the git repository to follow. Only used for Git, and main is used if not set
+	// +optional
+	Branch string `json:"branch,omitempty"`
+
+	// SecretName is the name of the Secret in the same namespace which holds the credentials of the git repository,
+	// in the same format as the one of GitRepository. Only used for Git
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Interval is the interval to check the git repository for new revisions. Only used for Git, and
+	// DefaultSourceInterval is used if not set
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// Kinds of the source of the config repository.
+const (
+	SourceKindGitRepository = "GitRepository"
+	SourceKindOCIRepository = "OCIRepository"
+	SourceKindBucket        = "Bucket"
+	SourceKindGit           = "Git"
+)
+
+// DefaultSourceInterval is the interval to check the git repository for new revisions by default.
+const DefaultSourceInterval = time.Minute
+
+// SourceKind returns the kind of the source of the config repository.
+func (dr *DeviceRollout) SourceKind() string {
+	if dr.Spec.Source == nil || dr.Spec.Source.Kind == "" {
+		return SourceKindGitRepository
+	}
+	return dr.Spec.Source.Kind
+}
+
+// SourceName returns the name of the Flux source object of the config repository.
+func (dr *DeviceRollout) SourceName() string {
+	if dr.SourceKind() == SourceKindGitRepository || dr.Spec.Source.Name == "" {
+		return dr.Name
+	}
+	return dr.Spec.Source.Name
+}
+
+// SourceInterval returns the interval to check the git repository for new revisions.
+func (dr *DeviceRollout) SourceInterval() time.Duration {
+	if dr.Spec.Source == nil || dr.Spec.Source.Interval == nil || dr.Spec.Source.Interval.Duration <= 0 {
+		return DefaultSourceInterval
+	}
+	return dr.Spec.Source.Interval.Duration
+}
+
+// MaintenanceWindow is a recurring period in which the devices are allowed to be provisioned.
+type MaintenanceWindow struct {
+	// Devices is a list of the names or the glob patterns of the devices which the window applies to. The window
+	// applies to all the devices if empty
+	// +optional
+	Devices []string `json:"devices,omitempty"`
+
+	// Schedule is the cron expression of the start time of the window, such as `0 2 * * SAT`. The time zone can be
+	// given with `CRON_TZ=` prefix, otherwise UTC is used. The window never opens if invalid
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is the length of the window
+	Duration metav1.Duration `json:"duration"`
+}
+
+// appliesTo returns true if the window applies to the given device.
+func (w *MaintenanceWindow) appliesTo(name string) bool {
+	if len(w.Devices) == 0 {
+		return true
+	}
+	for _, pattern := range w.Devices {
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// contains returns true if the given time is within the window.
+func (w *MaintenanceWindow) contains(now time.Time) bool {
+	sched, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return false
+	}
+	// NOTE the window which starts after now minus the duration is open now
+	start := sched.Next(now.UTC().Add(-w.Duration.Duration))
+	return !start.After(now)
+}
+
+// nextAfter returns the duration until the window opens next time, or 0 if never.
+func (w *MaintenanceWindow) nextAfter(now time.Time) time.Duration {
+	sched, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return 0
+	}
+	next := sched.Next(now.UTC())
+	if next.IsZero() {
+		return 0
+	}
+	return next.Sub(now)
+}
+
+// InMaintenanceWindow returns true if the device is allowed to be provisioned at the given time.
+func (spec *DeviceRolloutSpec) InMaintenanceWindow(name string, now time.Time) bool {
+	restricted := false
+	for i := range spec.MaintenanceWindows {
+		w := &spec.MaintenanceWindows[i]
+		if !w.appliesTo(name) {
+			continue
+		}
+		if w.contains(now) {
+			return true
+		}
+		restricted = true
+	}
+	return !restricted
+}
+
+// nextWindowAfter returns the duration until any window of the device opens next time, or 0 if never.
+func (spec *DeviceRolloutSpec) nextWindowAfter(name string, now time.Time) time.Duration {
+	var next time.Duration
+	for i := range spec.MaintenanceWindows {
+		w := &spec.MaintenanceWindows[i]
+		if !w.appliesTo(name) {
+			continue
+		}
+		if d := w.nextAfter(now); d > 0 && (next == 0 || d < next) {
+			next = d
+		}
+	}
+	return next
+}
+
+// DefaultHistoryLimit is the max number of the finished transactions kept in the history by default.
+const DefaultHistoryLimit = 10
+
+// RolloutStrategy defines how the devices are divided into the batches provisioned one after another.
+type RolloutStrategy struct {
+	// Canary is a list of the names or the glob patterns of the devices provisioned first as a batch of their own
+	// +optional
+	Canary []string `json:"canary,omitempty"`
+
+	// MaxParallel is the max number of the devices provisioned in a batch, given as an absolute number or a
+	// percentage of all the devices. All the devices except canaries are provisioned in a batch if not set
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MaxParallel *intstr.IntOrString `json:"maxParallel,omitempty"`
+
+	// Pause is the duration to wait after a batch is completed before starting the next batch
+	// +optional
+	Pause metav1.Duration `json:"pause,omitempty"`
+
+	// MaxFailures is the number of the failed devices tolerated in a transaction. The transaction is rolled back
+	// when more devices are failed
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxFailures int `json:"maxFailures,omitempty"`
+
+	// DeviceTimeout is the duration to wait for each device to be provisioned. The device which does not complete
+	// provisioning in time is marked as Timeout, which counts as a failure. No timeout is applied if not set
+	// +optional
+	DeviceTimeout metav1.Duration `json:"deviceTimeout,omitempty"`
+}
+
+// Batches divides the given devices into the batches according to the strategy. The canaries come first, and the
+// rest are divided in the name order.
+func (st *RolloutStrategy) Batches(devices []string) [][]string {
+	names := append([]string{}, devices...)
+	sort.Strings(names)
+
+	var batches [][]string
+	var canary, rest []string
+	for _, name := range names {
+		if st.isCanary(name) {
+			canary = append(canary, name)
+		} else {
+			rest = append(rest, name)
+		}
+	}
+	if len(canary) > 0 {
+		batches = append(batches, canary)
+	}
+
+	size := len(rest)
+	if st.MaxParallel != nil {
+		// NOTE percentage is rounded up so that at least one device is provisioned
+		if v, err := intstr.GetScaledValueFromIntOrPercent(st.MaxParallel, len(names), true); err == nil && v > 0 {
+			size = v
+		} else {
+			size = 1
+		}
+	}
+	for len(rest) > 0 {
+		n := size
+		if n > len(rest) {
+			n = len(rest)
+		}
+		batches = append(batches, rest[:n])
+		rest = rest[n:]
+	}
+	return batches
+}
+
+func (st *RolloutStrategy) isCanary(name string) bool {
+	for _, pattern := range st.Canary {
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
 }
 
 // DeviceConfig provides a digest and other required info of the device config to be provisioned.
@@ -81,6 +382,19 @@ func (m DeviceConfigMap) Equal(o DeviceConfigMap) bool {
 	return reflect.DeepEqual(m, o)
 }
 
+// Revision returns the git revision shared by all the device configs, or empty if they are provided from
+// different revisions.
+func (m DeviceConfigMap) Revision() string {
+	rev := ""
+	for _, c := range m {
+		if rev != "" && rev != c.GitRevision {
+			return ""
+		}
+		rev = c.GitRevision
+	}
+	return rev
+}
+
 // DeviceRolloutStatus defines the observed state of DeviceRollout.
 type DeviceRolloutStatus struct {
 	// Phase is the rollout phase
@@ -102,8 +416,171 @@ type DeviceRolloutStatus struct {
 	// DesiredDeviceConfigMap represents the desired device configs to be provisioned in the current transaction
 	// +optional
 	DesiredDeviceConfigMap DeviceConfigMap `json:"desiredDeviceConfigMap,omitempty"`
+
+	// Conditions represent the latest available observations of the DeviceRollout's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// ObservedGeneration is the generation of the DeviceRollout observed when the conditions are updated
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Batches is the device names divided into the batches provisioned one after another in the current transaction
+	// +optional
+	Batches [][]string `json:"batches,omitempty"`
+
+	// CurrentBatch is the index of the batch in progress
+	// +optional
+	CurrentBatch int `json:"currentBatch,omitempty"`
+
+	// NextBatchAt is the time after which the next batch is started
+	// +optional
+	NextBatchAt *metav1.Time `json:"nextBatchAt,omitempty"`
+
+	// DeviceStartedAt is the time when each running device is started to be provisioned in the current transaction.
+	// It is recorded only if the device timeout is set
+	// +optional
+	DeviceStartedAt map[string]metav1.Time `json:"deviceStartedAt,omitempty"`
+
+	// LastHandledAbort is the value of the abort annotation handled last
+	// +optional
+	LastHandledAbort string `json:"lastHandledAbort,omitempty"`
+
+	// LastHandledRetry is the value of the retry annotation handled last
+	// +optional
+	LastHandledRetry string `json:"lastHandledRetry,omitempty"`
+
+	// LastHandledApprove is the value of the approve annotation handled last
+	// +optional
+	LastHandledApprove string `json:"lastHandledApprove,omitempty"`
+
+	// TxStartedAt is the time when the current transaction is started
+	// +optional
+	TxStartedAt *metav1.Time `json:"txStartedAt,omitempty"`
+
+	// History is the finished transactions in the order of finished time, up to the history limit
+	// +optional
+	History []RolloutHistory `json:"history,omitempty"`
+
+	// ApprovedRevision is the revision approved last
+	// +optional
+	ApprovedRevision string `json:"approvedRevision,omitempty"`
+
+	// ApprovedBy is the name of the approver of the approved revision. It is self-declared in the approve annotation
+	// or the Approval, and does not prove who approved it
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+
+	// ArtifactChecksums is the checksums of the artifacts of the revisions seen by the provisioner lately, up to the
+	// history limit. The artifacts of the revisions other than the latest one are verified with them
+	// +optional
+	ArtifactChecksums []ArtifactChecksum `json:"artifactChecksums,omitempty"`
+}
+
+// ArtifactChecksum is the checksum of the artifact of a revision.
+type ArtifactChecksum struct {
+	// Revision is the revision of the artifact
+	Revision string `json:"revision"`
+
+	// Checksum is the SHA256 checksum of the artifact
+	Checksum string `json:"checksum"`
+}
+
+// RolloutHistory is the record of a finished transaction.
+type RolloutHistory struct {
+	// Revision is the git revision of the device configs provisioned in the transaction. It is empty if the device
+	// configs are provided from different revisions
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// Phase is the rollout phase of the transaction
+	Phase RolloutPhase `json:"phase"`
+
+	// Status is the rollout status with which the transaction is finished
+	Status RolloutStatus `json:"status"`
+
+	// StartedAt is the time when the transaction is started
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// FinishedAt is the time when the transaction is finished
+	FinishedAt metav1.Time `json:"finishedAt"`
+
+	// DeviceStatusMap is the device statuses when the transaction is finished
+	// +optional
+	DeviceStatusMap map[string]DeviceStatus `json:"deviceStatusMap,omitempty"`
 }
 
+const (
+	// ConditionTypeVerified indicates whether the commit signature of the latest source revision is verified.
+	ConditionTypeVerified = "Verified"
+	// ConditionTypeReady indicates whether all the devices are provisioned with the desired device configs.
+	ConditionTypeReady = "Ready"
+	// ConditionTypeProgressing indicates whether a transaction or its rollback is in progress.
+	ConditionTypeProgressing = "Progressing"
+	// ConditionTypeDegraded indicates whether the last transaction is failed, or is completed with the failed devices
+	// within the failure budget.
+	ConditionTypeDegraded = "Degraded"
+	// ConditionTypeRolledBack indicates whether the devices are rolled back to the previous device configs.
+	ConditionTypeRolledBack = "RolledBack"
+	// ConditionTypeSourceAvailable indicates whether the device configs of the revision to roll out are available
+	// from the source.
+	ConditionTypeSourceAvailable = "SourceAvailable"
+
+	// ReasonTransactionStarted indicates a transaction to provision the desired device configs is started.
+	ReasonTransactionStarted = "TransactionStarted"
+	// ReasonTransactionCompleted indicates all the devices are provisioned with the desired device configs.
+	ReasonTransactionCompleted = "TransactionCompleted"
+	// ReasonTransactionCompletedWithFailures indicates the transaction is completed while one or more devices are
+	// failed to be provisioned within the failure budget.
+	ReasonTransactionCompletedWithFailures = "TransactionCompletedWithFailures"
+	// ReasonTransactionFailed indicates one or more devices are failed to be provisioned.
+	ReasonTransactionFailed = "TransactionFailed"
+	// ReasonRollbackStarted indicates a rollback to the previous device configs is started.
+	ReasonRollbackStarted = "RollbackStarted"
+	// ReasonRollbackCompleted indicates all the devices are rolled back to the previous device configs.
+	ReasonRollbackCompleted = "RollbackCompleted"
+	// ReasonRollbackFailed indicates one or more devices are failed to be rolled back. Manual recover is needed.
+	ReasonRollbackFailed = "RollbackFailed"
+	// ReasonDeviceProvisioned indicates a device is provisioned with the device config.
+	ReasonDeviceProvisioned = "DeviceProvisioned"
+	// ReasonDeviceFailed indicates a device is failed to be provisioned.
+	ReasonDeviceFailed = "DeviceFailed"
+	// ReasonChecksumMismatch indicates the checksum of the fetched device config is different from the desired one.
+	ReasonChecksumMismatch = "ChecksumMismatch"
+	// ReasonBatchStarted indicates the next batch of the devices is started to be provisioned.
+	ReasonBatchStarted = "BatchStarted"
+	// ReasonDeviceTimeout indicates a device does not complete provisioning within the device timeout.
+	ReasonDeviceTimeout = "DeviceTimeout"
+	// ReasonPaused indicates the rollout is paused.
+	ReasonPaused = "Paused"
+	// ReasonAborted indicates the transaction is aborted and the devices are left as they are.
+	ReasonAborted = "Aborted"
+	// ReasonRetryStarted indicates the failed devices are started to be provisioned again.
+	ReasonRetryStarted = "RetryStarted"
+	// ReasonPendingApproval indicates the new revision waits for approval.
+	ReasonPendingApproval = "PendingApproval"
+	// ReasonApproved indicates the revision is approved.
+	ReasonApproved = "Approved"
+
+	// ReasonSignatureVerified indicates the commit signature is verified with the trusted keys.
+	ReasonSignatureVerified = "SignatureVerified"
+	// ReasonSignatureVerificationFailed indicates the commit signature is missing or not verified with the trusted keys.
+	ReasonSignatureVerificationFailed = "SignatureVerificationFailed"
+	// ReasonRevisionFetched indicates the device configs of the revision are fetched from the source.
+	ReasonRevisionFetched = "RevisionFetched"
+	// ReasonUnknownRevision indicates the artifact of the revision, such as the pinned one, is not available from
+	// the source.
+	ReasonUnknownRevision = "UnknownRevision"
+	// ReasonSourceNotVerifiable indicates the commit signature cannot be verified since the source does not provide
+	// the git commits, such as OCIRepository and Bucket.
+	ReasonSourceNotVerifiable = "SourceNotVerifiable"
+)
+
 // RolloutPhase are a set of rollout phases.
 type RolloutPhase string
 
@@ -112,6 +589,8 @@ const (
 	RolloutPhaseHealthy RolloutPhase = "Healthy"
 	// RolloutPhaseRollback indicates a rollout is degraded and under rollback.
 	RolloutPhaseRollback RolloutPhase = "Rollback"
+	// RolloutPhasePendingApproval indicates a new revision waits for approval before starting a transaction.
+	RolloutPhasePendingApproval RolloutPhase = "PendingApproval"
 )
 
 // RolloutStatus are a set of rollout progress.
@@ -127,6 +606,9 @@ const (
 	// RolloutStatusFailed indicates that a transaction is failed and stopped.
 	// Manual recover is needed to start next transaction.
 	RolloutStatusFailed RolloutStatus = "Failed"
+
+	// RolloutStatusAborted indicates that a transaction is aborted by the user and the devices are left as they are.
+	RolloutStatusAborted RolloutStatus = "Aborted"
 )
 
 // DeviceStatus are a set of rollout progress.
@@ -136,6 +618,9 @@ const (
 	// DeviceStatusRunning indicates that a transaction is in progress.
 	DeviceStatusRunning DeviceStatus = "Running"
 
+	// DeviceStatusPending indicates that the device waits for its batch or its maintenance window to be started.
+	DeviceStatusPending DeviceStatus = "Pending"
+
 	// DeviceStatusCompleted indicates provision is completed.
 	DeviceStatusCompleted DeviceStatus = "Completed"
 
@@ -145,6 +630,15 @@ const (
 	// DeviceStatusConnectionError indicates that provision is failed due to connection error.
 	DeviceStatusConnectionError DeviceStatus = "ConnectionError"
 
+	// DeviceStatusTimeout indicates that provision is not completed within the device timeout.
+	DeviceStatusTimeout DeviceStatus = "Timeout"
+
+	// DeviceStatusSkipped indicates that the device is excluded from the transaction by the user.
+	DeviceStatusSkipped DeviceStatus = "Skipped"
+
+	// DeviceStatusAborted indicates that provision is not completed since the transaction is aborted.
+	DeviceStatusAborted DeviceStatus = "Aborted"
+
 	// DeviceStatusPurged indicates that the device is not included in desired state.
 	DeviceStatusPurged DeviceStatus = "Purged"
 
@@ -155,6 +649,16 @@ const (
 	DeviceStatusChecksumError DeviceStatus = "ConnectionError"
 )
 
+// IsFailed returns true when the device status is `Failed`, `ConnectionError` or `Timeout`.
+func (s DeviceStatus) IsFailed() bool {
+	return s == DeviceStatusFailed || s == DeviceStatusConnectionError || s == DeviceStatusTimeout
+}
+
+// isExcluded returns true when the device status is not taken into account for the transaction status.
+func (s DeviceStatus) isExcluded() bool {
+	return s == DeviceStatusPurged || s == DeviceStatusSkipped
+}
+
 // IsRunning returns true when rollout status is in `Running`.
 func (s *DeviceRolloutStatus) IsRunning() bool {
 	return s.Status == RolloutStatusRunning
@@ -166,7 +670,7 @@ func (s *DeviceRolloutStatus) IsTxCompleted() bool {
 		return false
 	}
 	for _, v := range s.DeviceStatusMap {
-		if v == DeviceStatusPurged {
+		if v.isExcluded() {
 			continue
 		}
 		// TODO remove device completed from transaction completion condition
@@ -177,16 +681,16 @@ func (s *DeviceRolloutStatus) IsTxCompleted() bool {
 	return true
 }
 
-// IsTxFailed returns true when one or more device statuses are `Failed` or `ConnectionError`.
+// IsTxFailed returns true when one or more device statuses are `Failed`, `ConnectionError` or `Timeout`.
 func (s *DeviceRolloutStatus) IsTxFailed() bool {
 	if s.DeviceStatusMap == nil {
 		return false
 	}
 	for _, v := range s.DeviceStatusMap {
-		if v == DeviceStatusPurged {
+		if v.isExcluded() {
 			continue
 		}
-		if v == DeviceStatusFailed || v == DeviceStatusConnectionError {
+		if v.IsFailed() {
 			return true
 		}
 	}
@@ -199,7 +703,7 @@ func (s *DeviceRolloutStatus) IsTxRunning() bool {
 		return false
 	}
 	for _, v := range s.DeviceStatusMap {
-		if v == DeviceStatusPurged {
+		if v.isExcluded() {
 			continue
 		}
 		if v == DeviceStatusRunning {
@@ -215,7 +719,7 @@ func (s *DeviceRolloutStatus) IsTxIdle() bool {
 		return false
 	}
 	for _, v := range s.DeviceStatusMap {
-		if v == DeviceStatusPurged {
+		if v.isExcluded() {
 			continue
 		}
 		if v == DeviceStatusRunning {
@@ -225,8 +729,12 @@ func (s *DeviceRolloutStatus) IsTxIdle() bool {
 	return true
 }
 
-// StartTx initializes device transaction statuses.
+// StartTx initializes device transaction statuses to provision all the devices at once.
 func (s *DeviceRolloutStatus) StartTx() {
+	s.Batches = nil
+	s.CurrentBatch = 0
+	s.NextBatchAt = nil
+	s.DeviceStartedAt = nil
 	if s.DeviceStatusMap == nil {
 		s.DeviceStatusMap = map[string]DeviceStatus{}
 	}
@@ -241,6 +749,152 @@ func (s *DeviceRolloutStatus) StartTx() {
 	}
 }
 
+// StartBatchedTx initializes device transaction statuses to provision the devices batch by batch according to the
+// strategy. Only the devices in the first batch are marked as running, and the others are pending.
+func (s *DeviceRolloutStatus) StartBatchedTx(strategy *RolloutStrategy) {
+	s.startBatchedTx(strategy, nil)
+}
+
+// startBatchedTx is the same as StartBatchedTx except that the given devices are marked as completed without being
+// provisioned, and excluded from the batches.
+func (s *DeviceRolloutStatus) startBatchedTx(strategy *RolloutStrategy, completed map[string]struct{}) {
+	names := make([]string, 0, len(s.DesiredDeviceConfigMap))
+	for k := range s.DesiredDeviceConfigMap {
+		if _, ok := completed[k]; !ok {
+			names = append(names, k)
+		}
+	}
+	batches := strategy.Batches(names)
+	s.StartTx()
+	for k := range completed {
+		s.DeviceStatusMap[k] = DeviceStatusCompleted
+	}
+	if len(batches) <= 1 {
+		return
+	}
+	s.Batches = batches
+	for _, batch := range batches[1:] {
+		for _, name := range batch {
+			s.DeviceStatusMap[name] = DeviceStatusPending
+		}
+	}
+}
+
+// UnchangedDevices returns the sorted names of the devices whose desired device config has the same checksum as the
+// previous one. The device without checksum is regarded as changed.
+func (s *DeviceRolloutStatus) UnchangedDevices() []string {
+	var names []string
+	for k, v := range s.DesiredDeviceConfigMap {
+		if p, ok := s.PrevDeviceConfigMap[k]; ok && v.Checksum != "" && v.Checksum == p.Checksum {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NextBatchAfter returns the duration to wait before starting the next batch, or 0 if no batch is waiting.
+func (s *DeviceRolloutStatus) NextBatchAfter(now time.Time) time.Duration {
+	if s.NextBatchAt == nil || !now.Before(s.NextBatchAt.Time) {
+		return 0
+	}
+	return s.NextBatchAt.Sub(now)
+}
+
+// NextDeadlineAfter returns the duration until the earliest deadline of the running devices, or 0 if no device
+// has the deadline.
+func (s *DeviceRolloutStatus) NextDeadlineAfter(now time.Time, timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return 0
+	}
+	var next time.Duration
+	for name, startedAt := range s.DeviceStartedAt {
+		if s.DeviceStatusMap[name] != DeviceStatusRunning {
+			continue
+		}
+		if d := startedAt.Add(timeout).Sub(now); d > 0 && (next == 0 || d < next) {
+			next = d
+		}
+	}
+	return next
+}
+
+// RequeueAfter returns the duration after which the status must be updated again to start the next batch, to
+// expire the running devices or to start the devices in the maintenance window, or 0 if not needed.
+func (dr *DeviceRollout) RequeueAfter(now time.Time) time.Duration {
+	next := dr.Status.NextBatchAfter(now)
+	if d := dr.Status.NextDeadlineAfter(now, dr.Spec.Strategy.DeviceTimeout.Duration); d > 0 && (next == 0 || d < next) {
+		next = d
+	}
+	if d := dr.nextWindowAfter(now); d > 0 && (next == 0 || d < next) {
+		next = d
+	}
+	return next
+}
+
+// nextWindowAfter returns the duration until the earliest maintenance window of the devices waiting in the current
+// batch opens, or 0 if no device waits.
+func (dr *DeviceRollout) nextWindowAfter(now time.Time) time.Duration {
+	if dr.Status.Status != RolloutStatusRunning {
+		return 0
+	}
+	var next time.Duration
+	for _, name := range dr.Status.waitingDevices() {
+		if d := dr.Spec.nextWindowAfter(name, now); d > 0 && (next == 0 || d < next) {
+			next = d
+		}
+	}
+	return next
+}
+
+// ExpireDevices marks the running devices which exceed the device timeout as Timeout, and returns their names.
+// It is also done by UpdateStatus, though the device statuses are reset if the transaction is rolled back.
+func (dr *DeviceRollout) ExpireDevices(now time.Time) []string {
+	if dr.Status.Status != RolloutStatusRunning {
+		return nil
+	}
+	return dr.Status.trackDevices(now, dr.Spec.Strategy.DeviceTimeout.Duration)
+}
+
+// trackDevices records the start time of the devices which are newly running, and marks the running devices which
+// exceed the timeout as Timeout. It returns the sorted names of the devices marked as Timeout. The start time is not
+// recorded if no timeout is applied.
+func (s *DeviceRolloutStatus) trackDevices(now time.Time, timeout time.Duration) []string {
+	if timeout <= 0 {
+		s.DeviceStartedAt = nil
+		return nil
+	}
+	var expired []string
+	for name := range s.DeviceStartedAt {
+		if s.DeviceStatusMap[name] != DeviceStatusRunning {
+			delete(s.DeviceStartedAt, name)
+		}
+	}
+	for name, v := range s.DeviceStatusMap {
+		if v != DeviceStatusRunning {
+			continue
+		}
+		startedAt, ok := s.DeviceStartedAt[name]
+		if !ok {
+			if s.DeviceStartedAt == nil {
+				s.DeviceStartedAt = map[string]metav1.Time{}
+			}
+			s.DeviceStartedAt[name] = metav1.NewTime(now)
+			continue
+		}
+		if !now.Before(startedAt.Add(timeout)) {
+			s.DeviceStatusMap[name] = DeviceStatusTimeout
+			delete(s.DeviceStartedAt, name)
+			expired = append(expired, name)
+		}
+	}
+	if len(s.DeviceStartedAt) == 0 {
+		s.DeviceStartedAt = nil
+	}
+	sort.Strings(expired)
+	return expired
+}
+
 // ResolveNextDeviceConfig returns the next device config to transition to according to the current RolloutPhase.
 func (s *DeviceRolloutStatus) ResolveNextDeviceConfig(name string) *DeviceConfig {
 	if s.Phase == "" {
@@ -285,46 +939,496 @@ func (s *DeviceRolloutStatus) SetDeviceStatus(name string, status DeviceStatus)
 // Devices statuses are updated by respective device driver(operator) and this function will aggregate
 // these remote device statuses into one summarized transaction status.
 func (dr *DeviceRollout) UpdateStatus() bool {
+	return dr.UpdateStatusAt(time.Now())
+}
+
+// UpdateStatusAt is the same as UpdateStatus except that the next batch is started based on the given time.
+func (dr *DeviceRollout) UpdateStatusAt(now time.Time) bool {
 	prev := dr.Status.DeepCopy()
+	timeout := dr.Spec.Strategy.DeviceTimeout.Duration
+	dr.handleRequests(now)
 	if dr.Status.Status == RolloutStatusRunning {
-		dr.updateOnRunning()
+		dr.skipDevices()
+		dr.admitDevices(now)
+		dr.Status.trackDevices(now, timeout)
+		dr.updateOnRunning(now)
 	} else {
-		dr.updateOnIdle()
+		dr.updateOnIdle(now)
+	}
+	if dr.Status.Status == RolloutStatusRunning {
+		// NOTE apply to the devices started by this update
+		dr.skipDevices()
+		dr.Status.trackDevices(now, timeout)
 	}
 	return prev.Status != dr.Status.Status || prev.Phase != dr.Status.Phase
 }
 
-func (dr *DeviceRollout) updateOnRunning() {
+// handleRequests handles the abort, retry and approval requested by the annotations. Abort takes precedence if both
+// abort and retry are requested at once. The approval is handled only for the revision waiting for approval, so that
+// the annotation left for the older revision does not override the approval by an Approval.
+func (dr *DeviceRollout) handleRequests(now time.Time) {
+	s := &dr.Status
+	if v := dr.Annotations[AnnKeyAbort]; v != "" && v != s.LastHandledAbort {
+		s.LastHandledAbort = v
+		if s.Status == RolloutStatusRunning {
+			dr.abort(now)
+		}
+	}
+	if v := dr.Annotations[AnnKeyRetry]; v != "" && v != s.LastHandledRetry {
+		s.LastHandledRetry = v
+		if s.Status != "" {
+			dr.retry(now)
+		}
+	}
+	if v := dr.Annotations[AnnKeyApprove]; v != "" && v != s.LastHandledApprove && v == s.PendingRevision() && v != s.ApprovedRevision {
+		s.LastHandledApprove = v
+		dr.Approve(v, dr.Annotations[AnnKeyApprover])
+	}
+}
+
+// Approve approves the given revision to be provisioned by the given approver.
+func (dr *DeviceRollout) Approve(revision, approver string) {
+	dr.Status.ApprovedRevision = revision
+	dr.Status.ApprovedBy = approver
+}
+
+// PendingRevision returns the revision waiting for approval, or empty if not waiting.
+func (s *DeviceRolloutStatus) PendingRevision() string {
+	if s.Phase != RolloutPhasePendingApproval {
+		return ""
+	}
+	return s.DesiredDeviceConfigMap.Revision()
+}
+
+// isApproved returns true if the desired revision is approved.
+func (s *DeviceRolloutStatus) isApproved() bool {
+	rev := s.DesiredDeviceConfigMap.Revision()
+	return rev != "" && rev == s.ApprovedRevision
+}
+
+// abort stops the running transaction leaving the devices as they are. The devices in progress are marked as
+// Aborted so that they are not provisioned anymore.
+func (dr *DeviceRollout) abort(now time.Time) {
+	s := &dr.Status
+	for name, v := range s.DeviceStatusMap {
+		if v == DeviceStatusRunning || v == DeviceStatusPending {
+			s.DeviceStatusMap[name] = DeviceStatusAborted
+		}
+	}
+	s.Status = RolloutStatusAborted
+	s.NextBatchAt = nil
+	s.DeviceStartedAt = nil
+	dr.recordHistory(now)
+}
+
+// retry starts provisioning again only the failed and the aborted devices in the current phase. The transaction is
+// resumed from the current batch, so that the aborted devices in the later batches wait for their batch again.
+func (dr *DeviceRollout) retry(now time.Time) {
+	s := &dr.Status
+	later := map[string]struct{}{}
+	for i := s.CurrentBatch + 1; i < len(s.Batches); i++ {
+		for _, name := range s.Batches[i] {
+			later[name] = struct{}{}
+		}
+	}
+	retried := false
+	for name, v := range s.DeviceStatusMap {
+		if !v.IsFailed() && v != DeviceStatusAborted {
+			continue
+		}
+		if _, ok := later[name]; ok && v == DeviceStatusAborted {
+			s.DeviceStatusMap[name] = DeviceStatusPending
+		} else {
+			s.DeviceStatusMap[name] = DeviceStatusRunning
+		}
+		retried = true
+	}
+	if !retried {
+		return
+	}
+	s.Status = RolloutStatusRunning
+	s.NextBatchAt = nil
+	s.DeviceStartedAt = nil
+	s.TxStartedAt = timePtr(now)
+	dr.holdDevices(now)
+}
+
+// skipDevices marks the devices in the skip list as Skipped unless they are completed or purged.
+func (dr *DeviceRollout) skipDevices() {
+	for _, name := range dr.Spec.SkipDevices {
+		v, ok := dr.Status.DeviceStatusMap[name]
+		if !ok || v == DeviceStatusCompleted || v == DeviceStatusPurged {
+			continue
+		}
+		dr.Status.DeviceStatusMap[name] = DeviceStatusSkipped
+	}
+}
+
+// admitDevices starts the devices waiting in the current batch whose maintenance window is open unless the rollout
+// is paused.
+func (dr *DeviceRollout) admitDevices(now time.Time) {
+	if dr.Status.Phase != RolloutPhaseHealthy || dr.Spec.Paused {
+		return
+	}
+	for _, name := range dr.Status.waitingDevices() {
+		if dr.Spec.InMaintenanceWindow(name, now) {
+			dr.Status.DeviceStatusMap[name] = DeviceStatusRunning
+		}
+	}
+}
+
+// holdDevices makes the devices just started in the current batch wait for their maintenance window if closed.
+func (dr *DeviceRollout) holdDevices(now time.Time) {
+	if dr.Status.Phase != RolloutPhaseHealthy || len(dr.Spec.MaintenanceWindows) == 0 {
+		return
+	}
+	for _, name := range dr.Status.currentBatch() {
+		if dr.Status.DeviceStatusMap[name] == DeviceStatusRunning && !dr.Spec.InMaintenanceWindow(name, now) {
+			dr.Status.DeviceStatusMap[name] = DeviceStatusPending
+		}
+	}
+}
+
+// currentBatch returns the names of the devices in the current batch, which are all the devices if not batched.
+func (s *DeviceRolloutStatus) currentBatch() []string {
+	if len(s.Batches) > 0 {
+		return s.Batches[s.CurrentBatch]
+	}
+	names := make([]string, 0, len(s.DeviceStatusMap))
+	for name := range s.DeviceStatusMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// waitingDevices returns the names of the devices waiting for their maintenance window in the current batch.
+func (s *DeviceRolloutStatus) waitingDevices() []string {
+	var names []string
+	for _, name := range s.currentBatch() {
+		if s.DeviceStatusMap[name] == DeviceStatusPending {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (dr *DeviceRollout) updateOnRunning(now time.Time) {
 	switch {
 	case dr.Status.IsTxCompleted():
 		dr.Status.Status = RolloutStatusCompleted
-	case dr.Status.IsTxFailed():
-		if dr.Status.Phase == RolloutPhaseHealthy {
-			dr.Status.Phase = RolloutPhaseRollback
-			dr.Status.Status = RolloutStatusRunning
-			dr.Status.StartTx()
-		} else {
-			dr.Status.Status = RolloutStatusFailed
+		dr.recordHistory(now)
+	case dr.Status.Phase == RolloutPhaseHealthy && len(dr.Status.FailedDevices()) > dr.Spec.Strategy.MaxFailures:
+		// NOTE the failed transaction is recorded before the device statuses are reset for the rollback
+		dr.Status.Status = RolloutStatusFailed
+		dr.recordHistory(now)
+		// the devices completed without change are already provisioned with the previous device configs
+		intact := map[string]struct{}{}
+		for _, name := range dr.Status.UnchangedDevices() {
+			if dr.Status.DeviceStatusMap[name] == DeviceStatusCompleted {
+				intact[name] = struct{}{}
+			}
+		}
+		// NOTE all the devices are rolled back at once
+		dr.Status.Phase = RolloutPhaseRollback
+		dr.Status.Status = RolloutStatusRunning
+		dr.Status.StartTx()
+		for name := range intact {
+			dr.Status.DeviceStatusMap[name] = DeviceStatusCompleted
 		}
+		dr.Status.TxStartedAt = timePtr(now)
+	case dr.Status.Phase == RolloutPhaseRollback && dr.Status.IsTxFailed():
+		dr.Status.Status = RolloutStatusFailed
+		dr.recordHistory(now)
 	case dr.Status.IsTxRunning():
 		// noop
+	case len(dr.Status.waitingDevices()) > 0:
+		// noop: waiting for the maintenance window
+	default:
+		// the current batch is finished within the failure budget
+		dr.advanceBatch(now)
+	}
+}
+
+// advanceBatch starts the next batch after the pause, or completes the transaction if no batch is left.
+func (dr *DeviceRollout) advanceBatch(now time.Time) {
+	s := &dr.Status
+	if s.CurrentBatch+1 >= len(s.Batches) {
+		s.NextBatchAt = nil
+		s.Status = RolloutStatusCompleted
+		dr.recordHistory(now)
+		return
+	}
+	if dr.Spec.Paused {
+		s.NextBatchAt = nil
+		return
 	}
+	if pause := dr.Spec.Strategy.Pause.Duration; pause > 0 {
+		if s.NextBatchAt == nil {
+			t := metav1.NewTime(now.Add(pause))
+			s.NextBatchAt = &t
+			return
+		}
+		if now.Before(s.NextBatchAt.Time) {
+			return
+		}
+	}
+	s.NextBatchAt = nil
+	s.CurrentBatch++
+	for _, name := range s.Batches[s.CurrentBatch] {
+		s.DeviceStatusMap[name] = DeviceStatusRunning
+	}
+	dr.holdDevices(now)
 }
 
-func (dr *DeviceRollout) updateOnIdle() {
-	if dr.Spec.DeviceConfigMap.Equal(dr.Status.DesiredDeviceConfigMap) {
+func (dr *DeviceRollout) updateOnIdle(now time.Time) {
+	if dr.Spec.Paused {
 		return
 	}
+	// NOTE the devices are known to be provisioned with the previous device configs only if the last transaction
+	// is completed, otherwise all the devices are provisioned again
+	lastCompleted := dr.Status.Status == RolloutStatusCompleted
+	if dr.Status.Phase == RolloutPhasePendingApproval {
+		// NOTE the newer config given while waiting for approval replaces the pending one
+		dr.Status.DesiredDeviceConfigMap = dr.Spec.DeviceConfigMap.DeepCopy()
+	} else {
+		if dr.Spec.DeviceConfigMap.Equal(dr.Status.DesiredDeviceConfigMap) {
+			return
+		}
 
-	// copy desired config to prev config if healthy
-	if dr.Status.Phase == RolloutPhaseHealthy {
-		dr.Status.PrevDeviceConfigMap = dr.Status.DesiredDeviceConfigMap.DeepCopy()
+		// copy desired config to prev config if healthy
+		// NOTE the desired config of the aborted transaction is not copied since it is not provisioned to all devices
+		if dr.Status.Phase == RolloutPhaseHealthy && dr.Status.Status != RolloutStatusAborted {
+			dr.Status.PrevDeviceConfigMap = dr.Status.DesiredDeviceConfigMap.DeepCopy()
+		}
+		// copy new config to desired config
+		dr.Status.DesiredDeviceConfigMap = dr.Spec.DeviceConfigMap.DeepCopy()
+	}
+
+	if dr.Spec.RequireApproval && !dr.Status.isApproved() {
+		dr.Status.Phase = RolloutPhasePendingApproval
+		return
 	}
-	// copy new config to desired config
-	dr.Status.DesiredDeviceConfigMap = dr.Spec.DeviceConfigMap.DeepCopy()
 
 	// update status
+	// only the devices whose device config is changed are provisioned
+	// NOTE the device skipped or not included in the last transaction is not provisioned with the previous config
+	unchanged := map[string]struct{}{}
+	if lastCompleted {
+		for _, name := range dr.Status.UnchangedDevices() {
+			if dr.Status.DeviceStatusMap[name] == DeviceStatusCompleted {
+				unchanged[name] = struct{}{}
+			}
+		}
+	}
 	dr.Status.Phase = RolloutPhaseHealthy
 	dr.Status.Status = RolloutStatusRunning
-	dr.Status.StartTx()
+	dr.Status.startBatchedTx(&dr.Spec.Strategy, unchanged)
+	dr.Status.TxStartedAt = timePtr(now)
+	dr.holdDevices(now)
+}
+
+// recordHistory appends the finished transaction to the history, dropping the oldest ones over the history limit.
+func (dr *DeviceRollout) recordHistory(now time.Time) {
+	s := &dr.Status
+	cmap := s.DesiredDeviceConfigMap
+	if s.Phase == RolloutPhaseRollback {
+		cmap = s.PrevDeviceConfigMap
+	}
+	h := RolloutHistory{
+		Revision:   cmap.Revision(),
+		Phase:      s.Phase,
+		Status:     s.Status,
+		StartedAt:  s.TxStartedAt,
+		FinishedAt: metav1.NewTime(now),
+	}
+	if s.DeviceStatusMap != nil {
+		h.DeviceStatusMap = make(map[string]DeviceStatus, len(s.DeviceStatusMap))
+		for k, v := range s.DeviceStatusMap {
+			h.DeviceStatusMap[k] = v
+		}
+	}
+	s.History = append(s.History, h)
+
+	limit := dr.Spec.HistoryLimit
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	if n := len(s.History) - limit; n > 0 {
+		s.History = s.History[n:]
+	}
+	s.TxStartedAt = nil
+}
+
+// RecordArtifactChecksum records the checksum of the artifact of the revision as the latest one, dropping the
+// oldest ones over the history limit. The checksums of the revisions still referenced by the device configs or the
+// history are never dropped, since the devices may be provisioned or rolled back with them.
+func (dr *DeviceRollout) RecordArtifactChecksum(revision, checksum string) {
+	s := &dr.Status
+	checksums := make([]ArtifactChecksum, 0, len(s.ArtifactChecksums)+1)
+	for _, c := range s.ArtifactChecksums {
+		if c.Revision != revision {
+			checksums = append(checksums, c)
+		}
+	}
+	checksums = append(checksums, ArtifactChecksum{Revision: revision, Checksum: checksum})
+
+	limit := dr.Spec.HistoryLimit
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	if n := len(checksums) - limit; n > 0 {
+		referenced := dr.referencedRevisions()
+		referenced[revision] = struct{}{}
+		kept := checksums[:0]
+		for _, c := range checksums {
+			if _, ok := referenced[c.Revision]; n > 0 && !ok {
+				n--
+				continue
+			}
+			kept = append(kept, c)
+		}
+		checksums = kept
+	}
+	s.ArtifactChecksums = checksums
+}
+
+// referencedRevisions returns the revisions of the given, desired and previous device configs and the history.
+func (dr *DeviceRollout) referencedRevisions() map[string]struct{} {
+	revs := map[string]struct{}{}
+	for _, cmap := range []DeviceConfigMap{dr.Spec.DeviceConfigMap, dr.Status.DesiredDeviceConfigMap, dr.Status.PrevDeviceConfigMap} {
+		for _, c := range cmap {
+			if c.GitRevision != "" {
+				revs[c.GitRevision] = struct{}{}
+			}
+		}
+	}
+	for _, h := range dr.Status.History {
+		if h.Revision != "" {
+			revs[h.Revision] = struct{}{}
+		}
+	}
+	return revs
+}
+
+// ArtifactChecksumMap returns a map from the revisions to the checksums of their artifacts.
+func (s *DeviceRolloutStatus) ArtifactChecksumMap() map[string]string {
+	if len(s.ArtifactChecksums) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(s.ArtifactChecksums))
+	for _, c := range s.ArtifactChecksums {
+		m[c.Revision] = c.Checksum
+	}
+	return m
+}
+
+func timePtr(t time.Time) *metav1.Time {
+	mt := metav1.NewTime(t)
+	return &mt
+}
+
+// UpdateConditions updates DeviceRollout's Ready, Progressing, Degraded and RolledBack conditions according to the
+// current phase and status, and records the observed generation. It returns the reason of the Ready condition.
+func (dr *DeviceRollout) UpdateConditions() string {
+	s := &dr.Status
+	gen := dr.Generation
+	set := func(typ string, status bool, reason, msg string) {
+		cs := metav1.ConditionFalse
+		if status {
+			cs = metav1.ConditionTrue
+		}
+		meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+			Type:               typ,
+			Status:             cs,
+			ObservedGeneration: gen,
+			Reason:             reason,
+			Message:            msg,
+		})
+	}
+
+	var reason, msg string
+	rollback := s.Phase == RolloutPhaseRollback
+	switch {
+	case s.Phase == RolloutPhasePendingApproval:
+		reason = ReasonPendingApproval
+		msg = fmt.Sprintf("revision %s waits for approval", s.DesiredDeviceConfigMap.Revision())
+	case s.Status == "":
+		return ""
+	case s.Status == RolloutStatusAborted:
+		reason = ReasonAborted
+		msg = fmt.Sprintf("transaction is aborted: %s", s.DeviceSummary())
+	case s.Status == RolloutStatusRunning && !rollback:
+		reason = ReasonTransactionStarted
+		msg = fmt.Sprintf("provisioning %d devices: %s", len(s.DesiredDeviceConfigMap), s.DeviceSummary())
+		if len(s.Batches) > 1 {
+			msg = fmt.Sprintf("provisioning batch %d/%d of %d devices: %s", s.CurrentBatch+1, len(s.Batches), len(s.DesiredDeviceConfigMap), s.DeviceSummary())
+		}
+	case s.Status == RolloutStatusRunning && rollback:
+		reason = ReasonRollbackStarted
+		msg = fmt.Sprintf("rolling back %d devices: %s", len(s.PrevDeviceConfigMap), s.DeviceSummary())
+	case s.Status == RolloutStatusCompleted && !rollback && len(s.FailedDevices()) > 0:
+		failed := s.FailedDevices()
+		reason = ReasonTransactionCompletedWithFailures
+		msg = fmt.Sprintf("%d of %d devices are provisioned, %d devices are failed within the failure budget: %s",
+			len(s.DesiredDeviceConfigMap)-len(failed), len(s.DesiredDeviceConfigMap), len(failed), strings.Join(failed, ", "))
+	case s.Status == RolloutStatusCompleted && !rollback:
+		reason = ReasonTransactionCompleted
+		msg = fmt.Sprintf("%d devices are provisioned", len(s.DesiredDeviceConfigMap))
+	case s.Status == RolloutStatusCompleted && rollback:
+		reason = ReasonRollbackCompleted
+		msg = fmt.Sprintf("%d devices are rolled back", len(s.PrevDeviceConfigMap))
+	default:
+		reason = ReasonRollbackFailed
+		msg = fmt.Sprintf("rollback failed, manual recover is needed: %s", s.DeviceSummary())
+	}
+
+	set(ConditionTypeReady, reason == ReasonTransactionCompleted, reason, msg)
+	if dr.Spec.Paused {
+		set(ConditionTypeProgressing, false, ReasonPaused, "rollout is paused")
+	} else {
+		set(ConditionTypeProgressing, s.Status == RolloutStatusRunning, reason, msg)
+	}
+	if rollback {
+		degradedReason := ReasonTransactionFailed
+		if reason == ReasonRollbackFailed {
+			degradedReason = ReasonRollbackFailed
+		}
+		set(ConditionTypeDegraded, true, degradedReason, msg)
+	} else if reason == ReasonTransactionCompletedWithFailures {
+		set(ConditionTypeDegraded, true, reason, msg)
+	} else {
+		set(ConditionTypeDegraded, false, reason, msg)
+	}
+	set(ConditionTypeRolledBack, reason == ReasonRollbackCompleted, reason, msg)
+	s.ObservedGeneration = gen
+	return reason
+}
+
+// DeviceSummary returns the number of devices per device status, such as "Completed=2, Running=1".
+func (s *DeviceRolloutStatus) DeviceSummary() string {
+	count := map[DeviceStatus]int{}
+	for _, v := range s.DeviceStatusMap {
+		if v != DeviceStatusPurged {
+			count[v]++
+		}
+	}
+	var el []string
+	for k, v := range count {
+		el = append(el, fmt.Sprintf("%s=%d", k, v))
+	}
+	sort.Strings(el)
+	return strings.Join(el, ", ")
+}
+
+// FailedDevices returns the sorted names of the devices failed to be provisioned.
+func (s *DeviceRolloutStatus) FailedDevices() []string {
+	var names []string
+	for k, v := range s.DeviceStatusMap {
+		if v.IsFailed() {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
 }
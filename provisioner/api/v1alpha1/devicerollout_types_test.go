@@ -24,9 +24,13 @@ package v1alpha1_test
 
 import (
 	"testing"
+	"time"
 
 	apiv1alpha1 "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestDeviceConfigMap_Equal(t *testing.T) {
@@ -84,6 +88,41 @@ func TestDeviceConfigMap_Equal(t *testing.T) {
 	}
 }
 
+func TestDeviceConfigMap_Revision(t *testing.T) {
+	tests := []struct {
+		name  string
+		given apiv1alpha1.DeviceConfigMap
+		want  string
+	}{
+		{
+			"same revision",
+			apiv1alpha1.DeviceConfigMap{
+				"d1": {GitRevision: "rev1"},
+				"d2": {GitRevision: "rev1"},
+			},
+			"rev1",
+		},
+		{
+			"different revisions",
+			apiv1alpha1.DeviceConfigMap{
+				"d1": {GitRevision: "rev1"},
+				"d2": {GitRevision: "rev2"},
+			},
+			"",
+		},
+		{
+			"empty",
+			nil,
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.given.Revision())
+		})
+	}
+}
+
 func TestDeviceRolloutStatus_IsRunning(t *testing.T) {
 	s := apiv1alpha1.DeviceRolloutStatus{}
 	assert.False(t, s.IsRunning())
@@ -487,6 +526,7 @@ func TestDeviceRollout_UpdateStatus(t *testing.T) {
 			assert.Equal(t, oldDr.Status, newDr.Status)
 		})
 
+		now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
 		tests := []struct {
 			name        string
 			given       apiv1alpha1.DeviceRollout
@@ -527,6 +567,7 @@ func TestDeviceRollout_UpdateStatus(t *testing.T) {
 					DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
 						"device1": apiv1alpha1.DeviceStatusRunning,
 					},
+					TxStartedAt: &metav1.Time{Time: now},
 				},
 				true,
 			},
@@ -564,16 +605,1037 @@ func TestDeviceRollout_UpdateStatus(t *testing.T) {
 					DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
 						"device1": apiv1alpha1.DeviceStatusRunning,
 					},
+					TxStartedAt: &metav1.Time{Time: now},
 				},
 				true,
 			},
 		}
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				got := tt.given.UpdateStatus()
+				got := tt.given.UpdateStatusAt(now)
 				assert.Equal(t, tt.wantChanged, got)
 				assert.Equal(t, tt.want, tt.given.Status)
 			})
 		}
 	})
 }
+
+func TestDeviceRollout_UpdateConditions(t *testing.T) {
+	config := apiv1alpha1.DeviceConfigMap{
+		"device1": {GitRevision: "rev"},
+		"device2": {GitRevision: "rev"},
+	}
+	tests := []struct {
+		name        string
+		phase       apiv1alpha1.RolloutPhase
+		status      apiv1alpha1.RolloutStatus
+		wantReason  string
+		wantTrue    []string
+		wantFalse   []string
+		wantMessage string
+	}{
+		{
+			"transaction running",
+			apiv1alpha1.RolloutPhaseHealthy,
+			apiv1alpha1.RolloutStatusRunning,
+			apiv1alpha1.ReasonTransactionStarted,
+			[]string{apiv1alpha1.ConditionTypeProgressing},
+			[]string{apiv1alpha1.ConditionTypeReady, apiv1alpha1.ConditionTypeDegraded, apiv1alpha1.ConditionTypeRolledBack},
+			"provisioning 2 devices: Completed=1, Running=1",
+		},
+		{
+			"transaction completed",
+			apiv1alpha1.RolloutPhaseHealthy,
+			apiv1alpha1.RolloutStatusCompleted,
+			apiv1alpha1.ReasonTransactionCompleted,
+			[]string{apiv1alpha1.ConditionTypeReady},
+			[]string{apiv1alpha1.ConditionTypeProgressing, apiv1alpha1.ConditionTypeDegraded, apiv1alpha1.ConditionTypeRolledBack},
+			"2 devices are provisioned",
+		},
+		{
+			"rollback running",
+			apiv1alpha1.RolloutPhaseRollback,
+			apiv1alpha1.RolloutStatusRunning,
+			apiv1alpha1.ReasonRollbackStarted,
+			[]string{apiv1alpha1.ConditionTypeProgressing, apiv1alpha1.ConditionTypeDegraded},
+			[]string{apiv1alpha1.ConditionTypeReady, apiv1alpha1.ConditionTypeRolledBack},
+			"rolling back 2 devices: Completed=1, Running=1",
+		},
+		{
+			"rollback completed",
+			apiv1alpha1.RolloutPhaseRollback,
+			apiv1alpha1.RolloutStatusCompleted,
+			apiv1alpha1.ReasonRollbackCompleted,
+			[]string{apiv1alpha1.ConditionTypeDegraded, apiv1alpha1.ConditionTypeRolledBack},
+			[]string{apiv1alpha1.ConditionTypeReady, apiv1alpha1.ConditionTypeProgressing},
+			"2 devices are rolled back",
+		},
+		{
+			"rollback failed",
+			apiv1alpha1.RolloutPhaseRollback,
+			apiv1alpha1.RolloutStatusFailed,
+			apiv1alpha1.ReasonRollbackFailed,
+			[]string{apiv1alpha1.ConditionTypeDegraded},
+			[]string{apiv1alpha1.ConditionTypeReady, apiv1alpha1.ConditionTypeProgressing, apiv1alpha1.ConditionTypeRolledBack},
+			"rollback failed, manual recover is needed: Completed=1, Running=1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr := apiv1alpha1.DeviceRollout{
+				ObjectMeta: metav1.ObjectMeta{Generation: 3},
+				Status: apiv1alpha1.DeviceRolloutStatus{
+					Phase:                  tt.phase,
+					Status:                 tt.status,
+					DesiredDeviceConfigMap: config,
+					PrevDeviceConfigMap:    config,
+					DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+						"device1": apiv1alpha1.DeviceStatusCompleted,
+						"device2": apiv1alpha1.DeviceStatusRunning,
+						"purged":  apiv1alpha1.DeviceStatusPurged,
+					},
+				},
+			}
+			assert.Equal(t, tt.wantReason, dr.UpdateConditions())
+			assert.Equal(t, int64(3), dr.Status.ObservedGeneration)
+			for _, typ := range tt.wantTrue {
+				assert.True(t, meta.IsStatusConditionTrue(dr.Status.Conditions, typ), typ)
+			}
+			for _, typ := range tt.wantFalse {
+				assert.True(t, meta.IsStatusConditionFalse(dr.Status.Conditions, typ), typ)
+			}
+			ready := meta.FindStatusCondition(dr.Status.Conditions, apiv1alpha1.ConditionTypeReady)
+			assert.Equal(t, tt.wantMessage, ready.Message)
+			assert.Equal(t, int64(3), ready.ObservedGeneration)
+		})
+	}
+
+	t.Run("transaction completed with failures within budget", func(t *testing.T) {
+		dr := apiv1alpha1.DeviceRollout{
+			Spec: apiv1alpha1.DeviceRolloutSpec{Strategy: apiv1alpha1.RolloutStrategy{MaxFailures: 1}},
+			Status: apiv1alpha1.DeviceRolloutStatus{
+				Phase:                  apiv1alpha1.RolloutPhaseHealthy,
+				Status:                 apiv1alpha1.RolloutStatusCompleted,
+				DesiredDeviceConfigMap: config,
+				DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+					"device1": apiv1alpha1.DeviceStatusCompleted,
+					"device2": apiv1alpha1.DeviceStatusConnectionError,
+				},
+			},
+		}
+		assert.Equal(t, apiv1alpha1.ReasonTransactionCompletedWithFailures, dr.UpdateConditions())
+		assert.True(t, meta.IsStatusConditionFalse(dr.Status.Conditions, apiv1alpha1.ConditionTypeReady))
+		assert.True(t, meta.IsStatusConditionFalse(dr.Status.Conditions, apiv1alpha1.ConditionTypeProgressing))
+		assert.True(t, meta.IsStatusConditionFalse(dr.Status.Conditions, apiv1alpha1.ConditionTypeRolledBack))
+		degraded := meta.FindStatusCondition(dr.Status.Conditions, apiv1alpha1.ConditionTypeDegraded)
+		assert.Equal(t, metav1.ConditionTrue, degraded.Status)
+		assert.Equal(t, apiv1alpha1.ReasonTransactionCompletedWithFailures, degraded.Reason)
+		assert.Equal(t, "1 of 2 devices are provisioned, 1 devices are failed within the failure budget: device2", degraded.Message)
+	})
+
+	t.Run("not started", func(t *testing.T) {
+		var dr apiv1alpha1.DeviceRollout
+		assert.Equal(t, "", dr.UpdateConditions())
+		assert.Nil(t, dr.Status.Conditions)
+	})
+}
+
+func TestDeviceRolloutStatus_FailedDevices(t *testing.T) {
+	s := apiv1alpha1.DeviceRolloutStatus{
+		DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+			"device3": apiv1alpha1.DeviceStatusConnectionError,
+			"device1": apiv1alpha1.DeviceStatusFailed,
+			"device2": apiv1alpha1.DeviceStatusCompleted,
+		},
+	}
+	assert.Equal(t, []string{"device1", "device3"}, s.FailedDevices())
+}
+
+func TestRolloutStrategy_Batches(t *testing.T) {
+	devices := []string{"leaf4", "spine1", "leaf1", "leaf3", "leaf2", "spine2"}
+	pct := intstr.FromString("50%")
+	two := intstr.FromInt(2)
+	zero := intstr.FromInt(0)
+
+	tests := []struct {
+		name  string
+		given apiv1alpha1.RolloutStrategy
+		want  [][]string
+	}{
+		{
+			"all at once",
+			apiv1alpha1.RolloutStrategy{},
+			[][]string{{"leaf1", "leaf2", "leaf3", "leaf4", "spine1", "spine2"}},
+		},
+		{
+			"canary",
+			apiv1alpha1.RolloutStrategy{Canary: []string{"spine*", "leaf3"}},
+			[][]string{{"leaf3", "spine1", "spine2"}, {"leaf1", "leaf2", "leaf4"}},
+		},
+		{
+			"absolute max parallel",
+			apiv1alpha1.RolloutStrategy{MaxParallel: &two},
+			[][]string{{"leaf1", "leaf2"}, {"leaf3", "leaf4"}, {"spine1", "spine2"}},
+		},
+		{
+			"percentage max parallel with canary",
+			apiv1alpha1.RolloutStrategy{Canary: []string{"leaf1"}, MaxParallel: &pct},
+			[][]string{{"leaf1"}, {"leaf2", "leaf3", "leaf4"}, {"spine1", "spine2"}},
+		},
+		{
+			"zero max parallel",
+			apiv1alpha1.RolloutStrategy{MaxParallel: &zero},
+			[][]string{{"leaf1"}, {"leaf2"}, {"leaf3"}, {"leaf4"}, {"spine1"}, {"spine2"}},
+		},
+		{
+			"invalid canary pattern",
+			apiv1alpha1.RolloutStrategy{Canary: []string{"[leaf"}},
+			[][]string{{"leaf1", "leaf2", "leaf3", "leaf4", "spine1", "spine2"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.given.Batches(devices))
+		})
+	}
+}
+
+func TestDeviceRolloutStatus_StartBatchedTx(t *testing.T) {
+	two := intstr.FromInt(2)
+	s := apiv1alpha1.DeviceRolloutStatus{
+		DesiredDeviceConfigMap: apiv1alpha1.DeviceConfigMap{
+			"device1": {},
+			"device2": {},
+			"device3": {},
+		},
+		DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+			"gone": apiv1alpha1.DeviceStatusCompleted,
+		},
+		CurrentBatch: 3,
+	}
+	s.StartBatchedTx(&apiv1alpha1.RolloutStrategy{MaxParallel: &two})
+	assert.Equal(t, [][]string{{"device1", "device2"}, {"device3"}}, s.Batches)
+	assert.Equal(t, 0, s.CurrentBatch)
+	assert.Equal(t, map[string]apiv1alpha1.DeviceStatus{
+		"device1": apiv1alpha1.DeviceStatusRunning,
+		"device2": apiv1alpha1.DeviceStatusRunning,
+		"device3": apiv1alpha1.DeviceStatusPending,
+		"gone":    apiv1alpha1.DeviceStatusPurged,
+	}, s.DeviceStatusMap)
+
+	t.Run("single batch", func(t *testing.T) {
+		s := s.DeepCopy()
+		s.StartBatchedTx(&apiv1alpha1.RolloutStrategy{})
+		assert.Nil(t, s.Batches)
+		assert.Equal(t, apiv1alpha1.DeviceStatusRunning, s.DeviceStatusMap["device3"])
+	})
+}
+
+func TestDeviceRollout_UpdateStatusAt_Batches(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	one := intstr.FromInt(1)
+	newRollout := func(strategy apiv1alpha1.RolloutStrategy) *apiv1alpha1.DeviceRollout {
+		dr := &apiv1alpha1.DeviceRollout{
+			Spec: apiv1alpha1.DeviceRolloutSpec{
+				DeviceConfigMap: apiv1alpha1.DeviceConfigMap{
+					"device1": {GitRevision: "desired"},
+					"device2": {GitRevision: "desired"},
+					"device3": {GitRevision: "desired"},
+				},
+				Strategy: strategy,
+			},
+		}
+		assert.True(t, dr.UpdateStatusAt(now))
+		return dr
+	}
+
+	t.Run("advance batches with pause", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStrategy{MaxParallel: &one, Pause: metav1.Duration{Duration: time.Minute}})
+		assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("device1"))
+		assert.Equal(t, apiv1alpha1.DeviceStatusPending, dr.Status.GetDeviceStatus("device2"))
+
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		assert.False(t, dr.UpdateStatusAt(now))
+		assert.Equal(t, 0, dr.Status.CurrentBatch)
+		assert.Equal(t, time.Minute, dr.Status.NextBatchAfter(now))
+
+		assert.False(t, dr.UpdateStatusAt(now.Add(30*time.Second)))
+		assert.Equal(t, apiv1alpha1.DeviceStatusPending, dr.Status.GetDeviceStatus("device2"))
+
+		assert.False(t, dr.UpdateStatusAt(now.Add(time.Minute)))
+		assert.Equal(t, 1, dr.Status.CurrentBatch)
+		assert.Nil(t, dr.Status.NextBatchAt)
+		assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("device2"))
+		assert.Equal(t, apiv1alpha1.DeviceStatusPending, dr.Status.GetDeviceStatus("device3"))
+	})
+
+	t.Run("complete after the last batch", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStrategy{MaxParallel: &one})
+		for i, name := range []string{"device1", "device2", "device3"} {
+			assert.Equal(t, i, dr.Status.CurrentBatch)
+			dr.Status.SetDeviceStatus(name, apiv1alpha1.DeviceStatusCompleted)
+			dr.UpdateStatusAt(now)
+		}
+		assert.Equal(t, apiv1alpha1.RolloutStatusCompleted, dr.Status.Status)
+	})
+
+	t.Run("tolerate failures within budget", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStrategy{MaxParallel: &one, MaxFailures: 1})
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusFailed)
+		dr.UpdateStatusAt(now)
+		assert.Equal(t, apiv1alpha1.RolloutPhaseHealthy, dr.Status.Phase)
+		assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("device2"))
+
+		dr.Status.SetDeviceStatus("device2", apiv1alpha1.DeviceStatusConnectionError)
+		assert.True(t, dr.UpdateStatusAt(now))
+		assert.Equal(t, apiv1alpha1.RolloutPhaseRollback, dr.Status.Phase)
+		assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+		assert.Nil(t, dr.Status.Batches)
+		for _, name := range []string{"device1", "device2", "device3"} {
+			assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus(name))
+		}
+	})
+
+	t.Run("complete with tolerated failures", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStrategy{MaxFailures: 1})
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusFailed)
+		dr.Status.SetDeviceStatus("device2", apiv1alpha1.DeviceStatusCompleted)
+		dr.Status.SetDeviceStatus("device3", apiv1alpha1.DeviceStatusCompleted)
+		assert.True(t, dr.UpdateStatusAt(now))
+		assert.Equal(t, apiv1alpha1.RolloutPhaseHealthy, dr.Status.Phase)
+		assert.Equal(t, apiv1alpha1.RolloutStatusCompleted, dr.Status.Status)
+	})
+}
+
+func TestDeviceRollout_UpdateStatusAt_DeviceTimeout(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newRollout := func(strategy apiv1alpha1.RolloutStrategy) *apiv1alpha1.DeviceRollout {
+		dr := &apiv1alpha1.DeviceRollout{
+			Spec: apiv1alpha1.DeviceRolloutSpec{
+				DeviceConfigMap: apiv1alpha1.DeviceConfigMap{
+					"device1": {GitRevision: "desired"},
+					"device2": {GitRevision: "desired"},
+				},
+				Strategy: strategy,
+			},
+		}
+		dr.UpdateStatusAt(now)
+		return dr
+	}
+
+	t.Run("record start time", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStrategy{DeviceTimeout: metav1.Duration{Duration: time.Minute}})
+		assert.Equal(t, map[string]metav1.Time{
+			"device1": metav1.NewTime(now),
+			"device2": metav1.NewTime(now),
+		}, dr.Status.DeviceStartedAt)
+		assert.Equal(t, time.Minute, dr.RequeueAfter(now))
+		assert.Equal(t, 20*time.Second, dr.RequeueAfter(now.Add(40*time.Second)))
+
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		dr.UpdateStatusAt(now.Add(30 * time.Second))
+		assert.Equal(t, map[string]metav1.Time{"device2": metav1.NewTime(now)}, dr.Status.DeviceStartedAt)
+	})
+
+	t.Run("roll back on timeout", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStrategy{DeviceTimeout: metav1.Duration{Duration: time.Minute}})
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		assert.Nil(t, dr.ExpireDevices(now.Add(59*time.Second)))
+
+		assert.Equal(t, []string{"device2"}, dr.ExpireDevices(now.Add(time.Minute)))
+		assert.Equal(t, apiv1alpha1.DeviceStatusTimeout, dr.Status.GetDeviceStatus("device2"))
+		assert.Equal(t, []string{"device2"}, dr.Status.FailedDevices())
+
+		assert.True(t, dr.UpdateStatusAt(now.Add(time.Minute)))
+		assert.Equal(t, apiv1alpha1.RolloutPhaseRollback, dr.Status.Phase)
+		assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+		assert.Equal(t, map[string]metav1.Time{
+			"device1": metav1.NewTime(now.Add(time.Minute)),
+			"device2": metav1.NewTime(now.Add(time.Minute)),
+		}, dr.Status.DeviceStartedAt)
+	})
+
+	t.Run("no timeout", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStrategy{})
+		assert.Nil(t, dr.ExpireDevices(now.Add(24*time.Hour)))
+		assert.False(t, dr.UpdateStatusAt(now.Add(24*time.Hour)))
+		assert.Equal(t, time.Duration(0), dr.RequeueAfter(now))
+	})
+}
+
+func TestDeviceRollout_UpdateStatus_Controls(t *testing.T) {
+	one := intstr.FromInt(1)
+	newRollout := func(spec apiv1alpha1.DeviceRolloutSpec) *apiv1alpha1.DeviceRollout {
+		spec.DeviceConfigMap = apiv1alpha1.DeviceConfigMap{
+			"device1": {GitRevision: "desired"},
+			"device2": {GitRevision: "desired"},
+			"device3": {GitRevision: "desired"},
+		}
+		dr := &apiv1alpha1.DeviceRollout{Spec: spec}
+		dr.UpdateStatus()
+		return dr
+	}
+
+	t.Run("paused", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.DeviceRolloutSpec{Paused: true})
+		assert.Equal(t, apiv1alpha1.RolloutStatus(""), dr.Status.Status)
+
+		dr.Spec.Paused = false
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+	})
+
+	t.Run("paused between batches", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.DeviceRolloutSpec{Strategy: apiv1alpha1.RolloutStrategy{MaxParallel: &one}})
+		dr.Spec.Paused = true
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		dr.UpdateStatus()
+		assert.Equal(t, 0, dr.Status.CurrentBatch)
+		assert.Equal(t, apiv1alpha1.DeviceStatusPending, dr.Status.GetDeviceStatus("device2"))
+		assert.Equal(t, apiv1alpha1.ReasonPaused, meta.FindStatusCondition(func() []metav1.Condition {
+			dr.UpdateConditions()
+			return dr.Status.Conditions
+		}(), apiv1alpha1.ConditionTypeProgressing).Reason)
+
+		dr.Spec.Paused = false
+		dr.UpdateStatus()
+		assert.Equal(t, 1, dr.Status.CurrentBatch)
+		assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("device2"))
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.DeviceRolloutSpec{Strategy: apiv1alpha1.RolloutStrategy{MaxParallel: &one}})
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		dr.Annotations = map[string]string{apiv1alpha1.AnnKeyAbort: "t1"}
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, apiv1alpha1.RolloutPhaseHealthy, dr.Status.Phase)
+		assert.Equal(t, apiv1alpha1.RolloutStatusAborted, dr.Status.Status)
+		assert.Equal(t, "t1", dr.Status.LastHandledAbort)
+		assert.Equal(t, map[string]apiv1alpha1.DeviceStatus{
+			"device1": apiv1alpha1.DeviceStatusCompleted,
+			"device2": apiv1alpha1.DeviceStatusAborted,
+			"device3": apiv1alpha1.DeviceStatusAborted,
+		}, dr.Status.DeviceStatusMap)
+
+		// handled only once
+		assert.False(t, dr.UpdateStatus())
+
+		// the transaction is resumed from the current batch
+		dr.Annotations[apiv1alpha1.AnnKeyRetry] = "t2"
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+		assert.Equal(t, "t2", dr.Status.LastHandledRetry)
+		assert.Len(t, dr.Status.Batches, 3)
+		assert.Equal(t, 1, dr.Status.CurrentBatch)
+		assert.Equal(t, map[string]apiv1alpha1.DeviceStatus{
+			"device1": apiv1alpha1.DeviceStatusCompleted,
+			"device2": apiv1alpha1.DeviceStatusRunning,
+			"device3": apiv1alpha1.DeviceStatusPending,
+		}, dr.Status.DeviceStatusMap)
+
+		dr.Status.SetDeviceStatus("device2", apiv1alpha1.DeviceStatusCompleted)
+		dr.UpdateStatus()
+		assert.Equal(t, 2, dr.Status.CurrentBatch)
+		assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("device3"))
+	})
+
+	t.Run("retry failed devices", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.DeviceRolloutSpec{})
+		dr.Status.Phase = apiv1alpha1.RolloutPhaseRollback
+		dr.Status.Status = apiv1alpha1.RolloutStatusFailed
+		dr.Status.DeviceStatusMap = map[string]apiv1alpha1.DeviceStatus{
+			"device1": apiv1alpha1.DeviceStatusCompleted,
+			"device2": apiv1alpha1.DeviceStatusFailed,
+			"device3": apiv1alpha1.DeviceStatusTimeout,
+		}
+		dr.Annotations = map[string]string{apiv1alpha1.AnnKeyRetry: "t1"}
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, apiv1alpha1.RolloutPhaseRollback, dr.Status.Phase)
+		assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+		assert.Equal(t, map[string]apiv1alpha1.DeviceStatus{
+			"device1": apiv1alpha1.DeviceStatusCompleted,
+			"device2": apiv1alpha1.DeviceStatusRunning,
+			"device3": apiv1alpha1.DeviceStatusRunning,
+		}, dr.Status.DeviceStatusMap)
+	})
+
+	t.Run("skip devices", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.DeviceRolloutSpec{SkipDevices: []string{"device3"}})
+		assert.Equal(t, apiv1alpha1.DeviceStatusSkipped, dr.Status.GetDeviceStatus("device3"))
+
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		dr.UpdateStatus()
+		assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+
+		// skip the device blocking the transaction
+		dr.Spec.SkipDevices = append(dr.Spec.SkipDevices, "device2", "unknown")
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, apiv1alpha1.RolloutStatusCompleted, dr.Status.Status)
+		assert.Equal(t, apiv1alpha1.DeviceStatusCompleted, dr.Status.GetDeviceStatus("device1"))
+		assert.Equal(t, apiv1alpha1.DeviceStatusSkipped, dr.Status.GetDeviceStatus("device2"))
+		assert.Equal(t, apiv1alpha1.DeviceStatusUnknown, dr.Status.GetDeviceStatus("unknown"))
+	})
+}
+
+func TestDeviceRollout_UpdateStatusAt_History(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newConfig := func(rev string) apiv1alpha1.DeviceConfigMap {
+		return apiv1alpha1.DeviceConfigMap{
+			"device1": {Checksum: rev, GitRevision: rev},
+			"device2": {Checksum: rev, GitRevision: rev},
+		}
+	}
+
+	t.Run("completed and rolled back", func(t *testing.T) {
+		dr := &apiv1alpha1.DeviceRollout{Spec: apiv1alpha1.DeviceRolloutSpec{DeviceConfigMap: newConfig("rev1")}}
+		dr.UpdateStatusAt(now)
+		assert.Equal(t, &metav1.Time{Time: now}, dr.Status.TxStartedAt)
+
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		dr.Status.SetDeviceStatus("device2", apiv1alpha1.DeviceStatusCompleted)
+		dr.UpdateStatusAt(now.Add(time.Minute))
+		assert.Nil(t, dr.Status.TxStartedAt)
+
+		dr.Spec.DeviceConfigMap = newConfig("rev2")
+		dr.UpdateStatusAt(now.Add(2 * time.Minute))
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		dr.Status.SetDeviceStatus("device2", apiv1alpha1.DeviceStatusFailed)
+		dr.UpdateStatusAt(now.Add(3 * time.Minute))
+		assert.Equal(t, apiv1alpha1.RolloutPhaseRollback, dr.Status.Phase)
+
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		dr.Status.SetDeviceStatus("device2", apiv1alpha1.DeviceStatusCompleted)
+		dr.UpdateStatusAt(now.Add(4 * time.Minute))
+
+		assert.Equal(t, []apiv1alpha1.RolloutHistory{
+			{
+				Revision:   "rev1",
+				Phase:      apiv1alpha1.RolloutPhaseHealthy,
+				Status:     apiv1alpha1.RolloutStatusCompleted,
+				StartedAt:  &metav1.Time{Time: now},
+				FinishedAt: metav1.NewTime(now.Add(time.Minute)),
+				DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+					"device1": apiv1alpha1.DeviceStatusCompleted,
+					"device2": apiv1alpha1.DeviceStatusCompleted,
+				},
+			},
+			{
+				Revision:   "rev2",
+				Phase:      apiv1alpha1.RolloutPhaseHealthy,
+				Status:     apiv1alpha1.RolloutStatusFailed,
+				StartedAt:  &metav1.Time{Time: now.Add(2 * time.Minute)},
+				FinishedAt: metav1.NewTime(now.Add(3 * time.Minute)),
+				DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+					"device1": apiv1alpha1.DeviceStatusCompleted,
+					"device2": apiv1alpha1.DeviceStatusFailed,
+				},
+			},
+			{
+				Revision:   "rev1",
+				Phase:      apiv1alpha1.RolloutPhaseRollback,
+				Status:     apiv1alpha1.RolloutStatusCompleted,
+				StartedAt:  &metav1.Time{Time: now.Add(3 * time.Minute)},
+				FinishedAt: metav1.NewTime(now.Add(4 * time.Minute)),
+				DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+					"device1": apiv1alpha1.DeviceStatusCompleted,
+					"device2": apiv1alpha1.DeviceStatusCompleted,
+				},
+			},
+		}, dr.Status.History)
+	})
+
+	t.Run("bounded by history limit", func(t *testing.T) {
+		dr := &apiv1alpha1.DeviceRollout{Spec: apiv1alpha1.DeviceRolloutSpec{HistoryLimit: 2}}
+		for i, rev := range []string{"rev1", "rev2", "rev3"} {
+			dr.Spec.DeviceConfigMap = newConfig(rev)
+			dr.UpdateStatusAt(now.Add(time.Duration(i) * time.Minute))
+			dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+			dr.Status.SetDeviceStatus("device2", apiv1alpha1.DeviceStatusCompleted)
+			dr.UpdateStatusAt(now.Add(time.Duration(i) * time.Minute))
+		}
+		var revs []string
+		for _, h := range dr.Status.History {
+			revs = append(revs, h.Revision)
+		}
+		assert.Equal(t, []string{"rev2", "rev3"}, revs)
+	})
+
+	t.Run("aborted", func(t *testing.T) {
+		dr := &apiv1alpha1.DeviceRollout{Spec: apiv1alpha1.DeviceRolloutSpec{DeviceConfigMap: newConfig("rev1")}}
+		dr.UpdateStatusAt(now)
+		dr.Annotations = map[string]string{apiv1alpha1.AnnKeyAbort: "t1"}
+		dr.UpdateStatusAt(now.Add(time.Minute))
+		if assert.Len(t, dr.Status.History, 1) {
+			assert.Equal(t, apiv1alpha1.RolloutStatusAborted, dr.Status.History[0].Status)
+			assert.Equal(t, apiv1alpha1.DeviceStatusAborted, dr.Status.History[0].DeviceStatusMap["device1"])
+		}
+	})
+}
+
+func TestDeviceRolloutSpec_InMaintenanceWindow(t *testing.T) {
+	// 2022-01-01 is Saturday
+	sat := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	spec := apiv1alpha1.DeviceRolloutSpec{
+		MaintenanceWindows: []apiv1alpha1.MaintenanceWindow{
+			{
+				Devices:  []string{"core*"},
+				Schedule: "0 2 * * SAT",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+			},
+			{
+				Devices:  []string{"core2"},
+				Schedule: "0 10 * * *",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			{
+				Devices:  []string{"invalid"},
+				Schedule: "foo",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		device string
+		now    time.Time
+		want   bool
+	}{
+		{"before window", "core1", sat.Add(time.Hour), false},
+		{"window start", "core1", sat.Add(2 * time.Hour), true},
+		{"within window", "core1", sat.Add(3*time.Hour + 59*time.Minute), true},
+		{"window end", "core1", sat.Add(4 * time.Hour), false},
+		{"next day", "core1", sat.Add(26 * time.Hour), false},
+		{"one of windows", "core2", sat.Add(10*time.Hour + 30*time.Minute), true},
+		{"one of windows next day", "core2", sat.Add(34 * time.Hour), true},
+		{"not restricted", "edge1", sat.Add(time.Hour), true},
+		{"invalid schedule", "invalid", sat.Add(time.Hour), false},
+		{"time zone", "core1", time.Date(2022, 1, 1, 11, 0, 0, 0, time.FixedZone("JST", 9*60*60)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, spec.InMaintenanceWindow(tt.device, tt.now))
+		})
+	}
+}
+
+func TestDeviceRollout_UpdateStatusAt_MaintenanceWindow(t *testing.T) {
+	sat := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	dr := &apiv1alpha1.DeviceRollout{
+		Spec: apiv1alpha1.DeviceRolloutSpec{
+			DeviceConfigMap: apiv1alpha1.DeviceConfigMap{
+				"core1": {GitRevision: "desired"},
+				"edge1": {GitRevision: "desired"},
+			},
+			MaintenanceWindows: []apiv1alpha1.MaintenanceWindow{
+				{
+					Devices:  []string{"core*"},
+					Schedule: "0 2 * * SAT",
+					Duration: metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+		},
+	}
+
+	dr.UpdateStatusAt(sat)
+	assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+	assert.Equal(t, apiv1alpha1.DeviceStatusPending, dr.Status.GetDeviceStatus("core1"))
+	assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("edge1"))
+	assert.Equal(t, 2*time.Hour, dr.RequeueAfter(sat))
+
+	// the transaction waits for the window even if the other devices are completed
+	dr.Status.SetDeviceStatus("edge1", apiv1alpha1.DeviceStatusCompleted)
+	assert.False(t, dr.UpdateStatusAt(sat.Add(time.Hour)))
+	assert.Equal(t, apiv1alpha1.DeviceStatusPending, dr.Status.GetDeviceStatus("core1"))
+
+	dr.UpdateStatusAt(sat.Add(2 * time.Hour))
+	assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("core1"))
+	assert.Equal(t, time.Duration(0), dr.RequeueAfter(sat.Add(2*time.Hour)))
+
+	// the device in progress is not stopped when the window is closed
+	dr.UpdateStatusAt(sat.Add(5 * time.Hour))
+	assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("core1"))
+
+	dr.Status.SetDeviceStatus("core1", apiv1alpha1.DeviceStatusCompleted)
+	assert.True(t, dr.UpdateStatusAt(sat.Add(5*time.Hour)))
+	assert.Equal(t, apiv1alpha1.RolloutStatusCompleted, dr.Status.Status)
+}
+
+func TestDeviceRollout_UpdateStatusAt_MaintenanceWindowPaused(t *testing.T) {
+	sat := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	dr := &apiv1alpha1.DeviceRollout{
+		Spec: apiv1alpha1.DeviceRolloutSpec{
+			DeviceConfigMap: apiv1alpha1.DeviceConfigMap{
+				"core1": {GitRevision: "desired"},
+			},
+			MaintenanceWindows: []apiv1alpha1.MaintenanceWindow{
+				{
+					Devices:  []string{"core*"},
+					Schedule: "0 2 * * SAT",
+					Duration: metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+		},
+	}
+	dr.UpdateStatusAt(sat)
+	assert.Equal(t, apiv1alpha1.DeviceStatusPending, dr.Status.GetDeviceStatus("core1"))
+
+	// the device is not started while paused even if its window is open
+	dr.Spec.Paused = true
+	dr.UpdateStatusAt(sat.Add(2 * time.Hour))
+	assert.Equal(t, apiv1alpha1.DeviceStatusPending, dr.Status.GetDeviceStatus("core1"))
+
+	dr.Spec.Paused = false
+	dr.UpdateStatusAt(sat.Add(3 * time.Hour))
+	assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("core1"))
+}
+
+func TestDeviceRollout_UpdateStatus_Approval(t *testing.T) {
+	newConfig := func(rev string) apiv1alpha1.DeviceConfigMap {
+		return apiv1alpha1.DeviceConfigMap{
+			"device1": {Checksum: rev, GitRevision: rev},
+		}
+	}
+	dr := &apiv1alpha1.DeviceRollout{
+		Spec: apiv1alpha1.DeviceRolloutSpec{
+			DeviceConfigMap: newConfig("rev1"),
+		},
+		Status: apiv1alpha1.DeviceRolloutStatus{
+			Phase:                  apiv1alpha1.RolloutPhaseHealthy,
+			Status:                 apiv1alpha1.RolloutStatusCompleted,
+			DesiredDeviceConfigMap: newConfig("rev1"),
+			DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+				"device1": apiv1alpha1.DeviceStatusCompleted,
+			},
+		},
+	}
+	dr.Spec.RequireApproval = true
+	dr.Spec.DeviceConfigMap = newConfig("rev2")
+
+	assert.True(t, dr.UpdateStatus())
+	assert.Equal(t, apiv1alpha1.RolloutPhasePendingApproval, dr.Status.Phase)
+	assert.Equal(t, apiv1alpha1.RolloutStatusCompleted, dr.Status.Status)
+	assert.Equal(t, "rev2", dr.Status.PendingRevision())
+	assert.Equal(t, apiv1alpha1.DeviceStatusCompleted, dr.Status.GetDeviceStatus("device1"))
+	assert.Equal(t, apiv1alpha1.ReasonPendingApproval, dr.UpdateConditions())
+
+	// the newer revision replaces the pending one
+	dr.Spec.DeviceConfigMap = newConfig("rev3")
+	assert.False(t, dr.UpdateStatus())
+	assert.Equal(t, "rev3", dr.Status.PendingRevision())
+
+	// approval of the other revision is ignored
+	dr.Annotations = map[string]string{apiv1alpha1.AnnKeyApprove: "rev2", apiv1alpha1.AnnKeyApprover: "alice"}
+	assert.False(t, dr.UpdateStatus())
+	assert.Equal(t, apiv1alpha1.RolloutPhasePendingApproval, dr.Status.Phase)
+
+	dr.Annotations[apiv1alpha1.AnnKeyApprove] = "rev3"
+	assert.True(t, dr.UpdateStatus())
+	assert.Equal(t, apiv1alpha1.RolloutPhaseHealthy, dr.Status.Phase)
+	assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+	assert.Equal(t, "alice", dr.Status.ApprovedBy)
+	assert.Equal(t, newConfig("rev1"), dr.Status.PrevDeviceConfigMap)
+	assert.Equal(t, newConfig("rev3"), dr.Status.DesiredDeviceConfigMap)
+	assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("device1"))
+}
+
+func TestDeviceRollout_UpdateStatus_ApprovalWithStaleAnnotation(t *testing.T) {
+	newConfig := func(rev string) apiv1alpha1.DeviceConfigMap {
+		return apiv1alpha1.DeviceConfigMap{
+			"device1": {Checksum: rev, GitRevision: rev},
+		}
+	}
+	dr := &apiv1alpha1.DeviceRollout{
+		Spec: apiv1alpha1.DeviceRolloutSpec{
+			DeviceConfigMap: newConfig("rev2"),
+			RequireApproval: true,
+		},
+		Status: apiv1alpha1.DeviceRolloutStatus{
+			Phase:                  apiv1alpha1.RolloutPhaseHealthy,
+			Status:                 apiv1alpha1.RolloutStatusCompleted,
+			DesiredDeviceConfigMap: newConfig("rev1"),
+			DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+				"device1": apiv1alpha1.DeviceStatusCompleted,
+			},
+		},
+	}
+	dr.Annotations = map[string]string{apiv1alpha1.AnnKeyApprove: "rev2", apiv1alpha1.AnnKeyApprover: "alice"}
+
+	// rev2 is approved by the annotation
+	assert.True(t, dr.UpdateStatus())
+	assert.Equal(t, apiv1alpha1.RolloutPhasePendingApproval, dr.Status.Phase)
+	assert.True(t, dr.UpdateStatus())
+	assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+	assert.Equal(t, "rev2", dr.Status.ApprovedRevision)
+	assert.Equal(t, "alice", dr.Status.ApprovedBy)
+	assert.Equal(t, "rev2", dr.Status.LastHandledApprove)
+	dr.Status.DeviceStatusMap["device1"] = apiv1alpha1.DeviceStatusCompleted
+	dr.UpdateStatus()
+	assert.Equal(t, apiv1alpha1.RolloutStatusCompleted, dr.Status.Status)
+
+	// rev3 is approved by the Approval while the annotation for rev2 is left
+	dr.Spec.DeviceConfigMap = newConfig("rev3")
+	assert.True(t, dr.UpdateStatus())
+	assert.Equal(t, "rev3", dr.Status.PendingRevision())
+	dr.Approve(dr.Status.PendingRevision(), "bob")
+	assert.True(t, dr.UpdateStatus())
+	assert.Equal(t, apiv1alpha1.RolloutPhaseHealthy, dr.Status.Phase)
+	assert.Equal(t, apiv1alpha1.RolloutStatusRunning, dr.Status.Status)
+	assert.Equal(t, "rev3", dr.Status.ApprovedRevision)
+	assert.Equal(t, "bob", dr.Status.ApprovedBy)
+	assert.Equal(t, newConfig("rev3"), dr.Status.DesiredDeviceConfigMap)
+
+	// the annotation approved already is not handled again even if the revision waits for approval again
+	dr.Status.DeviceStatusMap["device1"] = apiv1alpha1.DeviceStatusCompleted
+	dr.UpdateStatus()
+	dr.Spec.DeviceConfigMap = newConfig("rev2")
+	assert.True(t, dr.UpdateStatus())
+	assert.Equal(t, "rev2", dr.Status.PendingRevision())
+	assert.False(t, dr.UpdateStatus())
+	assert.Equal(t, apiv1alpha1.RolloutPhasePendingApproval, dr.Status.Phase)
+}
+
+func TestDeviceRollout_UpdateStatus_UnchangedDevices(t *testing.T) {
+	one := intstr.FromInt(1)
+	curr := apiv1alpha1.DeviceConfigMap{
+		"device1": {Checksum: "curr1", GitRevision: "curr"},
+		"device2": {Checksum: "curr2", GitRevision: "curr"},
+		"device3": {Checksum: "curr3", GitRevision: "curr"},
+	}
+	desired := apiv1alpha1.DeviceConfigMap{
+		"device1": {Checksum: "desired1", GitRevision: "desired"},
+		"device2": {Checksum: "curr2", GitRevision: "desired"},
+		"device3": {Checksum: "desired3", GitRevision: "desired"},
+		"device4": {Checksum: "desired4", GitRevision: "desired"},
+	}
+	newRollout := func(status apiv1alpha1.RolloutStatus, strategy apiv1alpha1.RolloutStrategy) *apiv1alpha1.DeviceRollout {
+		return &apiv1alpha1.DeviceRollout{
+			Spec: apiv1alpha1.DeviceRolloutSpec{
+				DeviceConfigMap: desired,
+				Strategy:        strategy,
+			},
+			Status: apiv1alpha1.DeviceRolloutStatus{
+				Phase:                  apiv1alpha1.RolloutPhaseHealthy,
+				Status:                 status,
+				DesiredDeviceConfigMap: curr,
+				DeviceStatusMap: map[string]apiv1alpha1.DeviceStatus{
+					"device1": apiv1alpha1.DeviceStatusCompleted,
+					"device2": apiv1alpha1.DeviceStatusCompleted,
+					"device3": apiv1alpha1.DeviceStatusCompleted,
+				},
+			},
+		}
+	}
+
+	t.Run("only changed devices", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStatusCompleted, apiv1alpha1.RolloutStrategy{})
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, []string{"device2"}, dr.Status.UnchangedDevices())
+		assert.Equal(t, map[string]apiv1alpha1.DeviceStatus{
+			"device1": apiv1alpha1.DeviceStatusRunning,
+			"device2": apiv1alpha1.DeviceStatusCompleted,
+			"device3": apiv1alpha1.DeviceStatusRunning,
+			"device4": apiv1alpha1.DeviceStatusRunning,
+		}, dr.Status.DeviceStatusMap)
+		assert.Equal(t, desired, dr.Status.DesiredDeviceConfigMap)
+	})
+
+	t.Run("unchanged devices not completed in the last transaction", func(t *testing.T) {
+		for name, mutate := range map[string]func(m map[string]apiv1alpha1.DeviceStatus){
+			"skipped":     func(m map[string]apiv1alpha1.DeviceStatus) { m["device2"] = apiv1alpha1.DeviceStatusSkipped },
+			"not in tx":   func(m map[string]apiv1alpha1.DeviceStatus) { delete(m, "device2") },
+			"interrupted": func(m map[string]apiv1alpha1.DeviceStatus) { m["device2"] = apiv1alpha1.DeviceStatusAborted },
+		} {
+			t.Run(name, func(t *testing.T) {
+				dr := newRollout(apiv1alpha1.RolloutStatusCompleted, apiv1alpha1.RolloutStrategy{})
+				mutate(dr.Status.DeviceStatusMap)
+				assert.True(t, dr.UpdateStatus())
+				assert.Equal(t, apiv1alpha1.DeviceStatusRunning, dr.Status.GetDeviceStatus("device2"))
+			})
+		}
+	})
+
+	t.Run("skipped device is provisioned after unskipped", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStatusCompleted, apiv1alpha1.RolloutStrategy{})
+		dr.Spec.DeviceConfigMap = apiv1alpha1.DeviceConfigMap{
+			"device1": {Checksum: "desired1", GitRevision: "desired"},
+			"device2": {Checksum: "curr2", GitRevision: "desired"},
+		}
+		dr.Status.DesiredDeviceConfigMap = apiv1alpha1.DeviceConfigMap{
+			"device1": {Checksum: "curr1", GitRevision: "curr"},
+			"device2": {Checksum: "desired2", GitRevision: "curr"},
+		}
+		dr.Status.DeviceStatusMap = map[string]apiv1alpha1.DeviceStatus{
+			"device1": apiv1alpha1.DeviceStatusCompleted,
+			"device2": apiv1alpha1.DeviceStatusCompleted,
+		}
+
+		// tx1 skips device2
+		dr.Spec.SkipDevices = []string{"device2"}
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, apiv1alpha1.DeviceStatusSkipped, dr.Status.GetDeviceStatus("device2"))
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, apiv1alpha1.RolloutStatusCompleted, dr.Status.Status)
+
+		// tx2 changes only device1 after device2 is unskipped
+		dr.Spec.SkipDevices = nil
+		dr.Spec.DeviceConfigMap = apiv1alpha1.DeviceConfigMap{
+			"device1": {Checksum: "next1", GitRevision: "next"},
+			"device2": {Checksum: "curr2", GitRevision: "next"},
+		}
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, map[string]apiv1alpha1.DeviceStatus{
+			"device1": apiv1alpha1.DeviceStatusRunning,
+			"device2": apiv1alpha1.DeviceStatusRunning,
+		}, dr.Status.DeviceStatusMap)
+	})
+
+	t.Run("batches without unchanged devices", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStatusCompleted, apiv1alpha1.RolloutStrategy{MaxParallel: &one})
+		dr.UpdateStatus()
+		assert.Equal(t, [][]string{{"device1"}, {"device3"}, {"device4"}}, dr.Status.Batches)
+	})
+
+	t.Run("all devices after aborted", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStatusAborted, apiv1alpha1.RolloutStrategy{})
+		dr.Status.PrevDeviceConfigMap = curr
+		dr.UpdateStatus()
+		for _, v := range dr.Status.DeviceStatusMap {
+			assert.Equal(t, apiv1alpha1.DeviceStatusRunning, v)
+		}
+	})
+
+	t.Run("roll back only changed devices", func(t *testing.T) {
+		dr := newRollout(apiv1alpha1.RolloutStatusCompleted, apiv1alpha1.RolloutStrategy{})
+		dr.UpdateStatus()
+		dr.Status.SetDeviceStatus("device1", apiv1alpha1.DeviceStatusCompleted)
+		dr.Status.SetDeviceStatus("device3", apiv1alpha1.DeviceStatusFailed)
+		assert.True(t, dr.UpdateStatus())
+		assert.Equal(t, apiv1alpha1.RolloutPhaseRollback, dr.Status.Phase)
+		assert.Equal(t, map[string]apiv1alpha1.DeviceStatus{
+			"device1": apiv1alpha1.DeviceStatusRunning,
+			"device2": apiv1alpha1.DeviceStatusCompleted,
+			"device3": apiv1alpha1.DeviceStatusRunning,
+			"device4": apiv1alpha1.DeviceStatusRunning,
+		}, dr.Status.DeviceStatusMap)
+	})
+}
+
+func TestDeviceRollout_Source(t *testing.T) {
+	tests := []struct {
+		name         string
+		given        *apiv1alpha1.SourceReference
+		wantKind     string
+		wantName     string
+		wantInterval time.Duration
+	}{
+		{
+			"default",
+			nil,
+			apiv1alpha1.SourceKindGitRepository,
+			"test",
+			apiv1alpha1.DefaultSourceInterval,
+		},
+		{
+			"git repository ignores name",
+			&apiv1alpha1.SourceReference{Kind: apiv1alpha1.SourceKindGitRepository, Name: "other"},
+			apiv1alpha1.SourceKindGitRepository,
+			"test",
+			apiv1alpha1.DefaultSourceInterval,
+		},
+		{
+			"oci repository",
+			&apiv1alpha1.SourceReference{Kind: apiv1alpha1.SourceKindOCIRepository, Name: "other"},
+			apiv1alpha1.SourceKindOCIRepository,
+			"other",
+			apiv1alpha1.DefaultSourceInterval,
+		},
+		{
+			"git",
+			&apiv1alpha1.SourceReference{
+				Kind:     apiv1alpha1.SourceKindGit,
+				URL:      "https://example.com/config.git",
+				Interval: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			apiv1alpha1.SourceKindGit,
+			"test",
+			5 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr := apiv1alpha1.DeviceRollout{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       apiv1alpha1.DeviceRolloutSpec{Source: tt.given},
+			}
+			assert.Equal(t, tt.wantKind, dr.SourceKind())
+			assert.Equal(t, tt.wantName, dr.SourceName())
+			assert.Equal(t, tt.wantInterval, dr.SourceInterval())
+		})
+	}
+}
+
+func TestDeviceRollout_RecordArtifactChecksum(t *testing.T) {
+	dr := apiv1alpha1.DeviceRollout{
+		Spec: apiv1alpha1.DeviceRolloutSpec{
+			HistoryLimit: 3,
+		},
+	}
+	assert.Nil(t, dr.Status.ArtifactChecksumMap())
+
+	dr.RecordArtifactChecksum("rev1", "checksum1")
+	dr.RecordArtifactChecksum("rev2", "checksum2")
+	dr.RecordArtifactChecksum("rev3", "checksum3")
+	dr.RecordArtifactChecksum("rev1", "checksum1")
+	dr.RecordArtifactChecksum("rev4", "checksum4")
+	assert.Equal(t, []apiv1alpha1.ArtifactChecksum{
+		{Revision: "rev3", Checksum: "checksum3"},
+		{Revision: "rev1", Checksum: "checksum1"},
+		{Revision: "rev4", Checksum: "checksum4"},
+	}, dr.Status.ArtifactChecksums)
+	assert.Equal(t, map[string]string{
+		"rev1": "checksum1",
+		"rev3": "checksum3",
+		"rev4": "checksum4",
+	}, dr.Status.ArtifactChecksumMap())
+}
+
+func TestDeviceRollout_RecordArtifactChecksum_Referenced(t *testing.T) {
+	newConfig := func(rev string) apiv1alpha1.DeviceConfigMap {
+		return apiv1alpha1.DeviceConfigMap{"device1": {Checksum: rev, GitRevision: rev}}
+	}
+	dr := apiv1alpha1.DeviceRollout{
+		Spec: apiv1alpha1.DeviceRolloutSpec{
+			HistoryLimit:    2,
+			DeviceConfigMap: newConfig("rev3"),
+		},
+		Status: apiv1alpha1.DeviceRolloutStatus{
+			DesiredDeviceConfigMap: newConfig("rev2"),
+			PrevDeviceConfigMap:    newConfig("rev1"),
+			History:                []apiv1alpha1.RolloutHistory{{Revision: "rev0"}},
+		},
+	}
+
+	// the revisions referenced while paused are kept even if more revisions are recorded than the limit
+	for _, rev := range []string{"rev0", "rev1", "rev2", "rev3", "rev4", "rev5", "rev6"} {
+		dr.RecordArtifactChecksum(rev, "checksum-"+rev)
+	}
+	assert.Equal(t, []apiv1alpha1.ArtifactChecksum{
+		{Revision: "rev0", Checksum: "checksum-rev0"},
+		{Revision: "rev1", Checksum: "checksum-rev1"},
+		{Revision: "rev2", Checksum: "checksum-rev2"},
+		{Revision: "rev3", Checksum: "checksum-rev3"},
+		{Revision: "rev6", Checksum: "checksum-rev6"},
+	}, dr.Status.ArtifactChecksums)
+
+	// the revision no longer referenced is dropped
+	dr.Status.History = nil
+	dr.RecordArtifactChecksum("rev7", "checksum-rev7")
+	assert.Equal(t, []apiv1alpha1.ArtifactChecksum{
+		{Revision: "rev1", Checksum: "checksum-rev1"},
+		{Revision: "rev2", Checksum: "checksum-rev2"},
+		{Revision: "rev3", Checksum: "checksum-rev3"},
+		{Revision: "rev7", Checksum: "checksum-rev7"},
+	}, dr.Status.ArtifactChecksums)
+}
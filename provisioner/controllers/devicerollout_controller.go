@@ -24,24 +24,37 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/nttcom/kuesta/pkg/stacktrace"
 	kuestav1alpha1 "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // DeviceRolloutReconciler reconciles a DeviceRollout object.
 type DeviceRolloutReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts/finalizers,verbs=update
+//+kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=approvals,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -55,17 +68,116 @@ func (r *DeviceRolloutReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	changed := dr.UpdateStatus()
+	now := time.Now()
+	prev := dr.Status.DeepCopy()
+	if err := r.approve(ctx, &dr); err != nil {
+		r.Error(ctx, err, "get Approval")
+		return ctrl.Result{}, err
+	}
+	expired := dr.ExpireDevices(now)
+	// NOTE device statuses are reset on rollback, so failed devices must be taken before updating
+	failed := dr.Status.FailedDevices()
+	changed := dr.UpdateStatusAt(now)
+	reason := dr.UpdateConditions()
+	// NOTE requeue to start the next batch after the pause, to expire the devices at the deadline, or to start the
+	// devices when their maintenance window opens
+	result := ctrl.Result{RequeueAfter: dr.RequeueAfter(now)}
+	if !changed && equality.Semantic.DeepEqual(prev, &dr.Status) {
+		l.Info("not updated")
+		return result, nil
+	}
+
 	if changed {
 		l.Info("changed", "phase", dr.Status.Phase, "status", dr.Status.Status)
-		if err := r.Status().Update(ctx, &dr); err != nil {
-			r.Error(ctx, err, "update DeviceRollout")
-			return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if err := r.Status().Update(ctx, &dr); err != nil {
+		r.Error(ctx, err, "update DeviceRollout")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if dr.Status.ApprovedRevision != prev.ApprovedRevision {
+		r.event(&dr, corev1.EventTypeNormal, kuestav1alpha1.ReasonApproved,
+			fmt.Sprintf("revision %s is approved by %s", dr.Status.ApprovedRevision, dr.Status.ApprovedBy))
+	}
+	for _, name := range expired {
+		r.event(&dr, corev1.EventTypeWarning, kuestav1alpha1.ReasonDeviceTimeout,
+			fmt.Sprintf("%s: not provisioned within %s", name, dr.Spec.Strategy.DeviceTimeout.Duration))
+	}
+	switch {
+	case dr.Status.LastHandledRetry != prev.LastHandledRetry && dr.Status.IsRunning():
+		r.event(&dr, corev1.EventTypeNormal, kuestav1alpha1.ReasonRetryStarted,
+			fmt.Sprintf("retry started on devices: %s", strings.Join(failed, ", ")))
+	case changed:
+		r.recordTransition(&dr, reason, failed)
+	case prev.CurrentBatch != dr.Status.CurrentBatch && dr.Status.Batches != nil:
+		batch := dr.Status.Batches[dr.Status.CurrentBatch]
+		r.event(&dr, corev1.EventTypeNormal, kuestav1alpha1.ReasonBatchStarted,
+			fmt.Sprintf("batch %d/%d started: %s", dr.Status.CurrentBatch+1, len(dr.Status.Batches), strings.Join(batch, ", ")))
+	}
+	return result, nil
+}
+
+// recordTransition emits the event of the transition of the DeviceRollout status.
+func (r *DeviceRolloutReconciler) recordTransition(dr *kuestav1alpha1.DeviceRollout, reason string, failed []string) {
+	switch reason {
+	case kuestav1alpha1.ReasonTransactionStarted:
+		unchanged := 0
+		for _, v := range dr.Status.DeviceStatusMap {
+			if v == kuestav1alpha1.DeviceStatusCompleted {
+				unchanged++
+			}
 		}
-	} else {
-		l.Info("not updated")
+		msg := fmt.Sprintf("transaction started: %d devices", len(dr.Status.DesiredDeviceConfigMap)-unchanged)
+		if n := len(dr.Status.Batches); n > 1 {
+			msg = fmt.Sprintf("%s in %d batches, first batch: %s", msg, n, strings.Join(dr.Status.Batches[0], ", "))
+		}
+		if unchanged > 0 {
+			msg = fmt.Sprintf("%s, %d unchanged devices are skipped", msg, unchanged)
+		}
+		r.event(dr, corev1.EventTypeNormal, reason, msg)
+	case kuestav1alpha1.ReasonPendingApproval:
+		r.event(dr, corev1.EventTypeNormal, reason, fmt.Sprintf("revision %s waits for approval", dr.Status.PendingRevision()))
+	case kuestav1alpha1.ReasonTransactionCompleted:
+		r.event(dr, corev1.EventTypeNormal, reason, fmt.Sprintf("transaction completed: %d devices are provisioned", len(dr.Status.DesiredDeviceConfigMap)))
+	case kuestav1alpha1.ReasonTransactionCompletedWithFailures:
+		failed := dr.Status.FailedDevices()
+		r.event(dr, corev1.EventTypeWarning, reason, fmt.Sprintf("transaction completed: %d devices are provisioned, failed within the failure budget on devices: %s",
+			len(dr.Status.DesiredDeviceConfigMap)-len(failed), strings.Join(failed, ", ")))
+	case kuestav1alpha1.ReasonRollbackStarted:
+		r.event(dr, corev1.EventTypeWarning, kuestav1alpha1.ReasonTransactionFailed, fmt.Sprintf("transaction failed on devices: %s", strings.Join(failed, ", ")))
+		r.event(dr, corev1.EventTypeWarning, reason, fmt.Sprintf("rollback started: %d devices", len(dr.Status.PrevDeviceConfigMap)))
+	case kuestav1alpha1.ReasonRollbackCompleted:
+		r.event(dr, corev1.EventTypeNormal, reason, fmt.Sprintf("rollback completed: %d devices are rolled back", len(dr.Status.PrevDeviceConfigMap)))
+	case kuestav1alpha1.ReasonAborted:
+		r.event(dr, corev1.EventTypeWarning, reason, fmt.Sprintf("transaction aborted: %s", dr.Status.DeviceSummary()))
+	case kuestav1alpha1.ReasonRollbackFailed:
+		r.event(dr, corev1.EventTypeWarning, reason, fmt.Sprintf("rollback failed on devices: %s. manual recover is needed", strings.Join(failed, ", ")))
+	}
+}
+
+// approve approves the revision waiting for approval if an Approval referencing the revision exists.
+func (r *DeviceRolloutReconciler) approve(ctx context.Context, dr *kuestav1alpha1.DeviceRollout) error {
+	rev := dr.Status.PendingRevision()
+	if rev == "" || rev == dr.Status.ApprovedRevision {
+		return nil
+	}
+	var approvals kuestav1alpha1.ApprovalList
+	if err := r.List(ctx, &approvals, client.InNamespace(dr.Namespace)); err != nil {
+		return err
+	}
+	for _, a := range approvals.Items {
+		if a.Spec.RolloutName == dr.Name && a.Spec.Revision == rev {
+			dr.Approve(rev, a.Spec.Approver)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *DeviceRolloutReconciler) event(obj runtime.Object, eventType, reason, msg string) {
+	if r.Recorder == nil {
+		return
 	}
-	return ctrl.Result{}, nil
+	r.Recorder.Event(obj, eventType, reason, msg)
 }
 
 func (r *DeviceRolloutReconciler) Error(ctx context.Context, err error, msg string, kvs ...interface{}) {
@@ -74,12 +186,18 @@ func (r *DeviceRolloutReconciler) Error(ctx context.Context, err error, msg stri
 		l = l.WithValues("stacktrace", st)
 	}
 	l.Error(err, msg, kvs...)
-	// TODO Event emission
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DeviceRolloutReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&kuestav1alpha1.DeviceRollout{}).
+		Watches(&source.Kind{Type: &kuestav1alpha1.Approval{}}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			a, ok := obj.(*kuestav1alpha1.Approval)
+			if !ok {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: a.Namespace, Name: a.Spec.RolloutName}}}
+		})).
 		Complete(r)
 }
@@ -30,6 +30,8 @@ import (
 	provisioner "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -100,6 +102,9 @@ var _ = Describe("DeviceRollout controller", func() {
 			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&testDr), &dr)).NotTo(HaveOccurred())
 			Expect(dr.Status.Phase).Should(Equal(provisioner.RolloutPhaseHealthy))
 			Expect(dr.Status.Status).Should(Equal(provisioner.RolloutStatusCompleted))
+			Expect(meta.IsStatusConditionTrue(dr.Status.Conditions, provisioner.ConditionTypeReady)).Should(BeTrue())
+			Expect(meta.IsStatusConditionFalse(dr.Status.Conditions, provisioner.ConditionTypeProgressing)).Should(BeTrue())
+			Expect(dr.Status.ObservedGeneration).Should(Equal(dr.Generation))
 		})
 
 		Context("when new config provisioned", func() {
@@ -209,5 +214,68 @@ var _ = Describe("DeviceRollout controller", func() {
 				})
 			})
 		})
+
+		Context("when new config requires approval", func() {
+			BeforeEach(func() {
+				var dr provisioner.DeviceRollout
+				Eventually(func() error {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&testDr), &dr)).NotTo(HaveOccurred())
+					dr.Spec.DeviceConfigMap = desired
+					dr.Spec.RequireApproval = true
+					return k8sClient.Update(ctx, &dr)
+				}, timeout, interval).Should(Succeed())
+
+				Eventually(func() error {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&testDr), &dr)).NotTo(HaveOccurred())
+					if dr.Status.Phase != provisioner.RolloutPhasePendingApproval {
+						return fmt.Errorf("not updated yet")
+					}
+					return nil
+				}, timeout, interval).Should(Succeed())
+			})
+
+			AfterEach(func() {
+				err := k8sClient.DeleteAllOf(ctx, &provisioner.Approval{}, client.InNamespace(namespace))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should wait for approval without provisioning devices", func() {
+				var dr provisioner.DeviceRollout
+				Consistently(func() provisioner.RolloutPhase {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&testDr), &dr)).NotTo(HaveOccurred())
+					return dr.Status.Phase
+				}, "1s", interval).Should(Equal(provisioner.RolloutPhasePendingApproval))
+				Expect(dr.Status.Status).Should(Equal(provisioner.RolloutStatusCompleted))
+				for _, v := range dr.Status.DeviceStatusMap {
+					Expect(v).Should(Equal(provisioner.DeviceStatusCompleted))
+				}
+				Expect(meta.FindStatusCondition(dr.Status.Conditions, provisioner.ConditionTypeReady).Reason).Should(Equal(provisioner.ReasonPendingApproval))
+			})
+
+			It("should start transaction when Approval is created", func() {
+				approval := provisioner.Approval{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testDr.Namespace, Name: "approval"},
+					Spec: provisioner.ApprovalSpec{
+						RolloutName: testDr.Name,
+						Revision:    "desired",
+						Approver:    "alice",
+					},
+				}
+				Expect(k8sClient.Create(ctx, &approval)).NotTo(HaveOccurred())
+
+				var dr provisioner.DeviceRollout
+				Eventually(func() error {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&testDr), &dr)).NotTo(HaveOccurred())
+					if dr.Status.Status != provisioner.RolloutStatusRunning {
+						return fmt.Errorf("not updated yet")
+					}
+					return nil
+				}, timeout, interval).Should(Succeed())
+				Expect(dr.Status.Phase).Should(Equal(provisioner.RolloutPhaseHealthy))
+				Expect(dr.Status.ApprovedRevision).Should(Equal("desired"))
+				Expect(dr.Status.ApprovedBy).Should(Equal("alice"))
+				Expect(dr.Status.PrevDeviceConfigMap).Should(Equal(testDr.Spec.DeviceConfigMap))
+			})
+		})
 	})
 })
@@ -24,12 +24,14 @@ package controllers
 
 import (
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/nttcom/kuesta/provisioner/api/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
 // GitRepositoryRevisionChangePredicate triggers an update event
-// when a GitRepository revision changes.
+// when a GitRepository revision changes. It also applies to the other
+// Flux source objects such as OCIRepository and Bucket.
 type GitRepositoryRevisionChangePredicate struct {
 	predicate.Funcs
 }
@@ -70,3 +72,39 @@ func (GitRepositoryRevisionChangePredicate) Update(e event.UpdateEvent) bool {
 
 	return false
 }
+
+// DeviceRolloutPinnedRevisionChangePredicate triggers an update event
+// when a DeviceRollout pinned revision changes.
+type DeviceRolloutPinnedRevisionChangePredicate struct {
+	predicate.Funcs
+}
+
+func (DeviceRolloutPinnedRevisionChangePredicate) Create(e event.CreateEvent) bool {
+	return false
+}
+
+func (DeviceRolloutPinnedRevisionChangePredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+
+	oldDr, ok := e.ObjectOld.(*v1alpha1.DeviceRollout)
+	if !ok {
+		return false
+	}
+
+	newDr, ok := e.ObjectNew.(*v1alpha1.DeviceRollout)
+	if !ok {
+		return false
+	}
+
+	return oldDr.Spec.PinnedRevision != newDr.Spec.PinnedRevision
+}
+
+func (DeviceRolloutPinnedRevisionChangePredicate) Delete(e event.DeleteEvent) bool {
+	return false
+}
+
+func (DeviceRolloutPinnedRevisionChangePredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
@@ -24,31 +24,60 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/nttcom/kuesta/pkg/artifact"
+	"github.com/nttcom/kuesta/pkg/gitsign"
 	"github.com/nttcom/kuesta/pkg/kuesta"
 	"github.com/nttcom/kuesta/pkg/stacktrace"
 	"github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // GitRepositoryWatcher watches GitRepository objects for revision changes.
 type GitRepositoryWatcher struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// Verification is the policy to verify the commit signature of the revision before rolling it out.
+	// The revision is rolled out without verification if nil.
+	Verification *VerificationPolicy
+}
+
+// VerificationPolicy provides how to verify the commit signature of the GitRepository revision.
+type VerificationPolicy struct {
+	// SecretName is the name of the Secret which holds the trusted OpenPGP and SSH public keys.
+	// The Secret is looked up in the namespace of the GitRepository.
+	SecretName string
+
+	// FetchCommit fetches the commit of the GitRepository revision. artifact.FetchCommit is used if nil.
+	FetchCommit func(ctx context.Context, repository sourcev1.GitRepository, auth transport.AuthMethod) (*object.Commit, error)
 }
 
 // +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories,verbs=get;list;watch
 // +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories/status,verbs=get
 // +kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -62,39 +91,80 @@ func (r *GitRepositoryWatcher) Reconcile(ctx context.Context, req ctrl.Request)
 		r.Error(ctx, err, "get GitRepository")
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
-	l.Info(fmt.Sprintf("revision: %s", repository.Status.Artifact.Revision))
+	if repository.GetArtifact() == nil {
+		return ctrl.Result{}, nil
+	}
 
-	tmpDir, err := os.MkdirTemp("", repository.Name)
+	var current v1alpha1.DeviceRollout
+	if err := r.Get(ctx, req.NamespacedName, &current); client.IgnoreNotFound(err) != nil {
+		r.Error(ctx, err, "get DeviceRollout")
+		return ctrl.Result{}, err
+	}
+	if current.SourceKind() != v1alpha1.SourceKindGitRepository {
+		// NOTE the DeviceRollout with the other kind of source is handled by SourceWatcher
+		return ctrl.Result{}, nil
+	}
+
+	src, err := artifact.NewFluxSource(repository.DeepCopy())
 	if err != nil {
-		r.Error(ctx, err, "create temp dir")
+		r.Error(ctx, err, "create source")
 		return ctrl.Result{}, err
 	}
-	defer os.RemoveAll(tmpDir)
+	src.Checksums = current.Status.ArtifactChecksumMap()
 
-	summary, err := artifact.FetchArtifact(ctx, repository, tmpDir)
+	// build the spec from the pinned revision instead of the latest artifact if given
+	pinned, err := pinnedRevision(current, repository.GetArtifact().Revision)
 	if err != nil {
-		r.Error(ctx, err, "fetch artifact")
+		r.Error(ctx, err, "get pinned revision")
 		return ctrl.Result{}, err
 	}
-	l.Info(summary)
+	if pinned != "" {
+		repository.Status.Artifact.Revision = pinned
+		l.Info(fmt.Sprintf("revision: %s (pinned)", pinned))
+	} else {
+		l.Info(fmt.Sprintf("revision: %s", repository.Status.Artifact.Revision))
+	}
+
+	if r.Verification != nil {
+		if err := r.verifyRevision(ctx, repository); err != nil {
+			if !isVerificationFailure(err) {
+				r.Error(ctx, err, "verify revision")
+				return ctrl.Result{}, err
+			}
+			l.Info("revision rejected", "reason", err.Error())
+			if err := r.rejectRevision(ctx, req.NamespacedName, repository, err); err != nil {
+				r.Error(ctx, err, "reject revision")
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+	}
 
-	dps, err := kuesta.NewDevicePathList(tmpDir)
+	tmpDir, err := os.MkdirTemp("", repository.Name)
 	if err != nil {
-		r.Error(ctx, err, "list devices")
+		r.Error(ctx, err, "create temp dir")
 		return ctrl.Result{}, err
 	}
+	defer os.RemoveAll(tmpDir)
 
-	cmap := v1alpha1.DeviceConfigMap{}
-	for _, dp := range dps {
-		checksum, err := dp.CheckSum()
-		if err != nil {
-			r.Error(ctx, err, "get checksum")
+	revision, err := src.Fetch(ctx, tmpDir, pinned)
+	if artifact.IsUnknownRevision(err) {
+		// NOTE the revision is not fetched again until the GitRepository revision or the pinned revision is changed
+		l.Info("revision unavailable", "reason", err.Error())
+		if err := r.reportUnknownRevision(ctx, &current, repository, err); err != nil {
+			r.Error(ctx, err, "report unknown revision")
 			return ctrl.Result{}, err
 		}
-		cmap[dp.Device] = v1alpha1.DeviceConfig{
-			Checksum:    checksum,
-			GitRevision: repository.GetArtifact().Revision,
-		}
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		r.Error(ctx, err, "fetch artifact")
+		return ctrl.Result{}, err
+	}
+
+	cmap, err := buildDeviceConfigMap(tmpDir, revision)
+	if err != nil {
+		r.Error(ctx, err, "build device config map")
+		return ctrl.Result{}, err
 	}
 
 	dr := v1alpha1.DeviceRollout{
@@ -111,9 +181,189 @@ func (r *GitRepositoryWatcher) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
+	// NOTE the checksum is recorded so that the artifact of the revision is verified after it is no longer the latest
+	oldStatus := dr.Status.DeepCopy()
+	dr.RecordArtifactChecksum(src.Artifact.Revision, src.Artifact.Checksum)
+	setSourceAvailable(&dr, revision, nil)
+	if r.Verification != nil {
+		meta.SetStatusCondition(&dr.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionTypeVerified,
+			Status:  metav1.ConditionTrue,
+			Reason:  v1alpha1.ReasonSignatureVerified,
+			Message: fmt.Sprintf("commit signature of revision %s is verified", repository.GetArtifact().Revision),
+		})
+	}
+	if !equality.Semantic.DeepEqual(oldStatus, &dr.Status) {
+		if err := r.Status().Update(ctx, &dr); err != nil {
+			r.Error(ctx, err, "update DeviceRollout status")
+			return ctrl.Result{}, err
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// pinnedRevision returns the revision pinned by the DeviceRollout in the form of `<ref>/<commit sha>`, or empty if
+// not pinned. The reference name of the latest revision is complemented if the pinned revision only contains the
+// commit sha.
+func pinnedRevision(dr v1alpha1.DeviceRollout, latest string) (string, error) {
+	pinned := dr.Spec.PinnedRevision
+	if pinned == "" {
+		return "", nil
+	}
+	ref, hash, err := artifact.ParseRevision(pinned)
+	if err != nil {
+		return "", err
+	}
+	if ref == "" {
+		ref, _, _ = artifact.ParseRevision(latest)
+	}
+	if ref == "" {
+		return hash.String(), nil
+	}
+	return fmt.Sprintf("%s/%s", ref, hash), nil
+}
+
+// buildDeviceConfigMap builds the DeviceConfigMap from the config repository extracted to dir.
+func buildDeviceConfigMap(dir, revision string) (v1alpha1.DeviceConfigMap, error) {
+	dps, err := kuesta.NewDevicePathList(dir)
+	if err != nil {
+		return nil, fmt.Errorf("list devices: %w", err)
+	}
+
+	cmap := v1alpha1.DeviceConfigMap{}
+	for _, dp := range dps {
+		checksum, err := dp.CheckSum()
+		if err != nil {
+			return nil, fmt.Errorf("get checksum: %w", err)
+		}
+		cmap[dp.Device] = v1alpha1.DeviceConfig{
+			Checksum:    checksum,
+			GitRevision: revision,
+		}
+	}
+	return cmap, nil
+}
+
+// verifyRevision verifies the commit signature of the GitRepository revision with the trusted keys.
+func (r *GitRepositoryWatcher) verifyRevision(ctx context.Context, repository sourcev1.GitRepository) error {
+	var auth transport.AuthMethod
+	if ref := repository.Spec.SecretRef; ref != nil {
+		var authSecret corev1.Secret
+		if err := r.Get(ctx, types.NamespacedName{Namespace: repository.Namespace, Name: ref.Name}, &authSecret); err != nil {
+			return fmt.Errorf("get git auth secret: %w", err)
+		}
+		var err error
+		if auth, err = artifact.GitAuthFromSecretData(repository.Spec.URL, authSecret.Data); err != nil {
+			return err
+		}
+	}
+	return r.Verification.verify(ctx, r, repository, auth)
+}
+
+// verify verifies the commit signature of the revision of the git repository with the trusted keys. The trusted keys
+// are looked up in the namespace of the repository.
+func (p *VerificationPolicy) verify(ctx context.Context, c client.Reader, repository sourcev1.GitRepository, auth transport.AuthMethod) error {
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Namespace: repository.Namespace, Name: p.SecretName}, &secret); err != nil {
+		return fmt.Errorf("get trusted keys secret: %w", err)
+	}
+	keys, err := gitsign.TrustedKeysFromSecretData(secret.Data)
+	if err != nil {
+		return err
+	}
+
+	fetch := p.FetchCommit
+	if fetch == nil {
+		fetch = artifact.FetchCommit
+	}
+	commit, err := fetch(ctx, repository, auth)
+	if err != nil {
+		return err
+	}
+	return keys.Verify(commit)
+}
+
+// rejectRevision records that the GitRepository revision is rejected on the DeviceRollout status condition and
+// emits the warning event. The event is emitted to the GitRepository if the DeviceRollout does not exist yet.
+func (r *GitRepositoryWatcher) rejectRevision(ctx context.Context, nn types.NamespacedName, repository sourcev1.GitRepository, reason error) error {
+	msg := fmt.Sprintf("revision %s is rejected: %v", repository.GetArtifact().Revision, reason)
+
+	var dr v1alpha1.DeviceRollout
+	if err := r.Get(ctx, nn, &dr); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		r.event(&repository, corev1.EventTypeWarning, v1alpha1.ReasonSignatureVerificationFailed, msg)
+		return nil
+	}
+
+	meta.SetStatusCondition(&dr.Status.Conditions, metav1.Condition{
+		Type:    v1alpha1.ConditionTypeVerified,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.ReasonSignatureVerificationFailed,
+		Message: msg,
+	})
+	if err := r.Status().Update(ctx, &dr); err != nil {
+		return err
+	}
+	r.event(&dr, corev1.EventTypeWarning, v1alpha1.ReasonSignatureVerificationFailed, msg)
+	return nil
+}
+
+// reportUnknownRevision records that the revision is not available from the GitRepository on the DeviceRollout status
+// condition and emits the warning event. The event is emitted to the GitRepository if the DeviceRollout does not
+// exist yet.
+func (r *GitRepositoryWatcher) reportUnknownRevision(ctx context.Context, dr *v1alpha1.DeviceRollout, repository sourcev1.GitRepository, reason error) error {
+	if dr.ResourceVersion == "" {
+		r.event(&repository, corev1.EventTypeWarning, v1alpha1.ReasonUnknownRevision, reason.Error())
+		return nil
+	}
+	oldStatus := dr.Status.DeepCopy()
+	setSourceAvailable(dr, repository.GetArtifact().Revision, reason)
+	if equality.Semantic.DeepEqual(oldStatus, &dr.Status) {
+		return nil
+	}
+	if err := r.Status().Update(ctx, dr); err != nil {
+		return err
+	}
+	r.event(dr, corev1.EventTypeWarning, v1alpha1.ReasonUnknownRevision, reason.Error())
+	return nil
+}
+
+// setSourceAvailable sets the SourceAvailable condition of the DeviceRollout, which is false if the revision is not
+// available from the source with the given reason.
+func setSourceAvailable(dr *v1alpha1.DeviceRollout, revision string, reason error) {
+	cond := metav1.Condition{
+		Type:    v1alpha1.ConditionTypeSourceAvailable,
+		Status:  metav1.ConditionTrue,
+		Reason:  v1alpha1.ReasonRevisionFetched,
+		Message: fmt.Sprintf("revision %s is fetched", revision),
+	}
+	if reason != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = v1alpha1.ReasonUnknownRevision
+		cond.Message = fmt.Sprintf("revision %s is not available: %v", revision, reason)
+	}
+	meta.SetStatusCondition(&dr.Status.Conditions, cond)
+}
+
+func (r *GitRepositoryWatcher) event(obj runtime.Object, eventType, reason, msg string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(obj, eventType, reason, msg)
+}
+
+// errUnverifiableSource is returned when the commit signature of the revision cannot be verified since the source
+// does not provide the git commits.
+var errUnverifiableSource = errors.New("commit signature cannot be verified")
+
+func isVerificationFailure(err error) bool {
+	return errors.Is(err, gitsign.ErrUnsigned) || errors.Is(err, gitsign.ErrInvalidSignature) || errors.Is(err, gitsign.ErrUntrustedKey) ||
+		errors.Is(err, errUnverifiableSource)
+}
+
 func (r *GitRepositoryWatcher) Error(ctx context.Context, err error, msg string, kvs ...interface{}) {
 	if err == nil {
 		return
@@ -128,5 +378,8 @@ func (r *GitRepositoryWatcher) Error(ctx context.Context, err error, msg string,
 func (r *GitRepositoryWatcher) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sourcev1.GitRepository{}, builder.WithPredicates(GitRepositoryRevisionChangePredicate{})).
+		// NOTE the DeviceRollout is named after the GitRepository
+		Watches(&source.Kind{Type: &v1alpha1.DeviceRollout{}}, &handler.EnqueueRequestForObject{},
+			builder.WithPredicates(DeviceRolloutPinnedRevisionChangePredicate{})).
 		Complete(r)
 }
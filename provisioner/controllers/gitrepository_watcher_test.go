@@ -164,4 +164,98 @@ var _ = Describe("GitRepository watcher", func() {
 			Expect(dr.Spec.DeviceConfigMap).To(Equal(want))
 		})
 	})
+
+	Context("when revision pinned", func() {
+		pinnedConfig := []byte("foo-pinned")
+		pinnedSha := "0123456789abcdef0123456789abcdef01234567"
+
+		BeforeEach(func() {
+			var dr kuestav1alpha1.DeviceRollout
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKey{Namespace: testGr.Namespace, Name: testGr.Name}, &dr)
+			}, timeout, interval).Should(Succeed())
+
+			pinnedDir, err := os.MkdirTemp("", "git-watcher-test-*")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(pinnedDir)
+			Expect(testhelper.WriteFileWithMkdir(filepath.Join(pinnedDir, "devices", "device1", "config.cue"), pinnedConfig)).NotTo(HaveOccurred())
+			pinnedChecksum, pinnedBuf := testhelper.MustGenTgzArchiveDir(pinnedDir)
+			pinnedTgz, err := io.ReadAll(pinnedBuf)
+			Expect(err).NotTo(HaveOccurred())
+			checksum, buf := testhelper.MustGenTgzArchiveDir(dir)
+			tgz, err := io.ReadAll(buf)
+			Expect(err).NotTo(HaveOccurred())
+			h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				src := tgz
+				if r.URL.Path == fmt.Sprintf("/%s.tar.gz", pinnedSha) || r.URL.Path == "/pinned/latest.tar.gz" {
+					src = pinnedTgz
+				}
+				if _, err := w.Write(src); err != nil {
+					panic(err)
+				}
+			}))
+
+			// the pinned revision is seen as the latest artifact once so that its checksum is recorded
+			setArtifact := func(a *sourcev1.Artifact) {
+				Eventually(func() error {
+					var gr sourcev1.GitRepository
+					if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(&testGr), &gr); err != nil {
+						return err
+					}
+					gr.Status.Artifact = a
+					return k8sClient.Status().Update(ctx, &gr)
+				}, timeout, interval).Should(Succeed())
+			}
+			waitRevision := func(rev string) {
+				Eventually(func() error {
+					if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(&dr), &dr); err != nil {
+						return err
+					}
+					if dr.Status.ArtifactChecksumMap()[rev] == "" {
+						return fmt.Errorf("checksum of %s not recorded yet", rev)
+					}
+					return nil
+				}, timeout, interval).Should(Succeed())
+			}
+			setArtifact(&sourcev1.Artifact{
+				URL:      h.URL + "/pinned/latest.tar.gz",
+				Checksum: pinnedChecksum,
+				Revision: "main/" + pinnedSha,
+			})
+			waitRevision("main/" + pinnedSha)
+			setArtifact(&sourcev1.Artifact{
+				URL:      h.URL + "/latest.tar.gz",
+				Checksum: checksum,
+				Revision: "main/" + revision,
+			})
+			waitRevision("main/" + revision)
+
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(&dr), &dr); err != nil {
+					return err
+				}
+				dr.Spec.PinnedRevision = pinnedSha
+				return k8sClient.Update(ctx, &dr)
+			}, timeout, interval).Should(Succeed())
+		})
+
+		It("should build DeviceRollout from the pinned revision", func() {
+			var dr kuestav1alpha1.DeviceRollout
+			Eventually(func() error {
+				k8sClient.Get(ctx, client.ObjectKey{Namespace: testGr.Namespace, Name: testGr.Name}, &dr)
+				if dr.Spec.DeviceConfigMap["device1"].GitRevision != "main/"+pinnedSha {
+					return fmt.Errorf("not updated yet: %+v\n", dr.Spec.DeviceConfigMap)
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+
+			want := kuestav1alpha1.DeviceConfigMap{
+				"device1": kuestav1alpha1.DeviceConfig{
+					Checksum:    testhelper.Hash(pinnedConfig),
+					GitRevision: "main/" + pinnedSha,
+				},
+			}
+			Expect(dr.Spec.DeviceConfigMap).To(Equal(want))
+		})
+	})
 })
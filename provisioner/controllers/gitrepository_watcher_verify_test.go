@@ -0,0 +1,208 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package controllers_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	kuestav1alpha1 "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	"github.com/nttcom/kuesta/provisioner/controllers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("GitRepository watcher with verification policy", func() {
+	ctx := context.Background()
+	secretName := "trusted-keys"
+
+	var testGr sourcev1.GitRepository
+	Expect(testhelper.NewTestDataFromFixture("gitrepository", &testGr)).NotTo(HaveOccurred())
+
+	testScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(testScheme))
+	utilruntime.Must(sourcev1.AddToScheme(testScheme))
+	utilruntime.Must(kuestav1alpha1.AddToScheme(testScheme))
+
+	var (
+		dir      string
+		h        *httptest.Server
+		signKey  *openpgp.Entity
+		pubKey   string
+		commit   *object.Commit
+		recorder *record.FakeRecorder
+		c        client.Client
+		r        *controllers.GitRepositoryWatcher
+	)
+
+	newCommit := func(signKey *openpgp.Entity) *object.Commit {
+		sig := object.Signature{Name: "kuesta", Email: "kuesta@example.com", When: time.Now()}
+		cm := &object.Commit{Author: sig, Committer: sig, Message: "update device config"}
+		if signKey == nil {
+			return cm
+		}
+		o := &plumbing.MemoryObject{}
+		Expect(cm.EncodeWithoutSignature(o)).NotTo(HaveOccurred())
+		rd, err := o.Reader()
+		Expect(err).NotTo(HaveOccurred())
+		var buf bytes.Buffer
+		Expect(openpgp.ArmoredDetachSign(&buf, signKey, rd, nil)).NotTo(HaveOccurred())
+		cm.PGPSignature = buf.String()
+		return cm
+	}
+
+	reconcile := func() error {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&testGr)})
+		return err
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "git-watcher-verify-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testhelper.WriteFileWithMkdir(filepath.Join(dir, "devices", "device1", "config.cue"), []byte("foo"))).NotTo(HaveOccurred())
+
+		checksum, tgz := testhelper.MustGenTgzArchiveDir(dir)
+		buf, err := io.ReadAll(tgz)
+		Expect(err).NotTo(HaveOccurred())
+		h = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, err := w.Write(buf); err != nil {
+				panic(err)
+			}
+		}))
+
+		signKey, err = openpgp.NewEntity("kuesta", "", "kuesta@example.com", nil)
+		Expect(err).NotTo(HaveOccurred())
+		var pub bytes.Buffer
+		w, err := armor.Encode(&pub, openpgp.PublicKeyType, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signKey.Serialize(w)).NotTo(HaveOccurred())
+		Expect(w.Close()).NotTo(HaveOccurred())
+		pubKey = pub.String()
+
+		gr := testGr.DeepCopy()
+		gr.Status.Artifact = &sourcev1.Artifact{
+			URL:      h.URL,
+			Checksum: checksum,
+			Revision: "main/5394cb7f48332b2de7c17dd8b8384bbc84b7e738",
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: testGr.Namespace},
+			Data:       map[string][]byte{"kuesta.asc": []byte(pubKey)},
+		}
+		c = fake.NewClientBuilder().WithScheme(testScheme).WithObjects(gr, secret).Build()
+		recorder = record.NewFakeRecorder(10)
+		r = &controllers.GitRepositoryWatcher{
+			Client:   c,
+			Scheme:   testScheme,
+			Recorder: recorder,
+			Verification: &controllers.VerificationPolicy{
+				SecretName: secretName,
+				FetchCommit: func(ctx context.Context, repository sourcev1.GitRepository, auth transport.AuthMethod) (*object.Commit, error) {
+					return commit, nil
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		h.Close()
+		os.RemoveAll(dir)
+	})
+
+	It("should create DeviceRollout when commit is signed by trusted key", func() {
+		commit = newCommit(signKey)
+		Expect(reconcile()).NotTo(HaveOccurred())
+
+		var dr kuestav1alpha1.DeviceRollout
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(&testGr), &dr)).NotTo(HaveOccurred())
+		Expect(dr.Spec.DeviceConfigMap).To(HaveKey("device1"))
+		Expect(meta.IsStatusConditionTrue(dr.Status.Conditions, kuestav1alpha1.ConditionTypeVerified)).To(BeTrue())
+	})
+
+	It("should not create DeviceRollout when commit is unsigned", func() {
+		commit = newCommit(nil)
+		Expect(reconcile()).NotTo(HaveOccurred())
+
+		var dr kuestav1alpha1.DeviceRollout
+		err := c.Get(ctx, client.ObjectKeyFromObject(&testGr), &dr)
+		Expect(client.IgnoreNotFound(err)).NotTo(HaveOccurred())
+		Expect(err).To(HaveOccurred())
+		Expect(recorder.Events).To(Receive(ContainSubstring(kuestav1alpha1.ReasonSignatureVerificationFailed)))
+	})
+
+	Context("when DeviceRollout exists", func() {
+		BeforeEach(func() {
+			commit = newCommit(signKey)
+			Expect(reconcile()).NotTo(HaveOccurred())
+		})
+
+		It("should reject revision signed by untrusted key", func() {
+			otherKey, err := openpgp.NewEntity("other", "", "other@example.com", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			var before kuestav1alpha1.DeviceRollout
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(&testGr), &before)).NotTo(HaveOccurred())
+
+			var gr sourcev1.GitRepository
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(&testGr), &gr)).NotTo(HaveOccurred())
+			gr.Status.Artifact.Revision = "main/1b6453892473a467d07372d45eb05abc2031647a"
+			Expect(c.Status().Update(ctx, &gr)).NotTo(HaveOccurred())
+
+			commit = newCommit(otherKey)
+			Expect(reconcile()).NotTo(HaveOccurred())
+
+			var dr kuestav1alpha1.DeviceRollout
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(&testGr), &dr)).NotTo(HaveOccurred())
+			Expect(dr.Spec).To(Equal(before.Spec))
+			cond := meta.FindStatusCondition(dr.Status.Conditions, kuestav1alpha1.ConditionTypeVerified)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(kuestav1alpha1.ReasonSignatureVerificationFailed))
+			Expect(recorder.Events).To(Receive(ContainSubstring(kuestav1alpha1.ReasonSignatureVerificationFailed)))
+		})
+	})
+})
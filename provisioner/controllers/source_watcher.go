@@ -0,0 +1,316 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/nttcom/kuesta/pkg/artifact"
+	"github.com/nttcom/kuesta/pkg/stacktrace"
+	"github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	"github.com/nttcom/kuesta/provisioner/pkg/rolloutsource"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// SourceWatcher watches the sources of the DeviceRollouts other than GitRepository, which are OCIRepository, Bucket
+// and the git repository cloned without Flux, and builds the DeviceRollouts from their new revisions.
+type SourceWatcher struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// DisableFlux stops watching the Flux source objects so that the watcher runs without Flux installed. Only
+	// the Git source is available then.
+	DisableFlux bool
+
+	// Verification is the policy to verify the commit signature of the revision before rolling it out. Only the
+	// revisions of the Git source can be verified, and those of the other sources are refused if set. The revision
+	// is rolled out without verification if nil.
+	Verification *VerificationPolicy
+}
+
+// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=ocirepositories;buckets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=ocirepositories/status;buckets/status,verbs=get
+// +kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kuesta.hrk091.dev,resources=devicerollouts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *SourceWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	l := log.FromContext(ctx)
+	l.Info("start reconciliation")
+
+	var dr v1alpha1.DeviceRollout
+	if err := r.Get(ctx, req.NamespacedName, &dr); err != nil {
+		r.Error(ctx, err, "get DeviceRollout")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	kind := dr.SourceKind()
+	if kind == v1alpha1.SourceKindGitRepository {
+		// NOTE the DeviceRollout with GitRepository is handled by GitRepositoryWatcher
+		return ctrl.Result{}, nil
+	}
+	if r.DisableFlux && kind != v1alpha1.SourceKindGit {
+		l.Info(fmt.Sprintf("source %s is ignored since Flux is disabled", kind))
+		return ctrl.Result{}, nil
+	}
+
+	// NOTE the git repository is polled since nothing notifies its changes
+	var result ctrl.Result
+	if kind == v1alpha1.SourceKindGit {
+		result.RequeueAfter = dr.SourceInterval()
+	}
+
+	src, err := rolloutsource.New(ctx, r, &dr)
+	if err != nil {
+		r.Error(ctx, err, "get source")
+		return ctrl.Result{}, err
+	}
+	latest, err := src.Revision(ctx)
+	if err != nil {
+		r.Error(ctx, err, "get latest revision")
+		return ctrl.Result{}, err
+	}
+
+	// build the spec from the pinned revision instead of the latest revision if given
+	revision := latest
+	pinned, err := pinnedRevision(dr, latest)
+	if err != nil {
+		r.Error(ctx, err, "get pinned revision")
+		return ctrl.Result{}, err
+	}
+	if pinned != "" {
+		revision = pinned
+		l.Info(fmt.Sprintf("revision: %s (pinned)", revision))
+	} else {
+		l.Info(fmt.Sprintf("revision: %s", revision))
+	}
+	// NOTE the revision verified already is not verified again on every poll
+	verified := dr.Spec.DeviceConfigMap.Revision() == revision && meta.IsStatusConditionTrue(dr.Status.Conditions, v1alpha1.ConditionTypeVerified)
+	if r.Verification != nil && !verified {
+		if err := r.verifyRevision(ctx, &dr, src, revision); err != nil {
+			if !isVerificationFailure(err) {
+				r.Error(ctx, err, "verify revision")
+				return ctrl.Result{}, err
+			}
+			l.Info("revision rejected", "reason", err.Error())
+			if err := r.rejectRevision(ctx, &dr, revision, err); err != nil {
+				r.Error(ctx, err, "reject revision")
+				return ctrl.Result{}, err
+			}
+			return result, nil
+		}
+	}
+
+	if dr.Spec.DeviceConfigMap.Revision() != revision {
+		if err := r.updateDeviceConfigMap(ctx, &dr, src, revision); artifact.IsUnknownRevision(err) {
+			// NOTE the revision is not fetched again until the source revision or the pinned revision is changed
+			l.Info("revision unavailable", "reason", err.Error())
+			if err := r.reportUnknownRevision(ctx, &dr, revision, err); err != nil {
+				r.Error(ctx, err, "report unknown revision")
+				return ctrl.Result{}, err
+			}
+			return result, nil
+		} else if err != nil {
+			r.Error(ctx, err, "update DeviceRollout")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// NOTE the checksum is recorded so that the artifact of the revision is verified after it is no longer the latest
+	oldStatus := dr.Status.DeepCopy()
+	setSourceAvailable(&dr, revision, nil)
+	if cs, ok := src.(artifact.ChecksumSource); ok {
+		if checksum := cs.Checksum(latest); checksum != "" && dr.Status.ArtifactChecksumMap()[latest] != checksum {
+			dr.RecordArtifactChecksum(latest, checksum)
+		}
+	}
+	if r.Verification != nil {
+		meta.SetStatusCondition(&dr.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionTypeVerified,
+			Status:  metav1.ConditionTrue,
+			Reason:  v1alpha1.ReasonSignatureVerified,
+			Message: fmt.Sprintf("commit signature of revision %s is verified", revision),
+		})
+	}
+	if !equality.Semantic.DeepEqual(oldStatus, &dr.Status) {
+		if err := r.Status().Update(ctx, &dr); err != nil {
+			r.Error(ctx, err, "update DeviceRollout status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// verifyRevision verifies the commit signature of the revision of the Git source with the trusted keys. The
+// revisions of the other sources cannot be verified since they do not provide the git commits.
+func (r *SourceWatcher) verifyRevision(ctx context.Context, dr *v1alpha1.DeviceRollout, src artifact.Source, revision string) error {
+	gs, ok := src.(*artifact.GitSource)
+	if !ok {
+		return fmt.Errorf("%w: source %s does not provide git commits", errUnverifiableSource, dr.SourceKind())
+	}
+	repository := sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: dr.Namespace, Name: dr.Name},
+		Spec:       sourcev1.GitRepositorySpec{URL: gs.URL},
+		Status:     sourcev1.GitRepositoryStatus{Artifact: &sourcev1.Artifact{Revision: revision}},
+	}
+	return r.Verification.verify(ctx, r, repository, gs.Auth)
+}
+
+// rejectRevision records that the revision is rejected on the DeviceRollout status condition and emits the warning
+// event.
+func (r *SourceWatcher) rejectRevision(ctx context.Context, dr *v1alpha1.DeviceRollout, revision string, reason error) error {
+	msg := fmt.Sprintf("revision %s is rejected: %v", revision, reason)
+	condReason := v1alpha1.ReasonSignatureVerificationFailed
+	if errors.Is(reason, errUnverifiableSource) {
+		condReason = v1alpha1.ReasonSourceNotVerifiable
+	}
+	// NOTE the revision rejected already is not reported again on every poll
+	oldStatus := dr.Status.DeepCopy()
+	meta.SetStatusCondition(&dr.Status.Conditions, metav1.Condition{
+		Type:    v1alpha1.ConditionTypeVerified,
+		Status:  metav1.ConditionFalse,
+		Reason:  condReason,
+		Message: msg,
+	})
+	if equality.Semantic.DeepEqual(oldStatus, &dr.Status) {
+		return nil
+	}
+	if err := r.Status().Update(ctx, dr); err != nil {
+		return err
+	}
+	r.event(dr, corev1.EventTypeWarning, condReason, msg)
+	return nil
+}
+
+// reportUnknownRevision records that the revision is not available from the source on the DeviceRollout status
+// condition and emits the warning event.
+func (r *SourceWatcher) reportUnknownRevision(ctx context.Context, dr *v1alpha1.DeviceRollout, revision string, reason error) error {
+	oldStatus := dr.Status.DeepCopy()
+	setSourceAvailable(dr, revision, reason)
+	if equality.Semantic.DeepEqual(oldStatus, &dr.Status) {
+		return nil
+	}
+	if err := r.Status().Update(ctx, dr); err != nil {
+		return err
+	}
+	r.event(dr, corev1.EventTypeWarning, v1alpha1.ReasonUnknownRevision, reason.Error())
+	return nil
+}
+
+func (r *SourceWatcher) event(obj runtime.Object, eventType, reason, msg string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(obj, eventType, reason, msg)
+}
+
+// updateDeviceConfigMap builds the DeviceConfigMap of the DeviceRollout from the revision of the source.
+func (r *SourceWatcher) updateDeviceConfigMap(ctx context.Context, dr *v1alpha1.DeviceRollout, src artifact.Source, revision string) error {
+	tmpDir, err := os.MkdirTemp("", dr.Name)
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	revision, err = src.Fetch(ctx, tmpDir, revision)
+	if err != nil {
+		return fmt.Errorf("fetch artifact: %w", err)
+	}
+	cmap, err := buildDeviceConfigMap(tmpDir, revision)
+	if err != nil {
+		return err
+	}
+
+	dr.Spec.DeviceConfigMap = cmap
+	return r.Update(ctx, dr)
+}
+
+// findRolloutsForSource returns the map function to enqueue the DeviceRollouts referencing the given Flux source
+// object of the kind.
+func (r *SourceWatcher) findRolloutsForSource(kind string) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		var list v1alpha1.DeviceRolloutList
+		if err := r.List(context.Background(), &list, client.InNamespace(obj.GetNamespace())); err != nil {
+			r.Error(context.Background(), err, "list DeviceRollouts")
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, dr := range list.Items {
+			if dr.SourceKind() == kind && dr.SourceName() == obj.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: dr.Namespace, Name: dr.Name},
+				})
+			}
+		}
+		return requests
+	}
+}
+
+func (r *SourceWatcher) Error(ctx context.Context, err error, msg string, kvs ...interface{}) {
+	if err == nil {
+		return
+	}
+	l := log.FromContext(ctx).WithCallDepth(1)
+	if st := stacktrace.Get(err); st != "" {
+		l = l.WithValues("stacktrace", st)
+	}
+	l.Error(err, msg, kvs...)
+}
+
+func (r *SourceWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("sourcewatcher").
+		For(&v1alpha1.DeviceRollout{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	if !r.DisableFlux {
+		b = b.
+			Watches(&source.Kind{Type: &sourcev1.OCIRepository{}},
+				handler.EnqueueRequestsFromMapFunc(r.findRolloutsForSource(v1alpha1.SourceKindOCIRepository)),
+				builder.WithPredicates(GitRepositoryRevisionChangePredicate{})).
+			Watches(&source.Kind{Type: &sourcev1.Bucket{}},
+				handler.EnqueueRequestsFromMapFunc(r.findRolloutsForSource(v1alpha1.SourceKindBucket)),
+				builder.WithPredicates(GitRepositoryRevisionChangePredicate{}))
+	}
+	return b.Complete(r)
+}
@@ -0,0 +1,193 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	extgogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	kuestav1alpha1 "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Source watcher", func() {
+	ctx := context.Background()
+
+	config1 := []byte("foo")
+	config2 := []byte("bar")
+
+	AfterEach(func() {
+		err := k8sClient.DeleteAllOf(ctx, &kuestav1alpha1.DeviceRollout{}, client.InNamespace(namespace))
+		Expect(err).NotTo(HaveOccurred())
+		err = k8sClient.DeleteAllOf(ctx, &sourcev1.OCIRepository{}, client.InNamespace(namespace))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when source is OCIRepository", func() {
+		revision := "latest/0123456789abcdef"
+
+		BeforeEach(func() {
+			dir, err := os.MkdirTemp("", "source-watcher-test-*")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			Expect(testhelper.WriteFileWithMkdir(filepath.Join(dir, "devices", "device1", "config.cue"), config1)).NotTo(HaveOccurred())
+			Expect(testhelper.WriteFileWithMkdir(filepath.Join(dir, "devices", "device2", "config.cue"), config2)).NotTo(HaveOccurred())
+
+			checksum, buf := testhelper.MustGenTgzArchiveDir(dir)
+			h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if _, err := io.Copy(w, buf); err != nil {
+					panic(err)
+				}
+			}))
+
+			oci := &sourcev1.OCIRepository{
+				ObjectMeta: metav1.ObjectMeta{Name: "oci-source", Namespace: namespace},
+				Spec: sourcev1.OCIRepositorySpec{
+					URL:      "oci://example.com/config",
+					Interval: metav1.Duration{Duration: time.Minute},
+				},
+			}
+			Expect(k8sClient.Create(ctx, oci)).NotTo(HaveOccurred())
+			oci.Status.Artifact = &sourcev1.Artifact{
+				URL:      h.URL,
+				Checksum: checksum,
+				Revision: revision,
+			}
+			Eventually(func() error {
+				return k8sClient.Status().Update(ctx, oci)
+			}, timeout, interval).Should(Succeed())
+
+			dr := &kuestav1alpha1.DeviceRollout{
+				ObjectMeta: metav1.ObjectMeta{Name: "oci-rollout", Namespace: namespace},
+				Spec: kuestav1alpha1.DeviceRolloutSpec{
+					Source: &kuestav1alpha1.SourceReference{
+						Kind: kuestav1alpha1.SourceKindOCIRepository,
+						Name: "oci-source",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, dr)).NotTo(HaveOccurred())
+		})
+
+		It("should build DeviceRollout from the artifact", func() {
+			var dr kuestav1alpha1.DeviceRollout
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "oci-rollout"}, &dr); err != nil {
+					return err
+				}
+				if len(dr.Spec.DeviceConfigMap) == 0 {
+					return fmt.Errorf("not updated yet")
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+
+			want := kuestav1alpha1.DeviceConfigMap{
+				"device1": kuestav1alpha1.DeviceConfig{
+					Checksum:    testhelper.Hash(config1),
+					GitRevision: revision,
+				},
+				"device2": kuestav1alpha1.DeviceConfig{
+					Checksum:    testhelper.Hash(config2),
+					GitRevision: revision,
+				},
+			}
+			Expect(dr.Spec.DeviceConfigMap).To(Equal(want))
+		})
+	})
+
+	Context("when source is Git", func() {
+		var url, revision string
+
+		AfterEach(func() {
+			os.RemoveAll(url)
+		})
+
+		BeforeEach(func() {
+			var err error
+			url, err = os.MkdirTemp("", "source-watcher-test-*")
+			Expect(err).NotTo(HaveOccurred())
+			repo, err := extgogit.PlainInit(url, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testhelper.WriteFileWithMkdir(filepath.Join(url, "devices", "device1", "config.cue"), config1)).NotTo(HaveOccurred())
+			Expect(testhelper.WriteFileWithMkdir(filepath.Join(url, "devices", "device2", "config.cue"), config2)).NotTo(HaveOccurred())
+			w, err := repo.Worktree()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.AddGlob("devices")).NotTo(HaveOccurred())
+			h, err := w.Commit("add devices", &extgogit.CommitOptions{
+				Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			revision = "master/" + h.String()
+
+			dr := &kuestav1alpha1.DeviceRollout{
+				ObjectMeta: metav1.ObjectMeta{Name: "git-rollout", Namespace: namespace},
+				Spec: kuestav1alpha1.DeviceRolloutSpec{
+					Source: &kuestav1alpha1.SourceReference{
+						Kind:   kuestav1alpha1.SourceKindGit,
+						URL:    url,
+						Branch: "master",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, dr)).NotTo(HaveOccurred())
+		})
+
+		It("should build DeviceRollout from the head of the branch", func() {
+			var dr kuestav1alpha1.DeviceRollout
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "git-rollout"}, &dr); err != nil {
+					return err
+				}
+				if len(dr.Spec.DeviceConfigMap) == 0 {
+					return fmt.Errorf("not updated yet")
+				}
+				return nil
+			}, timeout, interval).Should(Succeed())
+
+			want := kuestav1alpha1.DeviceConfigMap{
+				"device1": kuestav1alpha1.DeviceConfig{
+					Checksum:    testhelper.Hash(config1),
+					GitRevision: revision,
+				},
+				"device2": kuestav1alpha1.DeviceConfig{
+					Checksum:    testhelper.Hash(config2),
+					GitRevision: revision,
+				},
+			}
+			Expect(dr.Spec.DeviceConfigMap).To(Equal(want))
+		})
+	})
+})
@@ -0,0 +1,224 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package controllers_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	extgogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/nttcom/kuesta/pkg/testing/testhelper"
+	kuestav1alpha1 "github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	"github.com/nttcom/kuesta/provisioner/controllers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Source watcher with verification policy", func() {
+	ctx := context.Background()
+	ns := "test-ns"
+	secretName := "trusted-keys"
+
+	testScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(testScheme))
+	utilruntime.Must(sourcev1.AddToScheme(testScheme))
+	utilruntime.Must(kuestav1alpha1.AddToScheme(testScheme))
+
+	var (
+		url      string
+		signKey  *openpgp.Entity
+		commit   *object.Commit
+		recorder *record.FakeRecorder
+		c        client.Client
+		r        *controllers.SourceWatcher
+	)
+
+	newCommit := func(signKey *openpgp.Entity) *object.Commit {
+		sig := object.Signature{Name: "kuesta", Email: "kuesta@example.com", When: time.Now()}
+		cm := &object.Commit{Author: sig, Committer: sig, Message: "update device config"}
+		if signKey == nil {
+			return cm
+		}
+		o := &plumbing.MemoryObject{}
+		Expect(cm.EncodeWithoutSignature(o)).NotTo(HaveOccurred())
+		rd, err := o.Reader()
+		Expect(err).NotTo(HaveOccurred())
+		var buf bytes.Buffer
+		Expect(openpgp.ArmoredDetachSign(&buf, signKey, rd, nil)).NotTo(HaveOccurred())
+		cm.PGPSignature = buf.String()
+		return cm
+	}
+
+	reconcile := func(name string) error {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: ns, Name: name}})
+		return err
+	}
+
+	getRollout := func(name string) kuestav1alpha1.DeviceRollout {
+		var dr kuestav1alpha1.DeviceRollout
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, &dr)).NotTo(HaveOccurred())
+		return dr
+	}
+
+	BeforeEach(func() {
+		var err error
+		url, err = os.MkdirTemp("", "source-watcher-verify-test-*")
+		Expect(err).NotTo(HaveOccurred())
+		repo, err := extgogit.PlainInit(url, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testhelper.WriteFileWithMkdir(filepath.Join(url, "devices", "device1", "config.cue"), []byte("foo"))).NotTo(HaveOccurred())
+		w, err := repo.Worktree()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.AddGlob("devices")).NotTo(HaveOccurred())
+		_, err = w.Commit("add devices", &extgogit.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		signKey, err = openpgp.NewEntity("kuesta", "", "kuesta@example.com", nil)
+		Expect(err).NotTo(HaveOccurred())
+		var pub bytes.Buffer
+		aw, err := armor.Encode(&pub, openpgp.PublicKeyType, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signKey.Serialize(aw)).NotTo(HaveOccurred())
+		Expect(aw.Close()).NotTo(HaveOccurred())
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: ns},
+			Data:       map[string][]byte{"kuesta.asc": pub.Bytes()},
+		}
+		gitRollout := &kuestav1alpha1.DeviceRollout{
+			ObjectMeta: metav1.ObjectMeta{Name: "git-rollout", Namespace: ns},
+			Spec: kuestav1alpha1.DeviceRolloutSpec{
+				Source: &kuestav1alpha1.SourceReference{Kind: kuestav1alpha1.SourceKindGit, URL: url, Branch: "master"},
+			},
+		}
+		oci := &sourcev1.OCIRepository{
+			ObjectMeta: metav1.ObjectMeta{Name: "oci-source", Namespace: ns},
+			Status: sourcev1.OCIRepositoryStatus{
+				Artifact: &sourcev1.Artifact{URL: "http://example.com/latest.tar.gz", Revision: "latest/0123456789abcdef"},
+			},
+		}
+		ociRollout := &kuestav1alpha1.DeviceRollout{
+			ObjectMeta: metav1.ObjectMeta{Name: "oci-rollout", Namespace: ns},
+			Spec: kuestav1alpha1.DeviceRolloutSpec{
+				Source: &kuestav1alpha1.SourceReference{Kind: kuestav1alpha1.SourceKindOCIRepository, Name: oci.Name},
+			},
+		}
+		c = fake.NewClientBuilder().WithScheme(testScheme).WithObjects(secret, gitRollout, oci, ociRollout).Build()
+		recorder = record.NewFakeRecorder(10)
+		r = &controllers.SourceWatcher{
+			Client:   c,
+			Scheme:   testScheme,
+			Recorder: recorder,
+			Verification: &controllers.VerificationPolicy{
+				SecretName: secretName,
+				FetchCommit: func(ctx context.Context, repository sourcev1.GitRepository, auth transport.AuthMethod) (*object.Commit, error) {
+					return commit, nil
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(url)
+	})
+
+	It("should build DeviceRollout when commit of Git source is signed by trusted key", func() {
+		commit = newCommit(signKey)
+		Expect(reconcile("git-rollout")).NotTo(HaveOccurred())
+
+		dr := getRollout("git-rollout")
+		Expect(dr.Spec.DeviceConfigMap).To(HaveKey("device1"))
+		Expect(meta.IsStatusConditionTrue(dr.Status.Conditions, kuestav1alpha1.ConditionTypeVerified)).To(BeTrue())
+	})
+
+	It("should not build DeviceRollout when commit of Git source is unsigned", func() {
+		commit = newCommit(nil)
+		Expect(reconcile("git-rollout")).NotTo(HaveOccurred())
+
+		dr := getRollout("git-rollout")
+		Expect(dr.Spec.DeviceConfigMap).To(BeEmpty())
+		cond := meta.FindStatusCondition(dr.Status.Conditions, kuestav1alpha1.ConditionTypeVerified)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(kuestav1alpha1.ReasonSignatureVerificationFailed))
+		Expect(recorder.Events).To(Receive(ContainSubstring(kuestav1alpha1.ReasonSignatureVerificationFailed)))
+	})
+
+	It("should refuse the source which cannot be verified", func() {
+		Expect(reconcile("oci-rollout")).NotTo(HaveOccurred())
+
+		dr := getRollout("oci-rollout")
+		Expect(dr.Spec.DeviceConfigMap).To(BeEmpty())
+		cond := meta.FindStatusCondition(dr.Status.Conditions, kuestav1alpha1.ConditionTypeVerified)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(kuestav1alpha1.ReasonSourceNotVerifiable))
+		Expect(recorder.Events).To(Receive(ContainSubstring(kuestav1alpha1.ReasonSourceNotVerifiable)))
+
+		// the rejection is not reported again
+		Expect(reconcile("oci-rollout")).NotTo(HaveOccurred())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should report the pinned revision not available from the source", func() {
+		commit = newCommit(signKey)
+		dr := getRollout("git-rollout")
+		dr.Spec.PinnedRevision = "master/0123456789abcdef0123456789abcdef01234567"
+		Expect(c.Update(ctx, &dr)).NotTo(HaveOccurred())
+
+		Expect(reconcile("git-rollout")).NotTo(HaveOccurred())
+
+		dr = getRollout("git-rollout")
+		Expect(dr.Spec.DeviceConfigMap).To(BeEmpty())
+		cond := meta.FindStatusCondition(dr.Status.Conditions, kuestav1alpha1.ConditionTypeSourceAvailable)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(kuestav1alpha1.ReasonUnknownRevision))
+		Expect(recorder.Events).To(Receive(ContainSubstring(kuestav1alpha1.ReasonUnknownRevision)))
+
+		// the unknown revision is not reported again
+		Expect(reconcile("git-rollout")).NotTo(HaveOccurred())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+})
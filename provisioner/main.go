@@ -59,11 +59,20 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var verifySecretName string
+	var disableFlux bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&verifySecretName, "verify-commit-secret", "",
+		"The name of the Secret which holds the trusted OpenPGP/SSH public keys in the namespace of the source. "+
+			"If set, revisions whose commit signature is not verified with the trusted keys are not rolled out, "+
+			"and the sources other than GitRepository and Git are refused since their commits cannot be verified.")
+	flag.BoolVar(&disableFlux, "disable-flux", false,
+		"Disable watching the Flux source objects so that the provisioner runs without Flux installed. "+
+			"Only the DeviceRollouts with the Git source are handled then.")
 	opts := zap.Options{
 		Development: true,
 		// show caller for debug use
@@ -105,16 +114,35 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controllers.GitRepositoryWatcher{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+	var verification *controllers.VerificationPolicy
+	if verifySecretName != "" {
+		verification = &controllers.VerificationPolicy{SecretName: verifySecretName}
+	}
+	if !disableFlux {
+		if err = (&controllers.GitRepositoryWatcher{
+			Client:       mgr.GetClient(),
+			Scheme:       mgr.GetScheme(),
+			Recorder:     mgr.GetEventRecorderFor("gitrepository-watcher"),
+			Verification: verification,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "GitRepositoryWatcher")
+			os.Exit(1)
+		}
+	}
+	if err = (&controllers.SourceWatcher{
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("source-watcher"),
+		DisableFlux:  disableFlux,
+		Verification: verification,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "GitRepositoryWatcher")
+		setupLog.Error(err, "unable to create controller", "controller", "SourceWatcher")
 		os.Exit(1)
 	}
 	if err = (&controllers.DeviceRolloutReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("devicerollout-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DeviceRollout")
 		os.Exit(1)
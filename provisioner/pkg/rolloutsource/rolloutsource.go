@@ -0,0 +1,87 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+// Package rolloutsource resolves the source of the config repository referenced by DeviceRollout.
+package rolloutsource
+
+import (
+	"context"
+	"fmt"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/nttcom/kuesta/pkg/artifact"
+	"github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// New returns the artifact.Source of the config repository referenced by the DeviceRollout. The artifacts of the
+// Flux source object are verified with the checksums recorded in the DeviceRollout status.
+func New(ctx context.Context, c client.Reader, dr *v1alpha1.DeviceRollout) (artifact.Source, error) {
+	nn := types.NamespacedName{Namespace: dr.Namespace, Name: dr.SourceName()}
+
+	var obj sourcev1.Source
+	switch kind := dr.SourceKind(); kind {
+	case v1alpha1.SourceKindGitRepository:
+		obj = &sourcev1.GitRepository{}
+	case v1alpha1.SourceKindOCIRepository:
+		obj = &sourcev1.OCIRepository{}
+	case v1alpha1.SourceKindBucket:
+		obj = &sourcev1.Bucket{}
+	case v1alpha1.SourceKindGit:
+		return newGitSource(ctx, c, dr)
+	default:
+		return nil, fmt.Errorf("unsupported source kind: %s", kind)
+	}
+
+	if err := c.Get(ctx, nn, obj.(client.Object)); err != nil {
+		return nil, fmt.Errorf("get %s %s: %w", dr.SourceKind(), nn, err)
+	}
+	src, err := artifact.NewFluxSource(obj)
+	if err != nil {
+		return nil, err
+	}
+	src.Checksums = dr.Status.ArtifactChecksumMap()
+	return src, nil
+}
+
+func newGitSource(ctx context.Context, c client.Reader, dr *v1alpha1.DeviceRollout) (artifact.Source, error) {
+	ref := dr.Spec.Source
+	if ref.URL == "" {
+		return nil, fmt.Errorf("url of the git source is not given")
+	}
+
+	var auth transport.AuthMethod
+	if ref.SecretName != "" {
+		var secret corev1.Secret
+		if err := c.Get(ctx, types.NamespacedName{Namespace: dr.Namespace, Name: ref.SecretName}, &secret); err != nil {
+			return nil, fmt.Errorf("get git auth secret: %w", err)
+		}
+		var err error
+		if auth, err = artifact.GitAuthFromSecretData(ref.URL, secret.Data); err != nil {
+			return nil, err
+		}
+	}
+	return &artifact.GitSource{URL: ref.URL, Branch: ref.Branch, Auth: auth}, nil
+}
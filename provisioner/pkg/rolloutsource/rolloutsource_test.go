@@ -0,0 +1,154 @@
+/*
+ Copyright (c) 2023 NTT Communications Corporation
+
+ Permission is hereby granted, free of charge, to any person obtaining a copy
+ of this software and associated documentation files (the "Software"), to deal
+ in the Software without restriction, including without limitation the rights
+ to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ copies of the Software, and to permit persons to whom the Software is
+ furnished to do so, subject to the following conditions:
+
+ The above copyright notice and this permission notice shall be included in
+ all copies or substantial portions of the Software.
+
+ THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ THE SOFTWARE.
+*/
+
+package rolloutsource_test
+
+import (
+	"context"
+	"testing"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/nttcom/kuesta/pkg/artifact"
+	"github.com/nttcom/kuesta/provisioner/api/v1alpha1"
+	"github.com/nttcom/kuesta/provisioner/pkg/rolloutsource"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNew(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(testScheme))
+	utilruntime.Must(sourcev1.AddToScheme(testScheme))
+	utilruntime.Must(v1alpha1.AddToScheme(testScheme))
+
+	a := &sourcev1.Artifact{Revision: "main/rev", URL: "http://example.com/latest.tar.gz"}
+	c := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+		&sourcev1.GitRepository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test"},
+			Status:     sourcev1.GitRepositoryStatus{Artifact: a},
+		},
+		&sourcev1.OCIRepository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "oci"},
+			Status:     sourcev1.OCIRepositoryStatus{Artifact: a},
+		},
+		&sourcev1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test"},
+			Status:     sourcev1.BucketStatus{Artifact: a},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "git-auth"},
+			Data: map[string][]byte{
+				artifact.SecretKeyUsername: []byte("user"),
+				artifact.SecretKeyPassword: []byte("pass"),
+			},
+		},
+	).Build()
+
+	tests := []struct {
+		name    string
+		given   *v1alpha1.SourceReference
+		want    artifact.Source
+		wantErr bool
+	}{
+		{
+			"git repository by default",
+			nil,
+			&artifact.FluxSource{Kind: sourcev1.GitRepositoryKind, Namespace: "test-ns", Name: "test", Artifact: a},
+			false,
+		},
+		{
+			"oci repository",
+			&v1alpha1.SourceReference{Kind: v1alpha1.SourceKindOCIRepository, Name: "oci"},
+			&artifact.FluxSource{Kind: sourcev1.OCIRepositoryKind, Namespace: "test-ns", Name: "oci", Artifact: a},
+			false,
+		},
+		{
+			"bucket",
+			&v1alpha1.SourceReference{Kind: v1alpha1.SourceKindBucket},
+			&artifact.FluxSource{Kind: sourcev1.BucketKind, Namespace: "test-ns", Name: "test", Artifact: a},
+			false,
+		},
+		{
+			"git",
+			&v1alpha1.SourceReference{Kind: v1alpha1.SourceKindGit, URL: "https://example.com/config.git", Branch: "dev", SecretName: "git-auth"},
+			&artifact.GitSource{URL: "https://example.com/config.git", Branch: "dev", Auth: &http.BasicAuth{Username: "user", Password: "pass"}},
+			false,
+		},
+		{
+			"err: source object not found",
+			&v1alpha1.SourceReference{Kind: v1alpha1.SourceKindOCIRepository, Name: "notfound"},
+			nil,
+			true,
+		},
+		{
+			"err: git url not given",
+			&v1alpha1.SourceReference{Kind: v1alpha1.SourceKindGit},
+			nil,
+			true,
+		},
+		{
+			"err: git auth secret not found",
+			&v1alpha1.SourceReference{Kind: v1alpha1.SourceKindGit, URL: "https://example.com/config.git", SecretName: "notfound"},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr := &v1alpha1.DeviceRollout{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test"},
+				Spec:       v1alpha1.DeviceRolloutSpec{Source: tt.given},
+			}
+			got, err := rolloutsource.New(context.Background(), c, dr)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("checksums recorded", func(t *testing.T) {
+		dr := &v1alpha1.DeviceRollout{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test"},
+			Status: v1alpha1.DeviceRolloutStatus{
+				ArtifactChecksums: []v1alpha1.ArtifactChecksum{{Revision: "main/old", Checksum: "checksum"}},
+			},
+		}
+		got, err := rolloutsource.New(context.Background(), c, dr)
+		assert.Nil(t, err)
+		assert.Equal(t, &artifact.FluxSource{
+			Kind:      sourcev1.GitRepositoryKind,
+			Namespace: "test-ns",
+			Name:      "test",
+			Artifact:  a,
+			Checksums: map[string]string{"main/old": "checksum"},
+		}, got)
+	})
+}